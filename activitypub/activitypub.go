@@ -0,0 +1,122 @@
+package activitypub
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/middleware"
+	"github.com/mikeydub/go-gallery/service/auth"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/spf13/viper"
+)
+
+// Init initializes the activitypub server, which lets users be followed from Mastodon and other fediverse servers.
+// Running it is optional: feed events are only sent to it when ACTIVITYPUB_URL is configured for the feed.
+func Init() {
+	setDefaults()
+
+	logger.InitWithGCPDefaults()
+	initSentry()
+
+	router := coreInit()
+	http.Handle("/", router)
+}
+
+func coreInit() *gin.Engine {
+	logger.For(nil).Info("initializing server...")
+
+	router := gin.Default()
+	router.Use(middleware.ErrLogger(), middleware.Sentry(true), middleware.Tracing())
+
+	if env.GetString("ENV") != "production" {
+		gin.SetMode(gin.DebugMode)
+	}
+
+	server, err := NewServer(
+		db.New(postgres.NewPgxClient()),
+		loadPrivateKey(),
+		env.GetString("ACTIVITYPUB_URL"),
+		env.GetString("ACTIVITYPUB_DOMAIN"),
+		env.GetString("GALLERY_HOST"),
+		env.GetString("ENV") == "local",
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	return handlersInit(router, server)
+}
+
+func setDefaults() {
+	viper.SetDefault("ENV", "local")
+	viper.SetDefault("POSTGRES_HOST", "0.0.0.0")
+	viper.SetDefault("POSTGRES_PORT", 5432)
+	viper.SetDefault("POSTGRES_USER", "gallery_backend")
+	viper.SetDefault("POSTGRES_PASSWORD", "")
+	viper.SetDefault("POSTGRES_DB", "postgres")
+	viper.SetDefault("SENTRY_DSN", "")
+	viper.SetDefault("GALLERY_HOST", "http://localhost:3000")
+	viper.SetDefault("ACTIVITYPUB_URL", "http://localhost:4125")
+	viper.SetDefault("ACTIVITYPUB_DOMAIN", "localhost:4125")
+	viper.SetDefault("ACTIVITYPUB_SECRET", "activitypub-secret")
+	viper.SetDefault("ACTIVITYPUB_PRIVATE_KEY", "")
+	viper.AutomaticEnv()
+
+	if env.GetString("ENV") != "local" {
+		util.VarNotSetTo("SENTRY_DSN", "")
+		util.VarNotSetTo("ACTIVITYPUB_PRIVATE_KEY", "")
+	}
+}
+
+// loadPrivateKey loads the key that requests to other servers are signed with. A temporary key is used when running
+// locally without one, but remote servers cache keys, so it should be configured anywhere that is federating.
+func loadPrivateKey() *rsa.PrivateKey {
+	if pem := env.GetString("ACTIVITYPUB_PRIVATE_KEY"); pem != "" {
+		key, err := parsePrivateKey(pem)
+		if err != nil {
+			panic(err)
+		}
+		return key
+	}
+
+	logger.For(nil).Warn("ACTIVITYPUB_PRIVATE_KEY is not set, generating a temporary key")
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		panic(err)
+	}
+	return key
+}
+
+func initSentry() {
+	if env.GetString("ENV") == "local" {
+		logger.For(nil).Info("skipping sentry init")
+		return
+	}
+
+	logger.For(nil).Info("initializing sentry...")
+
+	err := sentry.Init(sentry.ClientOptions{
+		Dsn:              env.GetString("SENTRY_DSN"),
+		Environment:      env.GetString("ENV"),
+		TracesSampleRate: env.GetFloat64("SENTRY_TRACES_SAMPLE_RATE"),
+		AttachStacktrace: true,
+		BeforeSend: func(event *sentry.Event, hint *sentry.EventHint) *sentry.Event {
+			event = auth.ScrubEventCookies(event, hint)
+			event = sentryutil.UpdateErrorFingerprints(event, hint)
+			return event
+		},
+	})
+
+	if err != nil {
+		logger.For(nil).Fatalf("failed to start sentry: %s", err)
+	}
+}
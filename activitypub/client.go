@@ -0,0 +1,200 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// maxDocumentSize is the largest document that is read from another server
+const maxDocumentSize = 1 << 20
+
+const (
+	// actorCacheSize is the number of remote actors whose keys are kept between requests
+	actorCacheSize = 10_000
+	// actorCacheTTL is how long a remote actor is kept before it's fetched again
+	actorCacheTTL = time.Hour
+)
+
+var errDocumentTooLarge = fmt.Errorf("document is larger than %d bytes", maxDocumentSize)
+
+type errRemoteRequest struct {
+	url    string
+	status int
+}
+
+func (e errRemoteRequest) Error() string {
+	return fmt.Sprintf("request to %s failed with status %d", e.url, e.status)
+}
+
+type errInsecureURL struct {
+	url string
+}
+
+func (e errInsecureURL) Error() string {
+	return fmt.Sprintf("refusing to make request to non-https url: %s", e.url)
+}
+
+type cachedActor struct {
+	actor     Actor
+	fetchedAt time.Time
+}
+
+// Client makes signed requests to other servers on behalf of local actors. Every actor shares the server's key.
+type Client struct {
+	http *http.Client
+	key  *rsa.PrivateKey
+	// allowHTTP allows requests to servers that aren't using https, which is only useful when running locally
+	allowHTTP bool
+	// actors are the remote actors that were fetched recently, so that their keys don't have to be fetched for
+	// every activity they send
+	actors *lru.Cache
+}
+
+func NewClient(key *rsa.PrivateKey, allowHTTP bool) *Client {
+	actors, err := lru.New(actorCacheSize)
+	if err != nil {
+		panic(err)
+	}
+
+	// Remote servers are chosen by whoever sends an activity, so requests can't be allowed to reach anything that
+	// isn't public. Servers run locally are the exception.
+	client := util.NewPublicHTTPClient(10 * time.Second)
+	if allowHTTP {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+
+	return &Client{
+		http:      client,
+		key:       key,
+		allowHTTP: allowHTTP,
+		actors:    actors,
+	}
+}
+
+// CachedActor returns a remote actor that was fetched recently
+func (c *Client) CachedActor(actorURI string) (Actor, bool) {
+	v, ok := c.actors.Get(actorURI)
+	if !ok {
+		return Actor{}, false
+	}
+	cached := v.(cachedActor)
+	if time.Since(cached.fetchedAt) > actorCacheTTL {
+		c.actors.Remove(actorURI)
+		return Actor{}, false
+	}
+	return cached.actor, true
+}
+
+// FetchActor retrieves a remote actor and caches it. The request is signed by the local actor for servers that
+// require it.
+func (c *Client) FetchActor(ctx context.Context, localActorID, actorURI string) (Actor, error) {
+	var actor Actor
+
+	if err := c.checkURL(actorURI); err != nil {
+		return actor, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, actorURI, nil)
+	if err != nil {
+		return actor, err
+	}
+	req.Header.Set("Accept", contentType)
+
+	if err := signRequest(req, nil, keyID(localActorID), c.key); err != nil {
+		return actor, err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return actor, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return actor, errRemoteRequest{url: actorURI, status: resp.StatusCode}
+	}
+
+	body, err := readDocument(resp.Body)
+	if err != nil {
+		return actor, err
+	}
+
+	if err := json.Unmarshal(body, &actor); err != nil {
+		return actor, err
+	}
+
+	c.actors.Add(actorURI, cachedActor{actor: actor, fetchedAt: time.Now()})
+
+	return actor, nil
+}
+
+// Deliver posts an activity to a remote inbox.
+func (c *Client) Deliver(ctx context.Context, localActorID, inbox string, activity Activity) error {
+	if err := c.checkURL(inbox); err != nil {
+		return err
+	}
+
+	body, err := json.Marshal(activity)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, inbox, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", contentType)
+
+	if err := signRequest(req, body, keyID(localActorID), c.key); err != nil {
+		return err
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return errRemoteRequest{url: inbox, status: resp.StatusCode}
+	}
+
+	return nil
+}
+
+func (c *Client) checkURL(rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+	if u.Scheme == "https" || (c.allowHTTP && u.Scheme == "http") {
+		return nil
+	}
+	return errInsecureURL{rawURL}
+}
+
+// readDocument reads a document sent by another server, refusing any that are larger than maxDocumentSize
+func readDocument(r io.Reader) ([]byte, error) {
+	body, err := io.ReadAll(io.LimitReader(r, maxDocumentSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(body) > maxDocumentSize {
+		return nil, errDocumentTooLarge
+	}
+	return body, nil
+}
+
+func keyID(actorID string) string {
+	return actorID + "#main-key"
+}
@@ -0,0 +1,54 @@
+package activitypub
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/mikeydub/go-gallery/env"
+)
+
+// taskRequired checks that the request came from Cloud Tasks.
+// Returns a 200 status in order to remove bad messages from the task queue.
+func taskRequired() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		taskName := c.Request.Header.Get("X-CloudTasks-TaskName")
+		if taskName == "" {
+			c.AbortWithError(http.StatusOK, errors.New("invalid task"))
+			return
+		}
+
+		queueName := c.Request.Header.Get("X-CloudTasks-QueueName")
+		if queueName == "" {
+			c.AbortWithError(http.StatusOK, errors.New("invalid queue"))
+			return
+		}
+
+		creds := c.Request.Header.Get("Authorization")
+		if creds != "Basic "+env.GetString("ACTIVITYPUB_SECRET") {
+			c.AbortWithError(http.StatusOK, errors.New("unauthorized request"))
+			return
+		}
+	}
+}
+
+func handlersInit(router *gin.Engine, s *Server) *gin.Engine {
+	router.GET("/ping", ping())
+	router.GET("/.well-known/webfinger", s.webFinger)
+
+	users := router.Group("/users/:username")
+	users.GET("", s.actor)
+	users.GET("/outbox", s.outbox)
+	users.GET("/followers", s.followers)
+	users.GET("/notes/:id", s.note)
+	users.POST("/inbox", s.inbox)
+
+	router.POST("/tasks/feed-event", taskRequired(), s.handleFeedEvent)
+	return router
+}
+
+func ping() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.JSON(http.StatusOK, gin.H{"ping": "pong"})
+	}
+}
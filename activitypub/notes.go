@@ -0,0 +1,294 @@
+package activitypub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/moderation"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// maxAttachments is the most media that is attached to a note, which is as many as Mastodon will show
+const maxAttachments = 4
+
+// outboxActions are the feed events that are published to remote followers
+var outboxActions = persist.ActionList{
+	persist.ActionGalleryUpdated,
+	persist.ActionCollectionCreated,
+	persist.ActionTokensAddedToCollection,
+	persist.ActionCollectorsNoteAddedToCollection,
+	persist.ActionCollectorsNoteAddedToToken,
+}
+
+func isPublished(action persist.Action) bool {
+	for _, it := range outboxActions {
+		if it == action {
+			return true
+		}
+	}
+	return false
+}
+
+// noteBuilder writes the content of a note one paragraph at a time, and keeps track of the tokens it mentions.
+type noteBuilder struct {
+	paragraphs []string
+	tokenIDs   persist.DBIDList
+}
+
+func (n *noteBuilder) add(format string, args ...interface{}) {
+	n.paragraphs = append(n.paragraphs, "<p>"+fmt.Sprintf(format, args...)+"</p>")
+}
+
+func (n *noteBuilder) quote(text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		n.add("%s", strings.ReplaceAll(html.EscapeString(text), "\n", "<br>"))
+	}
+}
+
+// createActivity wraps the note of a feed event in the Create activity that is delivered to followers.
+func (s *Server) createActivity(ctx context.Context, user db.User, event db.FeedEvent) (Activity, error) {
+	note, err := s.buildNote(ctx, user, event)
+	if err != nil {
+		return Activity{}, err
+	}
+
+	return Activity{
+		Context:   activityStreamsContext,
+		ID:        note.ID + "/activity",
+		Type:      typeCreate,
+		Actor:     note.AttributedTo,
+		Object:    note,
+		Published: &note.Published,
+		To:        note.To,
+		Cc:        note.Cc,
+	}, nil
+}
+
+// note renders a feed event as a public note with the media of its tokens attached.
+func (s *Server) buildNote(ctx context.Context, user db.User, event db.FeedEvent) (Note, error) {
+	username := user.Username.String
+	actorID := s.actorID(username)
+
+	var n noteBuilder
+	n.quote(event.Caption.String)
+
+	switch event.Action {
+	case persist.ActionCollectionCreated:
+		coll, _, err := s.collectionLink(ctx, username, event.Data.CollectionID)
+		if err != nil {
+			return Note{}, err
+		}
+		n.add("created a collection, %s", coll)
+		n.quote(event.Data.CollectionNewCollectorsNote)
+		n.tokenIDs = append(n.tokenIDs, event.Data.CollectionTokenIDs...)
+	case persist.ActionTokensAddedToCollection:
+		coll, _, err := s.collectionLink(ctx, username, event.Data.CollectionID)
+		if err != nil {
+			return Note{}, err
+		}
+		n.add("added %s to %s", pieces(len(event.Data.CollectionTokenIDs)), coll)
+		n.tokenIDs = append(n.tokenIDs, event.Data.CollectionTokenIDs...)
+	case persist.ActionCollectorsNoteAddedToCollection:
+		coll, _, err := s.collectionLink(ctx, username, event.Data.CollectionID)
+		if err != nil {
+			return Note{}, err
+		}
+		n.add("added a collector's note to %s", coll)
+		n.quote(event.Data.CollectionNewCollectorsNote)
+	case persist.ActionCollectorsNoteAddedToToken:
+		n.add("added a collector's note to a piece")
+		n.quote(event.Data.TokenNewCollectorsNote)
+		n.tokenIDs = append(n.tokenIDs, event.Data.TokenID)
+	case persist.ActionGalleryUpdated:
+		if err := s.writeGalleryUpdate(ctx, &n, username, event.Data); err != nil {
+			return Note{}, err
+		}
+	}
+
+	attachments, err := s.attachments(ctx, n.tokenIDs)
+	if err != nil {
+		return Note{}, err
+	}
+
+	return Note{
+		ID:           fmt.Sprintf("%s/notes/%s", actorID, event.ID),
+		Type:         typeNote,
+		AttributedTo: actorID,
+		Content:      strings.Join(n.paragraphs, ""),
+		URL:          s.profileURL(username),
+		Published:    event.EventTime,
+		To:           []string{publicCollection},
+		Cc:           []string{s.followersID(username)},
+		Attachment:   attachments,
+	}, nil
+}
+
+func (s *Server) writeGalleryUpdate(ctx context.Context, n *noteBuilder, username string, data persist.FeedEventData) error {
+	if data.GalleryName != "" {
+		n.add("updated their gallery, %s", html.EscapeString(data.GalleryName))
+	}
+
+	// New collections are written first in the order they were created, followed by any other updated collections
+	collectionIDs := make([]persist.DBID, 0, len(data.GalleryNewCollections))
+	isNew := make(map[persist.DBID]bool)
+	for _, id := range data.GalleryNewCollections {
+		collectionIDs = append(collectionIDs, id)
+		isNew[id] = true
+	}
+
+	var updated []persist.DBID
+	seen := make(map[persist.DBID]bool)
+	addUpdated := func(id persist.DBID) {
+		if !isNew[id] && !seen[id] {
+			updated = append(updated, id)
+			seen[id] = true
+		}
+	}
+	for id := range data.GalleryNewCollectionTokenIDs {
+		addUpdated(id)
+	}
+	for id := range data.GalleryNewCollectionCollectorsNotes {
+		addUpdated(id)
+	}
+	for id := range data.GalleryNewCollectionTokenCollectorsNotes {
+		addUpdated(id)
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i] < updated[j] })
+	collectionIDs = append(collectionIDs, updated...)
+
+	for _, collectionID := range collectionIDs {
+		coll, draft, err := s.collectionLink(ctx, username, collectionID)
+		if err != nil {
+			return err
+		}
+		if draft {
+			continue
+		}
+
+		tokenIDs := data.GalleryNewCollectionTokenIDs[collectionID]
+		if isNew[collectionID] {
+			n.add("created a collection, %s", coll)
+		} else if len(tokenIDs) > 0 {
+			n.add("added %s to %s", pieces(len(tokenIDs)), coll)
+		}
+		n.tokenIDs = append(n.tokenIDs, tokenIDs...)
+
+		if note, ok := data.GalleryNewCollectionCollectorsNotes[collectionID]; ok {
+			if !isNew[collectionID] {
+				n.add("added a collector's note to %s", coll)
+			}
+			n.quote(note)
+		}
+
+		tokenNotes := data.GalleryNewCollectionTokenCollectorsNotes[collectionID]
+		tokenNoteIDs := make([]persist.DBID, 0, len(tokenNotes))
+		for tokenID := range tokenNotes {
+			tokenNoteIDs = append(tokenNoteIDs, tokenID)
+		}
+		sort.Slice(tokenNoteIDs, func(i, j int) bool { return tokenNoteIDs[i] < tokenNoteIDs[j] })
+
+		for _, tokenID := range tokenNoteIDs {
+			n.add("added a collector's note to a piece in %s", coll)
+			n.quote(tokenNotes[tokenID])
+			n.tokenIDs = append(n.tokenIDs, tokenID)
+		}
+	}
+
+	return nil
+}
+
+// collectionLink returns a link to the collection on Gallery, named after the collection if it has a name. Drafts
+// aren't linked or named since only their owner can see them, and whether the collection is a draft is returned too.
+func (s *Server) collectionLink(ctx context.Context, username string, collectionID persist.DBID) (string, bool, error) {
+	name := "a collection"
+
+	collection, err := s.queries.GetCollectionById(ctx, collectionID)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return "", false, err
+	}
+	if err == nil && collection.Draft {
+		return name, true, nil
+	}
+	if err == nil && collection.Name.String != "" {
+		name = collection.Name.String
+	}
+
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(s.collectionURL(username, collectionID)), html.EscapeString(name)), false, nil
+}
+
+// attachments returns the media of the first few tokens that can be shown on other servers.
+func (s *Server) attachments(ctx context.Context, tokenIDs persist.DBIDList) ([]Attachment, error) {
+	if len(tokenIDs) == 0 {
+		return nil, nil
+	}
+
+	ids := make([]string, 0, len(tokenIDs))
+	seen := make(map[persist.DBID]bool)
+	for _, id := range tokenIDs {
+		if !seen[id] {
+			ids = append(ids, id.String())
+			seen[id] = true
+		}
+	}
+
+	tokens, err := s.queries.GetTokensByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	attachments := make([]Attachment, 0, maxAttachments)
+	for _, token := range tokens {
+		if len(attachments) == maxAttachments {
+			break
+		}
+		if attachment, ok := mediaAttachment(token.Name.String, token.Media); ok {
+			attachments = append(attachments, attachment)
+		}
+	}
+
+	return attachments, nil
+}
+
+// mediaAttachment converts token media to an attachment. Media that other servers can't show, like html or
+// 3D models, is attached by its thumbnail instead.
+func mediaAttachment(name string, media persist.Media) (Attachment, bool) {
+	attachment := Attachment{
+		Name:   name,
+		Width:  media.Dimensions.Width,
+		Height: media.Dimensions.Height,
+	}
+
+	// SVGs that weren't cached couldn't be sanitized
+	if media.MediaType == persist.MediaTypeSVG && !moderation.CanServeSVG(media.MediaURL.String()) {
+		return attachment, false
+	}
+
+	switch media.MediaType {
+	case persist.MediaTypeImage, persist.MediaTypeGIF:
+		attachment.Type, attachment.URL = typeImage, media.MediaURL.String()
+	case persist.MediaTypeVideo:
+		attachment.Type, attachment.URL = typeVideo, media.MediaURL.String()
+	case persist.MediaTypeAudio:
+		attachment.Type, attachment.URL = typeAudio, media.MediaURL.String()
+	}
+
+	if attachment.URL == "" && media.ThumbnailURL != "" {
+		attachment.Type, attachment.URL = typeImage, media.ThumbnailURL.String()
+		attachment.Width, attachment.Height = 0, 0
+	}
+
+	return attachment, attachment.URL != ""
+}
+
+func pieces(count int) string {
+	if count == 1 {
+		return "a piece"
+	}
+	return fmt.Sprintf("%d pieces", count)
+}
@@ -0,0 +1,533 @@
+package activitypub
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/sirupsen/logrus"
+)
+
+// outboxPageSize is the number of activities on each page of an outbox
+const outboxPageSize = 20
+
+// defaultCursorBeforeTime is where the first page of an outbox starts
+var defaultCursorBeforeTime = time.Date(3000, 1, 1, 1, 1, 1, 1, time.UTC)
+
+var errUnknownActor = errors.New("activity was not sent to this actor")
+var errActorMismatch = errors.New("activity was not signed by its actor")
+
+// store is the subset of queries that the server runs, so that the server can be tested without a database.
+type store interface {
+	AddActivityPubFollower(ctx context.Context, arg db.AddActivityPubFollowerParams) error
+	CountActivityPubFollowers(ctx context.Context, userID persist.DBID) (int64, error)
+	CountActivityPubOutbox(ctx context.Context, arg db.CountActivityPubOutboxParams) (int64, error)
+	GetActivityPubFeedEventByID(ctx context.Context, id persist.DBID) (db.FeedEvent, error)
+	GetActivityPubFollowerInboxes(ctx context.Context, userID persist.DBID) ([]string, error)
+	GetCollectionById(ctx context.Context, id persist.DBID) (db.Collection, error)
+	GetTokensByIDs(ctx context.Context, tokenIds []string) ([]db.Token, error)
+	GetUserById(ctx context.Context, id persist.DBID) (db.User, error)
+	GetUserByUsername(ctx context.Context, username string) (db.User, error)
+	PaginateActivityPubOutbox(ctx context.Context, arg db.PaginateActivityPubOutboxParams) ([]db.FeedEvent, error)
+	RemoveActivityPubFollower(ctx context.Context, arg db.RemoveActivityPubFollowerParams) error
+}
+
+// Server exposes Gallery users as ActivityPub actors so that they can be followed from Mastodon and other servers
+// on the fediverse.
+type Server struct {
+	queries store
+	client  *Client
+	// baseURL is where the server is hosted, e.g. https://social.gallery.so
+	baseURL string
+	// domain is the domain of account handles, e.g. gallery.so in @username@gallery.so
+	domain string
+	// galleryHost is where the Gallery profile of each actor is linked to
+	galleryHost  string
+	publicKeyPem string
+}
+
+func NewServer(queries store, key *rsa.PrivateKey, baseURL, domain, galleryHost string, allowHTTP bool) (*Server, error) {
+	publicKeyPem, err := encodePublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Server{
+		queries:      queries,
+		client:       NewClient(key, allowHTTP),
+		baseURL:      strings.TrimSuffix(baseURL, "/"),
+		domain:       strings.ToLower(domain),
+		galleryHost:  strings.TrimSuffix(galleryHost, "/"),
+		publicKeyPem: publicKeyPem,
+	}, nil
+}
+
+func (s *Server) actorID(username string) string {
+	return fmt.Sprintf("%s/users/%s", s.baseURL, username)
+}
+
+func (s *Server) outboxID(username string) string {
+	return s.actorID(username) + "/outbox"
+}
+
+func (s *Server) followersID(username string) string {
+	return s.actorID(username) + "/followers"
+}
+
+func (s *Server) profileURL(username string) string {
+	return fmt.Sprintf("%s/%s", s.galleryHost, username)
+}
+
+func (s *Server) collectionURL(username string, collectionID persist.DBID) string {
+	return fmt.Sprintf("%s/%s", s.profileURL(username), collectionID)
+}
+
+// webFinger resolves an account handle like acct:username@gallery.so, or the URL of an actor, to the actor.
+func (s *Server) webFinger(c *gin.Context) {
+	resource := c.Query("resource")
+
+	var username string
+	if acct := strings.TrimPrefix(resource, "acct:"); acct != resource {
+		name, domain, ok := strings.Cut(strings.TrimPrefix(acct, "@"), "@")
+		if !ok || strings.ToLower(domain) != s.domain {
+			c.JSON(http.StatusNotFound, util.ErrorResponse{Error: "unknown domain"})
+			return
+		}
+		username = name
+	} else if name := strings.TrimPrefix(resource, s.actorID("")); name != resource {
+		username = name
+	} else {
+		c.JSON(http.StatusBadRequest, util.ErrorResponse{Error: "resource must be an acct: handle or actor url"})
+		return
+	}
+
+	user, ok := s.user(c, username)
+	if !ok {
+		return
+	}
+
+	name := user.Username.String
+	render(c, http.StatusOK, jrdContentType, WebFinger{
+		Subject: fmt.Sprintf("acct:%s@%s", name, s.domain),
+		Aliases: []string{s.actorID(name), s.profileURL(name)},
+		Links: []WebFingerLink{
+			{Rel: "self", Type: contentType, Href: s.actorID(name)},
+			{Rel: "http://webfinger.net/rel/profile-page", Type: "text/html", Href: s.profileURL(name)},
+		},
+	})
+}
+
+func (s *Server) actor(c *gin.Context) {
+	user, ok := s.user(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	name := user.Username.String
+	id := s.actorID(name)
+
+	render(c, http.StatusOK, contentType, Actor{
+		Context:           []string{activityStreamsContext, securityContext},
+		ID:                id,
+		Type:              typePerson,
+		PreferredUsername: name,
+		Name:              name,
+		Summary:           user.Bio.String,
+		URL:               s.profileURL(name),
+		Inbox:             id + "/inbox",
+		Outbox:            s.outboxID(name),
+		Followers:         s.followersID(name),
+		PublicKey: PublicKey{
+			ID:           keyID(id),
+			Owner:        id,
+			PublicKeyPem: s.publicKeyPem,
+		},
+		Discoverable: true,
+	})
+}
+
+// outbox lists the feed events of a user, newest first. The collection itself only has the total, and its pages
+// are requested with ?page=true. Each page links to the next with the ID of its last feed event.
+func (s *Server) outbox(c *gin.Context) {
+	user, ok := s.user(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	name := user.Username.String
+	outboxID := s.outboxID(name)
+
+	if c.Query("page") == "" {
+		total, err := s.queries.CountActivityPubOutbox(c, db.CountActivityPubOutboxParams{
+			OwnerID: user.ID,
+			Actions: outboxActions,
+		})
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		render(c, http.StatusOK, contentType, OrderedCollection{
+			Context:    []string{activityStreamsContext},
+			ID:         outboxID,
+			Type:       typeOrderedCollection,
+			TotalItems: total,
+			First:      outboxID + "?page=true",
+		})
+		return
+	}
+
+	params := db.PaginateActivityPubOutboxParams{
+		OwnerID:       user.ID,
+		Actions:       outboxActions,
+		CurBeforeTime: defaultCursorBeforeTime,
+		Limit:         outboxPageSize,
+	}
+
+	pageID := outboxID + "?page=true"
+	if maxID := c.Query("max_id"); maxID != "" {
+		cursor, err := s.queries.GetActivityPubFeedEventByID(c, persist.DBID(maxID))
+		if err != nil || cursor.OwnerID != user.ID {
+			c.JSON(http.StatusBadRequest, util.ErrorResponse{Error: "invalid max_id"})
+			return
+		}
+		params.CurBeforeTime = cursor.EventTime
+		params.CurBeforeID = cursor.ID
+		pageID += "&max_id=" + maxID
+	}
+
+	events, err := s.queries.PaginateActivityPubOutbox(c, params)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	page := OrderedCollectionPage{
+		Context:      []string{activityStreamsContext},
+		ID:           pageID,
+		Type:         typeOrderedCollectionPage,
+		PartOf:       outboxID,
+		OrderedItems: make([]Activity, 0, len(events)),
+	}
+
+	for _, event := range events {
+		activity, err := s.createActivity(c, user, event)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+		// The page already has a context, so each activity doesn't need its own
+		activity.Context = nil
+		page.OrderedItems = append(page.OrderedItems, activity)
+	}
+
+	if len(events) == outboxPageSize {
+		page.Next = fmt.Sprintf("%s?page=true&max_id=%s", outboxID, events[len(events)-1].ID)
+	}
+
+	render(c, http.StatusOK, contentType, page)
+}
+
+// note serves a single note so that other servers can look it up by its ID.
+func (s *Server) note(c *gin.Context) {
+	user, ok := s.user(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	event, err := s.queries.GetActivityPubFeedEventByID(c, persist.DBID(c.Param("id")))
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && (event.OwnerID != user.ID || !isPublished(event.Action))) {
+		c.JSON(http.StatusNotFound, util.ErrorResponse{Error: "note not found"})
+		return
+	}
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	note, err := s.buildNote(c, user, event)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	note.Context = []string{activityStreamsContext}
+	render(c, http.StatusOK, contentType, note)
+}
+
+func (s *Server) followers(c *gin.Context) {
+	user, ok := s.user(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	total, err := s.queries.CountActivityPubFollowers(c, user.ID)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	render(c, http.StatusOK, contentType, OrderedCollection{
+		Context:    []string{activityStreamsContext},
+		ID:         s.followersID(user.Username.String),
+		Type:       typeOrderedCollection,
+		TotalItems: total,
+	})
+}
+
+// inbox receives activities from other servers. Every activity has to be signed by the actor that sent it.
+// Follows are accepted straight away, and anything other than a follow or its undo is ignored.
+func (s *Server) inbox(c *gin.Context) {
+	user, ok := s.user(c, c.Param("username"))
+	if !ok {
+		return
+	}
+
+	localActorID := s.actorID(user.Username.String)
+
+	body, err := readDocument(c.Request.Body)
+	if errors.Is(err, errDocumentTooLarge) {
+		util.ErrResponse(c, http.StatusRequestEntityTooLarge, err)
+		return
+	}
+	if err != nil {
+		util.ErrResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	remote, err := s.verifySender(c, localActorID, body)
+	if err != nil {
+		util.ErrResponse(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	var activity Activity
+	if err := json.Unmarshal(body, &activity); err != nil {
+		util.ErrResponse(c, http.StatusBadRequest, err)
+		return
+	}
+
+	if activity.Actor != remote.ID {
+		util.ErrResponse(c, http.StatusUnauthorized, errActorMismatch)
+		return
+	}
+
+	switch activity.Type {
+	case typeFollow:
+		err = s.acceptFollow(c, user, localActorID, remote, activity)
+	case typeUndo:
+		err = s.undo(c, user, remote, activity)
+	default:
+		logger.For(c).WithFields(logrus.Fields{"type": activity.Type, "actor": remote.ID}).Debug("ignoring activity")
+	}
+
+	if errors.Is(err, errUnknownActor) {
+		util.ErrResponse(c, http.StatusBadRequest, err)
+		return
+	}
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Status(http.StatusAccepted)
+}
+
+// verifySender checks the signature of a request against the key of the remote actor that signed it. The actor's
+// key is cached, and fetched again if the signature doesn't match it in case the actor has rotated its key.
+func (s *Server) verifySender(c *gin.Context, localActorID string, body []byte) (Actor, error) {
+	sig, err := parseSignature(c.Request)
+	if err != nil {
+		return Actor{}, err
+	}
+
+	actorURI, _, _ := strings.Cut(sig.keyID, "#")
+
+	if remote, ok := s.client.CachedActor(actorURI); ok {
+		if err := verifyActorSignature(c.Request, body, sig, remote); err == nil {
+			return remote, nil
+		}
+	}
+
+	remote, err := s.client.FetchActor(c, localActorID, actorURI)
+	if err != nil {
+		return Actor{}, err
+	}
+
+	if err := verifyActorSignature(c.Request, body, sig, remote); err != nil {
+		return Actor{}, err
+	}
+
+	return remote, nil
+}
+
+func verifyActorSignature(r *http.Request, body []byte, sig signature, remote Actor) error {
+	if remote.PublicKey.ID != sig.keyID || remote.PublicKey.Owner != remote.ID {
+		return errInvalidSignature{"key does not belong to actor"}
+	}
+
+	key, err := parsePublicKey(remote.PublicKey.PublicKeyPem)
+	if err != nil {
+		return err
+	}
+
+	return verifyRequest(r, body, sig, key)
+}
+
+func (s *Server) acceptFollow(ctx context.Context, user db.User, localActorID string, remote Actor, follow Activity) error {
+	if objectID(follow.Object) != localActorID {
+		return errUnknownActor
+	}
+
+	sharedInbox := ""
+	if remote.Endpoints != nil {
+		sharedInbox = remote.Endpoints.SharedInbox
+	}
+
+	err := s.queries.AddActivityPubFollower(ctx, db.AddActivityPubFollowerParams{
+		ID:          persist.GenerateID(),
+		UserID:      user.ID,
+		ActorUri:    remote.ID,
+		Inbox:       remote.Inbox,
+		SharedInbox: sharedInbox,
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.client.Deliver(ctx, localActorID, remote.Inbox, Activity{
+		Context: activityStreamsContext,
+		ID:      fmt.Sprintf("%s/accepts/%s", localActorID, persist.GenerateID()),
+		Type:    typeAccept,
+		Actor:   localActorID,
+		Object:  follow,
+	})
+}
+
+func (s *Server) undo(ctx context.Context, user db.User, remote Actor, undo Activity) error {
+	var follow Activity
+	if err := decodeObject(undo.Object, &follow); err != nil || follow.Type != typeFollow {
+		// Only follows can be undone, so anything else is ignored
+		return nil
+	}
+
+	if follow.Actor != remote.ID {
+		return errActorMismatch
+	}
+
+	return s.queries.RemoveActivityPubFollower(ctx, db.RemoveActivityPubFollowerParams{
+		UserID:   user.ID,
+		ActorUri: remote.ID,
+	})
+}
+
+// handleFeedEvent delivers a newly published feed event to the remote followers of its owner.
+func (s *Server) handleFeedEvent(c *gin.Context) {
+	message := task.ActivityPubMessage{}
+
+	if err := c.ShouldBindJSON(&message); err != nil {
+		util.ErrResponse(c, http.StatusOK, err)
+		return
+	}
+
+	event, err := s.queries.GetActivityPubFeedEventByID(c, message.FeedEventID)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && !isPublished(event.Action)) {
+		c.JSON(http.StatusOK, gin.H{"msg": fmt.Sprintf("event=%s is not published", message.FeedEventID)})
+		return
+	}
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	user, err := s.queries.GetUserById(c, event.OwnerID)
+	if err != nil {
+		util.ErrResponse(c, http.StatusOK, err)
+		return
+	}
+
+	activity, err := s.createActivity(c, user, event)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	inboxes, err := s.queries.GetActivityPubFollowerInboxes(c, user.ID)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	// The task is retried if any delivery fails. Servers that already have the activity ignore it the second time.
+	var failed int
+	for _, inbox := range inboxes {
+		if err := s.client.Deliver(c, activity.Actor, inbox, activity); err != nil {
+			logger.For(c).WithFields(logrus.Fields{"feedEventID": event.ID, "inbox": inbox}).Errorf("failed to deliver activity: %s", err)
+			sentryutil.ReportError(c, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		util.ErrResponse(c, http.StatusInternalServerError, fmt.Errorf("failed to deliver event=%s to %d of %d inboxes", event.ID, failed, len(inboxes)))
+		return
+	}
+
+	c.JSON(http.StatusOK, gin.H{"msg": fmt.Sprintf("event=%s delivered to %d inboxes", event.ID, len(inboxes))})
+}
+
+// user looks up the user of a request, and writes a not found response if there isn't one.
+func (s *Server) user(c *gin.Context, username string) (db.User, bool) {
+	user, err := s.queries.GetUserByUsername(c, username)
+	if errors.Is(err, pgx.ErrNoRows) {
+		c.JSON(http.StatusNotFound, util.ErrorResponse{Error: "user not found"})
+		return user, false
+	}
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return user, false
+	}
+	return user, true
+}
+
+func render(c *gin.Context, status int, contentType string, obj interface{}) {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+		return
+	}
+	c.Data(status, contentType, b)
+}
+
+// objectID returns the ID of an object that is either embedded or referred to by its ID.
+func objectID(obj interface{}) string {
+	switch o := obj.(type) {
+	case string:
+		return o
+	case map[string]interface{}:
+		id, _ := o["id"].(string)
+		return id
+	}
+	return ""
+}
+
+func decodeObject(obj interface{}, v interface{}) error {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
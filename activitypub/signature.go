@@ -0,0 +1,218 @@
+package activitypub
+
+import (
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// maxClockSkew is how far the date of a signed request can be from now before the signature is rejected
+const maxClockSkew = 12 * time.Hour
+
+var errMissingSignature = errors.New("request is not signed")
+
+type errInvalidSignature struct {
+	reason string
+}
+
+func (e errInvalidSignature) Error() string {
+	return fmt.Sprintf("invalid signature: %s", e.reason)
+}
+
+// signature is a parsed HTTP signature (https://datatracker.ietf.org/doc/html/draft-cavage-http-signatures-12),
+// which is what servers on the fediverse use to authenticate requests to each other.
+type signature struct {
+	keyID     string
+	algorithm string
+	headers   []string
+	signature []byte
+}
+
+// signRequest signs the request with the key of keyID. The body is covered by the signature through its digest.
+func signRequest(req *http.Request, body []byte, keyID string, key *rsa.PrivateKey) error {
+	headers := []string{"(request-target)", "host", "date"}
+
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	if body != nil {
+		req.Header.Set("Digest", digest(body))
+		headers = append(headers, "digest")
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(req, headers)))
+	sig, err := rsa.SignPKCS1v15(nil, key, crypto.SHA256, hashed[:])
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Signature", fmt.Sprintf(`keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(sig),
+	))
+
+	return nil
+}
+
+// parseSignature reads the signature of a request without verifying it, so that the key it was signed with can be looked up.
+func parseSignature(req *http.Request) (signature, error) {
+	header := req.Header.Get("Signature")
+	if header == "" {
+		return signature{}, errMissingSignature
+	}
+
+	var sig signature
+	for _, param := range strings.Split(header, ",") {
+		k, v, ok := strings.Cut(strings.TrimSpace(param), "=")
+		if !ok {
+			return signature{}, errInvalidSignature{"malformed parameter"}
+		}
+		v = strings.Trim(v, `"`)
+		switch k {
+		case "keyId":
+			sig.keyID = v
+		case "algorithm":
+			sig.algorithm = v
+		case "headers":
+			sig.headers = strings.Fields(strings.ToLower(v))
+		case "signature":
+			decoded, err := base64.StdEncoding.DecodeString(v)
+			if err != nil {
+				return signature{}, errInvalidSignature{"signature is not base64"}
+			}
+			sig.signature = decoded
+		}
+	}
+
+	if sig.keyID == "" || len(sig.signature) == 0 {
+		return signature{}, errInvalidSignature{"missing keyId or signature"}
+	}
+
+	// Only the date is signed when headers isn't set, which isn't enough to tie the signature to the request
+	if len(sig.headers) == 0 {
+		sig.headers = []string{"date"}
+	}
+
+	return sig, nil
+}
+
+// verifyRequest checks that the request was signed by the key, and that the signature covers its target, date and body.
+func verifyRequest(req *http.Request, body []byte, sig signature, key *rsa.PublicKey) error {
+	if sig.algorithm != "" && sig.algorithm != "rsa-sha256" && sig.algorithm != "hs2019" {
+		return errInvalidSignature{fmt.Sprintf("unsupported algorithm %s", sig.algorithm)}
+	}
+
+	required := []string{"(request-target)", "host", "date"}
+	if body != nil {
+		required = append(required, "digest")
+	}
+	for _, header := range required {
+		if !util.ContainsString(sig.headers, header) {
+			return errInvalidSignature{fmt.Sprintf("%s is not signed", header)}
+		}
+	}
+
+	date, err := http.ParseTime(req.Header.Get("Date"))
+	if err != nil {
+		return errInvalidSignature{"invalid date"}
+	}
+	if skew := time.Since(date); skew > maxClockSkew || skew < -maxClockSkew {
+		return errInvalidSignature{"date is too far from now"}
+	}
+
+	if body != nil && req.Header.Get("Digest") != digest(body) {
+		return errInvalidSignature{"digest does not match body"}
+	}
+
+	hashed := sha256.Sum256([]byte(signingString(req, sig.headers)))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, hashed[:], sig.signature); err != nil {
+		return errInvalidSignature{"signature does not match"}
+	}
+
+	return nil
+}
+
+func signingString(req *http.Request, headers []string) string {
+	lines := make([]string, len(headers))
+	for i, header := range headers {
+		switch header {
+		case "(request-target)":
+			lines[i] = fmt.Sprintf("(request-target): %s %s", strings.ToLower(req.Method), req.URL.RequestURI())
+		case "host":
+			host := req.Host
+			if host == "" {
+				host = req.URL.Host
+			}
+			lines[i] = fmt.Sprintf("host: %s", host)
+		default:
+			lines[i] = fmt.Sprintf("%s: %s", header, req.Header.Get(header))
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func digest(body []byte) string {
+	sum := sha256.Sum256(body)
+	return "SHA-256=" + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func encodePublicKey(key *rsa.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(key)
+	if err != nil {
+		return "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), nil
+}
+
+func parsePublicKey(s string) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("public key is not PEM encoded")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PublicKey)
+	if !ok {
+		return nil, errors.New("public key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
+
+func parsePrivateKey(s string) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(s))
+	if block == nil {
+		return nil, errors.New("private key is not PEM encoded")
+	}
+
+	if block.Type == "RSA PRIVATE KEY" {
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("private key is not an RSA key")
+	}
+
+	return rsaKey, nil
+}
@@ -0,0 +1,540 @@
+package activitypub
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"database/sql"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const localHost = "http://example.com"
+
+func TestWebFinger_Success(t *testing.T) {
+	f := newFixture(t)
+
+	for _, resource := range []string{"acct:alice@gallery.so", "acct:Alice@Gallery.so", localHost + "/users/alice"} {
+		resp := f.get(t, "/.well-known/webfinger?resource="+resource)
+		require.Equal(t, http.StatusOK, resp.Code, resource)
+
+		var actual WebFinger
+		require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &actual))
+		assert.Equal(t, "acct:alice@gallery.so", actual.Subject)
+		assert.Equal(t, localHost+"/users/alice", actual.Links[0].Href)
+	}
+}
+
+func TestWebFinger_Failure(t *testing.T) {
+	f := newFixture(t)
+
+	assert.Equal(t, http.StatusNotFound, f.get(t, "/.well-known/webfinger?resource=acct:alice@elsewhere.social").Code)
+	assert.Equal(t, http.StatusNotFound, f.get(t, "/.well-known/webfinger?resource=acct:nobody@gallery.so").Code)
+	assert.Equal(t, http.StatusBadRequest, f.get(t, "/.well-known/webfinger?resource=alice").Code)
+}
+
+func TestActor_Success(t *testing.T) {
+	f := newFixture(t)
+
+	resp := f.get(t, "/users/alice")
+	require.Equal(t, http.StatusOK, resp.Code)
+	assert.Equal(t, contentType, resp.Header().Get("Content-Type"))
+
+	var actual Actor
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &actual))
+	assert.Equal(t, localHost+"/users/alice/inbox", actual.Inbox)
+	assert.Equal(t, localHost+"/users/alice#main-key", actual.PublicKey.ID)
+
+	key, err := parsePublicKey(actual.PublicKey.PublicKeyPem)
+	require.NoError(t, err)
+	assert.True(t, key.Equal(&f.key.PublicKey))
+}
+
+func TestInbox_Success(t *testing.T) {
+	t.Run("follow is accepted", func(t *testing.T) {
+		f := newFixture(t)
+		follow := f.remote.activity(typeFollow, localHost+"/users/alice")
+
+		resp := f.postSigned(t, "/users/alice/inbox", follow, f.remote.key, f.remote.keyID())
+
+		require.Equal(t, http.StatusAccepted, resp.Code)
+		assert.Equal(t, []string{f.remote.sharedInbox()}, f.store.inboxes())
+
+		accept := f.remote.received(t)
+		assert.Equal(t, typeAccept, accept.Type)
+		assert.Equal(t, localHost+"/users/alice", accept.Actor)
+		assert.Equal(t, follow.ID, objectID(accept.Object))
+	})
+
+	t.Run("undo removes the follower", func(t *testing.T) {
+		f := newFixture(t)
+		follow := f.remote.activity(typeFollow, localHost+"/users/alice")
+		f.postSigned(t, "/users/alice/inbox", follow, f.remote.key, f.remote.keyID())
+		f.remote.received(t)
+
+		resp := f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeUndo, follow), f.remote.key, f.remote.keyID())
+
+		require.Equal(t, http.StatusAccepted, resp.Code)
+		assert.Empty(t, f.store.inboxes())
+	})
+
+	t.Run("the sender's key is cached", func(t *testing.T) {
+		f := newFixture(t)
+		for i := 0; i < 2; i++ {
+			resp := f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeFollow, localHost+"/users/alice"), f.remote.key, f.remote.keyID())
+			require.Equal(t, http.StatusAccepted, resp.Code)
+			f.remote.received(t)
+		}
+
+		assert.Equal(t, 1, f.remote.actorFetches())
+	})
+
+	t.Run("a rotated key is fetched again", func(t *testing.T) {
+		f := newFixture(t)
+		f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeFollow, localHost+"/users/alice"), f.remote.key, f.remote.keyID())
+		f.remote.received(t)
+		key := f.remote.rotateKey(t)
+
+		resp := f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeFollow, localHost+"/users/alice"), key, f.remote.keyID())
+
+		require.Equal(t, http.StatusAccepted, resp.Code)
+		assert.Equal(t, 2, f.remote.actorFetches())
+	})
+}
+
+func TestInbox_Failure(t *testing.T) {
+	t.Run("unsigned follow is rejected", func(t *testing.T) {
+		f := newFixture(t)
+		body, _ := json.Marshal(f.remote.activity(typeFollow, localHost+"/users/alice"))
+
+		resp := f.serve(httptest.NewRequest(http.MethodPost, "/users/alice/inbox", bytes.NewReader(body)))
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, f.store.inboxes())
+	})
+
+	t.Run("follow signed with another key is rejected", func(t *testing.T) {
+		f := newFixture(t)
+		other, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+
+		resp := f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeFollow, localHost+"/users/alice"), other, f.remote.keyID())
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, f.store.inboxes())
+	})
+
+	t.Run("follow on behalf of another actor is rejected", func(t *testing.T) {
+		f := newFixture(t)
+		follow := f.remote.activity(typeFollow, localHost+"/users/alice")
+		follow.Actor = f.remote.server.URL + "/users/mallory"
+
+		resp := f.postSigned(t, "/users/alice/inbox", follow, f.remote.key, f.remote.keyID())
+
+		assert.Equal(t, http.StatusUnauthorized, resp.Code)
+		assert.Empty(t, f.store.inboxes())
+	})
+
+	t.Run("oversized activity is rejected", func(t *testing.T) {
+		f := newFixture(t)
+
+		resp := f.serve(httptest.NewRequest(http.MethodPost, "/users/alice/inbox", bytes.NewReader(make([]byte, maxDocumentSize+1))))
+
+		assert.Equal(t, http.StatusRequestEntityTooLarge, resp.Code)
+	})
+
+	t.Run("follow of another actor is rejected", func(t *testing.T) {
+		f := newFixture(t)
+
+		resp := f.postSigned(t, "/users/alice/inbox", f.remote.activity(typeFollow, localHost+"/users/bob"), f.remote.key, f.remote.keyID())
+
+		assert.Equal(t, http.StatusBadRequest, resp.Code)
+		assert.Empty(t, f.store.inboxes())
+	})
+}
+
+func TestClient_Failure(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	t.Run("refuses to fetch from addresses that aren't public", func(t *testing.T) {
+		fetched := false
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			fetched = true
+		}))
+		defer server.Close()
+
+		_, err := NewClient(key, false).FetchActor(context.Background(), localHost+"/users/alice", server.URL+"/users/bob")
+
+		assert.ErrorIs(t, err, util.ErrNonPublicAddress)
+		assert.False(t, fetched)
+	})
+
+	t.Run("refuses oversized actors", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+			w.Write(bytes.Repeat([]byte(" "), maxDocumentSize+1))
+		}))
+		defer server.Close()
+
+		_, err := NewClient(key, true).FetchActor(context.Background(), localHost+"/users/alice", server.URL+"/users/bob")
+
+		assert.ErrorIs(t, err, errDocumentTooLarge)
+	})
+}
+
+func TestOutbox_Success(t *testing.T) {
+	f := newFixture(t)
+
+	resp := f.get(t, "/users/alice/outbox")
+	require.Equal(t, http.StatusOK, resp.Code)
+	var collection OrderedCollection
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &collection))
+	assert.Equal(t, int64(1), collection.TotalItems)
+
+	resp = f.get(t, "/users/alice/outbox?page=true")
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	var page struct {
+		OrderedItems []struct {
+			Type   string `json:"type"`
+			Object Note   `json:"object"`
+		} `json:"orderedItems"`
+	}
+	require.NoError(t, json.Unmarshal(resp.Body.Bytes(), &page))
+	require.Len(t, page.OrderedItems, 1)
+
+	create := page.OrderedItems[0]
+	assert.Equal(t, typeCreate, create.Type)
+	assert.Equal(t, localHost+"/users/alice/notes/event", create.Object.ID)
+	assert.Contains(t, create.Object.Content, `created a collection, <a href="https://gallery.so/alice/collection">Pets &amp; Friends</a>`)
+	assert.Contains(t, create.Object.Content, "<p>my &lt;3 collection</p>")
+	assert.Equal(t, []Attachment{
+		{Type: typeImage, URL: "https://media/cat.png", Name: "cat", Width: 100, Height: 100},
+		{Type: typeImage, URL: "https://media/dog-thumbnail.png", Name: "dog"},
+	}, create.Object.Attachment)
+}
+
+func TestHandleFeedEvent_Success(t *testing.T) {
+	f := newFixture(t)
+	f.store.followers["https://elsewhere/users/bob"] = db.AddActivityPubFollowerParams{Inbox: f.remote.inbox()}
+	viper.Set("ACTIVITYPUB_SECRET", "secret")
+
+	req := httptest.NewRequest(http.MethodPost, "/tasks/feed-event", bytes.NewReader([]byte(`{"id": "event"}`)))
+	req.Header.Set("X-CloudTasks-TaskName", "task")
+	req.Header.Set("X-CloudTasks-QueueName", "queue")
+	req.Header.Set("Authorization", "Basic secret")
+	resp := f.serve(req)
+
+	require.Equal(t, http.StatusOK, resp.Code, resp.Body.String())
+	create := f.remote.received(t)
+	assert.Equal(t, typeCreate, create.Type)
+	assert.Equal(t, localHost+"/users/alice/notes/event/activity", create.ID)
+}
+
+func TestSignature_Success(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	body := []byte(`{"type": "Follow"}`)
+
+	req := httptest.NewRequest(http.MethodPost, "http://example.com/users/alice/inbox", bytes.NewReader(body))
+	require.NoError(t, signRequest(req, body, "key", key))
+
+	sig, err := parseSignature(req)
+	require.NoError(t, err)
+	assert.Equal(t, "key", sig.keyID)
+	assert.NoError(t, verifyRequest(req, body, sig, &key.PublicKey))
+	assert.Error(t, verifyRequest(req, []byte(`{"type": "Undo"}`), sig, &key.PublicKey))
+
+	req.Header.Set("Date", time.Now().Add(-24*time.Hour).UTC().Format(http.TimeFormat))
+	assert.Error(t, verifyRequest(req, body, sig, &key.PublicKey))
+}
+
+func TestMediaAttachment_Success(t *testing.T) {
+	tests := []struct {
+		media    persist.Media
+		expected Attachment
+		ok       bool
+	}{
+		{persist.Media{MediaType: persist.MediaTypeVideo, MediaURL: "https://video.mp4"}, Attachment{Type: typeVideo, URL: "https://video.mp4"}, true},
+		{persist.Media{MediaType: persist.MediaTypeAudio, MediaURL: "https://audio.mp3"}, Attachment{Type: typeAudio, URL: "https://audio.mp3"}, true},
+		{persist.Media{MediaType: persist.MediaTypeHTML, MediaURL: "https://page.html", ThumbnailURL: "https://thumb.png"}, Attachment{Type: typeImage, URL: "https://thumb.png"}, true},
+		{persist.Media{MediaType: persist.MediaTypeHTML, MediaURL: "https://page.html"}, Attachment{}, false},
+		{persist.Media{MediaType: persist.MediaTypeSVG, MediaURL: "https://storage.googleapis.com/bucket/svg-token", ThumbnailURL: "https://thumb.png"}, Attachment{Type: typeImage, URL: "https://thumb.png"}, true},
+		{persist.Media{MediaType: persist.MediaTypeSVG, MediaURL: "https://ipfs.io/ipfs/QmHash", ThumbnailURL: "https://thumb.png"}, Attachment{}, false},
+	}
+
+	for _, test := range tests {
+		actual, ok := mediaAttachment("", test.media)
+		assert.Equal(t, test.ok, ok, test.media.MediaType)
+		if ok {
+			assert.Equal(t, test.expected, actual, test.media.MediaType)
+		}
+	}
+}
+
+type fixture struct {
+	key    *rsa.PrivateKey
+	store  *fakeStore
+	remote *remoteServer
+	router *gin.Engine
+}
+
+func newFixture(t *testing.T) *fixture {
+	gin.SetMode(gin.TestMode)
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	store := newFakeStore()
+	server, err := NewServer(store, key, localHost, "gallery.so", "https://gallery.so", true)
+	require.NoError(t, err)
+
+	return &fixture{
+		key:    key,
+		store:  store,
+		remote: newRemoteServer(t),
+		router: handlersInit(gin.New(), server),
+	}
+}
+
+func (f *fixture) serve(req *http.Request) *httptest.ResponseRecorder {
+	resp := httptest.NewRecorder()
+	f.router.ServeHTTP(resp, req)
+	return resp
+}
+
+func (f *fixture) get(t *testing.T, path string) *httptest.ResponseRecorder {
+	return f.serve(httptest.NewRequest(http.MethodGet, path, nil))
+}
+
+func (f *fixture) postSigned(t *testing.T, path string, activity Activity, key *rsa.PrivateKey, keyID string) *httptest.ResponseRecorder {
+	body, err := json.Marshal(activity)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(http.MethodPost, path, bytes.NewReader(body))
+	require.NoError(t, signRequest(req, body, keyID, key))
+
+	return f.serve(req)
+}
+
+// remoteServer stands in for another server on the fediverse with a single actor.
+type remoteServer struct {
+	server  *httptest.Server
+	mu      sync.Mutex
+	key     *rsa.PrivateKey
+	fetches int
+	inboxC  chan Activity
+}
+
+func newRemoteServer(t *testing.T) *remoteServer {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	r := &remoteServer{key: key, inboxC: make(chan Activity, 10)}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/users/bob", func(w http.ResponseWriter, req *http.Request) {
+		r.mu.Lock()
+		r.fetches++
+		pem, _ := encodePublicKey(&r.key.PublicKey)
+		r.mu.Unlock()
+		w.Header().Set("Content-Type", contentType)
+		json.NewEncoder(w).Encode(Actor{
+			ID:        r.actorID(),
+			Type:      typePerson,
+			Inbox:     r.inbox(),
+			Endpoints: &Endpoints{SharedInbox: r.sharedInbox()},
+			PublicKey: PublicKey{ID: r.keyID(), Owner: r.actorID(), PublicKeyPem: pem},
+		})
+	})
+	receive := func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		if _, err := parseSignature(req); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		var activity Activity
+		json.Unmarshal(body, &activity)
+		r.inboxC <- activity
+		w.WriteHeader(http.StatusAccepted)
+	}
+	mux.HandleFunc("/inbox", receive)
+	mux.HandleFunc("/users/bob/inbox", receive)
+
+	r.server = httptest.NewServer(mux)
+	t.Cleanup(r.server.Close)
+
+	return r
+}
+
+// rotateKey gives the remote actor a new key and returns it
+func (r *remoteServer) rotateKey(t *testing.T) *rsa.PrivateKey {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.key = key
+	return key
+}
+
+// actorFetches returns how many times the remote actor has been fetched
+func (r *remoteServer) actorFetches() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.fetches
+}
+
+func (r *remoteServer) actorID() string     { return r.server.URL + "/users/bob" }
+func (r *remoteServer) keyID() string       { return r.actorID() + "#main-key" }
+func (r *remoteServer) inbox() string       { return r.server.URL + "/users/bob/inbox" }
+func (r *remoteServer) sharedInbox() string { return r.server.URL + "/inbox" }
+
+func (r *remoteServer) activity(activityType string, object interface{}) Activity {
+	return Activity{
+		Context: activityStreamsContext,
+		ID:      r.actorID() + "/activities/" + persist.GenerateID().String(),
+		Type:    activityType,
+		Actor:   r.actorID(),
+		Object:  object,
+	}
+}
+
+// received returns the next activity delivered to the remote server's inbox.
+func (r *remoteServer) received(t *testing.T) Activity {
+	select {
+	case activity := <-r.inboxC:
+		return activity
+	case <-time.After(5 * time.Second):
+		t.Fatal("no activity was delivered")
+		return Activity{}
+	}
+}
+
+// fakeStore has a single user, alice, who has created one collection.
+type fakeStore struct {
+	mu        sync.Mutex
+	user      db.User
+	event     db.FeedEvent
+	followers map[string]db.AddActivityPubFollowerParams
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{
+		user: db.User{
+			ID:       "alice-id",
+			Username: sql.NullString{String: "alice", Valid: true},
+		},
+		event: db.FeedEvent{
+			ID:        "event",
+			OwnerID:   "alice-id",
+			Action:    persist.ActionCollectionCreated,
+			EventTime: time.Now(),
+			Caption:   sql.NullString{String: "my <3 collection", Valid: true},
+			Data: persist.FeedEventData{
+				CollectionID:       "collection",
+				CollectionTokenIDs: persist.DBIDList{"cat", "dog", "page"},
+			},
+		},
+		followers: make(map[string]db.AddActivityPubFollowerParams),
+	}
+}
+
+func (s *fakeStore) inboxes() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	var inboxes []string
+	for _, follower := range s.followers {
+		if follower.SharedInbox != "" {
+			inboxes = append(inboxes, follower.SharedInbox)
+		} else {
+			inboxes = append(inboxes, follower.Inbox)
+		}
+	}
+	return inboxes
+}
+
+func (s *fakeStore) AddActivityPubFollower(ctx context.Context, arg db.AddActivityPubFollowerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.followers[arg.ActorUri] = arg
+	return nil
+}
+
+func (s *fakeStore) RemoveActivityPubFollower(ctx context.Context, arg db.RemoveActivityPubFollowerParams) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.followers, arg.ActorUri)
+	return nil
+}
+
+func (s *fakeStore) CountActivityPubFollowers(ctx context.Context, userID persist.DBID) (int64, error) {
+	return int64(len(s.inboxes())), nil
+}
+
+func (s *fakeStore) GetActivityPubFollowerInboxes(ctx context.Context, userID persist.DBID) ([]string, error) {
+	return s.inboxes(), nil
+}
+
+func (s *fakeStore) CountActivityPubOutbox(ctx context.Context, arg db.CountActivityPubOutboxParams) (int64, error) {
+	return 1, nil
+}
+
+func (s *fakeStore) PaginateActivityPubOutbox(ctx context.Context, arg db.PaginateActivityPubOutboxParams) ([]db.FeedEvent, error) {
+	return []db.FeedEvent{s.event}, nil
+}
+
+func (s *fakeStore) GetActivityPubFeedEventByID(ctx context.Context, id persist.DBID) (db.FeedEvent, error) {
+	if id != s.event.ID {
+		return db.FeedEvent{}, pgx.ErrNoRows
+	}
+	return s.event, nil
+}
+
+func (s *fakeStore) GetCollectionById(ctx context.Context, id persist.DBID) (db.Collection, error) {
+	return db.Collection{ID: id, Name: sql.NullString{String: "Pets & Friends", Valid: true}}, nil
+}
+
+func (s *fakeStore) GetTokensByIDs(ctx context.Context, tokenIds []string) ([]db.Token, error) {
+	return []db.Token{
+		{ID: "cat", Name: sql.NullString{String: "cat", Valid: true}, Media: persist.Media{
+			MediaType:  persist.MediaTypeImage,
+			MediaURL:   "https://media/cat.png",
+			Dimensions: persist.Dimensions{Width: 100, Height: 100},
+		}},
+		{ID: "dog", Name: sql.NullString{String: "dog", Valid: true}, Media: persist.Media{
+			MediaType:    persist.MediaTypeAnimation,
+			MediaURL:     "https://media/dog.glb",
+			ThumbnailURL: "https://media/dog-thumbnail.png",
+		}},
+		{ID: "page", Media: persist.Media{MediaType: persist.MediaTypeHTML, MediaURL: "https://media/page.html"}},
+	}, nil
+}
+
+func (s *fakeStore) GetUserById(ctx context.Context, id persist.DBID) (db.User, error) {
+	if id != s.user.ID {
+		return db.User{}, pgx.ErrNoRows
+	}
+	return s.user, nil
+}
+
+func (s *fakeStore) GetUserByUsername(ctx context.Context, username string) (db.User, error) {
+	if username != "alice" && username != "Alice" {
+		return db.User{}, pgx.ErrNoRows
+	}
+	return s.user, nil
+}
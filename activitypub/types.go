@@ -0,0 +1,123 @@
+package activitypub
+
+import (
+	"time"
+)
+
+const (
+	// contentType is the media type that ActivityPub documents are served and delivered with
+	contentType    = "application/activity+json"
+	jrdContentType = "application/jrd+json"
+
+	activityStreamsContext = "https://www.w3.org/ns/activitystreams"
+	securityContext        = "https://w3id.org/security/v1"
+	publicCollection       = "https://www.w3.org/ns/activitystreams#Public"
+)
+
+const (
+	typePerson                = "Person"
+	typeNote                  = "Note"
+	typeImage                 = "Image"
+	typeVideo                 = "Video"
+	typeAudio                 = "Audio"
+	typeCreate                = "Create"
+	typeFollow                = "Follow"
+	typeAccept                = "Accept"
+	typeUndo                  = "Undo"
+	typeOrderedCollection     = "OrderedCollection"
+	typeOrderedCollectionPage = "OrderedCollectionPage"
+)
+
+// Actor is the ActivityPub representation of a Gallery user.
+type Actor struct {
+	Context                   []string   `json:"@context,omitempty"`
+	ID                        string     `json:"id"`
+	Type                      string     `json:"type"`
+	PreferredUsername         string     `json:"preferredUsername"`
+	Name                      string     `json:"name,omitempty"`
+	Summary                   string     `json:"summary,omitempty"`
+	URL                       string     `json:"url,omitempty"`
+	Inbox                     string     `json:"inbox"`
+	Outbox                    string     `json:"outbox,omitempty"`
+	Followers                 string     `json:"followers,omitempty"`
+	Endpoints                 *Endpoints `json:"endpoints,omitempty"`
+	PublicKey                 PublicKey  `json:"publicKey"`
+	ManuallyApprovesFollowers bool       `json:"manuallyApprovesFollowers"`
+	Discoverable              bool       `json:"discoverable"`
+}
+
+type Endpoints struct {
+	SharedInbox string `json:"sharedInbox,omitempty"`
+}
+
+type PublicKey struct {
+	ID           string `json:"id"`
+	Owner        string `json:"owner"`
+	PublicKeyPem string `json:"publicKeyPem"`
+}
+
+// Activity is an activity that is sent to or received from another server. Object is either the ID of the object
+// or the object itself.
+type Activity struct {
+	Context   interface{} `json:"@context,omitempty"`
+	ID        string      `json:"id"`
+	Type      string      `json:"type"`
+	Actor     string      `json:"actor"`
+	Object    interface{} `json:"object"`
+	Published *time.Time  `json:"published,omitempty"`
+	To        []string    `json:"to,omitempty"`
+	Cc        []string    `json:"cc,omitempty"`
+}
+
+// Note is a single feed event of a Gallery user.
+type Note struct {
+	Context      []string     `json:"@context,omitempty"`
+	ID           string       `json:"id"`
+	Type         string       `json:"type"`
+	AttributedTo string       `json:"attributedTo"`
+	Content      string       `json:"content"`
+	URL          string       `json:"url,omitempty"`
+	Published    time.Time    `json:"published"`
+	To           []string     `json:"to"`
+	Cc           []string     `json:"cc,omitempty"`
+	Attachment   []Attachment `json:"attachment,omitempty"`
+}
+
+// Attachment is a piece of media attached to a Note.
+type Attachment struct {
+	Type   string `json:"type"`
+	URL    string `json:"url"`
+	Name   string `json:"name,omitempty"`
+	Width  int    `json:"width,omitempty"`
+	Height int    `json:"height,omitempty"`
+}
+
+type OrderedCollection struct {
+	Context    []string `json:"@context,omitempty"`
+	ID         string   `json:"id"`
+	Type       string   `json:"type"`
+	TotalItems int64    `json:"totalItems"`
+	First      string   `json:"first,omitempty"`
+}
+
+type OrderedCollectionPage struct {
+	Context      []string   `json:"@context,omitempty"`
+	ID           string     `json:"id"`
+	Type         string     `json:"type"`
+	PartOf       string     `json:"partOf"`
+	Next         string     `json:"next,omitempty"`
+	OrderedItems []Activity `json:"orderedItems"`
+}
+
+// WebFinger is the JSON Resource Descriptor that remote servers use to find the actor of an account.
+type WebFinger struct {
+	Subject string          `json:"subject"`
+	Aliases []string        `json:"aliases,omitempty"`
+	Links   []WebFingerLink `json:"links"`
+}
+
+type WebFingerLink struct {
+	Rel  string `json:"rel"`
+	Type string `json:"type,omitempty"`
+	Href string `json:"href"`
+}
@@ -10,9 +10,12 @@ import (
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 	"github.com/mikeydub/go-gallery/service/user"
+	"github.com/mikeydub/go-gallery/util"
 	"github.com/mikeydub/go-gallery/validate"
 )
 
+const defaultTokenModerationQueueLimit = 100
+
 type AdminAPI struct {
 	repos      *postgres.Repositories
 	queries    *db.Queries
@@ -86,6 +89,73 @@ func (api *AdminAPI) RemoveRolesFromUser(ctx context.Context, username string, r
 	return &user, err
 }
 
+// GetTokenModerationQueue returns flagged tokens that are waiting to be reviewed, oldest first
+func (api *AdminAPI) GetTokenModerationQueue(ctx context.Context, limit *int) ([]db.TokenModeration, error) {
+	requireRetoolAuthorized(ctx)
+
+	l := defaultTokenModerationQueueLimit
+	if limit != nil {
+		l = *limit
+	}
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"limit": {l, "min=1,max=1000"},
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetTokenModerationQueue(ctx, int32(l))
+}
+
+// ReviewTokenModeration records a reviewer's decision on a flagged token. Approved tokens are shown in public feeds again.
+func (api *AdminAPI) ReviewTokenModeration(ctx context.Context, moderationID persist.DBID, approved bool) (*db.TokenModeration, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"moderationID": {moderationID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	status := persist.TokenModerationStatusRejected
+	if approved {
+		status = persist.TokenModerationStatusApproved
+	}
+
+	// Reviews usually come from retool, which isn't signed in as a user
+	var reviewerID persist.DBID
+	if gc := util.GinContextFromContext(ctx); auth.GetUserAuthedFromCtx(gc) {
+		reviewerID = auth.GetUserIDFromCtx(gc)
+	}
+
+	moderation, err := api.queries.ReviewTokenModeration(ctx, db.ReviewTokenModerationParams{
+		Status:     status,
+		ReviewedBy: reviewerID.String(),
+		ID:         moderationID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &moderation, nil
+}
+
+// GetModeratedToken returns a token that was flagged by moderation
+func (api *AdminAPI) GetModeratedToken(ctx context.Context, ti persist.TokenIdentifiers) (*db.Token, error) {
+	requireRetoolAuthorized(ctx)
+
+	token, err := api.queries.GetTokenByTokenIdentifiers(ctx, db.GetTokenByTokenIdentifiersParams{
+		TokenHex:        ti.TokenID,
+		ContractAddress: ti.ContractAddress,
+		Chain:           ti.Chain,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &token, nil
+}
+
 type authenticator struct {
 	authMethod func(context.Context) (*auth.AuthResult, error)
 }
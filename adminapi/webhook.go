@@ -0,0 +1,105 @@
+package adminapi
+
+import (
+	"context"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/webhook"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+const defaultWebhookDeliveryLimit = 50
+
+// CreateWebhook registers an endpoint that every event for the given actions is sent to, e.g. for internal tooling
+func (api *AdminAPI) CreateWebhook(ctx context.Context, url string, actions []persist.Action) (*db.Webhook, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"url":     {url, "required,url,startswith=https://,max=2048"},
+		"actions": {actions, "required,unique,dive,webhook_action"},
+	}); err != nil {
+		return nil, err
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := api.queries.CreateWebhook(ctx, db.CreateWebhookParams{
+		ID:      persist.GenerateID(),
+		Url:     url,
+		Secret:  secret,
+		Actions: actions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+// DeleteWebhook stops sending events to a webhook. Its delivery log is kept.
+func (api *AdminAPI) DeleteWebhook(ctx context.Context, webhookID persist.DBID) error {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": {webhookID, "required"},
+	}); err != nil {
+		return err
+	}
+
+	return api.queries.DeleteWebhook(ctx, webhookID)
+}
+
+// GetWebhooks returns the webhooks that were registered by admins
+func (api *AdminAPI) GetWebhooks(ctx context.Context) ([]db.Webhook, error) {
+	requireRetoolAuthorized(ctx)
+	return api.queries.GetAdminWebhooks(ctx)
+}
+
+// GetWebhookDeliveries returns the most recent deliveries of any webhook, newest first
+func (api *AdminAPI) GetWebhookDeliveries(ctx context.Context, webhookID persist.DBID, limit *int) ([]db.WebhookDelivery, error) {
+	requireRetoolAuthorized(ctx)
+
+	l := defaultWebhookDeliveryLimit
+	if limit != nil {
+		l = *limit
+	}
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": {webhookID, "required"},
+		"limit":     {l, "min=1,max=200"},
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetWebhookDeliveriesByWebhookID(ctx, db.GetWebhookDeliveriesByWebhookIDParams{
+		WebhookID: webhookID,
+		Limit:     int32(l),
+	})
+}
+
+// ReplayWebhookDelivery sends a delivery of any webhook again
+func (api *AdminAPI) ReplayWebhookDelivery(ctx context.Context, deliveryID persist.DBID) (*db.WebhookDelivery, error) {
+	requireRetoolAuthorized(ctx)
+
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"deliveryID": {deliveryID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	delivery, err := api.queries.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	replay, err := webhook.Replay(ctx, api.queries, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replay, nil
+}
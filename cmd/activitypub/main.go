@@ -0,0 +1,20 @@
+package main
+
+import (
+	"net/http"
+
+	"github.com/mikeydub/go-gallery/activitypub"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"google.golang.org/appengine"
+)
+
+func main() {
+	defer sentryutil.RecoverAndRaise(nil)
+
+	activitypub.Init()
+	if appengine.IsAppEngine() {
+		appengine.Main()
+	} else {
+		http.ListenAndServe(":4125", nil)
+	}
+}
@@ -358,7 +358,7 @@ func (b *GetAdmiresByActorIDBatchBatchResults) Close() error {
 }
 
 const getCollectionByIdBatch = `-- name: GetCollectionByIdBatch :batchone
-SELECT id, deleted, owner_user_id, nfts, version, last_updated, created_at, hidden, collectors_note, name, layout, token_settings, gallery_id FROM collections WHERE id = $1 AND deleted = false
+SELECT id, deleted, owner_user_id, nfts, version, last_updated, created_at, hidden, collectors_note, name, layout, token_settings, gallery_id, draft FROM collections WHERE id = $1 AND deleted = false
 `
 
 type GetCollectionByIdBatchBatchResults struct {
@@ -404,6 +404,7 @@ func (b *GetCollectionByIdBatchBatchResults) QueryRow(f func(int, Collection, er
 			&i.Layout,
 			&i.TokenSettings,
 			&i.GalleryID,
+			&i.Draft,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -417,7 +418,7 @@ func (b *GetCollectionByIdBatchBatchResults) Close() error {
 }
 
 const getCollectionsByGalleryIdBatch = `-- name: GetCollectionsByGalleryIdBatch :batchmany
-SELECT c.id, c.deleted, c.owner_user_id, c.nfts, c.version, c.last_updated, c.created_at, c.hidden, c.collectors_note, c.name, c.layout, c.token_settings, c.gallery_id FROM galleries g, unnest(g.collections)
+SELECT c.id, c.deleted, c.owner_user_id, c.nfts, c.version, c.last_updated, c.created_at, c.hidden, c.collectors_note, c.name, c.layout, c.token_settings, c.gallery_id, c.draft FROM galleries g, unnest(g.collections)
     WITH ORDINALITY AS x(coll_id, coll_ord)
     INNER JOIN collections c ON c.id = x.coll_id
     WHERE g.id = $1 AND g.deleted = false AND c.deleted = false ORDER BY x.coll_ord
@@ -473,6 +474,7 @@ func (b *GetCollectionsByGalleryIdBatchBatchResults) Query(f func(int, []Collect
 					&i.Layout,
 					&i.TokenSettings,
 					&i.GalleryID,
+					&i.Draft,
 				); err != nil {
 					return err
 				}
@@ -851,7 +853,7 @@ func (b *GetContractsDisplayedByUserIDBatchBatchResults) Close() error {
 }
 
 const getEventByIdBatch = `-- name: GetEventByIdBatch :batchone
-SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id FROM feed_events WHERE id = $1 AND deleted = false
+SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden FROM feed_events WHERE id = $1 AND deleted = false
 `
 
 type GetEventByIdBatchBatchResults struct {
@@ -896,6 +898,7 @@ func (b *GetEventByIdBatchBatchResults) QueryRow(f func(int, FeedEvent, error))
 			&i.CreatedAt,
 			&i.Caption,
 			&i.GroupID,
+			&i.Hidden,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -909,7 +912,7 @@ func (b *GetEventByIdBatchBatchResults) Close() error {
 }
 
 const getFollowersByUserIdBatch = `-- name: GetFollowersByUserIdBatch :batchmany
-SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences FROM follows f
+SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale FROM follows f
     INNER JOIN users u ON f.follower = u.id
     WHERE f.followee = $1 AND f.deleted = false
     ORDER BY f.last_updated DESC
@@ -969,6 +972,7 @@ func (b *GetFollowersByUserIdBatchBatchResults) Query(f func(int, []User, error)
 					&i.FeaturedGallery,
 					&i.PrimaryWalletID,
 					&i.UserExperiences,
+					&i.Locale,
 				); err != nil {
 					return err
 				}
@@ -988,7 +992,7 @@ func (b *GetFollowersByUserIdBatchBatchResults) Close() error {
 }
 
 const getFollowingByUserIdBatch = `-- name: GetFollowingByUserIdBatch :batchmany
-SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences FROM follows f
+SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale FROM follows f
     INNER JOIN users u ON f.followee = u.id
     WHERE f.follower = $1 AND f.deleted = false
     ORDER BY f.last_updated DESC
@@ -1048,6 +1052,7 @@ func (b *GetFollowingByUserIdBatchBatchResults) Query(f func(int, []User, error)
 					&i.FeaturedGallery,
 					&i.PrimaryWalletID,
 					&i.UserExperiences,
+					&i.Locale,
 				); err != nil {
 					return err
 				}
@@ -1067,7 +1072,7 @@ func (b *GetFollowingByUserIdBatchBatchResults) Close() error {
 }
 
 const getGalleriesByUserIdBatch = `-- name: GetGalleriesByUserIdBatch :batchmany
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
 `
 
 type GetGalleriesByUserIdBatchBatchResults struct {
@@ -1118,6 +1123,9 @@ func (b *GetGalleriesByUserIdBatchBatchResults) Query(f func(int, []Gallery, err
 					&i.Description,
 					&i.Hidden,
 					&i.Position,
+					&i.Draft,
+					&i.PublishAt,
+					&i.PublishEditID,
 				); err != nil {
 					return err
 				}
@@ -1137,7 +1145,7 @@ func (b *GetGalleriesByUserIdBatchBatchResults) Close() error {
 }
 
 const getGalleryByCollectionIdBatch = `-- name: GetGalleryByCollectionIdBatch :batchone
-SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
+SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position, g.draft, g.publish_at, g.publish_edit_id FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
 `
 
 type GetGalleryByCollectionIdBatchBatchResults struct {
@@ -1181,6 +1189,9 @@ func (b *GetGalleryByCollectionIdBatchBatchResults) QueryRow(f func(int, Gallery
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.Draft,
+			&i.PublishAt,
+			&i.PublishEditID,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -1194,7 +1205,7 @@ func (b *GetGalleryByCollectionIdBatchBatchResults) Close() error {
 }
 
 const getGalleryByIdBatch = `-- name: GetGalleryByIdBatch :batchone
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE id = $1 AND deleted = false
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id FROM galleries WHERE id = $1 AND deleted = false
 `
 
 type GetGalleryByIdBatchBatchResults struct {
@@ -1238,6 +1249,9 @@ func (b *GetGalleryByIdBatchBatchResults) QueryRow(f func(int, Gallery, error))
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.Draft,
+			&i.PublishAt,
+			&i.PublishEditID,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -1393,7 +1407,7 @@ func (b *GetNewTokensByFeedEventIdBatchBatchResults) Close() error {
 }
 
 const getNotificationByIDBatch = `-- name: GetNotificationByIDBatch :batchone
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE id = $1 AND deleted = false
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE id = $1 AND deleted = false
 `
 
 type GetNotificationByIDBatchBatchResults struct {
@@ -1440,6 +1454,7 @@ func (b *GetNotificationByIDBatchBatchResults) QueryRow(f func(int, Notification
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -1453,8 +1468,8 @@ func (b *GetNotificationByIDBatchBatchResults) Close() error {
 }
 
 const getOwnersByContractIdBatchPaginate = `-- name: GetOwnersByContractIdBatchPaginate :batchmany
-select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences from (
-    select distinct on (u.id) u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences from users u, tokens t
+select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, users.locale from (
+    select distinct on (u.id) u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale from users u, tokens t
         where t.contract = $1 and t.owner_user_id = u.id
         and (not $2::bool or u.universal = false)
         and t.deleted = false and u.deleted = false
@@ -1547,6 +1562,7 @@ func (b *GetOwnersByContractIdBatchPaginateBatchResults) Query(f func(int, []Use
 					&i.FeaturedGallery,
 					&i.PrimaryWalletID,
 					&i.UserExperiences,
+					&i.Locale,
 				); err != nil {
 					return err
 				}
@@ -1723,7 +1739,7 @@ func (b *GetSharedContractsBatchPaginateBatchResults) Close() error {
 }
 
 const getSharedFollowersBatchPaginate = `-- name: GetSharedFollowersBatchPaginate :batchmany
-select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, a.created_at followed_on
+select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, users.locale, a.created_at followed_on
 from users, follows a, follows b
 where a.follower = $1
 	and a.followee = b.follower
@@ -1774,6 +1790,7 @@ type GetSharedFollowersBatchPaginateRow struct {
 	FeaturedGallery      *persist.DBID
 	PrimaryWalletID      persist.DBID
 	UserExperiences      pgtype.JSONB
+	Locale               string
 	FollowedOn           time.Time
 }
 
@@ -1832,6 +1849,7 @@ func (b *GetSharedFollowersBatchPaginateBatchResults) Query(f func(int, []GetSha
 					&i.FeaturedGallery,
 					&i.PrimaryWalletID,
 					&i.UserExperiences,
+					&i.Locale,
 					&i.FollowedOn,
 				); err != nil {
 					return err
@@ -1922,7 +1940,7 @@ func (b *GetTokenByIdBatchBatchResults) Close() error {
 }
 
 const getTokenOwnerByIDBatch = `-- name: GetTokenOwnerByIDBatch :batchone
-SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences FROM tokens t
+SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale FROM tokens t
     JOIN users u ON u.id = t.owner_user_id
     WHERE t.id = $1 AND t.deleted = false AND u.deleted = false
 `
@@ -1974,6 +1992,7 @@ func (b *GetTokenOwnerByIDBatchBatchResults) QueryRow(f func(int, User, error))
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2636,7 +2655,7 @@ func (b *GetTokensByWalletIdsBatchBatchResults) Close() error {
 }
 
 const getUserByAddressBatch = `-- name: GetUserByAddressBatch :batchone
-select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences
+select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, users.locale
 from users, wallets
 where wallets.address = $1
 	and wallets.chain = $2::int
@@ -2698,6 +2717,7 @@ func (b *GetUserByAddressBatchBatchResults) QueryRow(f func(int, User, error)) {
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2711,7 +2731,7 @@ func (b *GetUserByAddressBatchBatchResults) Close() error {
 }
 
 const getUserByIdBatch = `-- name: GetUserByIdBatch :batchone
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE id = $1 AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE id = $1 AND deleted = false
 `
 
 type GetUserByIdBatchBatchResults struct {
@@ -2761,6 +2781,7 @@ func (b *GetUserByIdBatchBatchResults) QueryRow(f func(int, User, error)) {
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2774,7 +2795,7 @@ func (b *GetUserByIdBatchBatchResults) Close() error {
 }
 
 const getUserByUsernameBatch = `-- name: GetUserByUsernameBatch :batchone
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE username_idempotent = lower($1) AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE username_idempotent = lower($1) AND deleted = false
 `
 
 type GetUserByUsernameBatchBatchResults struct {
@@ -2824,6 +2845,7 @@ func (b *GetUserByUsernameBatchBatchResults) QueryRow(f func(int, User, error))
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		)
 		if f != nil {
 			f(t, i, err)
@@ -2837,7 +2859,7 @@ func (b *GetUserByUsernameBatchBatchResults) Close() error {
 }
 
 const getUserNotificationsBatch = `-- name: GetUserNotificationsBatch :batchmany
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE owner_id = $1 AND deleted = false
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false
     AND (created_at, id) < ($2, $3)
     AND (created_at, id) > ($4, $5)
     ORDER BY CASE WHEN $6::bool THEN (created_at, id) END ASC,
@@ -2912,6 +2934,7 @@ func (b *GetUserNotificationsBatchBatchResults) Query(f func(int, []Notification
 					&i.GalleryID,
 					&i.Seen,
 					&i.Amount,
+					&i.Archived,
 				); err != nil {
 					return err
 				}
@@ -2931,7 +2954,7 @@ func (b *GetUserNotificationsBatchBatchResults) Close() error {
 }
 
 const getUsersWithTraitBatch = `-- name: GetUsersWithTraitBatch :batchmany
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE (traits->$1::string) IS NOT NULL AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE (traits->$1::string) IS NOT NULL AND deleted = false
 `
 
 type GetUsersWithTraitBatchBatchResults struct {
@@ -2988,6 +3011,7 @@ func (b *GetUsersWithTraitBatchBatchResults) Query(f func(int, []User, error)) {
 					&i.FeaturedGallery,
 					&i.PrimaryWalletID,
 					&i.UserExperiences,
+					&i.Locale,
 				); err != nil {
 					return err
 				}
@@ -3363,7 +3387,9 @@ func (b *PaginateCommentsByFeedEventIDBatchBatchResults) Close() error {
 }
 
 const paginateGlobalFeed = `-- name: PaginateGlobalFeed :batchmany
-SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id FROM feed_events WHERE deleted = false
+SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden FROM feed_events WHERE deleted = false AND hidden = false
+    AND NOT EXISTS(SELECT 1 FROM feed_event_tokens fet WHERE fet.feed_event_id = feed_events.id AND fet.moderated)
+    AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = feed_events.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
     AND (event_time, id) < ($1, $2)
     AND (event_time, id) > ($3, $4)
     ORDER BY CASE WHEN $5::bool THEN (event_time, id) END ASC,
@@ -3434,6 +3460,7 @@ func (b *PaginateGlobalFeedBatchResults) Query(f func(int, []FeedEvent, error))
 					&i.CreatedAt,
 					&i.Caption,
 					&i.GroupID,
+					&i.Hidden,
 				); err != nil {
 					return err
 				}
@@ -3547,10 +3574,57 @@ func (b *PaginateInteractionsByFeedEventIDBatchBatchResults) Close() error {
 }
 
 const paginatePersonalFeedByUserID = `-- name: PaginatePersonalFeedByUserID :batchmany
-SELECT fe.id, fe.version, fe.owner_id, fe.action, fe.data, fe.event_time, fe.event_ids, fe.deleted, fe.last_updated, fe.created_at, fe.caption, fe.group_id FROM feed_events fe, follows fl WHERE fe.deleted = false AND fl.deleted = false
-    AND fe.owner_id = fl.followee AND fl.follower = $1
-    AND (fe.event_time, fe.id) < ($2, $3)
-    AND (fe.event_time, fe.id) > ($4, $5)
+WITH page AS (
+    (SELECT t.feed_event_id AS id FROM feed_timelines t, follows fl, feed_events fe
+        WHERE t.user_id = $1 AND fl.follower = t.user_id AND fl.followee = t.owner_id AND fl.deleted = false
+        AND fe.id = t.feed_event_id AND fe.deleted = false AND fe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = fe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (t.event_time, t.feed_event_id) < ($2, $3)
+        AND (t.event_time, t.feed_event_id) > ($4, $5)
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = t.root_id
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (t.event_time, t.feed_event_id)
+            AND lf.follower = $1 AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN $6::bool THEN (t.event_time, t.feed_event_id) END ASC,
+                CASE WHEN NOT $6::bool THEN (t.event_time, t.feed_event_id) END DESC
+        LIMIT $7)
+    UNION
+    (SELECT pe.id FROM feed_events pe, follows fl, feed_pull_owners po
+        WHERE fl.follower = $1 AND fl.deleted = false AND po.owner_id = fl.followee
+        AND pe.owner_id = fl.followee AND pe.deleted = false AND pe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = pe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (pe.event_time, pe.id) < ($2, $3)
+        AND (pe.event_time, pe.id) > ($4, $5)
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = coalesce(nullif(pe.data->>'reposted_feed_event_id', ''), pe.id)
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (pe.event_time, pe.id)
+            AND lf.follower = $1 AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN $6::bool THEN (pe.event_time, pe.id) END ASC,
+                CASE WHEN NOT $6::bool THEN (pe.event_time, pe.id) END DESC
+        LIMIT $7)
+    UNION
+    (SELECT oe.id FROM feed_events oe, follows fl
+        WHERE fl.follower = $1 AND fl.deleted = false
+        AND oe.owner_id = fl.followee AND oe.deleted = false AND oe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = oe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (oe.event_time, oe.id) < ($2, $3)
+        AND (oe.event_time, oe.id) > ($4, $5)
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_timelines ot WHERE ot.user_id = $1
+            AND (ot.event_time, ot.feed_event_id) <= (oe.event_time, oe.id)
+        )
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = coalesce(nullif(oe.data->>'reposted_feed_event_id', ''), oe.id)
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (oe.event_time, oe.id)
+            AND lf.follower = $1 AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN $6::bool THEN (oe.event_time, oe.id) END ASC,
+                CASE WHEN NOT $6::bool THEN (oe.event_time, oe.id) END DESC
+        LIMIT $7)
+)
+SELECT fe.id, fe.version, fe.owner_id, fe.action, fe.data, fe.event_time, fe.event_ids, fe.deleted, fe.last_updated, fe.created_at, fe.caption, fe.group_id, fe.hidden FROM feed_events fe WHERE fe.id IN (SELECT id FROM page)
     ORDER BY CASE WHEN $6::bool THEN (fe.event_time, fe.id) END ASC,
             CASE WHEN NOT $6::bool THEN (fe.event_time, fe.id) END DESC
     LIMIT $7
@@ -3572,6 +3646,9 @@ type PaginatePersonalFeedByUserIDParams struct {
 	Limit         int32
 }
 
+// Each source of the home feed is read a page at a time. Timeline rows are only read while their owner is still
+// followed, and events older than the oldest event in the user's timeline were trimmed from it or were never added to
+// it, so they're read from the feed directly. Events that were reposted later by someone the user follows are left out.
 func (q *Queries) PaginatePersonalFeedByUserID(ctx context.Context, arg []PaginatePersonalFeedByUserIDParams) *PaginatePersonalFeedByUserIDBatchResults {
 	batch := &pgx.Batch{}
 	for _, a := range arg {
@@ -3621,6 +3698,7 @@ func (b *PaginatePersonalFeedByUserIDBatchResults) Query(f func(int, []FeedEvent
 					&i.CreatedAt,
 					&i.Caption,
 					&i.GroupID,
+					&i.Hidden,
 				); err != nil {
 					return err
 				}
@@ -3640,12 +3718,14 @@ func (b *PaginatePersonalFeedByUserIDBatchResults) Close() error {
 }
 
 const paginateUserFeedByUserID = `-- name: PaginateUserFeedByUserID :batchmany
-SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id FROM feed_events WHERE owner_id = $1 AND deleted = false
-    AND (event_time, id) < ($2, $3)
-    AND (event_time, id) > ($4, $5)
-    ORDER BY CASE WHEN $6::bool THEN (event_time, id) END ASC,
-            CASE WHEN NOT $6::bool THEN (event_time, id) END DESC
-    LIMIT $7
+SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden FROM feed_events WHERE owner_id = $1 AND deleted = false AND (hidden = false OR $2::bool)
+    AND ($2::bool OR NOT EXISTS(SELECT 1 FROM feed_event_tokens fet WHERE fet.feed_event_id = feed_events.id AND fet.moderated))
+    AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = feed_events.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+    AND (event_time, id) < ($3, $4)
+    AND (event_time, id) > ($5, $6)
+    ORDER BY CASE WHEN $7::bool THEN (event_time, id) END ASC,
+            CASE WHEN NOT $7::bool THEN (event_time, id) END DESC
+    LIMIT $8
 `
 
 type PaginateUserFeedByUserIDBatchResults struct {
@@ -3656,6 +3736,7 @@ type PaginateUserFeedByUserIDBatchResults struct {
 
 type PaginateUserFeedByUserIDParams struct {
 	OwnerID       persist.DBID
+	IncludeHidden bool
 	CurBeforeTime time.Time
 	CurBeforeID   persist.DBID
 	CurAfterTime  time.Time
@@ -3664,11 +3745,13 @@ type PaginateUserFeedByUserIDParams struct {
 	Limit         int32
 }
 
+// Hidden and moderated feed events are only included for their owner
 func (q *Queries) PaginateUserFeedByUserID(ctx context.Context, arg []PaginateUserFeedByUserIDParams) *PaginateUserFeedByUserIDBatchResults {
 	batch := &pgx.Batch{}
 	for _, a := range arg {
 		vals := []interface{}{
 			a.OwnerID,
+			a.IncludeHidden,
 			a.CurBeforeTime,
 			a.CurBeforeID,
 			a.CurAfterTime,
@@ -3713,6 +3796,7 @@ func (b *PaginateUserFeedByUserIDBatchResults) Query(f func(int, []FeedEvent, er
 					&i.CreatedAt,
 					&i.Caption,
 					&i.GroupID,
+					&i.Hidden,
 				); err != nil {
 					return err
 				}
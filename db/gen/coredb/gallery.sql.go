@@ -68,7 +68,7 @@ func (q *Queries) GalleryRepoCountColls(ctx context.Context, id persist.DBID) (i
 }
 
 const galleryRepoCreate = `-- name: GalleryRepoCreate :one
-insert into galleries (id, owner_user_id, name, description, position) values ($1, $2, $3, $4, $5) returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position
+insert into galleries (id, owner_user_id, name, description, position) values ($1, $2, $3, $4, $5) returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id
 `
 
 type GalleryRepoCreateParams struct {
@@ -100,6 +100,9 @@ func (q *Queries) GalleryRepoCreate(ctx context.Context, arg GalleryRepoCreatePa
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.Draft,
+		&i.PublishAt,
+		&i.PublishEditID,
 	)
 	return i, err
 }
@@ -119,7 +122,7 @@ func (q *Queries) GalleryRepoDelete(ctx context.Context, arg GalleryRepoDeletePa
 }
 
 const galleryRepoGetByUserIDRaw = `-- name: GalleryRepoGetByUserIDRaw :many
-select id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position from galleries g where g.owner_user_id = $1 and g.deleted = false order by position
+select id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id from galleries g where g.owner_user_id = $1 and g.deleted = false order by position
 `
 
 func (q *Queries) GalleryRepoGetByUserIDRaw(ctx context.Context, ownerUserID persist.DBID) ([]Gallery, error) {
@@ -143,6 +146,9 @@ func (q *Queries) GalleryRepoGetByUserIDRaw(ctx context.Context, ownerUserID per
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.Draft,
+			&i.PublishAt,
+			&i.PublishEditID,
 		); err != nil {
 			return nil, err
 		}
@@ -182,9 +188,9 @@ func (q *Queries) GalleryRepoGetGalleryCollections(ctx context.Context, id persi
 
 const galleryRepoGetPreviewsForUserID = `-- name: GalleryRepoGetPreviewsForUserID :many
 select (t.media ->> 'thumbnail_url')::text from galleries g,
-    unnest(g.collections) with ordinality as collection_ids(id, ord) inner join collections c on c.id = collection_ids.id and c.deleted = false,
+    unnest(g.collections) with ordinality as collection_ids(id, ord) inner join collections c on c.id = collection_ids.id and c.deleted = false and c.draft = false,
     unnest(c.nfts) with ordinality as token_ids(id, ord) inner join tokens t on t.id = token_ids.id and t.deleted = false
-    where g.owner_user_id = $1 and g.deleted = false and t.media ->> 'thumbnail_url' != ''
+    where g.owner_user_id = $1 and g.deleted = false and g.draft = false and t.media ->> 'thumbnail_url' != ''
     order by collection_ids.ord, token_ids.ord limit $2
 `
 
@@ -6,12 +6,24 @@ package coredb
 
 import (
 	"database/sql"
+	"encoding/json"
 	"time"
 
 	"github.com/jackc/pgtype"
 	"github.com/mikeydub/go-gallery/service/persist"
 )
 
+type ActivitypubFollower struct {
+	ID          persist.DBID
+	UserID      persist.DBID
+	ActorUri    string
+	Inbox       string
+	SharedInbox string
+	Deleted     bool
+	CreatedAt   time.Time
+	LastUpdated time.Time
+}
+
 type Admire struct {
 	ID          persist.DBID
 	Version     int32
@@ -36,6 +48,7 @@ type Collection struct {
 	Layout         persist.TokenLayout
 	TokenSettings  map[persist.DBID]persist.CollectionTokenSettings
 	GalleryID      persist.DBID
+	Draft          bool
 }
 
 type Comment struct {
@@ -72,6 +85,13 @@ type ContractRelevance struct {
 	Score int32
 }
 
+type DeferredNotification struct {
+	NotificationID persist.DBID
+	Channel        persist.NotificationChannel
+	DeliverAfter   time.Time
+	CreatedAt      time.Time
+}
+
 type DevMetadataUser struct {
 	UserID          persist.DBID
 	HasEmailAddress persist.Email
@@ -82,6 +102,14 @@ type EarlyAccess struct {
 	Address persist.Address
 }
 
+type EmailDigest struct {
+	UserID      persist.DBID
+	Timezone    string
+	LastSentAt  sql.NullTime
+	CreatedAt   time.Time
+	LastUpdated time.Time
+}
+
 type Event struct {
 	ID             persist.DBID
 	Version        int32
@@ -127,6 +155,21 @@ type FeedEvent struct {
 	CreatedAt   time.Time
 	Caption     sql.NullString
 	GroupID     sql.NullString
+	Hidden      bool
+}
+
+type FeedPullOwner struct {
+	OwnerID   persist.DBID
+	CreatedAt time.Time
+}
+
+type FeedTimeline struct {
+	UserID      persist.DBID
+	FeedEventID persist.DBID
+	OwnerID     persist.DBID
+	RootID      persist.DBID
+	EventTime   time.Time
+	CreatedAt   time.Time
 }
 
 type Follow struct {
@@ -139,17 +182,20 @@ type Follow struct {
 }
 
 type Gallery struct {
-	ID          persist.DBID
-	Deleted     bool
-	LastUpdated time.Time
-	CreatedAt   time.Time
-	Version     sql.NullInt32
-	OwnerUserID persist.DBID
-	Collections persist.DBIDList
-	Name        string
-	Description string
-	Hidden      bool
-	Position    string
+	ID            persist.DBID
+	Deleted       bool
+	LastUpdated   time.Time
+	CreatedAt     time.Time
+	Version       sql.NullInt32
+	OwnerUserID   persist.DBID
+	Collections   persist.DBIDList
+	Name          string
+	Description   string
+	Hidden        bool
+	Position      string
+	Draft         bool
+	PublishAt     sql.NullTime
+	PublishEditID sql.NullString
 }
 
 type GalleryRelevance struct {
@@ -157,6 +203,13 @@ type GalleryRelevance struct {
 	Score int32
 }
 
+type HeldEvent struct {
+	EventID      persist.DBID
+	GalleryID    persist.DBID
+	CollectionID persist.DBID
+	CreatedAt    time.Time
+}
+
 type LegacyView struct {
 	UserID      persist.DBID
 	ViewCount   sql.NullInt32
@@ -169,6 +222,18 @@ type MarketplaceContract struct {
 	ContractID persist.DBID
 }
 
+type MediaBlob struct {
+	ID          persist.DBID
+	Deleted     bool
+	CreatedAt   time.Time
+	LastUpdated time.Time
+	Bucket      string
+	ContentKey  string
+	ObjectName  string
+	MediaType   string
+	ContentType sql.NullString
+}
+
 type Membership struct {
 	ID          persist.DBID
 	Deleted     bool
@@ -220,6 +285,7 @@ type Notification struct {
 	GalleryID   persist.DBID
 	Seen        bool
 	Amount      int32
+	Archived    bool
 }
 
 type OwnedContract struct {
@@ -275,10 +341,23 @@ type PiiUserView struct {
 	FeaturedGallery      *persist.DBID
 	PrimaryWalletID      persist.DBID
 	UserExperiences      pgtype.JSONB
+	Locale               string
 	PiiEmailAddress      persist.Email
 	PiiSocials           persist.Socials
 }
 
+type PushDevice struct {
+	ID          persist.DBID
+	OwnerID     persist.DBID
+	Platform    string
+	Token       string
+	P256dh      string
+	Auth        string
+	Deleted     bool
+	CreatedAt   time.Time
+	LastUpdated time.Time
+}
+
 type RecommendationResult struct {
 	ID                persist.DBID
 	Version           sql.NullInt32
@@ -306,6 +385,18 @@ type SpamUserScore struct {
 	CreatedAt     time.Time
 }
 
+type TextEntity struct {
+	ID         persist.DBID
+	Source     string
+	SourceID   persist.DBID
+	Kind       string
+	StartIndex int32
+	Length     int32
+	Value      string
+	UserID     persist.DBID
+	CreatedAt  time.Time
+}
+
 type Token struct {
 	ID                   persist.DBID
 	Deleted              bool
@@ -333,6 +424,63 @@ type Token struct {
 	LastSynced           time.Time
 }
 
+type TokenMediaBlob struct {
+	ID              persist.DBID
+	Deleted         bool
+	CreatedAt       time.Time
+	LastUpdated     time.Time
+	Chain           persist.Chain
+	ContractAddress persist.Address
+	TokenID         persist.TokenID
+	UrlType         string
+	BlobID          persist.DBID
+}
+
+type TokenMetadataHistory struct {
+	ID              persist.DBID
+	CreatedAt       time.Time
+	Chain           persist.Chain
+	ContractAddress persist.Address
+	TokenID         persist.TokenID
+	TokenMetadata   persist.TokenMetadata
+	Media           persist.Media
+	Hash            string
+}
+
+type TokenModeration struct {
+	ID              persist.DBID
+	Deleted         bool
+	CreatedAt       time.Time
+	LastUpdated     time.Time
+	Chain           persist.Chain
+	ContractAddress persist.Address
+	TokenID         persist.TokenID
+	Flags           []string
+	Status          persist.TokenModerationStatus
+	ReviewedBy      persist.DBID
+	ReviewedAt      sql.NullTime
+}
+
+type TokenProcessingJob struct {
+	ID                persist.DBID
+	Deleted           bool
+	CreatedAt         time.Time
+	LastUpdated       time.Time
+	Chain             persist.Chain
+	ContractAddress   persist.Address
+	TokenID           persist.TokenID
+	OwnerAddress      persist.Address
+	ImageKeywords     []string
+	AnimationKeywords []string
+	Status            persist.TokenProcessingJobStatus
+	Priority          int32
+	Attempts          int32
+	LastError         sql.NullString
+	NextAttemptAt     time.Time
+	StartedAt         sql.NullTime
+	FinishedAt        sql.NullTime
+}
+
 type TopRecommendedUser struct {
 	RecommendedUserID persist.DBID
 	Frequency         int64
@@ -357,6 +505,13 @@ type User struct {
 	FeaturedGallery      *persist.DBID
 	PrimaryWalletID      persist.DBID
 	UserExperiences      pgtype.JSONB
+	Locale               string
+}
+
+type UserNotificationCount struct {
+	UserID      persist.DBID
+	Unseen      int32
+	LastUpdated time.Time
 }
 
 type UserRelevance struct {
@@ -384,3 +539,34 @@ type Wallet struct {
 	WalletType  persist.WalletType
 	Chain       persist.Chain
 }
+
+type Webhook struct {
+	ID          persist.DBID
+	Deleted     bool
+	CreatedAt   time.Time
+	LastUpdated time.Time
+	OwnerID     persist.DBID
+	Url         string
+	Secret      string
+	Actions     persist.ActionList
+	Active      bool
+}
+
+type WebhookDelivery struct {
+	ID             persist.DBID
+	Deleted        bool
+	CreatedAt      time.Time
+	LastUpdated    time.Time
+	WebhookID      persist.DBID
+	EventID        persist.DBID
+	ReplayOfID     persist.DBID
+	Action         persist.Action
+	Payload        json.RawMessage
+	Status         persist.WebhookDeliveryStatus
+	Attempts       int32
+	ResponseStatus sql.NullInt32
+	LastError      sql.NullString
+	NextAttemptAt  time.Time
+	StartedAt      sql.NullTime
+	DeliveredAt    sql.NullTime
+}
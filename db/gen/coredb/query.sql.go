@@ -105,7 +105,7 @@ func (q *Queries) BlockUserFromFeed(ctx context.Context, arg BlockUserFromFeedPa
 }
 
 const clearNotificationsForUser = `-- name: ClearNotificationsForUser :many
-UPDATE notifications SET seen = true WHERE owner_id = $1 AND seen = false RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount
+UPDATE notifications SET seen = true WHERE owner_id = $1 AND seen = false RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
 `
 
 func (q *Queries) ClearNotificationsForUser(ctx context.Context, ownerID persist.DBID) ([]Notification, error) {
@@ -132,6 +132,7 @@ func (q *Queries) ClearNotificationsForUser(ctx context.Context, ownerID persist
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		); err != nil {
 			return nil, err
 		}
@@ -256,7 +257,7 @@ func (q *Queries) CountTokensByContractId(ctx context.Context, arg CountTokensBy
 }
 
 const countUserNotifications = `-- name: CountUserNotifications :one
-SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false
+SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false
 `
 
 func (q *Queries) CountUserNotifications(ctx context.Context, ownerID persist.DBID) (int64, error) {
@@ -267,7 +268,7 @@ func (q *Queries) CountUserNotifications(ctx context.Context, ownerID persist.DB
 }
 
 const countUserUnseenNotifications = `-- name: CountUserUnseenNotifications :one
-SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false
+SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false AND seen = false
 `
 
 func (q *Queries) CountUserUnseenNotifications(ctx context.Context, ownerID persist.DBID) (int64, error) {
@@ -332,7 +333,7 @@ func (q *Queries) CreateAdmireEvent(ctx context.Context, arg CreateAdmireEventPa
 }
 
 const createAdmireNotification = `-- name: CreateAdmireNotification :one
-INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount
+INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
 `
 
 type CreateAdmireNotificationParams struct {
@@ -369,12 +370,13 @@ func (q *Queries) CreateAdmireNotification(ctx context.Context, arg CreateAdmire
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
 
 const createCollection = `-- name: CreateCollection :one
-insert into collections (id, version, name, collectors_note, owner_user_id, gallery_id, layout, nfts, hidden, token_settings, created_at, last_updated) values ($1, 1, $2, $3, $4, $5, $6, $7, $8, $9, now(), now()) returning id
+insert into collections (id, version, name, collectors_note, owner_user_id, gallery_id, layout, nfts, hidden, token_settings, draft, created_at, last_updated) values ($1, 1, $2, $3, $4, $5, $6, $7, $8, $9, $10, now(), now()) returning id
 `
 
 type CreateCollectionParams struct {
@@ -387,6 +389,7 @@ type CreateCollectionParams struct {
 	Nfts           persist.DBIDList
 	Hidden         bool
 	TokenSettings  map[persist.DBID]persist.CollectionTokenSettings
+	Draft          bool
 }
 
 func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionParams) (persist.DBID, error) {
@@ -400,6 +403,7 @@ func (q *Queries) CreateCollection(ctx context.Context, arg CreateCollectionPara
 		arg.Nfts,
 		arg.Hidden,
 		arg.TokenSettings,
+		arg.Draft,
 	)
 	var id persist.DBID
 	err := row.Scan(&id)
@@ -515,7 +519,7 @@ func (q *Queries) CreateCommentEvent(ctx context.Context, arg CreateCommentEvent
 }
 
 const createCommentNotification = `-- name: CreateCommentNotification :one
-INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id, comment_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount
+INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id, comment_id) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
 `
 
 type CreateCommentNotificationParams struct {
@@ -554,12 +558,13 @@ func (q *Queries) CreateCommentNotification(ctx context.Context, arg CreateComme
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
 
 const createFeedEvent = `-- name: CreateFeedEvent :one
-INSERT INTO feed_events (id, owner_id, action, data, event_time, event_ids, group_id, caption) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id
+INSERT INTO feed_events (id, owner_id, action, data, event_time, event_ids, group_id, caption) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden
 `
 
 type CreateFeedEventParams struct {
@@ -598,12 +603,65 @@ func (q *Queries) CreateFeedEvent(ctx context.Context, arg CreateFeedEventParams
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
+	)
+	return i, err
+}
+
+const createFeedEventEvent = `-- name: CreateFeedEventEvent :one
+INSERT INTO events (id, actor_id, action, resource_type_id, feed_event_id, subject_id, data, group_id, caption) VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8) RETURNING id, version, actor_id, resource_type_id, subject_id, user_id, token_id, collection_id, action, data, deleted, last_updated, created_at, gallery_id, comment_id, admire_id, feed_event_id, external_id, caption, group_id
+`
+
+type CreateFeedEventEventParams struct {
+	ID             persist.DBID
+	ActorID        sql.NullString
+	Action         persist.Action
+	ResourceTypeID persist.ResourceType
+	FeedEventID    persist.DBID
+	Data           persist.EventData
+	GroupID        sql.NullString
+	Caption        sql.NullString
+}
+
+func (q *Queries) CreateFeedEventEvent(ctx context.Context, arg CreateFeedEventEventParams) (Event, error) {
+	row := q.db.QueryRow(ctx, createFeedEventEvent,
+		arg.ID,
+		arg.ActorID,
+		arg.Action,
+		arg.ResourceTypeID,
+		arg.FeedEventID,
+		arg.Data,
+		arg.GroupID,
+		arg.Caption,
+	)
+	var i Event
+	err := row.Scan(
+		&i.ID,
+		&i.Version,
+		&i.ActorID,
+		&i.ResourceTypeID,
+		&i.SubjectID,
+		&i.UserID,
+		&i.TokenID,
+		&i.CollectionID,
+		&i.Action,
+		&i.Data,
+		&i.Deleted,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.GalleryID,
+		&i.CommentID,
+		&i.AdmireID,
+		&i.FeedEventID,
+		&i.ExternalID,
+		&i.Caption,
+		&i.GroupID,
 	)
 	return i, err
 }
 
 const createFollowNotification = `-- name: CreateFollowNotification :one
-INSERT INTO notifications (id, owner_id, action, data, event_ids) VALUES ($1, $2, $3, $4, $5) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount
+INSERT INTO notifications (id, owner_id, action, data, event_ids) VALUES ($1, $2, $3, $4, $5) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
 `
 
 type CreateFollowNotificationParams struct {
@@ -638,6 +696,7 @@ func (q *Queries) CreateFollowNotification(ctx context.Context, arg CreateFollow
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
@@ -696,6 +755,51 @@ func (q *Queries) CreateGalleryEvent(ctx context.Context, arg CreateGalleryEvent
 	return i, err
 }
 
+const createMentionNotification = `-- name: CreateMentionNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id, comment_id) VALUES ($1, $2, $3, $4, $5, nullif($6::varchar, ''), nullif($7::varchar, '')) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
+`
+
+type CreateMentionNotificationParams struct {
+	ID          persist.DBID
+	OwnerID     persist.DBID
+	Action      persist.Action
+	Data        persist.NotificationData
+	EventIds    persist.DBIDList
+	FeedEventID string
+	CommentID   string
+}
+
+func (q *Queries) CreateMentionNotification(ctx context.Context, arg CreateMentionNotificationParams) (Notification, error) {
+	row := q.db.QueryRow(ctx, createMentionNotification,
+		arg.ID,
+		arg.OwnerID,
+		arg.Action,
+		arg.Data,
+		arg.EventIds,
+		arg.FeedEventID,
+		arg.CommentID,
+	)
+	var i Notification
+	err := row.Scan(
+		&i.ID,
+		&i.Deleted,
+		&i.OwnerID,
+		&i.Version,
+		&i.LastUpdated,
+		&i.CreatedAt,
+		&i.Action,
+		&i.Data,
+		&i.EventIds,
+		&i.FeedEventID,
+		&i.CommentID,
+		&i.GalleryID,
+		&i.Seen,
+		&i.Amount,
+		&i.Archived,
+	)
+	return i, err
+}
+
 const createTokenEvent = `-- name: CreateTokenEvent :one
 INSERT INTO events (id, actor_id, action, resource_type_id, token_id, subject_id, data, group_id, caption, gallery_id, collection_id) VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8, $9, $10) RETURNING id, version, actor_id, resource_type_id, subject_id, user_id, token_id, collection_id, action, data, deleted, last_updated, created_at, gallery_id, comment_id, admire_id, feed_event_id, external_id, caption, group_id
 `
@@ -805,7 +909,7 @@ func (q *Queries) CreateUserEvent(ctx context.Context, arg CreateUserEventParams
 }
 
 const createViewGalleryNotification = `-- name: CreateViewGalleryNotification :one
-INSERT INTO notifications (id, owner_id, action, data, event_ids, gallery_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount
+INSERT INTO notifications (id, owner_id, action, data, event_ids, gallery_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived
 `
 
 type CreateViewGalleryNotificationParams struct {
@@ -842,6 +946,7 @@ func (q *Queries) CreateViewGalleryNotification(ctx context.Context, arg CreateV
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
@@ -998,7 +1103,7 @@ func (q *Queries) GetAllTimeTrendingUserIDs(ctx context.Context, limit int32) ([
 }
 
 const getCollectionById = `-- name: GetCollectionById :one
-SELECT id, deleted, owner_user_id, nfts, version, last_updated, created_at, hidden, collectors_note, name, layout, token_settings, gallery_id FROM collections WHERE id = $1 AND deleted = false
+SELECT id, deleted, owner_user_id, nfts, version, last_updated, created_at, hidden, collectors_note, name, layout, token_settings, gallery_id, draft FROM collections WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetCollectionById(ctx context.Context, id persist.DBID) (Collection, error) {
@@ -1018,6 +1123,7 @@ func (q *Queries) GetCollectionById(ctx context.Context, id persist.DBID) (Colle
 		&i.Layout,
 		&i.TokenSettings,
 		&i.GalleryID,
+		&i.Draft,
 	)
 	return i, err
 }
@@ -1034,7 +1140,7 @@ func (q *Queries) GetCollectionTokensByCollectionID(ctx context.Context, id pers
 }
 
 const getCollectionsByGalleryId = `-- name: GetCollectionsByGalleryId :many
-SELECT c.id, c.deleted, c.owner_user_id, c.nfts, c.version, c.last_updated, c.created_at, c.hidden, c.collectors_note, c.name, c.layout, c.token_settings, c.gallery_id FROM galleries g, unnest(g.collections)
+SELECT c.id, c.deleted, c.owner_user_id, c.nfts, c.version, c.last_updated, c.created_at, c.hidden, c.collectors_note, c.name, c.layout, c.token_settings, c.gallery_id, c.draft FROM galleries g, unnest(g.collections)
     WITH ORDINALITY AS x(coll_id, coll_ord)
     INNER JOIN collections c ON c.id = x.coll_id
     WHERE g.id = $1 AND g.deleted = false AND c.deleted = false ORDER BY x.coll_ord
@@ -1063,6 +1169,7 @@ func (q *Queries) GetCollectionsByGalleryId(ctx context.Context, id persist.DBID
 			&i.Layout,
 			&i.TokenSettings,
 			&i.GalleryID,
+			&i.Draft,
 		); err != nil {
 			return nil, err
 		}
@@ -1447,7 +1554,7 @@ func (q *Queries) GetEventsInWindow(ctx context.Context, arg GetEventsInWindowPa
 }
 
 const getFeedEventByID = `-- name: GetFeedEventByID :one
-SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id FROM feed_events WHERE id = $1 AND deleted = false
+SELECT id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden FROM feed_events WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetFeedEventByID(ctx context.Context, id persist.DBID) (FeedEvent, error) {
@@ -1466,12 +1573,13 @@ func (q *Queries) GetFeedEventByID(ctx context.Context, id persist.DBID) (FeedEv
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
 	)
 	return i, err
 }
 
 const getGalleriesByUserId = `-- name: GetGalleriesByUserId :many
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id FROM galleries WHERE owner_user_id = $1 AND deleted = false order by position
 `
 
 func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.DBID) ([]Gallery, error) {
@@ -1495,6 +1603,9 @@ func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.Draft,
+			&i.PublishAt,
+			&i.PublishEditID,
 		); err != nil {
 			return nil, err
 		}
@@ -1507,7 +1618,7 @@ func (q *Queries) GetGalleriesByUserId(ctx context.Context, ownerUserID persist.
 }
 
 const getGalleryByCollectionId = `-- name: GetGalleryByCollectionId :one
-SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
+SELECT g.id, g.deleted, g.last_updated, g.created_at, g.version, g.owner_user_id, g.collections, g.name, g.description, g.hidden, g.position, g.draft, g.publish_at, g.publish_edit_id FROM galleries g, collections c WHERE c.id = $1 AND c.deleted = false AND $1 = ANY(g.collections) AND g.deleted = false
 `
 
 func (q *Queries) GetGalleryByCollectionId(ctx context.Context, id persist.DBID) (Gallery, error) {
@@ -1525,12 +1636,15 @@ func (q *Queries) GetGalleryByCollectionId(ctx context.Context, id persist.DBID)
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.Draft,
+		&i.PublishAt,
+		&i.PublishEditID,
 	)
 	return i, err
 }
 
 const getGalleryById = `-- name: GetGalleryById :one
-SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position FROM galleries WHERE id = $1 AND deleted = false
+SELECT id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id FROM galleries WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetGalleryById(ctx context.Context, id persist.DBID) (Gallery, error) {
@@ -1548,6 +1662,9 @@ func (q *Queries) GetGalleryById(ctx context.Context, id persist.DBID) (Gallery,
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.Draft,
+		&i.PublishAt,
+		&i.PublishEditID,
 	)
 	return i, err
 }
@@ -1636,16 +1753,17 @@ func (q *Queries) GetGalleryIDByCollectionID(ctx context.Context, id persist.DBI
 }
 
 const getGalleryTokenMediasByGalleryID = `-- name: GetGalleryTokenMediasByGalleryID :many
-select t.media from tokens t, collections c, galleries g where g.id = $1 and c.id = any(g.collections) and t.id = any(c.nfts) and t.deleted = false and g.deleted = false and c.deleted = false and (length(t.media->>'thumbnail_url'::varchar) > 0 or length(t.media->>'media_url'::varchar) > 0) order by array_position(g.collections, c.id),array_position(c.nfts, t.id) limit $2
+select t.media from tokens t, collections c, galleries g where g.id = $1 and c.id = any(g.collections) and t.id = any(c.nfts) and t.deleted = false and g.deleted = false and c.deleted = false and (c.draft = false or g.owner_user_id = $2) and (length(t.media->>'thumbnail_url'::varchar) > 0 or length(t.media->>'media_url'::varchar) > 0) order by array_position(g.collections, c.id),array_position(c.nfts, t.id) limit $3
 `
 
 type GetGalleryTokenMediasByGalleryIDParams struct {
-	ID    persist.DBID
-	Limit int32
+	ID       persist.DBID
+	ViewerID persist.DBID
+	Limit    int32
 }
 
 func (q *Queries) GetGalleryTokenMediasByGalleryID(ctx context.Context, arg GetGalleryTokenMediasByGalleryIDParams) ([]persist.Media, error) {
-	rows, err := q.db.Query(ctx, getGalleryTokenMediasByGalleryID, arg.ID, arg.Limit)
+	rows, err := q.db.Query(ctx, getGalleryTokenMediasByGalleryID, arg.ID, arg.ViewerID, arg.Limit)
 	if err != nil {
 		return nil, err
 	}
@@ -1665,7 +1783,7 @@ func (q *Queries) GetGalleryTokenMediasByGalleryID(ctx context.Context, arg GetG
 }
 
 const getLastFeedEventForCollection = `-- name: GetLastFeedEventForCollection :one
-select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id from feed_events where deleted = false
+select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden from feed_events where deleted = false
     and owner_id = $1
     and action = any($3)
     and data ->> 'collection_id' = $4
@@ -1702,12 +1820,13 @@ func (q *Queries) GetLastFeedEventForCollection(ctx context.Context, arg GetLast
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
 	)
 	return i, err
 }
 
 const getLastFeedEventForToken = `-- name: GetLastFeedEventForToken :one
-select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id from feed_events where deleted = false
+select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden from feed_events where deleted = false
     and owner_id = $1
     and action = any($3)
     and data ->> 'token_id' = $4::varchar
@@ -1744,12 +1863,13 @@ func (q *Queries) GetLastFeedEventForToken(ctx context.Context, arg GetLastFeedE
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
 	)
 	return i, err
 }
 
 const getLastFeedEventForUser = `-- name: GetLastFeedEventForUser :one
-select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id from feed_events where deleted = false
+select id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden from feed_events where deleted = false
     and owner_id = $1
     and action = any($3)
     and event_time < $2
@@ -1779,6 +1899,7 @@ func (q *Queries) GetLastFeedEventForUser(ctx context.Context, arg GetLastFeedEv
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
 	)
 	return i, err
 }
@@ -1816,10 +1937,11 @@ func (q *Queries) GetMerchDiscountCodeByTokenID(ctx context.Context, tokenHex pe
 }
 
 const getMostRecentNotificationByOwnerIDForAction = `-- name: GetMostRecentNotificationByOwnerIDForAction :one
-select id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount from notifications
+select id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived from notifications
     where owner_id = $1
     and action = $2
     and deleted = false
+    and archived = false
     and (not $4::bool or feed_event_id = $3)
     order by created_at desc
     limit 1
@@ -1855,12 +1977,13 @@ func (q *Queries) GetMostRecentNotificationByOwnerIDForAction(ctx context.Contex
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
 
 const getNotificationByID = `-- name: GetNotificationByID :one
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE id = $1 AND deleted = false
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetNotificationByID(ctx context.Context, id persist.DBID) (Notification, error) {
@@ -1881,13 +2004,14 @@ func (q *Queries) GetNotificationByID(ctx context.Context, id persist.DBID) (Not
 		&i.GalleryID,
 		&i.Seen,
 		&i.Amount,
+		&i.Archived,
 	)
 	return i, err
 }
 
 const getNotificationsByOwnerIDForActionAfter = `-- name: GetNotificationsByOwnerIDForActionAfter :many
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications
-    WHERE owner_id = $1 AND action = $2 AND deleted = false AND created_at > $3
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications
+    WHERE owner_id = $1 AND action = $2 AND deleted = false AND archived = false AND created_at > $3
     ORDER BY created_at DESC
 `
 
@@ -1921,6 +2045,7 @@ func (q *Queries) GetNotificationsByOwnerIDForActionAfter(ctx context.Context, a
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		); err != nil {
 			return nil, err
 		}
@@ -1962,7 +2087,7 @@ func (q *Queries) GetPreviewURLsByContractIdAndUserId(ctx context.Context, arg G
 }
 
 const getRecentUnseenNotifications = `-- name: GetRecentUnseenNotifications :many
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false and created_at > $2 order by created_at desc limit $3
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false AND seen = false and created_at > $2 order by created_at desc limit $3
 `
 
 type GetRecentUnseenNotificationsParams struct {
@@ -1995,6 +2120,7 @@ func (q *Queries) GetRecentUnseenNotifications(ctx context.Context, arg GetRecen
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		); err != nil {
 			return nil, err
 		}
@@ -2274,7 +2400,7 @@ func (q *Queries) GetTokenByTokenIdentifiers(ctx context.Context, arg GetTokenBy
 }
 
 const getTokenOwnerByID = `-- name: GetTokenOwnerByID :one
-SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences FROM tokens t
+SELECT u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale FROM tokens t
     JOIN users u ON u.id = t.owner_user_id
     WHERE t.id = $1 AND t.deleted = false AND u.deleted = false
 `
@@ -2300,6 +2426,7 @@ func (q *Queries) GetTokenOwnerByID(ctx context.Context, id persist.DBID) (User,
 		&i.FeaturedGallery,
 		&i.PrimaryWalletID,
 		&i.UserExperiences,
+		&i.Locale,
 	)
 	return i, err
 }
@@ -2707,7 +2834,7 @@ func (q *Queries) GetTokensByWalletIds(ctx context.Context, ownedByWallets persi
 const getTrendingFeedEventIDs = `-- name: GetTrendingFeedEventIDs :many
 select feed_events.id, feed_events.created_at, count(*)
 from events as interactions, feed_events
-where interactions.action IN ('CommentedOnFeedEvent', 'AdmiredFeedEvent') and interactions.created_at >= $1 and interactions.feed_event_id is not null and interactions.feed_event_id = feed_events.id
+where interactions.action IN ('CommentedOnFeedEvent', 'AdmiredFeedEvent', 'RepostedFeedEvent') and interactions.created_at >= $1 and interactions.feed_event_id is not null and interactions.feed_event_id = feed_events.id and feed_events.deleted = false and feed_events.hidden = false
 group by feed_events.id, feed_events.created_at
 `
 
@@ -2738,7 +2865,7 @@ func (q *Queries) GetTrendingFeedEventIDs(ctx context.Context, windowEnd time.Ti
 }
 
 const getTrendingUsersByIDs = `-- name: GetTrendingUsersByIDs :many
-select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences from users join unnest($1::varchar[]) with ordinality t(id, pos) using (id) where deleted = false order by t.pos asc
+select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, users.locale from users join unnest($1::varchar[]) with ordinality t(id, pos) using (id) where deleted = false order by t.pos asc
 `
 
 func (q *Queries) GetTrendingUsersByIDs(ctx context.Context, userIds []string) ([]User, error) {
@@ -2768,6 +2895,7 @@ func (q *Queries) GetTrendingUsersByIDs(ctx context.Context, userIds []string) (
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -2780,7 +2908,7 @@ func (q *Queries) GetTrendingUsersByIDs(ctx context.Context, userIds []string) (
 }
 
 const getUserById = `-- name: GetUserById :one
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE id = $1 AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE id = $1 AND deleted = false
 `
 
 func (q *Queries) GetUserById(ctx context.Context, id persist.DBID) (User, error) {
@@ -2804,12 +2932,13 @@ func (q *Queries) GetUserById(ctx context.Context, id persist.DBID) (User, error
 		&i.FeaturedGallery,
 		&i.PrimaryWalletID,
 		&i.UserExperiences,
+		&i.Locale,
 	)
 	return i, err
 }
 
 const getUserByUsername = `-- name: GetUserByUsername :one
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE username_idempotent = lower($1) AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE username_idempotent = lower($1) AND deleted = false
 `
 
 func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User, error) {
@@ -2833,6 +2962,7 @@ func (q *Queries) GetUserByUsername(ctx context.Context, username string) (User,
 		&i.FeaturedGallery,
 		&i.PrimaryWalletID,
 		&i.UserExperiences,
+		&i.Locale,
 	)
 	return i, err
 }
@@ -2849,7 +2979,7 @@ func (q *Queries) GetUserExperiencesByUserID(ctx context.Context, id persist.DBI
 }
 
 const getUserNotifications = `-- name: GetUserNotifications :many
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE owner_id = $1 AND deleted = false
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false
     AND (created_at, id) < ($3, $4)
     AND (created_at, id) > ($5, $6)
     ORDER BY CASE WHEN $7::bool THEN (created_at, id) END ASC,
@@ -2899,6 +3029,7 @@ func (q *Queries) GetUserNotifications(ctx context.Context, arg GetUserNotificat
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		); err != nil {
 			return nil, err
 		}
@@ -2986,7 +3117,7 @@ func (q *Queries) GetUserRolesByUserId(ctx context.Context, arg GetUserRolesByUs
 }
 
 const getUserUnseenNotifications = `-- name: GetUserUnseenNotifications :many
-SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false
+SELECT id, deleted, owner_id, version, last_updated, created_at, action, data, event_ids, feed_event_id, comment_id, gallery_id, seen, amount, archived FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false AND seen = false
     AND (created_at, id) < ($3, $4)
     AND (created_at, id) > ($5, $6)
     ORDER BY CASE WHEN $7::bool THEN (created_at, id) END ASC,
@@ -3036,6 +3167,7 @@ func (q *Queries) GetUserUnseenNotifications(ctx context.Context, arg GetUserUns
 			&i.GalleryID,
 			&i.Seen,
 			&i.Amount,
+			&i.Archived,
 		); err != nil {
 			return nil, err
 		}
@@ -3048,7 +3180,7 @@ func (q *Queries) GetUserUnseenNotifications(ctx context.Context, arg GetUserUns
 }
 
 const getUserWithPIIByID = `-- name: GetUserWithPIIByID :one
-select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, pii_email_address, pii_socials from pii.user_view where id = $1 and deleted = false
+select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale, pii_email_address, pii_socials from pii.user_view where id = $1 and deleted = false
 `
 
 func (q *Queries) GetUserWithPIIByID(ctx context.Context, userID persist.DBID) (PiiUserView, error) {
@@ -3072,6 +3204,7 @@ func (q *Queries) GetUserWithPIIByID(ctx context.Context, userID persist.DBID) (
 		&i.FeaturedGallery,
 		&i.PrimaryWalletID,
 		&i.UserExperiences,
+		&i.Locale,
 		&i.PiiEmailAddress,
 		&i.PiiSocials,
 	)
@@ -3079,7 +3212,7 @@ func (q *Queries) GetUserWithPIIByID(ctx context.Context, userID persist.DBID) (
 }
 
 const getUsersByChainAddresses = `-- name: GetUsersByChainAddresses :many
-select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences,wallets.address from users, wallets where wallets.address = ANY($1::varchar[]) AND wallets.chain = $2::int AND ARRAY[wallets.id] <@ users.wallets AND users.deleted = false AND wallets.deleted = false
+select users.id, users.deleted, users.version, users.last_updated, users.created_at, users.username, users.username_idempotent, users.wallets, users.bio, users.traits, users.universal, users.notification_settings, users.email_verified, users.email_unsubscriptions, users.featured_gallery, users.primary_wallet_id, users.user_experiences, users.locale,wallets.address from users, wallets where wallets.address = ANY($1::varchar[]) AND wallets.chain = $2::int AND ARRAY[wallets.id] <@ users.wallets AND users.deleted = false AND wallets.deleted = false
 `
 
 type GetUsersByChainAddressesParams struct {
@@ -3105,6 +3238,7 @@ type GetUsersByChainAddressesRow struct {
 	FeaturedGallery      *persist.DBID
 	PrimaryWalletID      persist.DBID
 	UserExperiences      pgtype.JSONB
+	Locale               string
 	Address              persist.Address
 }
 
@@ -3135,6 +3269,7 @@ func (q *Queries) GetUsersByChainAddresses(ctx context.Context, arg GetUsersByCh
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 			&i.Address,
 		); err != nil {
 			return nil, err
@@ -3148,7 +3283,7 @@ func (q *Queries) GetUsersByChainAddresses(ctx context.Context, arg GetUsersByCh
 }
 
 const getUsersByIDs = `-- name: GetUsersByIDs :many
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE id = ANY($2) AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE id = ANY($2) AND deleted = false
     AND (created_at, id) < ($3, $4)
     AND (created_at, id) > ($5, $6)
     ORDER BY CASE WHEN $7::bool THEN (created_at, id) END ASC,
@@ -3201,6 +3336,7 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, arg GetUsersByIDsParams) ([
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -3213,7 +3349,7 @@ func (q *Queries) GetUsersByIDs(ctx context.Context, arg GetUsersByIDsParams) ([
 }
 
 const getUsersByPositionPaginate = `-- name: GetUsersByPositionPaginate :many
-select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences from users u join unnest($1::text[]) with ordinality t(id, pos) using(id) where u.deleted = false
+select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale from users u join unnest($1::text[]) with ordinality t(id, pos) using(id) where u.deleted = false
   and t.pos > $2::int
   and t.pos < $3::int
   order by case when $4::bool then t.pos end desc,
@@ -3262,6 +3398,7 @@ func (q *Queries) GetUsersByPositionPaginate(ctx context.Context, arg GetUsersBy
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -3274,7 +3411,7 @@ func (q *Queries) GetUsersByPositionPaginate(ctx context.Context, arg GetUsersBy
 }
 
 const getUsersWithEmailNotificationsOn = `-- name: GetUsersWithEmailNotificationsOn :many
-select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, pii_email_address, pii_socials from pii.user_view
+select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale, pii_email_address, pii_socials from pii.user_view
     where (email_unsubscriptions->>'all' = 'false' or email_unsubscriptions->>'all' is null)
     and deleted = false and pii_email_address is not null and email_verified = $1
     and (created_at, id) < ($3, $4)
@@ -3330,6 +3467,7 @@ func (q *Queries) GetUsersWithEmailNotificationsOn(ctx context.Context, arg GetU
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 			&i.PiiEmailAddress,
 			&i.PiiSocials,
 		); err != nil {
@@ -3344,7 +3482,7 @@ func (q *Queries) GetUsersWithEmailNotificationsOn(ctx context.Context, arg GetU
 }
 
 const getUsersWithEmailNotificationsOnForEmailType = `-- name: GetUsersWithEmailNotificationsOnForEmailType :many
-select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, pii_email_address, pii_socials from pii.user_view
+select id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale, pii_email_address, pii_socials from pii.user_view
     where (email_unsubscriptions->>'all' = 'false' or email_unsubscriptions->>'all' is null)
     and (email_unsubscriptions->>$3::varchar = 'false' or email_unsubscriptions->>$3::varchar is null)
     and deleted = false and pii_email_address is not null and email_verified = $1
@@ -3403,6 +3541,7 @@ func (q *Queries) GetUsersWithEmailNotificationsOnForEmailType(ctx context.Conte
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 			&i.PiiEmailAddress,
 			&i.PiiSocials,
 		); err != nil {
@@ -3417,7 +3556,7 @@ func (q *Queries) GetUsersWithEmailNotificationsOnForEmailType(ctx context.Conte
 }
 
 const getUsersWithRolePaginate = `-- name: GetUsersWithRolePaginate :many
-select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences from users u, user_roles ur where u.deleted = false and ur.deleted = false
+select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale from users u, user_roles ur where u.deleted = false and ur.deleted = false
     and u.id = ur.user_id and ur.role = $2
     and (u.username_idempotent, u.id) < ($3::varchar, $4)
     and (u.username_idempotent, u.id) > ($5::varchar, $6)
@@ -3471,6 +3610,7 @@ func (q *Queries) GetUsersWithRolePaginate(ctx context.Context, arg GetUsersWith
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -3483,7 +3623,7 @@ func (q *Queries) GetUsersWithRolePaginate(ctx context.Context, arg GetUsersWith
 }
 
 const getUsersWithTrait = `-- name: GetUsersWithTrait :many
-SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences FROM users WHERE (traits->$1::string) IS NOT NULL AND deleted = false
+SELECT id, deleted, version, last_updated, created_at, username, username_idempotent, wallets, bio, traits, universal, notification_settings, email_verified, email_unsubscriptions, featured_gallery, primary_wallet_id, user_experiences, locale FROM users WHERE (traits->$1::string) IS NOT NULL AND deleted = false
 `
 
 func (q *Queries) GetUsersWithTrait(ctx context.Context, dollar_1 string) ([]User, error) {
@@ -3513,6 +3653,7 @@ func (q *Queries) GetUsersWithTrait(ctx context.Context, dollar_1 string) ([]Use
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -3824,7 +3965,9 @@ func (q *Queries) IsFeedUserActionBlocked(ctx context.Context, arg IsFeedUserAct
 }
 
 const paginateTrendingFeed = `-- name: PaginateTrendingFeed :many
-select f.id, f.version, f.owner_id, f.action, f.data, f.event_time, f.event_ids, f.deleted, f.last_updated, f.created_at, f.caption, f.group_id from feed_events f join unnest($1::text[]) with ordinality t(id, pos) using(id) where f.deleted = false
+select f.id, f.version, f.owner_id, f.action, f.data, f.event_time, f.event_ids, f.deleted, f.last_updated, f.created_at, f.caption, f.group_id, f.hidden from feed_events f join unnest($1::text[]) with ordinality t(id, pos) using(id) where f.deleted = false and f.hidden = false
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = f.id and fet.moderated)
+  and not exists(select 1 from feed_events ofe where ofe.id = f.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden))
   and t.pos > $2::int
   and t.pos < $3::int
   order by case when $4::bool then t.pos end desc,
@@ -3868,6 +4011,7 @@ func (q *Queries) PaginateTrendingFeed(ctx context.Context, arg PaginateTrending
 			&i.CreatedAt,
 			&i.Caption,
 			&i.GroupID,
+			&i.Hidden,
 		); err != nil {
 			return nil, err
 		}
@@ -3879,6 +4023,18 @@ func (q *Queries) PaginateTrendingFeed(ctx context.Context, arg PaginateTrending
 	return items, nil
 }
 
+const publishGalleryDrafts = `-- name: PublishGalleryDrafts :exec
+with published_collections as (
+    update collections set draft = false, last_updated = now() where gallery_id = $1 and draft = true and deleted = false
+)
+update galleries set draft = false, publish_at = null, publish_edit_id = null, last_updated = now() where id = $1 and deleted = false
+`
+
+func (q *Queries) PublishGalleryDrafts(ctx context.Context, galleryID persist.DBID) error {
+	_, err := q.db.Exec(ctx, publishGalleryDrafts, galleryID)
+	return err
+}
+
 const redeemMerch = `-- name: RedeemMerch :one
 update merch set redeemed = true, token_id = $1, last_updated = now() where id = (select m.id from merch m where m.object_type = $2 and m.token_id is null and m.redeemed = false and m.deleted = false order by m.id limit 1) and token_id is null and redeemed = false returning discount_code
 `
@@ -3923,6 +4079,21 @@ func (q *Queries) RemoveSocialFromUser(ctx context.Context, arg RemoveSocialFrom
 	return err
 }
 
+const scheduleGalleryPublish = `-- name: ScheduleGalleryPublish :exec
+update galleries set publish_at = $1, publish_edit_id = $2, last_updated = now() where id = $3 and deleted = false
+`
+
+type ScheduleGalleryPublishParams struct {
+	PublishAt     sql.NullTime
+	PublishEditID sql.NullString
+	ID            persist.DBID
+}
+
+func (q *Queries) ScheduleGalleryPublish(ctx context.Context, arg ScheduleGalleryPublishParams) error {
+	_, err := q.db.Exec(ctx, scheduleGalleryPublish, arg.PublishAt, arg.PublishEditID, arg.ID)
+	return err
+}
+
 const unblockUserFromFeed = `-- name: UnblockUserFromFeed :exec
 UPDATE feed_blocklist SET deleted = true WHERE user_id = $1
 `
@@ -4003,7 +4174,7 @@ func (q *Queries) UpdateEventCaptionByGroup(ctx context.Context, arg UpdateEvent
 }
 
 const updateFeedEventCaptionByGroup = `-- name: UpdateFeedEventCaptionByGroup :one
-UPDATE feed_events SET caption = (select caption from events where events.group_id = $1) WHERE group_id = $1 AND deleted = false returning id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id
+UPDATE feed_events SET caption = (select caption from events where events.group_id = $1) WHERE group_id = $1 AND deleted = false returning id, version, owner_id, action, data, event_time, event_ids, deleted, last_updated, created_at, caption, group_id, hidden
 `
 
 func (q *Queries) UpdateFeedEventCaptionByGroup(ctx context.Context, groupID sql.NullString) (FeedEvent, error) {
@@ -4022,6 +4193,7 @@ func (q *Queries) UpdateFeedEventCaptionByGroup(ctx context.Context, groupID sql
 		&i.CreatedAt,
 		&i.Caption,
 		&i.GroupID,
+		&i.Hidden,
 	)
 	return i, err
 }
@@ -4041,7 +4213,7 @@ func (q *Queries) UpdateGalleryCollections(ctx context.Context, arg UpdateGaller
 }
 
 const updateGalleryHidden = `-- name: UpdateGalleryHidden :one
-update galleries set hidden = $1, last_updated = now() where id = $2 and deleted = false returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position
+update galleries set hidden = $1, last_updated = now() where id = $2 and deleted = false returning id, deleted, last_updated, created_at, version, owner_user_id, collections, name, description, hidden, position, draft, publish_at, publish_edit_id
 `
 
 type UpdateGalleryHiddenParams struct {
@@ -4064,12 +4236,15 @@ func (q *Queries) UpdateGalleryHidden(ctx context.Context, arg UpdateGalleryHidd
 		&i.Description,
 		&i.Hidden,
 		&i.Position,
+		&i.Draft,
+		&i.PublishAt,
+		&i.PublishEditID,
 	)
 	return i, err
 }
 
 const updateGalleryInfo = `-- name: UpdateGalleryInfo :exec
-update galleries set name = case when $1::bool then $2 else name end, description = case when $3::bool then $4 else description end, last_updated = now() where id = $5 and deleted = false
+update galleries set name = case when $1::bool then $2 else name end, description = case when $3::bool then $4 else description end, draft = case when $5::bool then $6 else draft end, last_updated = now() where id = $7 and deleted = false
 `
 
 type UpdateGalleryInfoParams struct {
@@ -4077,6 +4252,8 @@ type UpdateGalleryInfoParams struct {
 	Name           string
 	DescriptionSet bool
 	Description    string
+	DraftSet       bool
+	Draft          bool
 	ID             persist.DBID
 }
 
@@ -4086,6 +4263,8 @@ func (q *Queries) UpdateGalleryInfo(ctx context.Context, arg UpdateGalleryInfoPa
 		arg.Name,
 		arg.DescriptionSet,
 		arg.Description,
+		arg.DraftSet,
+		arg.Draft,
 		arg.ID,
 	)
 	return err
@@ -4210,6 +4389,20 @@ func (q *Queries) UpdateUserFeaturedGallery(ctx context.Context, arg UpdateUserF
 	return err
 }
 
+const updateUserLocale = `-- name: UpdateUserLocale :exec
+update users set locale = $1, last_updated = now() where id = $2 and deleted = false
+`
+
+type UpdateUserLocaleParams struct {
+	Locale string
+	UserID persist.DBID
+}
+
+func (q *Queries) UpdateUserLocale(ctx context.Context, arg UpdateUserLocaleParams) error {
+	_, err := q.db.Exec(ctx, updateUserLocale, arg.Locale, arg.UserID)
+	return err
+}
+
 const updateUserPrimaryWallet = `-- name: UpdateUserPrimaryWallet :exec
 update users set primary_wallet_id = $1 from wallets
     where users.id = $2 and wallets.id = $1
@@ -95,7 +95,7 @@ const searchGalleries = `-- name: SearchGalleries :many
 with min_content_score as (
     select score from gallery_relevance where id is null
 )
-select galleries.id, galleries.deleted, galleries.last_updated, galleries.created_at, galleries.version, galleries.owner_user_id, galleries.collections, galleries.name, galleries.description, galleries.hidden, galleries.position from galleries left join gallery_relevance on gallery_relevance.id = galleries.id,
+select galleries.id, galleries.deleted, galleries.last_updated, galleries.created_at, galleries.version, galleries.owner_user_id, galleries.collections, galleries.name, galleries.description, galleries.hidden, galleries.position, galleries.draft, galleries.publish_at, galleries.publish_edit_id from galleries left join gallery_relevance on gallery_relevance.id = galleries.id,
     to_tsquery('simple', websearch_to_tsquery('simple', $1)::text || ':*') simple_partial_query,
     websearch_to_tsquery('english', $1) english_full_query,
     min_content_score,
@@ -108,7 +108,7 @@ where (
     simple_partial_query @@ fts_name or
     english_full_query @@ fts_description_english
     )
-    and deleted = false and hidden = false
+    and deleted = false and hidden = false and draft = false
 order by content_score * match_score desc, content_score desc, match_score desc
 limit $4
 `
@@ -146,6 +146,9 @@ func (q *Queries) SearchGalleries(ctx context.Context, arg SearchGalleriesParams
 			&i.Description,
 			&i.Hidden,
 			&i.Position,
+			&i.Draft,
+			&i.PublishAt,
+			&i.PublishEditID,
 		); err != nil {
 			return nil, err
 		}
@@ -161,7 +164,7 @@ const searchUsers = `-- name: SearchUsers :many
 with min_content_score as (
     select score from user_relevance where id is null
 )
-select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences from users u left join user_relevance on u.id = user_relevance.id,
+select u.id, u.deleted, u.version, u.last_updated, u.created_at, u.username, u.username_idempotent, u.wallets, u.bio, u.traits, u.universal, u.notification_settings, u.email_verified, u.email_unsubscriptions, u.featured_gallery, u.primary_wallet_id, u.user_experiences, u.locale from users u left join user_relevance on u.id = user_relevance.id,
     -- Adding the search condition to the wallet join statement is a very helpful optimization, but we can't use
     -- "simple_full_query" at this point in the statement, so we're repeating the "websearch_to_tsquery..." part here
     unnest(u.wallets) as wallet_id left join wallets w on w.id = wallet_id and w.deleted = false and websearch_to_tsquery('simple', $1) @@ w.fts_address,
@@ -225,6 +228,7 @@ func (q *Queries) SearchUsers(ctx context.Context, arg SearchUsersParams) ([]Use
 			&i.FeaturedGallery,
 			&i.PrimaryWalletID,
 			&i.UserExperiences,
+			&i.Locale,
 		); err != nil {
 			return nil, err
 		}
@@ -0,0 +1,31 @@
+-- Media that has been cached to the token content bucket, keyed by the content it holds.
+-- Tokens that share content (e.g. editions that point at the same CID) share a single blob.
+create table if not exists media_blobs (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    bucket varchar not null,
+    content_key varchar not null,
+    object_name varchar not null,
+    media_type varchar not null,
+    content_type varchar
+);
+
+create unique index if not exists media_blobs_bucket_content_key_idx on media_blobs(bucket, content_key) where deleted = false;
+
+-- Maps a token's image and video URLs to the blob their media was cached under.
+create table if not exists token_media_blobs (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    chain int not null,
+    contract_address varchar(255) not null,
+    token_id varchar(255) not null,
+    url_type varchar not null,
+    blob_id varchar(255) not null references media_blobs(id)
+);
+
+create unique index if not exists token_media_blobs_token_url_type_idx on token_media_blobs(chain, contract_address, token_id, url_type) where deleted = false;
+create index if not exists token_media_blobs_blob_id_idx on token_media_blobs(blob_id) where deleted = false;
@@ -0,0 +1,24 @@
+-- Tracks media processing for a token so that failures can be retried and surfaced to users.
+-- There is at most one job per token; re-enqueueing a finished job resets it.
+create table if not exists token_processing_jobs (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    chain int not null,
+    contract_address varchar(255) not null,
+    token_id varchar(255) not null,
+    owner_address varchar(255),
+    image_keywords varchar[] not null default '{}',
+    animation_keywords varchar[] not null default '{}',
+    status varchar not null default 'queued',
+    priority int not null default 0,
+    attempts int not null default 0,
+    last_error varchar,
+    next_attempt_at timestamptz not null default current_timestamp,
+    started_at timestamptz,
+    finished_at timestamptz
+);
+
+create unique index if not exists token_processing_jobs_token_idx on token_processing_jobs(chain, contract_address, token_id) where deleted = false;
+create index if not exists token_processing_jobs_queue_idx on token_processing_jobs(priority desc, next_attempt_at) where status = 'queued' and deleted = false;
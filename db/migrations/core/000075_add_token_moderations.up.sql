@@ -0,0 +1,33 @@
+-- Media that moderation flagged for a token. Flagged tokens are kept out of the global and trending feeds
+-- until a reviewer approves them. A token without flags has no row.
+create table if not exists token_moderations (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    chain int not null,
+    contract_address varchar(255) not null,
+    token_id varchar(255) not null,
+    flags varchar[] not null default '{}',
+    status varchar not null default 'flagged',
+    reviewed_by varchar(255) references users(id),
+    reviewed_at timestamptz
+);
+
+create unique index if not exists token_moderations_token_idx on token_moderations(chain, contract_address, token_id) where deleted = false;
+create index if not exists token_moderations_queue_idx on token_moderations(created_at) where status = 'flagged' and deleted = false;
+
+-- Returns true if a feed event's data refers to a token whose media is flagged and hasn't been approved
+create or replace function feed_event_has_moderated_tokens(data jsonb) returns boolean as $$
+    select exists(
+        select 1 from tokens t
+            join contracts c on c.id = t.contract
+            join token_moderations m on m.chain = t.chain and m.contract_address = c.address and m.token_id = t.token_id
+        where m.deleted = false and m.status != 'approved'
+            and t.id in (
+                select jsonb_path_query(data, '$.token_id') #>> '{}'
+                union all select jsonb_path_query(data, '$.collection_token_ids[*]') #>> '{}'
+                union all select jsonb_path_query(data, '$.gallery_new_token_ids.*[*]') #>> '{}'
+            )
+    );
+$$ language sql stable;
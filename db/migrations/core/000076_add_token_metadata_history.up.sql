@@ -0,0 +1,14 @@
+-- Snapshots of a token's metadata and media, appended whenever processing changes them. Tokens are identified by
+-- chain, contract and token ID rather than by tokens.id so that every owner's copy of a token shares its history.
+create table if not exists token_metadata_history (
+    id varchar(255) primary key,
+    created_at timestamptz not null default current_timestamp,
+    chain int not null,
+    contract_address varchar(255) not null,
+    token_id varchar(255) not null,
+    token_metadata jsonb,
+    media jsonb,
+    hash varchar(255) not null
+);
+
+create index if not exists token_metadata_history_token_idx on token_metadata_history(chain, contract_address, token_id, created_at, id);
@@ -0,0 +1,16 @@
+-- Tokens that were acquired or minted are also checked when filtering moderated feed events
+create or replace function feed_event_has_moderated_tokens(data jsonb) returns boolean as $$
+    select exists(
+        select 1 from tokens t
+            join contracts c on c.id = t.contract
+            join token_moderations m on m.chain = t.chain and m.contract_address = c.address and m.token_id = t.token_id
+        where m.deleted = false and m.status != 'approved'
+            and t.id in (
+                select jsonb_path_query(data, '$.token_id') #>> '{}'
+                union all select jsonb_path_query(data, '$.collection_token_ids[*]') #>> '{}'
+                union all select jsonb_path_query(data, '$.gallery_new_token_ids.*[*]') #>> '{}'
+                union all select jsonb_path_query(data, '$.acquired_token_ids[*]') #>> '{}'
+                union all select jsonb_path_query(data, '$.minted_token_ids[*]') #>> '{}'
+            )
+    );
+$$ language sql stable;
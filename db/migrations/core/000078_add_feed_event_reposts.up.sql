@@ -0,0 +1,6 @@
+/* {% require_sudo %} */
+-- Reposts count as interactions on the feed event that was reposted
+drop index if exists events_feed_interactions_idx;
+create index if not exists events_feed_interactions_idx on events (created_at) where action in ('CommentedOnFeedEvent', 'AdmiredFeedEvent', 'RepostedFeedEvent') and feed_event_id is not null;
+
+create index if not exists feed_events_reposted_feed_event_id_idx on feed_events ((data->>'reposted_feed_event_id')) where deleted = false;
@@ -0,0 +1,29 @@
+-- Home feed timelines that feed events are fanned out to when they're published. Users with too many followers
+-- to fan out to are kept in feed_pull_owners instead, and their events are read when a timeline is paged.
+create table if not exists feed_timelines (
+    user_id varchar(255) not null references users(id),
+    feed_event_id varchar(255) not null references feed_events(id),
+    owner_id varchar(255) not null references users(id),
+    root_id varchar(255) not null,
+    event_time timestamptz not null,
+    created_at timestamptz not null default current_timestamp,
+    primary key (user_id, feed_event_id)
+);
+
+create index if not exists feed_timelines_user_event_time_idx on feed_timelines (user_id, event_time desc, feed_event_id desc);
+create index if not exists feed_timelines_user_root_idx on feed_timelines (user_id, root_id);
+
+create table if not exists feed_pull_owners (
+    owner_id varchar(255) primary key references users(id),
+    created_at timestamptz not null default current_timestamp
+);
+
+-- Seed timelines with the last month of events so that existing home feeds aren't empty
+insert into feed_timelines (user_id, feed_event_id, owner_id, root_id, event_time)
+select fl.follower, fe.id, fe.owner_id, coalesce(nullif(fe.data->>'reposted_feed_event_id', ''), fe.id), fe.event_time
+from feed_events fe, follows fl
+where fe.owner_id = fl.followee
+    and fe.deleted = false
+    and fl.deleted = false
+    and fe.event_time > now() - interval '30 days'
+on conflict do nothing;
@@ -0,0 +1,13 @@
+-- Remote ActivityPub actors that follow a user from another server, e.g. Mastodon
+create table if not exists activitypub_followers (
+    id varchar(255) primary key,
+    user_id varchar(255) not null references users(id),
+    actor_uri varchar not null,
+    inbox varchar not null,
+    shared_inbox varchar not null default '',
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp
+);
+
+create unique index if not exists activitypub_followers_user_actor_idx on activitypub_followers (user_id, actor_uri);
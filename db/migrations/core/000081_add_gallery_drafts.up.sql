@@ -0,0 +1,7 @@
+-- Drafts aren't shown to other users until they're published
+alter table galleries add column if not exists draft boolean not null default false;
+alter table collections add column if not exists draft boolean not null default false;
+
+-- A scheduled publish of a gallery's edit, which is cleared once the edit is published
+alter table galleries add column if not exists publish_at timestamptz;
+alter table galleries add column if not exists publish_edit_id varchar(255);
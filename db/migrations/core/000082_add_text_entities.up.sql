@@ -0,0 +1,16 @@
+-- @mentions and #hashtags found in comments, feed event captions and collectors notes. Entities are replaced
+-- whenever the text they were parsed from changes.
+create table if not exists text_entities (
+    id varchar(255) primary key,
+    source varchar(32) not null,
+    source_id varchar(255) not null,
+    kind varchar(32) not null,
+    start_index int not null,
+    length int not null,
+    value varchar(255) not null,
+    user_id varchar(255) references users(id),
+    created_at timestamptz not null default current_timestamp
+);
+
+create index if not exists text_entities_source_idx on text_entities (source, source_id);
+create index if not exists text_entities_hashtag_idx on text_entities (value, source) where kind = 'hashtag';
@@ -0,0 +1,16 @@
+-- Devices and browsers that notifications are pushed to. token is the APNs or FCM registration token, or the
+-- endpoint of a Web Push subscription, in which case p256dh and auth are the subscription's keys.
+create table if not exists push_devices (
+    id varchar(255) primary key,
+    owner_id varchar(255) not null references users(id),
+    platform varchar(32) not null,
+    token varchar not null,
+    p256dh varchar not null default '',
+    auth varchar not null default '',
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp
+);
+
+create unique index if not exists push_devices_platform_token_idx on push_devices (platform, token) where deleted = false;
+create index if not exists push_devices_owner_id_idx on push_devices (owner_id) where deleted = false;
@@ -0,0 +1,9 @@
+-- Weekly digest emails are sent at the same local time for everyone, so each user's timezone is kept here along with
+-- when their last digest went out. Users without a row are sent their digest in UTC.
+create table if not exists email_digests (
+    user_id varchar(255) primary key references users(id),
+    timezone varchar not null default 'UTC',
+    last_sent_at timestamptz,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp
+);
@@ -0,0 +1,40 @@
+-- Endpoints that are sent Gallery activity as it happens. Webhooks without an owner are registered by admins and
+-- are sent every event for their actions. A user's webhooks are sent the user's own activity, and activity that
+-- notifies the user if their notification settings allow it. secret is used to sign each payload.
+create table if not exists webhooks (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    owner_id varchar(255) references users(id),
+    url varchar not null,
+    secret varchar not null,
+    actions varchar[] not null default '{}',
+    active boolean not null default true
+);
+
+create index if not exists webhooks_owner_id_idx on webhooks(owner_id) where deleted = false;
+
+-- The delivery log. A delivery is retried with exponential backoff until it succeeds or runs out of attempts.
+-- Replaying a delivery sends its payload again as a new delivery that refers back to the original.
+create table if not exists webhook_deliveries (
+    id varchar(255) primary key,
+    deleted boolean not null default false,
+    created_at timestamptz not null default current_timestamp,
+    last_updated timestamptz not null default current_timestamp,
+    webhook_id varchar(255) not null references webhooks(id),
+    event_id varchar(255) references events(id),
+    replay_of_id varchar(255) references webhook_deliveries(id),
+    action varchar(255) not null,
+    payload jsonb not null,
+    status varchar not null default 'pending',
+    attempts int not null default 0,
+    response_status int,
+    last_error varchar,
+    next_attempt_at timestamptz not null default current_timestamp,
+    started_at timestamptz,
+    delivered_at timestamptz
+);
+
+create index if not exists webhook_deliveries_webhook_id_idx on webhook_deliveries(webhook_id, created_at desc) where deleted = false;
+create index if not exists webhook_deliveries_queue_idx on webhook_deliveries(next_attempt_at) where status = 'pending' and deleted = false;
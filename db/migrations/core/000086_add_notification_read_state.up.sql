@@ -0,0 +1,45 @@
+-- Archived notifications are hidden from the user's notification list but are kept so that they can be grouped into
+-- and so that their read state still syncs across devices.
+alter table notifications add column if not exists archived boolean not null default false;
+
+-- The number of unseen, unarchived notifications each user has. It is kept up to date by a trigger on notifications
+-- so that the unread badge doesn't have to count a user's notifications every time it's shown.
+create table if not exists user_notification_counts (
+    user_id varchar(255) primary key references users(id),
+    unseen int not null default 0,
+    last_updated timestamptz not null default current_timestamp
+);
+
+insert into user_notification_counts (user_id, unseen)
+    select owner_id, count(*) from notifications
+    where seen = false and archived = false and deleted = false
+    group by owner_id
+on conflict (user_id) do update set unseen = excluded.unseen, last_updated = now();
+
+create or replace function update_user_notification_counts() returns trigger as $$
+declare
+    was_unseen boolean := false;
+    is_unseen boolean := false;
+begin
+    if tg_op in ('UPDATE', 'DELETE') then
+        was_unseen := not old.seen and not old.archived and not old.deleted;
+    end if;
+    if tg_op in ('INSERT', 'UPDATE') then
+        is_unseen := not new.seen and not new.archived and not new.deleted;
+    end if;
+
+    if is_unseen and not was_unseen then
+        insert into user_notification_counts (user_id, unseen) values (new.owner_id, 1)
+        on conflict (user_id) do update set unseen = user_notification_counts.unseen + 1, last_updated = now();
+    elsif was_unseen and not is_unseen then
+        update user_notification_counts set unseen = greatest(unseen - 1, 0), last_updated = now() where user_id = old.owner_id;
+    end if;
+
+    return null;
+end;
+$$ language plpgsql;
+
+drop trigger if exists notifications_update_user_notification_counts on notifications;
+create trigger notifications_update_user_notification_counts
+    after insert or update of seen, archived, deleted or delete on notifications
+    for each row execute function update_user_notification_counts();
@@ -0,0 +1,11 @@
+-- The locale that emails are sent to a user in. Empty means the default locale.
+alter table users add column if not exists locale varchar not null default '';
+
+set role to access_rw_pii;
+
+-- The view has to be recreated to pick up the new column
+drop view if exists pii.user_view;
+create or replace view pii.user_view as
+    select users.*, for_users.pii_email_address, for_users.pii_socials from users left join pii.for_users on users.id = for_users.user_id and for_users.deleted = false;
+
+grant select on pii.user_view to access_ro_pii;
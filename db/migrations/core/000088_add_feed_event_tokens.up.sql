@@ -0,0 +1,68 @@
+-- The tokens each feed event refers to and whether any of them is moderated. Public feeds filter on the flag instead
+-- of checking every feed event's tokens against token_moderations when they are read.
+create table if not exists feed_event_tokens (
+    feed_event_id varchar(255) not null references feed_events(id),
+    token_id varchar(255) not null,
+    moderated boolean not null default false,
+    primary key (feed_event_id, token_id)
+);
+
+create index if not exists feed_event_tokens_token_id_idx on feed_event_tokens(token_id);
+create index if not exists feed_event_tokens_moderated_idx on feed_event_tokens(feed_event_id) where moderated;
+
+create or replace function feed_event_token_ids(data jsonb) returns setof varchar as $$
+    select distinct id from (
+        select jsonb_path_query(data, '$.token_id') #>> '{}'
+        union all select jsonb_path_query(data, '$.collection_token_ids[*]') #>> '{}'
+        union all select jsonb_path_query(data, '$.gallery_new_token_ids.*[*]') #>> '{}'
+        union all select jsonb_path_query(data, '$.acquired_token_ids[*]') #>> '{}'
+        union all select jsonb_path_query(data, '$.minted_token_ids[*]') #>> '{}'
+    ) ids(id) where id is not null and id != '';
+$$ language sql immutable;
+
+-- Returns true if the token's media is flagged and hasn't been approved
+create or replace function token_is_moderated(token_id varchar) returns boolean as $$
+    select exists(
+        select 1 from tokens t
+            join contracts c on c.id = t.contract
+            join token_moderations m on m.chain = t.chain and m.contract_address = c.address and m.token_id = t.token_id
+        where t.id = token_is_moderated.token_id and m.deleted = false and m.status != 'approved'
+    );
+$$ language sql stable;
+
+-- A feed event's tokens are recorded when it is written
+create or replace function update_feed_event_tokens() returns trigger as $$
+begin
+    delete from feed_event_tokens where feed_event_id = new.id;
+    insert into feed_event_tokens (feed_event_id, token_id, moderated)
+        select new.id, ids.id, token_is_moderated(ids.id) from feed_event_token_ids(new.data) ids(id);
+    return null;
+end;
+$$ language plpgsql;
+
+drop trigger if exists feed_events_update_feed_event_tokens on feed_events;
+create trigger feed_events_update_feed_event_tokens
+    after insert or update of data on feed_events
+    for each row execute function update_feed_event_tokens();
+
+-- The feed events of a token are flagged or cleared as its moderation changes
+create or replace function update_moderated_feed_event_tokens() returns trigger as $$
+begin
+    update feed_event_tokens fet set moderated = token_is_moderated(fet.token_id)
+    from tokens t join contracts c on c.id = t.contract
+    where fet.token_id = t.id and t.chain = new.chain and c.address = new.contract_address and t.token_id = new.token_id;
+    return null;
+end;
+$$ language plpgsql;
+
+drop trigger if exists token_moderations_update_feed_event_tokens on token_moderations;
+create trigger token_moderations_update_feed_event_tokens
+    after insert or update on token_moderations
+    for each row execute function update_moderated_feed_event_tokens();
+
+insert into feed_event_tokens (feed_event_id, token_id, moderated)
+    select fe.id, ids.id, token_is_moderated(ids.id) from feed_events fe, feed_event_token_ids(fe.data) ids(id)
+    where fe.deleted = false
+on conflict do nothing;
+
+drop function if exists feed_event_has_moderated_tokens(jsonb);
@@ -0,0 +1,2 @@
+-- Hidden feed events are kept, along with their interactions, but are left out of every feed except their owner's own
+alter table feed_events add column if not exists hidden boolean not null default false;
@@ -0,0 +1,13 @@
+-- Push settings used to be kept apart under "push", keyed by the same names as notification types. They're moved into
+-- the push channel of each type's preference, unless the user has already set that channel since.
+update users set notification_settings = (notification_settings - 'push') || jsonb_build_object('preferences',
+    coalesce(notification_settings->'preferences', '{}'::jsonb) || (
+        select coalesce(jsonb_object_agg(p.key, coalesce(notification_settings->'preferences'->p.key, '{}'::jsonb) || jsonb_build_object('push', p.value)), '{}'::jsonb)
+        from jsonb_each(notification_settings->'push') p
+        where jsonb_typeof(p.value) = 'boolean' and notification_settings->'preferences'->p.key->'push' is null
+    )
+)
+where jsonb_typeof(notification_settings->'push') = 'object';
+
+-- Anything else left under "push" isn't a setting that can be moved
+update users set notification_settings = notification_settings - 'push' where notification_settings ? 'push';
@@ -0,0 +1,11 @@
+-- Hashtags in collectors notes are now kept with the feed events they were published with. Feed events published
+-- before then take the hashtags that their notes have now, found through the events they were made from.
+insert into text_entities (id, source, source_id, kind, start_index, length, value, created_at)
+select distinct on (fe.id, te.id) md5(fe.id || te.id), 'feed_event_collectors_notes', fe.id, te.kind, te.start_index, te.length, te.value, fe.event_time
+from feed_events fe
+    join events e on e.id = any(fe.event_ids) and e.deleted = false
+    join text_entities te on te.kind = 'hashtag'
+        and ((e.action = 'CollectorsNoteAddedToCollection' and te.source = 'collection_collectors_note' and te.source_id = e.collection_id)
+            or (e.action = 'CollectorsNoteAddedToToken' and te.source = 'token_collectors_note' and te.source_id = e.token_id))
+where fe.deleted = false
+on conflict do nothing;
@@ -0,0 +1,8 @@
+-- Events about drafts are held back from notifications and webhooks until the gallery and collection that they're
+-- about have been published
+create table if not exists held_events (
+    event_id varchar(255) primary key references events(id),
+    gallery_id varchar(255) not null references galleries(id),
+    collection_id varchar(255) not null default '',
+    created_at timestamptz not null default current_timestamp
+);
@@ -0,0 +1,6 @@
+-- Reposts are deleted along with the feed event that they repost
+update feed_events r set deleted = true, last_updated = now()
+from feed_events o
+where r.data->>'reposted_feed_event_id' = o.id
+  and o.deleted = true
+  and r.deleted = false;
@@ -0,0 +1,11 @@
+-- Notifications that arrive during their owner's quiet hours are sent over instant channels, like push and direct
+-- messages, once the quiet hours end
+create table if not exists deferred_notifications (
+    notification_id varchar(255) not null references notifications(id),
+    channel varchar not null,
+    deliver_after timestamptz not null,
+    created_at timestamptz not null default current_timestamp,
+    primary key (notification_id, channel)
+);
+
+create index if not exists deferred_notifications_deliver_after_idx on deferred_notifications (deliver_after);
@@ -0,0 +1,45 @@
+-- name: AddActivityPubFollower :exec
+insert into activitypub_followers (id, user_id, actor_uri, inbox, shared_inbox) values (@id, @user_id, @actor_uri, @inbox, @shared_inbox)
+    on conflict (user_id, actor_uri) do update set inbox = excluded.inbox, shared_inbox = excluded.shared_inbox, deleted = false, last_updated = now();
+
+-- name: RemoveActivityPubFollower :exec
+update activitypub_followers set deleted = true, last_updated = now() where user_id = @user_id and actor_uri = @actor_uri and deleted = false;
+
+-- name: CountActivityPubFollowers :one
+select count(*) from activitypub_followers where user_id = @user_id and deleted = false;
+
+-- name: GetActivityPubFollowerInboxes :many
+-- Followers on the same server share an inbox when the server has one, so each activity is only delivered to it once
+select distinct coalesce(nullif(shared_inbox, ''), inbox)::varchar as inbox from activitypub_followers where user_id = @user_id and deleted = false;
+
+-- name: CountActivityPubOutbox :one
+select count(*) from feed_events
+where owner_id = @owner_id
+  and action = any(@actions)
+  and deleted = false and hidden = false
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = feed_events.id and fet.moderated)
+  and not exists(select 1 from galleries g where g.id in (feed_events.data->>'gallery_id', feed_events.data->>'collection_gallery_id', feed_events.data->>'token_gallery_id') and g.draft)
+  and not exists(select 1 from collections c where c.id in (feed_events.data->>'collection_id', feed_events.data->>'token_collection_id') and c.draft)
+  and not exists(select 1 from feed_events ofe where ofe.id = feed_events.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden));
+
+-- name: PaginateActivityPubOutbox :many
+select * from feed_events
+where owner_id = @owner_id
+  and action = any(@actions)
+  and deleted = false and hidden = false
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = feed_events.id and fet.moderated)
+  and not exists(select 1 from galleries g where g.id in (feed_events.data->>'gallery_id', feed_events.data->>'collection_gallery_id', feed_events.data->>'token_gallery_id') and g.draft)
+  and not exists(select 1 from collections c where c.id in (feed_events.data->>'collection_id', feed_events.data->>'token_collection_id') and c.draft)
+  and not exists(select 1 from feed_events ofe where ofe.id = feed_events.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden))
+  and (event_time, id) < (@cur_before_time, @cur_before_id)
+order by event_time desc, id desc
+limit sqlc.arg('limit');
+
+-- name: GetActivityPubFeedEventByID :one
+select * from feed_events
+where id = @id
+  and deleted = false and hidden = false
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = feed_events.id and fet.moderated)
+  and not exists(select 1 from galleries g where g.id in (feed_events.data->>'gallery_id', feed_events.data->>'collection_gallery_id', feed_events.data->>'token_gallery_id') and g.draft)
+  and not exists(select 1 from collections c where c.id in (feed_events.data->>'collection_id', feed_events.data->>'token_collection_id') and c.draft)
+  and not exists(select 1 from feed_events ofe where ofe.id = feed_events.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden));
@@ -0,0 +1,14 @@
+-- name: DeferNotification :exec
+-- Defers sending a notification over an instant channel until deliver_after. Deferring it again moves it.
+insert into deferred_notifications (notification_id, channel, deliver_after) values (@notification_id, @channel, @deliver_after)
+on conflict (notification_id, channel) do update set deliver_after = excluded.deliver_after;
+
+-- name: GetDueDeferredNotifications :many
+-- Locks the deferred notifications that are due, so that they're only sent once
+select * from deferred_notifications where deliver_after <= now()
+order by deliver_after
+limit sqlc.arg('limit')
+for update skip locked;
+
+-- name: DeleteDeferredNotification :exec
+delete from deferred_notifications where notification_id = @notification_id and channel = @channel;
@@ -0,0 +1,61 @@
+-- name: GetUsersDueForDigestEmail :many
+-- Users whose local time is on or after the digest's send time that day and who haven't been sent a digest in the last day
+select u.* from pii.user_view u left join email_digests d on d.user_id = u.id
+    where (u.email_unsubscriptions->>'all' = 'false' or u.email_unsubscriptions->>'all' is null)
+    and (u.email_unsubscriptions->>'digest' = 'false' or u.email_unsubscriptions->>'digest' is null)
+    and u.deleted = false and u.pii_email_address is not null and u.email_verified = @email_verified
+    and extract(isodow from @now::timestamptz at time zone coalesce(d.timezone, 'UTC')) = @send_weekday::int
+    and extract(hour from @now::timestamptz at time zone coalesce(d.timezone, 'UTC')) >= @send_hour::int
+    and (d.last_sent_at is null or d.last_sent_at < @now::timestamptz - interval '1 day')
+    and u.id > @cur_after_id
+    order by u.id
+    limit sqlc.arg('limit');
+
+-- name: MarkDigestEmailSent :exec
+insert into email_digests (user_id, last_sent_at) values (@user_id, @sent_at)
+    on conflict (user_id) do update set last_sent_at = excluded.last_sent_at, last_updated = now();
+
+-- name: GetDigestTimezoneByUserID :one
+select timezone from email_digests where user_id = @user_id;
+
+-- name: UpsertDigestTimezone :exec
+insert into email_digests (user_id, timezone) values (@user_id, @timezone)
+    on conflict (user_id) do update set timezone = excluded.timezone, last_updated = now();
+
+-- name: GetDigestFeedEventsByFollower :many
+-- The feed events posted by the people a user follows since windowStart, most interacted with first
+select fe.* from feed_events fe
+    join follows fl on fl.followee = fe.owner_id and fl.follower = @follower and fl.deleted = false
+    left join events i on i.feed_event_id = fe.id and i.deleted = false
+        and i.action in ('CommentedOnFeedEvent', 'AdmiredFeedEvent', 'RepostedFeedEvent')
+    where fe.deleted = false and fe.hidden = false and fe.event_time >= @window_start
+    and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = fe.id and fet.moderated)
+    group by fe.id
+    order by count(i.id) desc, fe.event_time desc
+    limit sqlc.arg('limit');
+
+-- name: GetNewCommunityHoldersForDigest :many
+-- Counts the collectors who started holding a token from one of a user's communities since windowStart
+with new_holders as (
+    select t.contract, t.owner_user_id from tokens t
+        where t.deleted = false and t.owner_user_id != @user_id
+        and t.contract in (select distinct contract from tokens where owner_user_id = @user_id and deleted = false)
+        group by t.contract, t.owner_user_id
+        having min(t.created_at) >= @window_start
+)
+select c.id, c.name, count(*) as new_holder_count from new_holders nh
+    join contracts c on c.id = nh.contract and c.deleted = false
+    join users u on u.id = nh.owner_user_id and u.deleted = false and u.universal = false
+    group by c.id, c.name
+    order by new_holder_count desc
+    limit sqlc.arg('limit');
+
+-- name: GetGalleryViewCountsForDigest :many
+-- Counts the views of each of a user's galleries since windowStart, not counting their own
+select g.id, g.name, count(e.id) as view_count from galleries g
+    join events e on e.gallery_id = g.id
+    where g.owner_user_id = @owner_id and g.deleted = false
+    and e.action = 'ViewedGallery' and e.deleted = false and e.created_at >= @window_start
+    and (e.actor_id is null or e.actor_id != @owner_id)
+    group by g.id, g.name
+    order by view_count desc;
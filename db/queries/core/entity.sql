@@ -0,0 +1,24 @@
+-- name: GetTextEntitiesBySource :many
+select * from text_entities where source = $1 and source_id = $2 order by start_index;
+
+-- name: CreateTextEntity :exec
+insert into text_entities (id, source, source_id, kind, start_index, length, value, user_id) values ($1, $2, $3, $4, $5, $6, $7, $8);
+
+-- name: DeleteTextEntitiesBySource :exec
+delete from text_entities where source = $1 and source_id = $2;
+
+-- name: PaginateHashtagFeed :many
+-- Collectors notes are matched by the hashtags they had when the feed event was published, so that editing a note
+-- later doesn't add its feed events to or remove them from a hashtag
+select fe.* from feed_events fe where fe.deleted = false and fe.hidden = false
+    and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = fe.id and fet.moderated)
+    and not exists(select 1 from feed_events ofe where ofe.id = fe.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden))
+    and exists(
+        select 1 from text_entities te where te.kind = 'hashtag' and te.value = @hashtag::varchar
+            and te.source in ('caption', 'feed_event_collectors_notes') and te.source_id = fe.id
+    )
+    and (fe.event_time, fe.id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+    and (fe.event_time, fe.id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+    order by case when sqlc.arg('paging_forward')::bool then (fe.event_time, fe.id) end asc,
+            case when not sqlc.arg('paging_forward')::bool then (fe.event_time, fe.id) end desc
+    limit sqlc.arg('limit');
@@ -0,0 +1,143 @@
+-- name: GetFeedEventCandidatesByOwnerIDs :many
+select id, owner_id, event_time, coalesce(nullif(data->>'reposted_feed_event_id', ''), id)::varchar as root_id from feed_events
+where owner_id = any(@owner_ids::varchar[])
+  and deleted = false
+  and hidden = false
+  and event_time > @window_start
+  and event_time <= @window_end
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = feed_events.id and fet.moderated)
+  and not exists(select 1 from feed_events ofe where ofe.id = feed_events.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden))
+order by event_time desc
+limit sqlc.arg('limit');
+
+-- name: CountFeedEventInteractionsInWindow :many
+select feed_event_id, count(*) from events
+where action in ('CommentedOnFeedEvent', 'AdmiredFeedEvent', 'RepostedFeedEvent')
+  and feed_event_id = any(@feed_event_ids::varchar[])
+  and deleted = false
+  and created_at > @window_start
+  and created_at <= @window_end
+group by feed_event_id;
+
+-- name: CountSharedContractsByUserIDs :many
+select b.user_id, count(*)
+from owned_contracts a, owned_contracts b, contracts
+left join marketplace_contracts on contracts.id = marketplace_contracts.contract_id
+where a.user_id = @user_id
+  and b.user_id = any(@user_ids::varchar[])
+  and a.contract_id = b.contract_id
+  and a.contract_id = contracts.id
+  and marketplace_contracts.contract_id is null
+  and contracts.name is not null
+  and contracts.name != ''
+  and contracts.name != 'Unidentified contract'
+group by b.user_id;
+
+-- name: IsFeedEventRepostedByUser :one
+select exists(
+  select 1 from feed_events where deleted = false
+  and owner_id = @owner_id
+  and action = 'RepostedFeedEvent'
+  and data->>'reposted_feed_event_id' = @feed_event_id::varchar
+);
+
+-- name: UpdateFeedEventCaption :one
+update feed_events set caption = @caption, last_updated = now() where id = @id and deleted = false returning *;
+
+-- name: SetFeedEventHidden :one
+update feed_events set hidden = @hidden, last_updated = now() where id = @id and deleted = false returning *;
+
+-- name: DeleteFeedEvent :exec
+update feed_events set deleted = true, last_updated = now() where id = $1 and deleted = false;
+
+-- name: DeleteAdmiresByFeedEventID :exec
+update admires set deleted = true, last_updated = now() where feed_event_id = $1 and deleted = false;
+
+-- name: DeleteCommentsByFeedEventID :exec
+update comments set deleted = true, last_updated = now() where feed_event_id = $1 and deleted = false;
+
+-- name: DeleteNotificationsByFeedEventID :exec
+update notifications set deleted = true, last_updated = now() where feed_event_id = $1 and deleted = false;
+
+-- name: DeleteRepostsOfFeedEvent :exec
+update feed_events set deleted = true, last_updated = now() where data->>'reposted_feed_event_id' = @feed_event_id::varchar and deleted = false;
+
+-- name: CountFollowersByUserID :one
+select count(*) from follows where followee = $1 and deleted = false;
+
+-- name: FanOutFeedEvent :exec
+insert into feed_timelines (user_id, feed_event_id, owner_id, root_id, event_time)
+select follower, @feed_event_id, @owner_id, @root_id, @event_time from follows where followee = @owner_id and deleted = false
+on conflict do nothing;
+
+-- name: AddFeedPullOwner :exec
+insert into feed_pull_owners (owner_id) values ($1) on conflict do nothing;
+
+-- name: RemoveFeedPullOwner :exec
+delete from feed_pull_owners where owner_id = $1;
+
+-- name: BackfillFeedTimelinesForOwner :exec
+insert into feed_timelines (user_id, feed_event_id, owner_id, root_id, event_time)
+select fl.follower, fe.id, fe.owner_id, coalesce(nullif(fe.data->>'reposted_feed_event_id', ''), fe.id), fe.event_time
+from follows fl, (select * from feed_events where owner_id = @owner_id and deleted = false order by event_time desc limit sqlc.arg('limit')) fe
+where fl.followee = fe.owner_id and fl.deleted = false
+on conflict do nothing;
+
+-- name: BackfillFeedTimelineFromFollowees :exec
+-- Copies the recent events of users that were just followed to the follower's timeline. Followees that have been
+-- unfollowed again since are skipped.
+insert into feed_timelines (user_id, feed_event_id, owner_id, root_id, event_time)
+select fl.follower, fe.id, fe.owner_id, coalesce(nullif(fe.data->>'reposted_feed_event_id', ''), fe.id), fe.event_time
+from follows fl, lateral (
+    select id, owner_id, data, event_time from feed_events
+    where owner_id = fl.followee and deleted = false
+    order by event_time desc
+    limit sqlc.arg('limit')
+) fe
+where fl.follower = @user_id and fl.followee = any(@followee_ids::varchar[]) and fl.deleted = false
+  and not exists(select 1 from feed_pull_owners po where po.owner_id = fl.followee)
+on conflict do nothing;
+
+-- name: RemoveFolloweeFromFeedTimeline :exec
+-- Removes an unfollowed user's events from the follower's timeline, unless they've been followed again since
+delete from feed_timelines where user_id = @user_id and owner_id = @followee_id
+  and not exists(select 1 from follows where follower = @user_id and followee = @followee_id and deleted = false);
+
+-- name: TrimFeedTimeline :exec
+delete from feed_timelines where user_id = @user_id
+  and feed_event_id not in (
+    select feed_event_id from feed_timelines where user_id = @user_id order by event_time desc, feed_event_id desc limit sqlc.arg('limit')
+  );
+
+-- name: TrimFeedTimelinesOfFollowers :exec
+-- Trims the timelines of an owner's followers to their most recent events after a fan out
+delete from feed_timelines t
+using (
+    select fl.follower, cutoff.event_time, cutoff.feed_event_id
+    from follows fl, lateral (
+        select event_time, feed_event_id from feed_timelines
+        where user_id = fl.follower
+        order by event_time desc, feed_event_id desc
+        offset sqlc.arg('limit') limit 1
+    ) cutoff
+    where fl.followee = @owner_id and fl.deleted = false
+) c
+where t.user_id = c.follower and (t.event_time, t.feed_event_id) <= (c.event_time, c.feed_event_id);
+
+-- name: IsFeedPullOwner :one
+select exists(select 1 from feed_pull_owners where owner_id = $1);
+
+-- name: PaginateCollectionFeedByCollectionID :many
+select * from feed_events where deleted = false and hidden = false
+    and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = feed_events.id and fet.moderated)
+    and (data->>'collection_id' = @collection_id::varchar
+        or data->>'token_collection_id' = @collection_id
+        or data->'gallery_new_collections' ? @collection_id
+        or data->'gallery_new_token_ids' ? @collection_id
+        or data->'gallery_new_collection_collectors_notes' ? @collection_id
+        or data->'gallery_new_collection_token_collectors_notes' ? @collection_id)
+    and (event_time, id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+    and (event_time, id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+    order by case when sqlc.arg('paging_forward')::bool then (event_time, id) end asc,
+            case when not sqlc.arg('paging_forward')::bool then (event_time, id) end desc
+    limit sqlc.arg('limit');
@@ -27,9 +27,9 @@ select * from galleries g where g.owner_user_id = $1 and g.deleted = false order
 
 -- name: GalleryRepoGetPreviewsForUserID :many
 select (t.media ->> 'thumbnail_url')::text from galleries g,
-    unnest(g.collections) with ordinality as collection_ids(id, ord) inner join collections c on c.id = collection_ids.id and c.deleted = false,
+    unnest(g.collections) with ordinality as collection_ids(id, ord) inner join collections c on c.id = collection_ids.id and c.deleted = false and c.draft = false,
     unnest(c.nfts) with ordinality as token_ids(id, ord) inner join tokens t on t.id = token_ids.id and t.deleted = false
-    where g.owner_user_id = $1 and g.deleted = false and t.media ->> 'thumbnail_url' != ''
+    where g.owner_user_id = $1 and g.deleted = false and g.draft = false and t.media ->> 'thumbnail_url' != ''
     order by collection_ids.ord, token_ids.ord limit $2;
 
 -- name: GalleryRepoDelete :exec
@@ -0,0 +1,33 @@
+-- name: HoldEventIfDraft :one
+-- Holds back an event if its gallery, or a collection that it's about, is a draft. No rows are returned if the event
+-- isn't held.
+insert into held_events (event_id, gallery_id, collection_id)
+select @event_id::varchar, g.id, coalesce(c.id, '')
+from galleries g
+    left join collections c on c.gallery_id = g.id and c.id = any(@collection_ids::varchar[]) and c.deleted = false
+where g.deleted = false and (g.id = @gallery_id or c.id is not null) and (g.draft or coalesce(c.draft, false))
+limit 1
+returning event_id;
+
+-- name: GetReleasableHeldEvents :many
+-- Locks the held events whose gallery and collection have both been published since, so that they're only released
+-- once. Events about drafts that were deleted instead aren't returned.
+select e.* from held_events h
+    join events e on e.id = h.event_id
+where not exists(select 1 from galleries g where g.id = h.gallery_id and g.draft and g.deleted = false)
+    and not exists(select 1 from collections c where c.id = h.collection_id and c.draft and c.deleted = false)
+    and e.deleted = false
+    and exists(select 1 from galleries g where g.id = h.gallery_id and g.deleted = false)
+    and (h.collection_id = '' or exists(select 1 from collections c where c.id = h.collection_id and c.deleted = false))
+order by e.created_at, e.id
+for update of h skip locked;
+
+-- name: DeleteHeldEvents :exec
+delete from held_events where event_id = any(@event_ids::varchar[]);
+
+-- name: DeleteAbandonedHeldEvents :exec
+-- Deletes the held events that won't be released because they, or the drafts they're about, were deleted
+delete from held_events h
+where not exists(select 1 from events e where e.id = h.event_id and e.deleted = false)
+    or not exists(select 1 from galleries g where g.id = h.gallery_id and g.deleted = false)
+    or (h.collection_id != '' and not exists(select 1 from collections c where c.id = h.collection_id and c.deleted = false));
@@ -0,0 +1,46 @@
+-- name: GetMediaBlobByContentKey :one
+select * from media_blobs where bucket = $1 and content_key = $2 and deleted = false;
+
+-- name: UpsertMediaBlob :one
+insert into media_blobs (id, bucket, content_key, object_name, media_type, content_type) values ($1, $2, $3, $4, $5, $6)
+    on conflict (bucket, content_key) where deleted = false
+    do update set object_name = excluded.object_name, media_type = excluded.media_type, content_type = excluded.content_type, last_updated = now()
+    returning *;
+
+-- name: LinkTokenToMediaBlob :execrows
+-- Touching the blob keeps it out of the grace period of the collector and locks it until the link is committed.
+-- Nothing is linked if the blob was already collected.
+with blob as (
+    update media_blobs set last_updated = now() where id = @blob_id and deleted = false returning id
+)
+insert into token_media_blobs (id, chain, contract_address, token_id, url_type, blob_id)
+    select @id, @chain, @contract_address, @token_id, @url_type, blob.id from blob
+    on conflict (chain, contract_address, token_id, url_type) where deleted = false
+    do update set blob_id = excluded.blob_id, last_updated = now();
+
+-- name: UnlinkTokenFromMediaBlob :exec
+update token_media_blobs set deleted = true, last_updated = now()
+    where chain = $1 and contract_address = $2 and token_id = $3 and url_type = $4 and deleted = false;
+
+-- name: UnlinkMediaBlobsOfDeletedTokens :execrows
+update token_media_blobs m set deleted = true, last_updated = now()
+    where m.deleted = false and not exists (
+        select 1 from tokens t join contracts c on c.id = t.contract
+        where t.chain = m.chain and c.address = m.contract_address and t.token_id = m.token_id and t.deleted = false
+    );
+
+-- name: GetUnreferencedMediaBlobs :many
+select b.* from media_blobs b
+    where b.deleted = false and b.last_updated < $1
+    and not exists (select 1 from token_media_blobs m where m.blob_id = b.id and m.deleted = false)
+    order by b.last_updated
+    limit $2;
+
+-- name: LockUnreferencedMediaBlob :one
+select * from media_blobs where id = $1 and deleted = false and last_updated < $2 for update;
+
+-- name: CountMediaBlobReferences :one
+select count(*) from token_media_blobs where blob_id = $1 and deleted = false;
+
+-- name: DeleteMediaBlob :exec
+update media_blobs set deleted = true, last_updated = now() where id = $1;
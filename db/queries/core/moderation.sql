@@ -0,0 +1,20 @@
+-- name: UpsertTokenModeration :one
+insert into token_moderations (id, chain, contract_address, token_id, flags) values ($1, $2, $3, $4, $5)
+    on conflict (chain, contract_address, token_id) where deleted = false
+    do update set
+        -- A review stands for as long as the token is flagged for the same reasons
+        status = case when token_moderations.flags @> excluded.flags and excluded.flags @> token_moderations.flags then token_moderations.status else 'flagged' end,
+        reviewed_by = case when token_moderations.flags @> excluded.flags and excluded.flags @> token_moderations.flags then token_moderations.reviewed_by else null end,
+        reviewed_at = case when token_moderations.flags @> excluded.flags and excluded.flags @> token_moderations.flags then token_moderations.reviewed_at else null end,
+        flags = excluded.flags,
+        last_updated = now()
+    returning *;
+
+-- name: ClearTokenModeration :exec
+update token_moderations set deleted = true, last_updated = now() where chain = $1 and contract_address = $2 and token_id = $3 and deleted = false;
+
+-- name: GetTokenModerationQueue :many
+select * from token_moderations where status = 'flagged' and deleted = false order by created_at limit $1;
+
+-- name: ReviewTokenModeration :one
+update token_moderations set status = @status, reviewed_by = nullif(@reviewed_by::varchar, ''), reviewed_at = now(), last_updated = now() where id = @id and deleted = false returning *;
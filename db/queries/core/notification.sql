@@ -0,0 +1,55 @@
+-- name: CreateSimpleNotification :one
+insert into notifications (id, owner_id, action, data, event_ids) values ($1, $2, $3, $4, $5) returning *;
+
+-- name: GetTokenCreators :many
+-- A token's creator is the user whose wallet deployed the token's contract
+select t.id as token_id, u.id as creator_id
+from tokens t
+    join contracts c on c.id = t.contract and c.deleted = false
+    join wallets w on w.address = c.creator_address and w.chain = c.chain and w.deleted = false
+    join users u on array[w.id] <@ u.wallets and u.deleted = false
+where t.id = any(@token_ids::varchar[]) and t.deleted = false;
+
+-- name: GetCommunityMembersToNotifyOfJoin :many
+-- Users who hold a token from the same contract as a new user and haven't already been told that the user joined.
+-- Spam doesn't make a community, so the new user's spam tokens are left out, and universal users aren't members of
+-- anything. Members who share the most communities with the new user are told first, then members of the smallest
+-- communities, and each member is told about the smallest community they share.
+with new_contracts as (
+    select distinct contract from tokens
+    where owner_user_id = @new_user_id and deleted = false and is_user_marked_spam is not true and is_provider_marked_spam is not true
+), community_sizes as (
+    select t.contract, count(distinct t.owner_user_id) as holders from tokens t
+        join new_contracts nc on nc.contract = t.contract
+    where t.deleted = false
+    group by t.contract
+), members as (
+    select t.owner_user_id as user_id, t.contract as contract_id, cs.holders from tokens t
+        join community_sizes cs on cs.contract = t.contract
+        join users u on u.id = t.owner_user_id and u.deleted = false and u.universal = false
+    where t.owner_user_id != @new_user_id and t.deleted = false
+    group by t.owner_user_id, t.contract, cs.holders
+), ranked as (
+    select distinct on (user_id) user_id, contract_id, holders, count(*) over (partition by user_id) as shared_communities
+    from members
+    order by user_id, holders, contract_id
+)
+select r.user_id, r.contract_id from ranked r
+where not exists (
+    select 1 from events e where e.action = 'CommunityMemberJoined' and e.actor_id = @new_user_id and e.subject_id = r.user_id and e.deleted = false
+)
+order by r.shared_communities desc, r.holders, r.user_id
+limit @lim;
+
+-- name: GetUnseenNotificationCount :one
+-- The count is kept up to date by a trigger on notifications, so a user without a row has never had an unseen notification
+select coalesce((select unseen from user_notification_counts where user_id = @user_id), 0)::int as unseen;
+
+-- name: SetNotificationSeen :one
+update notifications set seen = @seen where id = @id and owner_id = @owner_id and deleted = false returning *;
+
+-- name: ArchiveNotification :one
+update notifications set archived = true where id = @id and owner_id = @owner_id and deleted = false returning *;
+
+-- name: MarkNotificationsSeenBefore :many
+update notifications set seen = true where owner_id = @owner_id and seen = false and deleted = false and last_updated <= @before returning *;
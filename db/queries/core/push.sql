@@ -0,0 +1,14 @@
+-- name: UpsertPushDevice :one
+-- A device can only be registered to one user at a time, so registering it again moves it to the new user
+insert into push_devices (id, owner_id, platform, token, p256dh, auth) values (@id, @owner_id, @platform, @token, @p256dh, @auth)
+    on conflict (platform, token) where deleted = false do update set owner_id = excluded.owner_id, p256dh = excluded.p256dh, auth = excluded.auth, last_updated = now()
+    returning *;
+
+-- name: GetPushDevicesByOwnerID :many
+select * from push_devices where owner_id = @owner_id and deleted = false order by created_at;
+
+-- name: DeletePushDeviceByToken :exec
+update push_devices set deleted = true, last_updated = now() where owner_id = @owner_id and platform = @platform and token = @token and deleted = false;
+
+-- name: DeletePushDeviceByID :exec
+update push_devices set deleted = true, last_updated = now() where id = @id and deleted = false;
@@ -316,6 +316,9 @@ INSERT INTO events (id, actor_id, action, resource_type_id, admire_id, feed_even
 -- name: CreateCommentEvent :one
 INSERT INTO events (id, actor_id, action, resource_type_id, comment_id, feed_event_id, subject_id, data, group_id, caption) VALUES ($1, $2, $3, $4, $5, $6, $5, $7, $8, $9) RETURNING *;
 
+-- name: CreateFeedEventEvent :one
+INSERT INTO events (id, actor_id, action, resource_type_id, feed_event_id, subject_id, data, group_id, caption) VALUES ($1, $2, $3, $4, $5, $5, $6, $7, $8) RETURNING *;
+
 -- name: GetEvent :one
 SELECT * FROM events WHERE id = $1 AND deleted = false;
 
@@ -398,7 +401,9 @@ select exists(
 );
 
 -- name: PaginateGlobalFeed :batchmany
-SELECT * FROM feed_events WHERE deleted = false
+SELECT * FROM feed_events WHERE deleted = false AND hidden = false
+    AND NOT EXISTS(SELECT 1 FROM feed_event_tokens fet WHERE fet.feed_event_id = feed_events.id AND fet.moderated)
+    AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = feed_events.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
     AND (event_time, id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
     AND (event_time, id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (event_time, id) END ASC,
@@ -406,16 +411,69 @@ SELECT * FROM feed_events WHERE deleted = false
     LIMIT sqlc.arg('limit');
 
 -- name: PaginatePersonalFeedByUserID :batchmany
-SELECT fe.* FROM feed_events fe, follows fl WHERE fe.deleted = false AND fl.deleted = false
-    AND fe.owner_id = fl.followee AND fl.follower = sqlc.arg('follower')
-    AND (fe.event_time, fe.id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
-    AND (fe.event_time, fe.id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+-- Each source of the home feed is read a page at a time. Timeline rows are only read while their owner is still
+-- followed, and events older than the oldest event in the user's timeline were trimmed from it or were never added to
+-- it, so they're read from the feed directly. Events that were reposted later by someone the user follows are left out.
+WITH page AS (
+    (SELECT t.feed_event_id AS id FROM feed_timelines t, follows fl, feed_events fe
+        WHERE t.user_id = sqlc.arg('follower') AND fl.follower = t.user_id AND fl.followee = t.owner_id AND fl.deleted = false
+        AND fe.id = t.feed_event_id AND fe.deleted = false AND fe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = fe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (t.event_time, t.feed_event_id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+        AND (t.event_time, t.feed_event_id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = t.root_id
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (t.event_time, t.feed_event_id)
+            AND lf.follower = sqlc.arg('follower') AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (t.event_time, t.feed_event_id) END ASC,
+                CASE WHEN NOT sqlc.arg('paging_forward')::bool THEN (t.event_time, t.feed_event_id) END DESC
+        LIMIT sqlc.arg('limit'))
+    UNION
+    (SELECT pe.id FROM feed_events pe, follows fl, feed_pull_owners po
+        WHERE fl.follower = sqlc.arg('follower') AND fl.deleted = false AND po.owner_id = fl.followee
+        AND pe.owner_id = fl.followee AND pe.deleted = false AND pe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = pe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (pe.event_time, pe.id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+        AND (pe.event_time, pe.id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = coalesce(nullif(pe.data->>'reposted_feed_event_id', ''), pe.id)
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (pe.event_time, pe.id)
+            AND lf.follower = sqlc.arg('follower') AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (pe.event_time, pe.id) END ASC,
+                CASE WHEN NOT sqlc.arg('paging_forward')::bool THEN (pe.event_time, pe.id) END DESC
+        LIMIT sqlc.arg('limit'))
+    UNION
+    (SELECT oe.id FROM feed_events oe, follows fl
+        WHERE fl.follower = sqlc.arg('follower') AND fl.deleted = false
+        AND oe.owner_id = fl.followee AND oe.deleted = false AND oe.hidden = false
+        AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = oe.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
+        AND (oe.event_time, oe.id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+        AND (oe.event_time, oe.id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_timelines ot WHERE ot.user_id = sqlc.arg('follower')
+            AND (ot.event_time, ot.feed_event_id) <= (oe.event_time, oe.id)
+        )
+        AND NOT EXISTS(
+            SELECT 1 FROM feed_events later, follows lf WHERE later.data->>'reposted_feed_event_id' = coalesce(nullif(oe.data->>'reposted_feed_event_id', ''), oe.id)
+            AND later.deleted = false AND later.hidden = false AND (later.event_time, later.id) > (oe.event_time, oe.id)
+            AND lf.follower = sqlc.arg('follower') AND lf.followee = later.owner_id AND lf.deleted = false
+        )
+        ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (oe.event_time, oe.id) END ASC,
+                CASE WHEN NOT sqlc.arg('paging_forward')::bool THEN (oe.event_time, oe.id) END DESC
+        LIMIT sqlc.arg('limit'))
+)
+SELECT fe.* FROM feed_events fe WHERE fe.id IN (SELECT id FROM page)
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (fe.event_time, fe.id) END ASC,
             CASE WHEN NOT sqlc.arg('paging_forward')::bool THEN (fe.event_time, fe.id) END DESC
     LIMIT sqlc.arg('limit');
 
 -- name: PaginateUserFeedByUserID :batchmany
-SELECT * FROM feed_events WHERE owner_id = sqlc.arg('owner_id') AND deleted = false
+-- Hidden and moderated feed events are only included for their owner
+SELECT * FROM feed_events WHERE owner_id = sqlc.arg('owner_id') AND deleted = false AND (hidden = false OR sqlc.arg('include_hidden')::bool)
+    AND (sqlc.arg('include_hidden')::bool OR NOT EXISTS(SELECT 1 FROM feed_event_tokens fet WHERE fet.feed_event_id = feed_events.id AND fet.moderated))
+    AND NOT EXISTS(SELECT 1 FROM feed_events ofe WHERE ofe.id = feed_events.data->>'reposted_feed_event_id' AND (ofe.deleted OR ofe.hidden))
     AND (event_time, id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
     AND (event_time, id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (event_time, id) END ASC,
@@ -423,7 +481,9 @@ SELECT * FROM feed_events WHERE owner_id = sqlc.arg('owner_id') AND deleted = fa
     LIMIT sqlc.arg('limit');
 
 -- name: PaginateTrendingFeed :many
-select f.* from feed_events f join unnest(@feed_event_ids::text[]) with ordinality t(id, pos) using(id) where f.deleted = false
+select f.* from feed_events f join unnest(@feed_event_ids::text[]) with ordinality t(id, pos) using(id) where f.deleted = false and f.hidden = false
+  and not exists(select 1 from feed_event_tokens fet where fet.feed_event_id = f.id and fet.moderated)
+  and not exists(select 1 from feed_events ofe where ofe.id = f.data->>'reposted_feed_event_id' and (ofe.deleted or ofe.hidden))
   and t.pos > @cur_before_pos::int
   and t.pos < @cur_after_pos::int
   order by case when @paging_forward::bool then t.pos end desc,
@@ -532,7 +592,7 @@ SELECT * FROM comments WHERE actor_id = $1 AND deleted = false ORDER BY created_
 SELECT * FROM comments WHERE actor_id = $1 AND deleted = false ORDER BY created_at DESC;
 
 -- name: GetUserNotifications :many
-SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false
+SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false
     AND (created_at, id) < (@cur_before_time, @cur_before_id)
     AND (created_at, id) > (@cur_after_time, @cur_after_id)
     ORDER BY CASE WHEN @paging_forward::bool THEN (created_at, id) END ASC,
@@ -540,7 +600,7 @@ SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false
     LIMIT $2;
 
 -- name: GetUserUnseenNotifications :many
-SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false
+SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false AND seen = false
     AND (created_at, id) < (@cur_before_time, @cur_before_id)
     AND (created_at, id) > (@cur_after_time, @cur_after_id)
     ORDER BY CASE WHEN @paging_forward::bool THEN (created_at, id) END ASC,
@@ -548,10 +608,10 @@ SELECT * FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = f
     LIMIT $2;
 
 -- name: GetRecentUnseenNotifications :many
-SELECT * FROM notifications WHERE owner_id = @owner_id AND deleted = false AND seen = false and created_at > @created_after order by created_at desc limit @lim;
+SELECT * FROM notifications WHERE owner_id = @owner_id AND deleted = false AND archived = false AND seen = false and created_at > @created_after order by created_at desc limit @lim;
 
 -- name: GetUserNotificationsBatch :batchmany
-SELECT * FROM notifications WHERE owner_id = sqlc.arg('owner_id') AND deleted = false
+SELECT * FROM notifications WHERE owner_id = sqlc.arg('owner_id') AND deleted = false AND archived = false
     AND (created_at, id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
     AND (created_at, id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
     ORDER BY CASE WHEN sqlc.arg('paging_forward')::bool THEN (created_at, id) END ASC,
@@ -559,10 +619,10 @@ SELECT * FROM notifications WHERE owner_id = sqlc.arg('owner_id') AND deleted =
     LIMIT sqlc.arg('limit');
 
 -- name: CountUserNotifications :one
-SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false;
+SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false;
 
 -- name: CountUserUnseenNotifications :one
-SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND seen = false;
+SELECT count(*) FROM notifications WHERE owner_id = $1 AND deleted = false AND archived = false AND seen = false;
 
 -- name: GetNotificationByID :one
 SELECT * FROM notifications WHERE id = $1 AND deleted = false;
@@ -575,13 +635,14 @@ select * from notifications
     where owner_id = $1
     and action = $2
     and deleted = false
+    and archived = false
     and (not @only_for_feed_event::bool or feed_event_id = $3)
     order by created_at desc
     limit 1;
 
 -- name: GetNotificationsByOwnerIDForActionAfter :many
 SELECT * FROM notifications
-    WHERE owner_id = $1 AND action = $2 AND deleted = false AND created_at > @created_after
+    WHERE owner_id = $1 AND action = $2 AND deleted = false AND archived = false AND created_at > @created_after
     ORDER BY created_at DESC;
 
 -- name: CreateAdmireNotification :one
@@ -593,6 +654,9 @@ INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id,
 -- name: CreateFollowNotification :one
 INSERT INTO notifications (id, owner_id, action, data, event_ids) VALUES ($1, $2, $3, $4, $5) RETURNING *;
 
+-- name: CreateMentionNotification :one
+INSERT INTO notifications (id, owner_id, action, data, event_ids, feed_event_id, comment_id) VALUES (@id, @owner_id, @action, @data, @event_ids, nullif(@feed_event_id::varchar, ''), nullif(@comment_id::varchar, '')) RETURNING *;
+
 -- name: CreateViewGalleryNotification :one
 INSERT INTO notifications (id, owner_id, action, data, event_ids, gallery_id) VALUES ($1, $2, $3, $4, $5, $6) RETURNING *;
 
@@ -686,6 +750,9 @@ update users set email_verified = 0 where users.id = @user_id;
 -- name: UpdateUserEmailUnsubscriptions :exec
 UPDATE users SET email_unsubscriptions = $2 WHERE id = $1;
 
+-- name: UpdateUserLocale :exec
+update users set locale = @locale, last_updated = now() where id = @user_id and deleted = false;
+
 -- name: UpdateUserPrimaryWallet :exec
 update users set primary_wallet_id = @wallet_id from wallets
     where users.id = @user_id and wallets.id = @wallet_id
@@ -744,7 +811,16 @@ update galleries g set position = updates.position, last_updated = now() from up
 select exists(select position,count(*) from galleries where owner_user_id = $1 and deleted = false group by position having count(*) > 1);
 
 -- name: UpdateGalleryInfo :exec
-update galleries set name = case when @name_set::bool then @name else name end, description = case when @description_set::bool then @description else description end, last_updated = now() where id = @id and deleted = false;
+update galleries set name = case when @name_set::bool then @name else name end, description = case when @description_set::bool then @description else description end, draft = case when @draft_set::bool then @draft else draft end, last_updated = now() where id = @id and deleted = false;
+
+-- name: ScheduleGalleryPublish :exec
+update galleries set publish_at = @publish_at, publish_edit_id = @publish_edit_id, last_updated = now() where id = @id and deleted = false;
+
+-- name: PublishGalleryDrafts :exec
+with published_collections as (
+    update collections set draft = false, last_updated = now() where gallery_id = @gallery_id and draft = true and deleted = false
+)
+update galleries set draft = false, publish_at = null, publish_edit_id = null, last_updated = now() where id = @gallery_id and deleted = false;
 
 -- name: UpdateGalleryCollections :exec
 update galleries set collections = @collections, last_updated = now() where galleries.id = @gallery_id and galleries.deleted = false and (select count(*) from collections c where c.id = any(@collections) and c.gallery_id = @gallery_id and c.deleted = false) = cardinality(@collections);
@@ -753,7 +829,7 @@ update galleries set collections = @collections, last_updated = now() where gall
 update users set featured_gallery = @gallery_id, last_updated = now() from galleries where users.id = @user_id and galleries.id = @gallery_id and galleries.owner_user_id = @user_id and galleries.deleted = false;
 
 -- name: GetGalleryTokenMediasByGalleryID :many
-select t.media from tokens t, collections c, galleries g where g.id = $1 and c.id = any(g.collections) and t.id = any(c.nfts) and t.deleted = false and g.deleted = false and c.deleted = false and (length(t.media->>'thumbnail_url'::varchar) > 0 or length(t.media->>'media_url'::varchar) > 0) order by array_position(g.collections, c.id),array_position(c.nfts, t.id) limit $2;
+select t.media from tokens t, collections c, galleries g where g.id = @id and c.id = any(g.collections) and t.id = any(c.nfts) and t.deleted = false and g.deleted = false and c.deleted = false and (c.draft = false or g.owner_user_id = @viewer_id) and (length(t.media->>'thumbnail_url'::varchar) > 0 or length(t.media->>'media_url'::varchar) > 0) order by array_position(g.collections, c.id),array_position(c.nfts, t.id) limit sqlc.arg('limit');
 
 -- name: GetTokenByTokenIdentifiers :one
 select * from tokens where tokens.token_id = @token_hex and contract = (select contracts.id from contracts where contracts.address = @contract_address) and tokens.chain = @chain and tokens.deleted = false;
@@ -777,7 +853,7 @@ select nfts from collections where id = $1 and deleted = false;
 update collections set nfts = @nfts, last_updated = now() where id = @id and deleted = false;
 
 -- name: CreateCollection :one
-insert into collections (id, version, name, collectors_note, owner_user_id, gallery_id, layout, nfts, hidden, token_settings, created_at, last_updated) values (@id, 1, @name, @collectors_note, @owner_user_id, @gallery_id, @layout, @nfts, @hidden, @token_settings, now(), now()) returning id;
+insert into collections (id, version, name, collectors_note, owner_user_id, gallery_id, layout, nfts, hidden, token_settings, draft, created_at, last_updated) values (@id, 1, @name, @collectors_note, @owner_user_id, @gallery_id, @layout, @nfts, @hidden, @token_settings, @draft, now(), now()) returning id;
 
 -- name: GetGalleryIDByCollectionID :one
 select gallery_id from collections where id = $1 and deleted = false;
@@ -837,7 +913,7 @@ select users.* from users join unnest(@user_ids::varchar[]) with ordinality t(id
 -- name: GetTrendingFeedEventIDs :many
 select feed_events.id, feed_events.created_at, count(*)
 from events as interactions, feed_events
-where interactions.action IN ('CommentedOnFeedEvent', 'AdmiredFeedEvent') and interactions.created_at >= @window_end and interactions.feed_event_id is not null and interactions.feed_event_id = feed_events.id
+where interactions.action IN ('CommentedOnFeedEvent', 'AdmiredFeedEvent', 'RepostedFeedEvent') and interactions.created_at >= @window_end and interactions.feed_event_id is not null and interactions.feed_event_id = feed_events.id and feed_events.deleted = false and feed_events.hidden = false
 group by feed_events.id, feed_events.created_at;
 
 -- name: UpdateCollectionGallery :exec
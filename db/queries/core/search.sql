@@ -43,7 +43,7 @@ where (
     simple_partial_query @@ fts_name or
     english_full_query @@ fts_description_english
     )
-    and deleted = false and hidden = false
+    and deleted = false and hidden = false and draft = false
 order by content_score * match_score desc, content_score desc, match_score desc
 limit sqlc.arg('limit');
 
@@ -0,0 +1,21 @@
+-- name: InsertTokenMetadataHistory :execrows
+insert into token_metadata_history (id, chain, contract_address, token_id, token_metadata, media, hash)
+    select @id, @chain, @contract_address, @token_id, @token_metadata, @media, @hash::varchar
+    where @hash::varchar is distinct from (
+        select hash from token_metadata_history
+            where chain = @chain and contract_address = @contract_address and token_id = @token_id
+            order by created_at desc, id desc
+            limit 1
+    );
+
+-- name: PaginateTokenMetadataHistory :many
+select * from token_metadata_history
+    where chain = sqlc.arg('chain') and contract_address = sqlc.arg('contract_address') and token_id = sqlc.arg('token_id')
+    and (created_at, id) < (sqlc.arg('cur_before_time'), sqlc.arg('cur_before_id'))
+    and (created_at, id) > (sqlc.arg('cur_after_time'), sqlc.arg('cur_after_id'))
+    order by case when sqlc.arg('paging_forward')::bool then (created_at, id) end asc,
+             case when not sqlc.arg('paging_forward')::bool then (created_at, id) end desc
+    limit sqlc.arg('limit');
+
+-- name: CountTokenMetadataHistory :one
+select count(*) from token_metadata_history where chain = $1 and contract_address = $2 and token_id = $3;
@@ -0,0 +1,52 @@
+-- name: EnqueueTokenProcessingJob :one
+insert into token_processing_jobs (id, chain, contract_address, token_id, owner_address, image_keywords, animation_keywords, priority) values ($1, $2, $3, $4, $5, $6, $7, $8)
+    on conflict (chain, contract_address, token_id) where deleted = false
+    do update set
+        owner_address = excluded.owner_address,
+        image_keywords = excluded.image_keywords,
+        animation_keywords = excluded.animation_keywords,
+        priority = case when token_processing_jobs.status = 'queued' then greatest(token_processing_jobs.priority, excluded.priority) when token_processing_jobs.status = 'running' then token_processing_jobs.priority else excluded.priority end,
+        status = case when token_processing_jobs.status = 'running' then token_processing_jobs.status else 'queued' end,
+        attempts = case when token_processing_jobs.status = 'running' then token_processing_jobs.attempts else 0 end,
+        next_attempt_at = case when token_processing_jobs.status = 'running' then token_processing_jobs.next_attempt_at else now() end,
+        last_updated = now()
+    returning *;
+
+-- name: ClaimNextTokenProcessingJob :one
+update token_processing_jobs set status = 'running', attempts = attempts + 1, started_at = now(), last_updated = now()
+    where id = (
+        select j.id from token_processing_jobs j
+        where j.status = 'queued' and j.next_attempt_at <= now() and j.deleted = false
+        order by j.priority desc, j.next_attempt_at
+        limit 1
+        for update skip locked
+    )
+    returning *;
+
+-- name: ClaimTokenProcessingJobByID :one
+update token_processing_jobs set status = 'running', attempts = attempts + 1, started_at = now(), last_updated = now()
+    where id = $1 and status = 'queued' and deleted = false
+    returning *;
+
+-- name: CompleteTokenProcessingJob :exec
+update token_processing_jobs set status = 'succeeded', last_error = null, finished_at = now(), last_updated = now() where id = $1;
+
+-- name: RetryTokenProcessingJob :exec
+update token_processing_jobs set status = 'queued', last_error = $2, next_attempt_at = $3, last_updated = now() where id = $1;
+
+-- name: FailTokenProcessingJob :exec
+update token_processing_jobs set status = 'failed', last_error = $2, finished_at = now(), last_updated = now() where id = $1;
+
+-- name: RequeueStaleTokenProcessingJobs :execrows
+update token_processing_jobs set status = 'queued', last_updated = now() where status = 'running' and started_at < $1 and deleted = false;
+
+-- name: GetTokenProcessingJobByTokenIdentifiers :one
+select * from token_processing_jobs where chain = $1 and contract_address = $2 and token_id = $3 and deleted = false;
+
+-- name: GetTokenByTokenIdentifiersAndOwnerAddress :one
+select tokens.* from tokens, contracts, wallets
+where tokens.token_id = @token_hex and tokens.chain = @chain and tokens.deleted = false
+    and contracts.id = tokens.contract and contracts.address = @contract_address
+    and wallets.address = @owner_address and wallets.chain = @chain and wallets.deleted = false
+    and array[wallets.id] <@ tokens.owned_by_wallets
+limit 1;
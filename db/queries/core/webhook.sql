@@ -0,0 +1,52 @@
+-- name: CreateWebhook :one
+insert into webhooks (id, owner_id, url, secret, actions) values (@id, nullif(@owner_id::varchar, ''), @url, @secret, @actions) returning *;
+
+-- name: GetWebhookByID :one
+select * from webhooks where id = $1 and deleted = false;
+
+-- name: GetWebhooksByOwnerID :many
+select * from webhooks where owner_id = $1 and deleted = false order by created_at;
+
+-- name: GetAdminWebhooks :many
+select * from webhooks where owner_id is null and deleted = false order by created_at;
+
+-- name: GetWebhooksForEvent :many
+-- Returns the admin webhooks subscribed to an action, and the webhooks of the given users that are subscribed to it
+select * from webhooks
+    where @action::varchar = any(actions) and active = true and deleted = false
+    and (owner_id is null or owner_id = any(@owner_ids::varchar[]));
+
+-- name: DeleteWebhook :exec
+update webhooks set deleted = true, active = false, last_updated = now() where id = $1 and deleted = false;
+
+-- name: CreateWebhookDelivery :one
+insert into webhook_deliveries (id, webhook_id, event_id, replay_of_id, action, payload) values (@id, @webhook_id, @event_id, nullif(@replay_of_id::varchar, ''), @action, @payload) returning *;
+
+-- name: GetWebhookDeliveryByID :one
+select * from webhook_deliveries where id = $1 and deleted = false;
+
+-- name: GetWebhookDeliveriesByWebhookID :many
+select * from webhook_deliveries where webhook_id = $1 and deleted = false order by created_at desc limit $2;
+
+-- name: ClaimNextWebhookDelivery :one
+update webhook_deliveries set status = 'delivering', attempts = attempts + 1, started_at = now(), last_updated = now()
+    where id = (
+        select d.id from webhook_deliveries d
+        where d.status = 'pending' and d.next_attempt_at <= now() and d.deleted = false
+        order by d.next_attempt_at
+        limit 1
+        for update skip locked
+    )
+    returning *;
+
+-- name: CompleteWebhookDelivery :exec
+update webhook_deliveries set status = 'succeeded', response_status = $2, last_error = null, delivered_at = now(), last_updated = now() where id = $1;
+
+-- name: RetryWebhookDelivery :exec
+update webhook_deliveries set status = 'pending', response_status = $2, last_error = $3, next_attempt_at = $4, last_updated = now() where id = $1;
+
+-- name: FailWebhookDelivery :exec
+update webhook_deliveries set status = 'failed', response_status = $2, last_error = $3, last_updated = now() where id = $1;
+
+-- name: RequeueStaleWebhookDeliveries :execrows
+update webhook_deliveries set status = 'pending', last_updated = now() where status = 'delivering' and started_at < $1 and deleted = false;
@@ -0,0 +1,423 @@
+package emails
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"cloud.google.com/go/pubsub"
+	"github.com/gin-gonic/gin"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/mail"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"golang.org/x/sync/errgroup"
+)
+
+func init() {
+	env.RegisterValidation("PUBSUB_DIGEST_EMAILS_SUBSCRIPTION", "required")
+	env.RegisterValidation("DIGEST_SEND_WEEKDAY", "min=1", "max=7")
+	env.RegisterValidation("DIGEST_SEND_HOUR", "min=0", "max=23")
+}
+
+const (
+	// digestWindow is how far back the weekly digest looks
+	digestWindow = 7 * 24 * time.Hour
+
+	digestsAtATime    = 1_000
+	digestConcurrency = 50
+	digestFeedEvents  = 5
+	digestTrending    = 5
+	digestCommunities = 3
+
+	// Notifications are searched for before they're filtered down to the ones the user wants in their digest
+	digestNotificationSearch = 100
+	digestNotifications      = 10
+)
+
+type digestFeedEventTemplateData struct {
+	FeedEventID persist.DBID `json:"feedEventId"`
+	Actor       string       `json:"actor"`
+	Action      string       `json:"action"`
+	Caption     string       `json:"caption"`
+}
+
+type digestUserTemplateData struct {
+	Username string `json:"username"`
+}
+
+type digestCommunityTemplateData struct {
+	ContractID     persist.DBID `json:"contractId"`
+	Name           string       `json:"name"`
+	NewHolderCount int64        `json:"newHolderCount"`
+}
+
+type digestGalleryTemplateData struct {
+	GalleryID persist.DBID `json:"galleryId"`
+	Name      string       `json:"name"`
+	ViewCount int64        `json:"viewCount"`
+}
+
+type digestEmailTemplateData struct {
+	Username         string                                 `json:"username"`
+	UnsubscribeToken string                                 `json:"unsubscribeToken"`
+	Notifications    []notificationEmailDynamicTemplateData `json:"notifications"`
+	FeedEvents       []digestFeedEventTemplateData          `json:"feedEvents"`
+	TrendingUsers    []digestUserTemplateData               `json:"trendingUsers"`
+	Communities      []digestCommunityTemplateData          `json:"communities"`
+	Galleries        []digestGalleryTemplateData            `json:"galleries"`
+	TotalViews       int64                                  `json:"totalViews"`
+}
+
+// isEmpty is true when there's nothing personal to put in the digest. Trending users alone aren't worth an email.
+func (d digestEmailTemplateData) isEmpty() bool {
+	return len(d.Notifications) == 0 && len(d.FeedEvents) == 0 && len(d.Communities) == 0 && d.TotalViews == 0
+}
+
+type previewDigestEmailHttpInput struct {
+	UserID persist.DBID `form:"user_id" binding:"required"`
+	Format string       `form:"format"`
+}
+
+// adminPreviewDigestEmail renders the digest a user would be sent right now without sending it. The rendered HTML is
+// returned by default, or the subject and template data if format=json.
+func adminPreviewDigestEmail(queries *coredb.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input previewDigestEmailHttpInput
+		if err := c.ShouldBindQuery(&input); err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		userWithPII, err := queries.GetUserWithPIIByID(c, input.UserID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		trending, err := getDigestTrendingUsers(c, queries, time.Now())
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		data, err := digestToTemplateData(c, queries, userWithPII, trending, time.Now())
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		rendered, err := renderEmail("digest", userWithPII.Locale, data)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		if input.Format == "json" {
+			c.JSON(http.StatusOK, gin.H{
+				"subject": rendered.Subject,
+				"empty":   data.isEmpty(),
+				"data":    data,
+			})
+			return
+		}
+
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(rendered.HTML))
+	}
+}
+
+// autoSendDigestEmails sends the weekly digest. The subscription should be published to every hour, and each run
+// sends to the users whose local time is DIGEST_SEND_HOUR on DIGEST_SEND_WEEKDAY (1 is Monday).
+func autoSendDigestEmails(queries *coredb.Queries, p mail.Provider, psub *pubsub.Client) error {
+	ctx := context.Background()
+	sub := psub.Subscription(env.GetString("PUBSUB_DIGEST_EMAILS_SUBSCRIPTION"))
+
+	return sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
+		err := sendDigestEmailsToDueUsers(ctx, queries, p, msg.PublishTime, env.GetString("ENV") == "production")
+		if err != nil {
+			logger.For(ctx).Errorf("error sending digest emails: %s", err)
+			msg.Nack()
+			return
+		}
+		msg.Ack()
+	})
+}
+
+func sendDigestEmailsToDueUsers(ctx context.Context, queries *coredb.Queries, p mail.Provider, now time.Time, sendRealEmails bool) error {
+	emailsSent := new(atomic.Uint64)
+	defer func() {
+		logger.For(ctx).Infof("sent %d digest emails", emailsSent.Load())
+	}()
+
+	trending, err := getDigestTrendingUsers(ctx, queries, now)
+	if err != nil {
+		return err
+	}
+
+	requiredStatus := persist.EmailVerificationStatusVerified
+	if isDevEnv() {
+		requiredStatus = persist.EmailVerificationStatusAdmin
+	}
+
+	errGroup := new(errgroup.Group)
+	errGroup.SetLimit(digestConcurrency)
+
+	var lastID persist.DBID
+	for {
+		users, err := queries.GetUsersDueForDigestEmail(ctx, coredb.GetUsersDueForDigestEmailParams{
+			EmailVerified: requiredStatus,
+			Now:           now,
+			SendWeekday:   int32(env.GetInt("DIGEST_SEND_WEEKDAY")),
+			SendHour:      int32(env.GetInt("DIGEST_SEND_HOUR")),
+			CurAfterID:    lastID,
+			Limit:         digestsAtATime,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, user := range users {
+			u := user
+			errGroup.Go(func() error {
+				sent, err := sendDigestEmailToUser(ctx, u, queries, p, trending, now, sendRealEmails)
+				if err != nil {
+					return err
+				}
+				if sent {
+					emailsSent.Add(1)
+				}
+				return nil
+			})
+		}
+
+		if len(users) < digestsAtATime {
+			break
+		}
+
+		lastID = users[len(users)-1].ID
+	}
+
+	return errGroup.Wait()
+}
+
+// sendDigestEmailToUser sends a user their digest and records when it was sent, so that the user isn't sent it
+// again if the run is retried. It returns whether an email was sent, which it isn't if the digest would be empty.
+// An empty digest is still recorded as sent, otherwise the user would be looked at again by every run that day.
+func sendDigestEmailToUser(ctx context.Context, u coredb.PiiUserView, queries *coredb.Queries, p mail.Provider, trending []digestUserTemplateData, now time.Time, sendRealEmail bool) (bool, error) {
+	// The digest isn't marked as sent, so it goes out in the first run after quiet hours end
+	if u.NotificationSettings.InQuietHours(now) {
+		return false, nil
+	}
+
+	data, err := digestToTemplateData(ctx, queries, u, trending, now)
+	if err != nil {
+		return false, err
+	}
+
+	if data.isEmpty() {
+		return false, markDigestEmailSent(ctx, queries, u.ID, now)
+	}
+
+	if !sendRealEmail {
+		asJSON, err := json.Marshal(data)
+		if err != nil {
+			return false, err
+		}
+
+		logger.For(ctx).Infof("would have sent digest to %s (username: %s): %s", u.ID, u.Username.String, string(asJSON))
+		return true, nil
+	}
+
+	rendered, err := renderEmail("digest", u.Locale, data)
+	if err != nil {
+		return false, err
+	}
+
+	err = p.Send(ctx, mail.Message{
+		From:    fromAddress(),
+		To:      toAddress(u.Username.String, u.PiiEmailAddress.String()),
+		Subject: rendered.Subject,
+		HTML:    rendered.HTML,
+		Text:    rendered.Text,
+		Headers: map[string]string{
+			"List-Unsubscribe": fmt.Sprintf("<%s?digest=true&jwt=%s>", galleryURL("/unsubscribe"), data.UnsubscribeToken),
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, markDigestEmailSent(ctx, queries, u.ID, now)
+}
+
+func markDigestEmailSent(ctx context.Context, queries *coredb.Queries, userID persist.DBID, now time.Time) error {
+	err := queries.MarkDigestEmailSent(ctx, coredb.MarkDigestEmailSentParams{
+		UserID: userID,
+		SentAt: sql.NullTime{Time: now, Valid: true},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to mark digest sent for user %s: %w", userID, err)
+	}
+	return nil
+}
+
+// getDigestTrendingUsers returns the galleries that trended over the last week. They're the same for everyone, so
+// they're only looked up once per run.
+func getDigestTrendingUsers(ctx context.Context, queries *coredb.Queries, now time.Time) ([]digestUserTemplateData, error) {
+	trendingIDs, err := queries.GetWindowedTrendingUserIDs(ctx, coredb.GetWindowedTrendingUserIDsParams{
+		WindowEnd: now.Add(-digestWindow),
+		// Fetch an extra user in case the recipient is one of them
+		Limit: digestTrending + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending users: %w", err)
+	}
+
+	asStr := make([]string, len(trendingIDs))
+	for i, id := range trendingIDs {
+		asStr[i] = id.String()
+	}
+
+	users, err := queries.GetTrendingUsersByIDs(ctx, asStr)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trending users: %w", err)
+	}
+
+	trending := make([]digestUserTemplateData, 0, len(users))
+	for _, user := range users {
+		trending = append(trending, digestUserTemplateData{Username: user.Username.String})
+	}
+
+	return trending, nil
+}
+
+func digestToTemplateData(ctx context.Context, queries *coredb.Queries, u coredb.PiiUserView, trending []digestUserTemplateData, now time.Time) (digestEmailTemplateData, error) {
+	windowStart := now.Add(-digestWindow)
+
+	j, err := jwtGenerate(u.ID, u.PiiEmailAddress.String())
+	if err != nil {
+		return digestEmailTemplateData{}, fmt.Errorf("failed to generate jwt for user %s: %w", u.ID, err)
+	}
+
+	data := digestEmailTemplateData{
+		Username:         u.Username.String,
+		UnsubscribeToken: j,
+	}
+
+	notifs, err := queries.GetRecentUnseenNotifications(ctx, coredb.GetRecentUnseenNotificationsParams{
+		OwnerID:      u.ID,
+		Lim:          digestNotificationSearch,
+		CreatedAfter: windowStart,
+	})
+	if err != nil {
+		return digestEmailTemplateData{}, fmt.Errorf("failed to get notifications for user %s: %w", u.ID, err)
+	}
+
+	for _, notif := range notificationsForEmail(u.NotificationSettings, notifs, persist.NotificationDeliveryDigest) {
+		notifTemplate, err := notifToTemplateData(ctx, queries, notif, u.Locale)
+		if err != nil {
+			logger.For(ctx).Warnf("failed to get template data for notification %s: %s", notif.ID, err)
+			continue
+		}
+		data.Notifications = append(data.Notifications, notifTemplate)
+		if len(data.Notifications) >= digestNotifications {
+			break
+		}
+	}
+
+	feedEvents, err := queries.GetDigestFeedEventsByFollower(ctx, coredb.GetDigestFeedEventsByFollowerParams{
+		Follower:    u.ID,
+		WindowStart: windowStart,
+		Limit:       digestFeedEvents,
+	})
+	if err != nil {
+		return digestEmailTemplateData{}, fmt.Errorf("failed to get feed events for user %s: %w", u.ID, err)
+	}
+
+	for _, event := range feedEvents {
+		actor, err := queries.GetUserById(ctx, event.OwnerID)
+		if err != nil {
+			logger.For(ctx).Warnf("failed to get owner %s of feed event %s: %s", event.OwnerID, event.ID, err)
+			continue
+		}
+		data.FeedEvents = append(data.FeedEvents, digestFeedEventTemplateData{
+			FeedEventID: event.ID,
+			Actor:       actor.Username.String,
+			Action:      digestFeedEventAction(event.Action, u.Locale),
+			Caption:     util.TruncateWithEllipsis(event.Caption.String, 80),
+		})
+	}
+
+	for _, user := range trending {
+		if user.Username != u.Username.String && len(data.TrendingUsers) < digestTrending {
+			data.TrendingUsers = append(data.TrendingUsers, user)
+		}
+	}
+
+	communities, err := queries.GetNewCommunityHoldersForDigest(ctx, coredb.GetNewCommunityHoldersForDigestParams{
+		UserID:      u.ID,
+		WindowStart: windowStart,
+		Limit:       digestCommunities,
+	})
+	if err != nil {
+		return digestEmailTemplateData{}, fmt.Errorf("failed to get new community holders for user %s: %w", u.ID, err)
+	}
+
+	for _, community := range communities {
+		data.Communities = append(data.Communities, digestCommunityTemplateData{
+			ContractID:     community.ID,
+			Name:           community.Name.String,
+			NewHolderCount: community.NewHolderCount,
+		})
+	}
+
+	galleries, err := queries.GetGalleryViewCountsForDigest(ctx, coredb.GetGalleryViewCountsForDigestParams{
+		OwnerID:     u.ID,
+		WindowStart: windowStart,
+	})
+	if err != nil {
+		return digestEmailTemplateData{}, fmt.Errorf("failed to get gallery views for user %s: %w", u.ID, err)
+	}
+
+	for _, gallery := range galleries {
+		data.TotalViews += gallery.ViewCount
+		data.Galleries = append(data.Galleries, digestGalleryTemplateData{
+			GalleryID: gallery.ID,
+			Name:      gallery.Name,
+			ViewCount: gallery.ViewCount,
+		})
+	}
+
+	return data, nil
+}
+
+func digestFeedEventAction(action persist.Action, locale string) string {
+	switch action {
+	case persist.ActionUserCreated:
+		return phrase(locale, "feedEvent.joined", nil)
+	case persist.ActionUserFollowedUsers:
+		return phrase(locale, "feedEvent.followed", nil)
+	case persist.ActionCollectorsNoteAddedToToken, persist.ActionCollectorsNoteAddedToCollection:
+		return phrase(locale, "feedEvent.collectorsNote", nil)
+	case persist.ActionCollectionCreated:
+		return phrase(locale, "feedEvent.collectionCreated", nil)
+	case persist.ActionTokensAddedToCollection:
+		return phrase(locale, "feedEvent.tokensAdded", nil)
+	case persist.ActionCollectionUpdated, persist.ActionGalleryUpdated, persist.ActionGalleryInfoUpdated:
+		return phrase(locale, "feedEvent.galleryUpdated", nil)
+	case persist.ActionTokenMinted:
+		return phrase(locale, "feedEvent.minted", nil)
+	case persist.ActionTokenAcquired, persist.ActionTokensAcquired:
+		return phrase(locale, "feedEvent.collected", nil)
+	case persist.ActionRepostedFeedEvent:
+		return phrase(locale, "feedEvent.reposted", nil)
+	default:
+		return phrase(locale, "feedEvent.posted", nil)
+	}
+}
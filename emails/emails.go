@@ -21,7 +21,6 @@ import (
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/service/tracing"
 	"github.com/mikeydub/go-gallery/util"
-	"github.com/sendgrid/sendgrid-go"
 	"github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"google.golang.org/api/option"
@@ -45,7 +44,7 @@ func coreInitServer() *gin.Engine {
 
 	loaders := dataloader.NewLoaders(context.Background(), queries, false)
 
-	sendgridClient := sendgrid.NewSendClient(env.GetString("SENDGRID_API_KEY"))
+	mailProvider := newMailProvider()
 
 	http.DefaultClient = &http.Client{Transport: tracing.NewTracingTransport(http.DefaultTransport, false)}
 
@@ -74,11 +73,12 @@ func coreInitServer() *gin.Engine {
 		}
 	}
 
-	go autoSendNotificationEmails(queries, sendgridClient, pub)
+	go autoSendNotificationEmails(queries, mailProvider, pub)
+	go autoSendDigestEmails(queries, mailProvider, pub)
 
 	redisClient := redis.NewClient(redis.EmailRateLimiterDB)
 
-	return handlersInitServer(router, loaders, queries, sendgridClient, redisClient)
+	return handlersInitServer(router, loaders, queries, mailProvider, redisClient)
 }
 
 func setDefaults() {
@@ -92,14 +92,21 @@ func setDefaults() {
 	viper.SetDefault("REDIS_URL", "localhost:6379")
 	viper.SetDefault("SENTRY_DSN", "")
 	viper.SetDefault("VERSION", "")
+	viper.SetDefault("MAIL_PROVIDER", "sendgrid")
 	viper.SetDefault("SENDGRID_API_KEY", "")
 	viper.SetDefault("SENDGRID_VALIDATION_KEY", "")
-	viper.SetDefault("FROM_EMAIL", "test@gallery.so")
 	viper.SetDefault("SENDGRID_DEFAULT_LIST_ID", "865cea98-bf23-4ca3-a8d7-2dc9ea29951b")
-	viper.SetDefault("SENDGRID_NOTIFICATIONS_TEMPLATE_ID", "d-6135d8f36e9946979b0dcf1800363ab4")
-	viper.SetDefault("SENDGRID_VERIFICATION_TEMPLATE_ID", "d-b575d54dc86d40fdbf67b3119589475a")
-	viper.SetDefault("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID", 20676)
+	viper.SetDefault("SMTP_HOST", "")
+	viper.SetDefault("SMTP_PORT", 587)
+	viper.SetDefault("SMTP_USERNAME", "")
+	viper.SetDefault("SMTP_PASSWORD", "")
+	viper.SetDefault("MAIL_FILE_DIR", "./emails/outbox")
+	viper.SetDefault("FROM_EMAIL", "test@gallery.so")
+	viper.SetDefault("GALLERY_HOST", "http://localhost:3000")
 	viper.SetDefault("PUBSUB_NOTIFICATIONS_EMAILS_SUBSCRIPTION", "notifications-email-sub")
+	viper.SetDefault("PUBSUB_DIGEST_EMAILS_SUBSCRIPTION", "digest-email-sub")
+	viper.SetDefault("DIGEST_SEND_WEEKDAY", 1)
+	viper.SetDefault("DIGEST_SEND_HOUR", 9)
 	viper.SetDefault("GOOGLE_CLOUD_PROJECT", "")
 	viper.SetDefault("ADMIN_PASS", "admin")
 
@@ -119,9 +126,12 @@ func setDefaults() {
 	if env.GetString("ENV") != "local" {
 		util.VarNotSetTo("SENTRY_DSN", "")
 		util.VarNotSetTo("VERSION", "")
-		util.VarNotSetTo("SENDGRID_API_KEY", "")
 		util.VarNotSetTo("JWT_SECRET", "")
 		util.VarNotSetTo("FROM_EMAIL", "")
+		util.VarNotSetTo("GALLERY_HOST", "http://localhost:3000")
+		if env.GetString("MAIL_PROVIDER") == "sendgrid" {
+			util.VarNotSetTo("SENDGRID_API_KEY", "")
+		}
 	}
 }
 
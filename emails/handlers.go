@@ -8,23 +8,27 @@ import (
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/middleware"
-	"github.com/sendgrid/sendgrid-go"
+	"github.com/mikeydub/go-gallery/service/mail"
 )
 
-func handlersInitServer(router *gin.Engine, loaders *dataloader.Loaders, queries *coredb.Queries, s *sendgrid.Client, r *redis.Client) *gin.Engine {
+func handlersInitServer(router *gin.Engine, loaders *dataloader.Loaders, queries *coredb.Queries, p mail.Provider, r *redis.Client) *gin.Engine {
 	sendGroup := router.Group("/send")
 
-	sendGroup.POST("/notifications", middleware.AdminRequired(), adminSendNotificationEmail(queries, s))
+	sendGroup.POST("/notifications", middleware.AdminRequired(), adminSendNotificationEmail(queries, p))
+
+	previewGroup := router.Group("/preview")
+	previewGroup.GET("/digest", middleware.AdminRequired(), adminPreviewDigestEmail(queries))
+	previewGroup.GET("/notifications", middleware.AdminRequired(), adminPreviewNotificationEmail(queries))
 
 	verificationLimiter := middleware.RateLimited(middleware.NewKeyRateLimiter(1, time.Second*5, r))
-	sendGroup.POST("/verification", verificationLimiter, sendVerificationEmail(loaders, queries, s))
+	sendGroup.POST("/verification", verificationLimiter, sendVerificationEmail(loaders, queries, p))
 
 	router.POST("/subscriptions", updateSubscriptions(queries))
 	router.POST("/unsubscribe", unsubscribe(queries))
 	router.POST("/resubscribe", resubscribe(queries))
 
-	router.POST("/verify", verifyEmail(queries))
+	router.POST("/verify", verifyEmail(queries, p))
 	preVerifyLimiter := middleware.RateLimited(middleware.NewKeyRateLimiter(1, time.Millisecond*500, r))
-	router.GET("/preverify", preVerifyLimiter, preverifyEmail())
+	router.GET("/preverify", preVerifyLimiter, preverifyEmail(p))
 	return router
 }
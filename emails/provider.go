@@ -0,0 +1,35 @@
+package emails
+
+import (
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/mail"
+)
+
+func init() {
+	env.RegisterValidation("MAIL_PROVIDER", "required", "oneof=sendgrid smtp file")
+	env.RegisterValidation("SENDGRID_API_KEY", "required")
+	env.RegisterValidation("SENDGRID_DEFAULT_LIST_ID", "required")
+	env.RegisterValidation("SMTP_HOST", "required")
+	env.RegisterValidation("MAIL_FILE_DIR", "required")
+}
+
+// newMailProvider returns the provider that MAIL_PROVIDER is set to. "file" writes emails to MAIL_FILE_DIR instead of
+// sending them, which is handy for working on templates locally.
+func newMailProvider() mail.Provider {
+	switch env.GetString("MAIL_PROVIDER") {
+	case "smtp":
+		return mail.NewSMTPProvider(env.GetString("SMTP_HOST"), env.GetInt("SMTP_PORT"), env.GetString("SMTP_USERNAME"), env.GetString("SMTP_PASSWORD"))
+	case "file":
+		return mail.NewFileProvider(env.GetString("MAIL_FILE_DIR"))
+	default:
+		return mail.NewSendGridProvider(env.GetString("SENDGRID_API_KEY"), env.GetString("SENDGRID_VALIDATION_KEY"), env.GetString("SENDGRID_DEFAULT_LIST_ID"))
+	}
+}
+
+func fromAddress() mail.Address {
+	return mail.Address{Name: "Gallery", Email: env.GetString("FROM_EMAIL")}
+}
+
+func toAddress(username string, email string) mail.Address {
+	return mail.Address{Name: username, Email: email}
+}
@@ -14,17 +14,15 @@ import (
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/mail"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/util"
-	"github.com/sendgrid/rest"
-	sendgrid "github.com/sendgrid/sendgrid-go"
-	"github.com/sendgrid/sendgrid-go/helpers/mail"
 	"golang.org/x/sync/errgroup"
 )
 
 func init() {
 	env.RegisterValidation("FROM_EMAIL", "required", "email")
-	env.RegisterValidation("SENDGRID_VERIFICATION_TEMPLATE_ID", "required")
+	env.RegisterValidation("GALLERY_HOST", "required")
 	env.RegisterValidation("PUBSUB_NOTIFICATIONS_EMAILS_SUBSCRIPTION", "required")
 }
 
@@ -40,6 +38,18 @@ type sendNotificationEmailHttpInput struct {
 	SendRealEmails bool          `json:"send_real_emails"`
 }
 
+type previewNotificationEmailHttpInput struct {
+	UserID          persist.DBID   `form:"user_id" binding:"required"`
+	NotificationIDs []persist.DBID `form:"notification_ids"`
+	Locale          string         `form:"locale"`
+	Format          string         `form:"format"`
+}
+
+type errNotificationNotOwned struct {
+	userID         persist.DBID
+	notificationID persist.DBID
+}
+
 type verificationEmailTemplateData struct {
 	Username string
 	JWT      string
@@ -53,7 +63,7 @@ type errEmailMismatch struct {
 	userID persist.DBID
 }
 
-func sendVerificationEmail(dataloaders *dataloader.Loaders, queries *coredb.Queries, s *sendgrid.Client) gin.HandlerFunc {
+func sendVerificationEmail(dataloaders *dataloader.Loaders, queries *coredb.Queries, p mail.Provider) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
 		var input VerificationEmailInput
@@ -83,20 +93,22 @@ func sendVerificationEmail(dataloaders *dataloader.Loaders, queries *coredb.Quer
 
 		//logger.For(c).Debugf("sending verification email to %s with token %s", emailAddress, j)
 
-		from := mail.NewEmail("Gallery", env.GetString("FROM_EMAIL"))
-		to := mail.NewEmail(userWithPII.Username.String, emailAddress)
-		m := mail.NewV3Mail()
-		m.SetFrom(from)
-		p := mail.NewPersonalization()
-		m.SetTemplateID(env.GetString("SENDGRID_VERIFICATION_TEMPLATE_ID"))
-		p.DynamicTemplateData = map[string]interface{}{
-			"username":          userWithPII.Username.String,
-			"verificationToken": j,
+		rendered, err := renderEmail("verification", userWithPII.Locale, verificationEmailTemplateData{
+			Username: userWithPII.Username.String,
+			JWT:      j,
+		})
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
 		}
-		m.AddPersonalizations(p)
-		p.AddTos(to)
 
-		_, err = s.Send(m)
+		err = p.Send(c, mail.Message{
+			From:    fromAddress(),
+			To:      toAddress(userWithPII.Username.String, emailAddress),
+			Subject: rendered.Subject,
+			HTML:    rendered.HTML,
+			Text:    rendered.Text,
+		})
 		if err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
@@ -112,6 +124,8 @@ type notificationEmailDynamicTemplateData struct {
 	Action         string       `json:"action"`
 	CollectionName string       `json:"collectionName"`
 	CollectionID   persist.DBID `json:"collectionId"`
+	TokenName      string       `json:"tokenName"`
+	CommunityName  string       `json:"communityName"`
 	PreviewText    string       `json:"previewText"`
 }
 type notificationsEmailDynamicTemplateData struct {
@@ -120,7 +134,7 @@ type notificationsEmailDynamicTemplateData struct {
 	UnsubscribeToken string                                 `json:"unsubscribeToken"`
 }
 
-func adminSendNotificationEmail(queries *coredb.Queries, s *sendgrid.Client) gin.HandlerFunc {
+func adminSendNotificationEmail(queries *coredb.Queries, p mail.Provider) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
 
@@ -136,7 +150,7 @@ func adminSendNotificationEmail(queries *coredb.Queries, s *sendgrid.Client) gin
 			return
 		}
 
-		if _, err := sendNotificationEmailToUser(c, userWithPII, input.ToEmail, queries, s, 10, 5, input.SendRealEmails); err != nil {
+		if _, err := sendNotificationEmailToUser(c, userWithPII, input.ToEmail, queries, p, 10, 5, input.SendRealEmails); err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
 		}
@@ -145,12 +159,82 @@ func adminSendNotificationEmail(queries *coredb.Queries, s *sendgrid.Client) gin
 	}
 }
 
-func autoSendNotificationEmails(queries *coredb.Queries, s *sendgrid.Client, psub *pubsub.Client) error {
+// adminPreviewNotificationEmail renders a notifications email for a user without sending it. The email describes the
+// given notifications, or the ones the user would be sent right now if none are given, in the user's locale unless
+// another is given. The rendered HTML is returned by default, or the subject, text and template data if format=json.
+func adminPreviewNotificationEmail(queries *coredb.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var input previewNotificationEmailHttpInput
+		if err := c.ShouldBindQuery(&input); err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		userWithPII, err := queries.GetUserWithPIIByID(c, input.UserID)
+		if err != nil {
+			util.ErrResponse(c, http.StatusBadRequest, err)
+			return
+		}
+
+		if input.Locale != "" {
+			userWithPII.Locale = input.Locale
+		}
+
+		var notifs []coredb.Notification
+		if len(input.NotificationIDs) > 0 {
+			for _, id := range input.NotificationIDs {
+				notif, err := queries.GetNotificationByID(c, id)
+				if err != nil {
+					util.ErrResponse(c, http.StatusBadRequest, fmt.Errorf("failed to get notification %s: %w", id, err))
+					return
+				}
+				if notif.OwnerID != userWithPII.ID {
+					util.ErrResponse(c, http.StatusBadRequest, errNotificationNotOwned{userID: userWithPII.ID, notificationID: id})
+					return
+				}
+				notifs = append(notifs, notif)
+			}
+		} else {
+			notifs, err = notificationsToEmail(c, queries, userWithPII, 10)
+			if err != nil {
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
+			}
+		}
+
+		j, err := jwtGenerate(userWithPII.ID, userWithPII.PiiEmailAddress.String())
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		data := notificationsToTemplateData(c, queries, userWithPII, notifs, j, len(notifs))
+
+		rendered, err := renderEmail("notifications", userWithPII.Locale, data)
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		if input.Format == "json" {
+			c.JSON(http.StatusOK, gin.H{
+				"subject": rendered.Subject,
+				"text":    rendered.Text,
+				"data":    data,
+			})
+			return
+		}
+
+		c.Data(http.StatusOK, "text/html; charset=utf-8", []byte(rendered.HTML))
+	}
+}
+
+func autoSendNotificationEmails(queries *coredb.Queries, p mail.Provider, psub *pubsub.Client) error {
 	ctx := context.Background()
 	sub := psub.Subscription(env.GetString("PUBSUB_NOTIFICATIONS_EMAILS_SUBSCRIPTION"))
 
 	return sub.Receive(ctx, func(ctx context.Context, msg *pubsub.Message) {
-		err := sendNotificationEmailsToAllUsers(ctx, queries, s, env.GetString("ENV") == "production")
+		err := sendNotificationEmailsToAllUsers(ctx, queries, p, env.GetString("ENV") == "production")
 		if err != nil {
 			logger.For(ctx).Errorf("error sending notification emails: %s", err)
 			msg.Nack()
@@ -160,7 +244,7 @@ func autoSendNotificationEmails(queries *coredb.Queries, s *sendgrid.Client, psu
 	})
 }
 
-func sendNotificationEmailsToAllUsers(c context.Context, queries *coredb.Queries, s *sendgrid.Client, sendRealEmails bool) error {
+func sendNotificationEmailsToAllUsers(c context.Context, queries *coredb.Queries, p mail.Provider, sendRealEmails bool) error {
 
 	emailsSent := new(atomic.Uint64)
 	defer func() {
@@ -168,26 +252,78 @@ func sendNotificationEmailsToAllUsers(c context.Context, queries *coredb.Queries
 	}()
 	return runForUsersWithNotificationsOnForEmailType(c, persist.EmailTypeNotifications, queries, func(u coredb.PiiUserView) error {
 
-		response, err := sendNotificationEmailToUser(c, u, u.PiiEmailAddress, queries, s, 10, 5, sendRealEmails)
+		sent, err := sendNotificationEmailToUser(c, u, u.PiiEmailAddress, queries, p, 10, 5, sendRealEmails)
 		if err != nil {
 			return err
 		}
-		if response != nil {
-			if response.StatusCode >= 299 || response.StatusCode < 200 {
-				logger.For(c).Errorf("error sending email to %s: %s", u.Username.String, response.Body)
-			} else {
-				emailsSent.Add(1)
-			}
+		if sent {
+			emailsSent.Add(1)
 		}
 
 		return nil
 	})
 }
 
-func sendNotificationEmailToUser(c context.Context, u coredb.PiiUserView, emailRecipient persist.Email, queries *coredb.Queries, s *sendgrid.Client, searchLimit int32, resultLimit int, sendRealEmail bool) (*rest.Response, error) {
+// sendNotificationEmailToUser sends a user their recent unseen notifications. It returns whether an email was sent,
+// which it isn't if the user doesn't have any.
+func sendNotificationEmailToUser(c context.Context, u coredb.PiiUserView, emailRecipient persist.Email, queries *coredb.Queries, p mail.Provider, searchLimit int32, resultLimit int, sendRealEmail bool) (bool, error) {
+
+	// Unseen notifications are still picked up by the next run, so quiet hours only delay the email
+	if u.NotificationSettings.InQuietHours(time.Now()) {
+		return false, nil
+	}
+
+	notifs, err := notificationsToEmail(c, queries, u, searchLimit)
+	if err != nil {
+		return false, err
+	}
+
+	j, err := jwtGenerate(u.ID, u.PiiEmailAddress.String())
+	if err != nil {
+		return false, fmt.Errorf("failed to generate jwt for user %s: %w", u.ID, err)
+	}
+
+	data := notificationsToTemplateData(c, queries, u, notifs, j, resultLimit)
+
+	if len(data.Notifications) == 0 {
+		return false, nil
+	}
+
+	if !sendRealEmail {
+		asJSON, err := json.Marshal(data)
+		if err != nil {
+			return false, err
+		}
+
+		logger.For(c).Infof("would have sent email to %s (username: %s): %s", u.ID, u.Username.String, string(asJSON))
+		return true, nil
+	}
+
+	rendered, err := renderEmail("notifications", u.Locale, data)
+	if err != nil {
+		return false, err
+	}
+
+	err = p.Send(c, mail.Message{
+		From:    fromAddress(),
+		To:      toAddress(u.Username.String, emailRecipient.String()),
+		Subject: rendered.Subject,
+		HTML:    rendered.HTML,
+		Text:    rendered.Text,
+		Headers: map[string]string{
+			"List-Unsubscribe": fmt.Sprintf("<%s?notifications=true&jwt=%s>", galleryURL("/unsubscribe"), j),
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
 
-	// generate notification data for user
-	notifs, err := queries.GetRecentUnseenNotifications(c, coredb.GetRecentUnseenNotificationsParams{
+// notificationsToEmail returns the user's recent unseen notifications that they want emailed right away
+func notificationsToEmail(ctx context.Context, queries *coredb.Queries, u coredb.PiiUserView, searchLimit int32) ([]coredb.Notification, error) {
+	notifs, err := queries.GetRecentUnseenNotifications(ctx, coredb.GetRecentUnseenNotificationsParams{
 		OwnerID:      u.ID,
 		Lim:          searchLimit,
 		CreatedAfter: time.Now().Add(-7 * 24 * time.Hour),
@@ -196,15 +332,16 @@ func sendNotificationEmailToUser(c context.Context, u coredb.PiiUserView, emailR
 		return nil, fmt.Errorf("failed to get notifications for user %s: %w", u.ID, err)
 	}
 
-	j, err := jwtGenerate(u.ID, u.PiiEmailAddress.String())
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate jwt for user %s: %w", u.ID, err)
-	}
+	return notificationsForEmail(u.NotificationSettings, notifs, persist.NotificationDeliveryInstant), nil
+}
 
+// notificationsToTemplateData describes up to resultLimit notifications in the user's locale. Notifications that
+// can't be described are logged and left out.
+func notificationsToTemplateData(ctx context.Context, queries notificationQueries, u coredb.PiiUserView, notifs []coredb.Notification, unsubscribeToken string, resultLimit int) notificationsEmailDynamicTemplateData {
 	data := notificationsEmailDynamicTemplateData{
 		Notifications:    make([]notificationEmailDynamicTemplateData, 0, resultLimit),
 		Username:         u.Username.String,
-		UnsubscribeToken: j,
+		UnsubscribeToken: unsubscribeToken,
 	}
 	notifTemplates := make(chan notificationEmailDynamicTemplateData)
 	errChan := make(chan error)
@@ -212,7 +349,7 @@ func sendNotificationEmailToUser(c context.Context, u coredb.PiiUserView, emailR
 	for _, n := range notifs {
 		notif := n
 		go func() {
-			notifTemplate, err := notifToTemplateData(c, queries, notif)
+			notifTemplate, err := notifToTemplateData(ctx, queries, notif, u.Locale)
 			if err != nil {
 				errChan <- err
 				return
@@ -225,7 +362,7 @@ outer:
 	for i := 0; i < len(notifs); i++ {
 		select {
 		case err := <-errChan:
-			logger.For(c).Errorf("failed to get notification template data: %v", err)
+			logger.For(ctx).Errorf("failed to get notification template data: %v", err)
 		case notifTemplate := <-notifTemplates:
 			data.Notifications = append(data.Notifications, notifTemplate)
 			if len(data.Notifications) >= resultLimit {
@@ -234,47 +371,21 @@ outer:
 		}
 	}
 
-	if len(data.Notifications) == 0 {
-		return nil, nil
-	}
-
-	asJSON, err := json.Marshal(data)
-	if err != nil {
-		return nil, err
-	}
-
-	asMap := make(map[string]interface{})
-
-	err = json.Unmarshal(asJSON, &asMap)
-	if err != nil {
-		return nil, err
-	}
-
-	if sendRealEmail {
-		// send email
-		from := mail.NewEmail("Gallery", env.GetString("FROM_EMAIL"))
-		to := mail.NewEmail(u.Username.String, emailRecipient.String())
-		m := mail.NewV3Mail()
-		m.SetFrom(from)
-		p := mail.NewPersonalization()
-		m.SetTemplateID(env.GetString("SENDGRID_NOTIFICATIONS_TEMPLATE_ID"))
-		p.DynamicTemplateData = asMap
-		m.AddPersonalizations(p)
-		p.AddTos(to)
-
-		response, err := s.Send(m)
-		if err != nil {
-			return nil, err
-		}
-		return response, nil
-	}
-
-	logger.For(c).Infof("would have sent email to %s (username: %s): %s", u.ID, u.Username.String, string(asJSON))
+	return data
+}
 
-	return &rest.Response{StatusCode: 200, Body: "not sending real emails", Headers: map[string][]string{}}, nil
+// notificationQueries are the queries needed to describe a notification in an email
+type notificationQueries interface {
+	GetCollectionById(ctx context.Context, id persist.DBID) (coredb.Collection, error)
+	GetCommentByCommentID(ctx context.Context, id persist.DBID) (coredb.Comment, error)
+	GetContractByID(ctx context.Context, id persist.DBID) (coredb.Contract, error)
+	GetFeedEventByID(ctx context.Context, id persist.DBID) (coredb.FeedEvent, error)
+	GetTokenById(ctx context.Context, id persist.DBID) (coredb.Token, error)
+	GetUserById(ctx context.Context, id persist.DBID) (coredb.User, error)
 }
 
-func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.Notification) (notificationEmailDynamicTemplateData, error) {
+// notifToTemplateData describes a notification in the given locale
+func notifToTemplateData(ctx context.Context, queries notificationQueries, n coredb.Notification, locale string) (notificationEmailDynamicTemplateData, error) {
 
 	switch n.Action {
 	case persist.ActionAdmiredFeedEvent:
@@ -287,12 +398,12 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 		if collection.ID != "" && collection.Name.String != "" {
 			data.CollectionID = collection.ID
 			data.CollectionName = collection.Name.String
-			data.Action = "admired your additions to"
+			data.Action = phrase(locale, "admiredAdditions", nil)
 		} else {
-			data.Action = "admired your gallery update"
+			data.Action = phrase(locale, "admiredUpdate", nil)
 		}
 		if len(n.Data.AdmirerIDs) > 1 {
-			data.Actor = fmt.Sprintf("%d collectors", len(n.Data.AdmirerIDs))
+			data.Actor = phrase(locale, "collectors", len(n.Data.AdmirerIDs))
 		} else {
 			actorUser, err := queries.GetUserById(ctx, n.Data.AdmirerIDs[0])
 			if err != nil {
@@ -304,8 +415,8 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 	case persist.ActionUserFollowedUsers:
 		if len(n.Data.FollowerIDs) > 1 {
 			return notificationEmailDynamicTemplateData{
-				Actor:  fmt.Sprintf("%d users", len(n.Data.FollowerIDs)),
-				Action: "followed you",
+				Actor:  phrase(locale, "users", len(n.Data.FollowerIDs)),
+				Action: phrase(locale, "followedYou", nil),
 			}, nil
 		}
 		if len(n.Data.FollowerIDs) == 1 {
@@ -313,9 +424,9 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 			if err != nil {
 				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for follower %s: %w", n.Data.FollowerIDs[0], err)
 			}
-			action := "followed you"
+			action := phrase(locale, "followedYou", nil)
 			if n.Data.FollowedBack {
-				action = "followed you back"
+				action = phrase(locale, "followedYouBack", nil)
 			}
 			return notificationEmailDynamicTemplateData{
 				Actor:  userActor.Username.String,
@@ -323,6 +434,24 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 			}, nil
 		}
 		return notificationEmailDynamicTemplateData{}, fmt.Errorf("no follower ids")
+	case persist.ActionRepostedFeedEvent:
+		if len(n.Data.ReposterIDs) > 1 {
+			return notificationEmailDynamicTemplateData{
+				Actor:  phrase(locale, "collectors", len(n.Data.ReposterIDs)),
+				Action: phrase(locale, "repostedUpdate", nil),
+			}, nil
+		}
+		if len(n.Data.ReposterIDs) == 1 {
+			userActor, err := queries.GetUserById(ctx, n.Data.ReposterIDs[0])
+			if err != nil {
+				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for reposter %s: %w", n.Data.ReposterIDs[0], err)
+			}
+			return notificationEmailDynamicTemplateData{
+				Actor:  userActor.Username.String,
+				Action: phrase(locale, "repostedUpdate", nil),
+			}, nil
+		}
+		return notificationEmailDynamicTemplateData{}, fmt.Errorf("no reposter ids")
 	case persist.ActionCommentedOnFeedEvent:
 		comment, err := queries.GetCommentByCommentID(ctx, n.CommentID)
 		if err != nil {
@@ -340,7 +469,7 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 		if collection.ID != "" {
 			return notificationEmailDynamicTemplateData{
 				Actor:          userActor.Username.String,
-				Action:         "commented on your additions to",
+				Action:         phrase(locale, "commentedOnAdditions", nil),
 				CollectionName: collection.Name.String,
 				CollectionID:   collection.ID,
 				PreviewText:    util.TruncateWithEllipsis(comment.Comment, 20),
@@ -348,14 +477,114 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 		}
 		return notificationEmailDynamicTemplateData{
 			Actor:       userActor.Username.String,
-			Action:      "commented on your gallery update",
+			Action:      phrase(locale, "commentedOnUpdate", nil),
 			PreviewText: util.TruncateWithEllipsis(comment.Comment, 20),
 		}, nil
+	case persist.ActionMentionedUser:
+		userActor, err := queries.GetUserById(ctx, n.Data.MentionerID)
+		if err != nil {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for mentioner %s: %w", n.Data.MentionerID, err)
+		}
+		data := notificationEmailDynamicTemplateData{
+			Actor:  userActor.Username.String,
+			Action: phrase(locale, "mentionedYou", nil),
+		}
+		if n.CommentID != "" {
+			comment, err := queries.GetCommentByCommentID(ctx, n.CommentID)
+			if err != nil {
+				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get comment for mention %s: %w", n.CommentID, err)
+			}
+			data.Action = phrase(locale, "mentionedYouInComment", nil)
+			data.PreviewText = util.TruncateWithEllipsis(comment.Comment, 20)
+		}
+		return data, nil
+	case persist.ActionMintedTokenAddedToGallery:
+		if len(n.Data.CollectorIDs) == 0 {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("no collector ids")
+		}
+		data := notificationEmailDynamicTemplateData{
+			Actor:  phrase(locale, "collectors", len(n.Data.CollectorIDs)),
+			Action: phrase(locale, "displayedYourTokens", nil),
+		}
+		if len(n.Data.CollectorIDs) == 1 {
+			userActor, err := queries.GetUserById(ctx, n.Data.CollectorIDs[0])
+			if err != nil {
+				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for collector %s: %w", n.Data.CollectorIDs[0], err)
+			}
+			data.Actor = userActor.Username.String
+		}
+		if len(n.Data.TokenIDs) == 1 {
+			token, err := queries.GetTokenById(ctx, n.Data.TokenIDs[0])
+			if err != nil {
+				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get token %s: %w", n.Data.TokenIDs[0], err)
+			}
+			data.Action = phrase(locale, "displayedYourToken", nil)
+			data.TokenName = token.Name.String
+		}
+		return data, nil
+	case persist.ActionCollectorsNoteAddedToMintedToken:
+		if len(n.Data.CollectorIDs) == 0 || len(n.Data.TokenIDs) == 0 {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("no collector or token ids")
+		}
+		userActor, err := queries.GetUserById(ctx, n.Data.CollectorIDs[0])
+		if err != nil {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for collector %s: %w", n.Data.CollectorIDs[0], err)
+		}
+		token, err := queries.GetTokenById(ctx, n.Data.TokenIDs[0])
+		if err != nil {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get token %s: %w", n.Data.TokenIDs[0], err)
+		}
+		return notificationEmailDynamicTemplateData{
+			Actor:       userActor.Username.String,
+			Action:      phrase(locale, "wroteNoteOnToken", nil),
+			TokenName:   token.Name.String,
+			PreviewText: util.TruncateWithEllipsis(token.CollectorsNote.String, 20),
+		}, nil
+	case persist.ActionCommunityMemberJoined:
+		if len(n.Data.NewMemberIDs) == 0 {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("no new member ids")
+		}
+		contract, err := queries.GetContractByID(ctx, n.Data.CommunityContractID)
+		if err != nil {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get contract %s: %w", n.Data.CommunityContractID, err)
+		}
+		data := notificationEmailDynamicTemplateData{
+			Actor:         phrase(locale, "collectors", len(n.Data.NewMemberIDs)),
+			Action:        phrase(locale, "joinedFromCommunity", nil),
+			CommunityName: contract.Name.String,
+		}
+		if len(n.Data.NewMemberIDs) == 1 {
+			userActor, err := queries.GetUserById(ctx, n.Data.NewMemberIDs[0])
+			if err != nil {
+				return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get user for new member %s: %w", n.Data.NewMemberIDs[0], err)
+			}
+			data.Actor = userActor.Username.String
+		}
+		return data, nil
+	case persist.ActionTokenMediaProcessed:
+		if len(n.Data.TokenIDs) == 0 {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("no token ids")
+		}
+		token, err := queries.GetTokenById(ctx, n.Data.TokenIDs[0])
+		if err != nil {
+			return notificationEmailDynamicTemplateData{}, fmt.Errorf("failed to get token %s: %w", n.Data.TokenIDs[0], err)
+		}
+		data := notificationEmailDynamicTemplateData{
+			Actor:  token.Name.String,
+			Action: phrase(locale, "finishedProcessing", nil),
+		}
+		if data.Actor == "" {
+			data.Actor = phrase(locale, "yourToken", nil)
+		}
+		if n.Data.MediaFailed {
+			data.Action = phrase(locale, "processingFailed", nil)
+		}
+		return data, nil
 	case persist.ActionViewedGallery:
 		if len(n.Data.AuthedViewerIDs)+len(n.Data.UnauthedViewerIDs) > 1 {
 			return notificationEmailDynamicTemplateData{
-				Actor:  fmt.Sprintf("%d collectors", len(n.Data.AuthedViewerIDs)+len(n.Data.UnauthedViewerIDs)),
-				Action: "viewed your gallery",
+				Actor:  phrase(locale, "collectors", len(n.Data.AuthedViewerIDs)+len(n.Data.UnauthedViewerIDs)),
+				Action: phrase(locale, "viewedGallery", nil),
 			}, nil
 		}
 		if len(n.Data.AuthedViewerIDs) == 1 {
@@ -365,13 +594,13 @@ func notifToTemplateData(ctx context.Context, queries *coredb.Queries, n coredb.
 			}
 			return notificationEmailDynamicTemplateData{
 				Actor:  userActor.Username.String,
-				Action: "viewed your gallery",
+				Action: phrase(locale, "viewedGallery", nil),
 			}, nil
 		}
 		if len(n.Data.UnauthedViewerIDs) == 1 {
 			return notificationEmailDynamicTemplateData{
-				Actor:  "Someone",
-				Action: "viewed your gallery",
+				Actor:  phrase(locale, "someone", nil),
+				Action: phrase(locale, "viewedGallery", nil),
 			}, nil
 		}
 
@@ -437,3 +666,18 @@ func (e errNoEmailSet) Error() string {
 func (e errEmailMismatch) Error() string {
 	return fmt.Sprintf("wrong email for user %s", e.userID)
 }
+
+func (e errNotificationNotOwned) Error() string {
+	return fmt.Sprintf("notification %s doesn't belong to user %s", e.notificationID, e.userID)
+}
+
+// notificationsForEmail keeps the notifications that the user wants emailed with the given delivery
+func notificationsForEmail(settings persist.UserNotificationSettings, notifs []coredb.Notification, delivery persist.NotificationDelivery) []coredb.Notification {
+	wanted := make([]coredb.Notification, 0, len(notifs))
+	for _, n := range notifs {
+		if settings.Wants(n.Action, persist.NotificationChannelEmail) && settings.DeliveryFor(n.Action) == delivery {
+			wanted = append(wanted, n)
+		}
+	}
+	return wanted
+}
@@ -1,29 +1,19 @@
 package emails
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
-	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/graphql/model"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/util"
-	"github.com/sendgrid/sendgrid-go"
-	"golang.org/x/sync/errgroup"
 )
 
-func init() {
-	env.RegisterValidation("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID", "required")
-	env.RegisterValidation("SENDGRID_API_KEY", "required")
-}
-
-var emailTypes = []model.EmailUnsubscriptionType{model.EmailUnsubscriptionTypeAll, model.EmailUnsubscriptionTypeNotifications}
+var emailTypes = []model.EmailUnsubscriptionType{model.EmailUnsubscriptionTypeAll, model.EmailUnsubscriptionTypeNotifications, model.EmailUnsubscriptionTypeDigest}
 
 type UpdateSubscriptionsTypeInput struct {
 	UserID persist.DBID                 `json:"user_id,required"`
@@ -61,35 +51,6 @@ func updateSubscriptions(queries *coredb.Queries) gin.HandlerFunc {
 			return
 		}
 
-		emailAddress := userWithPII.PiiEmailAddress.String()
-
-		errGroup := new(errgroup.Group)
-
-		for _, emailType := range emailTypes {
-			switch emailType {
-			case model.EmailUnsubscriptionTypeAll:
-				errGroup.Go(func() error {
-					if input.Unsubs.All {
-						return addEmailToGlobalUnsubscribeGroup(c, emailAddress)
-					}
-					return removeEmailFromGlobalUnsubscribeGroup(c, emailAddress)
-				})
-
-			case model.EmailUnsubscriptionTypeNotifications:
-
-				errGroup.Go(func() error {
-					if input.Unsubs.Notifications {
-						return addEmailToUnsubscribeGroup(c, emailAddress, env.GetString("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID"))
-					}
-					return removeEmailFromUnsubscribeGroup(c, emailAddress, env.GetString("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID"))
-				})
-			default:
-				util.ErrResponse(c, http.StatusBadRequest, fmt.Errorf("unsupported email type: %s", emailType))
-				return
-			}
-
-		}
-
 		logger.For(c).Infof("unsubscribing user %s from email types: %+v", input.UserID, input.Unsubs)
 		err = queries.UpdateUserEmailUnsubscriptions(c, coredb.UpdateUserEmailUnsubscriptionsParams{
 			ID:                   input.UserID,
@@ -100,10 +61,6 @@ func updateSubscriptions(queries *coredb.Queries) gin.HandlerFunc {
 			return
 		}
 
-		if err := errGroup.Wait(); err != nil {
-			logger.For(c).Errorf("error unsubscribing user %s from email types %+v: %s", input.UserID, input.Unsubs, err)
-		}
-
 		c.Status(http.StatusOK)
 	}
 }
@@ -143,21 +100,14 @@ func unsubscribe(queries *coredb.Queries) gin.HandlerFunc {
 
 		unsubs := userWithPII.EmailUnsubscriptions
 
-		errGroup := new(errgroup.Group)
-
 		for _, emailType := range input.Unsubs {
 			switch emailType {
 			case model.EmailUnsubscriptionTypeAll:
 				unsubs.All = true
-				errGroup.Go(func() error {
-					return addEmailToGlobalUnsubscribeGroup(c, emailAddress)
-				})
-
 			case model.EmailUnsubscriptionTypeNotifications:
 				unsubs.Notifications = true
-				errGroup.Go(func() error {
-					return addEmailToUnsubscribeGroup(c, emailAddress, env.GetString("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID"))
-				})
+			case model.EmailUnsubscriptionTypeDigest:
+				unsubs.Digest = true
 			default:
 				util.ErrResponse(c, http.StatusBadRequest, fmt.Errorf("unsupported email type: %s", emailType))
 				return
@@ -175,10 +125,6 @@ func unsubscribe(queries *coredb.Queries) gin.HandlerFunc {
 			return
 		}
 
-		if err := errGroup.Wait(); err != nil {
-			logger.For(c).Errorf("error unsubscribing user %s from email types %+v: %s", userID, input.Unsubs, err)
-		}
-
 		c.Status(http.StatusOK)
 	}
 }
@@ -218,21 +164,14 @@ func resubscribe(queries *coredb.Queries) gin.HandlerFunc {
 
 		unsubs := userWithPII.EmailUnsubscriptions
 
-		errGroup := new(errgroup.Group)
-
 		for _, emailType := range input.Resubs {
 			switch emailType {
 			case model.EmailUnsubscriptionTypeAll:
 				unsubs.All = false
-				errGroup.Go(func() error {
-					return removeEmailFromGlobalUnsubscribeGroup(c, emailAddress)
-				})
-
 			case model.EmailUnsubscriptionTypeNotifications:
 				unsubs.Notifications = false
-				errGroup.Go(func() error {
-					return removeEmailFromUnsubscribeGroup(c, emailAddress, env.GetString("SENDGRID_UNSUBSCRIBE_NOTIFICATIONS_GROUP_ID"))
-				})
+			case model.EmailUnsubscriptionTypeDigest:
+				unsubs.Digest = false
 			default:
 				util.ErrResponse(c, http.StatusBadRequest, fmt.Errorf("unsupported email type: %s", emailType))
 				return
@@ -250,76 +189,6 @@ func resubscribe(queries *coredb.Queries) gin.HandlerFunc {
 			return
 		}
 
-		if err := errGroup.Wait(); err != nil {
-			logger.For(c).Errorf("error unsubscribing user %s from email types %+v: %s", userID, input.Resubs, err)
-		}
-
 		c.Status(http.StatusOK)
 	}
 }
-
-type unsubscribeGroupRecipients struct {
-	RecipientEmails []string `json:"recipient_emails"`
-}
-
-func addEmailToUnsubscribeGroup(ctx context.Context, email string, groupID string) error {
-	return unsubscribeSendgrid(ctx, email, fmt.Sprintf("/v3/asm/groups/%s/suppressions", groupID))
-
-}
-
-func addEmailToGlobalUnsubscribeGroup(ctx context.Context, email string) error {
-	return unsubscribeSendgrid(ctx, email, "/v3/asm/suppressions/global")
-}
-
-func unsubscribeSendgrid(ctx context.Context, email string, url string) error {
-	request := sendgrid.GetRequest(env.GetString("SENDGRID_API_KEY"), url, "https://api.sendgrid.com")
-	request.Method = "POST"
-
-	emails := unsubscribeGroupRecipients{
-		RecipientEmails: []string{email},
-	}
-
-	body, err := json.Marshal(emails)
-	if err != nil {
-		return err
-	}
-
-	request.Body = body
-
-	response, err := sendgrid.API(request)
-	if err != nil {
-		return err
-	}
-
-	if response.StatusCode != 202 {
-		return fmt.Errorf("email unsub addition failed and returned: %+v", response)
-	}
-
-	return nil
-
-}
-
-func removeEmailFromUnsubscribeGroup(ctx context.Context, email string, groupID string) error {
-	return sendSendgridDeleteRequest(ctx, fmt.Sprintf("/v3/asm/groups/%s/suppressions/%s", groupID, email))
-}
-
-func removeEmailFromGlobalUnsubscribeGroup(ctx context.Context, email string) error {
-	return sendSendgridDeleteRequest(ctx, fmt.Sprintf("/v3/asm/suppressions/global/%s", email))
-}
-
-func sendSendgridDeleteRequest(ctx context.Context, url string) error {
-	request := sendgrid.GetRequest(env.GetString("SENDGRID_API_KEY"), url, "https://api.sendgrid.com")
-	request.Method = "DELETE"
-
-	response, err := sendgrid.API(request)
-	if err != nil {
-		return err
-	}
-
-	if response.StatusCode != 202 {
-		return fmt.Errorf("sendgrid delete request failed and returned: %+v", response)
-	}
-
-	return nil
-
-}
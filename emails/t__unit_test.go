@@ -2,12 +2,22 @@ package emails
 
 import (
 	"context"
+	"database/sql"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/require"
 )
 
+var updateGolden = flag.Bool("update", false, "update the golden files of rendered emails")
+
 func TestNotificationTemplating_Success(t *testing.T) {
 	a, _, pgx := setupTest(t)
 
@@ -17,20 +27,20 @@ func TestNotificationTemplating_Success(t *testing.T) {
 	q := coredb.New(pgx)
 
 	t.Run("creates a template for admire notifications", func(t *testing.T) {
-		data, err := notifToTemplateData(ctx, q, admireNotif)
+		data, err := notifToTemplateData(ctx, q, admireNotif, defaultLocale)
 		a.NoError(err)
 		a.Equal(testUser2.Username.String, data.Actor)
 		a.Equal(data.CollectionID, testGallery.Collections[0])
 	})
 
 	t.Run("creates a template for follow notifications", func(t *testing.T) {
-		data, err := notifToTemplateData(ctx, q, followNotif)
+		data, err := notifToTemplateData(ctx, q, followNotif, defaultLocale)
 		a.NoError(err)
 		a.Equal(testUser2.Username.String, data.Actor)
 	})
 
 	t.Run("creates a template for comment notifications", func(t *testing.T) {
-		data, err := notifToTemplateData(ctx, q, commentNotif)
+		data, err := notifToTemplateData(ctx, q, commentNotif, defaultLocale)
 		a.NoError(err)
 		a.Equal(testUser2.Username.String, data.Actor)
 		a.Equal(data.CollectionID, testGallery.Collections[0])
@@ -38,9 +48,196 @@ func TestNotificationTemplating_Success(t *testing.T) {
 	})
 
 	t.Run("creates a template for view notifications", func(t *testing.T) {
-		data, err := notifToTemplateData(ctx, q, viewNotif)
+		data, err := notifToTemplateData(ctx, q, viewNotif, defaultLocale)
 		a.NoError(err)
 		a.Equal(testUser2.Username.String, data.Actor)
 	})
 
 }
+
+func TestDigestTemplating_Success(t *testing.T) {
+	a, _, pgx := setupTest(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 1*time.Minute)
+	defer cancel()
+
+	q := coredb.New(pgx)
+
+	t.Run("counts views of the user's galleries", func(t *testing.T) {
+		data, err := digestToTemplateData(ctx, q, testUser, nil, time.Now())
+		a.NoError(err)
+		a.EqualValues(1, data.TotalViews)
+		a.False(data.isEmpty())
+	})
+
+	t.Run("renders a digest with an unsubscribe link", func(t *testing.T) {
+		data, err := digestToTemplateData(ctx, q, testUser, []digestUserTemplateData{{Username: testUser2.Username.String}}, time.Now())
+		a.NoError(err)
+		rendered, err := renderEmail("digest", defaultLocale, data)
+		a.NoError(err)
+		a.Contains(rendered.Subject, "1 gallery view")
+		a.Contains(rendered.Text, testUser2.Username.String)
+		a.Contains(rendered.HTML, "digest=true&jwt="+data.UnsubscribeToken)
+	})
+
+	t.Run("skips users with nothing in their digest", func(t *testing.T) {
+		data, err := digestToTemplateData(ctx, q, testUser2, nil, time.Now())
+		a.NoError(err)
+		a.True(data.isEmpty())
+	})
+
+	t.Run("marks an empty digest as sent", func(t *testing.T) {
+		now := time.Now().Truncate(time.Second)
+
+		sent, err := sendDigestEmailToUser(ctx, testUser2, q, nil, nil, now, true)
+		a.NoError(err)
+		a.False(sent)
+
+		var lastSentAt time.Time
+		err = pgx.QueryRow(ctx, "select last_sent_at from email_digests where user_id = $1", testUser2.ID).Scan(&lastSentAt)
+		a.NoError(err)
+		a.True(now.Equal(lastSentAt))
+	})
+}
+
+func TestNotificationEmailGolden_Success(t *testing.T) {
+	viper.Set("GALLERY_HOST", "https://gallery.so")
+
+	q := newStubNotificationQueries()
+
+	notifs := map[string]coredb.Notification{
+		"admire":           {Action: persist.ActionAdmiredFeedEvent, FeedEventID: "feedEvent", Data: persist.NotificationData{AdmirerIDs: []persist.DBID{"actor"}}},
+		"admire_many":      {Action: persist.ActionAdmiredFeedEvent, FeedEventID: "feedEventWithoutCollection", Data: persist.NotificationData{AdmirerIDs: []persist.DBID{"actor", "other"}}},
+		"follow":           {Action: persist.ActionUserFollowedUsers, Data: persist.NotificationData{FollowerIDs: []persist.DBID{"actor"}}},
+		"follow_back":      {Action: persist.ActionUserFollowedUsers, Data: persist.NotificationData{FollowerIDs: []persist.DBID{"actor"}, FollowedBack: true}},
+		"follow_many":      {Action: persist.ActionUserFollowedUsers, Data: persist.NotificationData{FollowerIDs: []persist.DBID{"actor", "other"}}},
+		"repost":           {Action: persist.ActionRepostedFeedEvent, Data: persist.NotificationData{ReposterIDs: []persist.DBID{"actor"}}},
+		"comment":          {Action: persist.ActionCommentedOnFeedEvent, FeedEventID: "feedEvent", CommentID: "comment"},
+		"comment_update":   {Action: persist.ActionCommentedOnFeedEvent, FeedEventID: "feedEventWithoutCollection", CommentID: "comment"},
+		"mention":          {Action: persist.ActionMentionedUser, Data: persist.NotificationData{MentionerID: "actor"}},
+		"mention_comment":  {Action: persist.ActionMentionedUser, CommentID: "comment", Data: persist.NotificationData{MentionerID: "actor"}},
+		"minted_token":     {Action: persist.ActionMintedTokenAddedToGallery, Data: persist.NotificationData{CollectorIDs: []persist.DBID{"actor"}, TokenIDs: []persist.DBID{"token"}}},
+		"minted_tokens":    {Action: persist.ActionMintedTokenAddedToGallery, Data: persist.NotificationData{CollectorIDs: []persist.DBID{"actor", "other"}, TokenIDs: []persist.DBID{"token", "otherToken"}}},
+		"collectors_note":  {Action: persist.ActionCollectorsNoteAddedToMintedToken, Data: persist.NotificationData{CollectorIDs: []persist.DBID{"actor"}, TokenIDs: []persist.DBID{"token"}}},
+		"community_joined": {Action: persist.ActionCommunityMemberJoined, Data: persist.NotificationData{NewMemberIDs: []persist.DBID{"actor"}, CommunityContractID: "contract"}},
+		"media_processed":  {Action: persist.ActionTokenMediaProcessed, Data: persist.NotificationData{TokenIDs: []persist.DBID{"token"}}},
+		"media_failed":     {Action: persist.ActionTokenMediaProcessed, Data: persist.NotificationData{TokenIDs: []persist.DBID{"untitledToken"}, MediaFailed: true}},
+		"view":             {Action: persist.ActionViewedGallery, Data: persist.NotificationData{AuthedViewerIDs: []persist.DBID{"actor"}}},
+		"view_unauthed":    {Action: persist.ActionViewedGallery, Data: persist.NotificationData{UnauthedViewerIDs: []string{"someone"}}},
+		"view_many":        {Action: persist.ActionViewedGallery, Data: persist.NotificationData{AuthedViewerIDs: []persist.DBID{"actor"}, UnauthedViewerIDs: []string{"someone"}}},
+	}
+
+	for _, locale := range []string{"en", "es"} {
+		for name, notif := range notifs {
+			locale, name, notif := locale, name, notif
+			t.Run(fmt.Sprintf("renders %s notifications in %s", name, locale), func(t *testing.T) {
+				data, err := notifToTemplateData(context.Background(), q, notif, locale)
+				require.NoError(t, err)
+
+				rendered, err := renderEmail("notifications", locale, notificationsEmailDynamicTemplateData{
+					Notifications:    []notificationEmailDynamicTemplateData{data},
+					Username:         "recipient",
+					UnsubscribeToken: "token",
+				})
+				require.NoError(t, err)
+
+				assertGolden(t, filepath.Join("testdata", locale, name+".golden"), rendered)
+			})
+		}
+	}
+
+	t.Run("renders regional locales in their language", func(t *testing.T) {
+		data, err := notifToTemplateData(context.Background(), q, notifs["follow"], "es-MX")
+		require.NoError(t, err)
+		require.Equal(t, "te siguió", data.Action)
+	})
+
+	t.Run("renders unsupported locales in the default locale", func(t *testing.T) {
+		data, err := notifToTemplateData(context.Background(), q, notifs["follow"], "fr")
+		require.NoError(t, err)
+		require.Equal(t, "followed you", data.Action)
+	})
+}
+
+func assertGolden(t *testing.T, path string, rendered renderedEmail) {
+	t.Helper()
+
+	actual := fmt.Sprintf("Subject: %s\n\n%s\n%s", rendered.Subject, rendered.Text, rendered.HTML)
+
+	if *updateGolden {
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0755))
+		require.NoError(t, os.WriteFile(path, []byte(actual), 0644))
+	}
+
+	expected, err := os.ReadFile(path)
+	require.NoError(t, err, "run the tests with -update to create the golden file")
+	require.Equal(t, string(expected), actual)
+}
+
+type stubNotificationQueries struct {
+	collections map[persist.DBID]coredb.Collection
+	comments    map[persist.DBID]coredb.Comment
+	contracts   map[persist.DBID]coredb.Contract
+	feedEvents  map[persist.DBID]coredb.FeedEvent
+	tokens      map[persist.DBID]coredb.Token
+	users       map[persist.DBID]coredb.User
+}
+
+func newStubNotificationQueries() stubNotificationQueries {
+	return stubNotificationQueries{
+		collections: map[persist.DBID]coredb.Collection{
+			"collection": {ID: "collection", Name: sql.NullString{String: "Grails", Valid: true}},
+		},
+		comments: map[persist.DBID]coredb.Comment{
+			"comment": {ID: "comment", ActorID: "actor", Comment: "this is a great addition to the gallery"},
+		},
+		contracts: map[persist.DBID]coredb.Contract{
+			"contract": {ID: "contract", Name: sql.NullString{String: "Chromie Squiggle", Valid: true}},
+		},
+		feedEvents: map[persist.DBID]coredb.FeedEvent{
+			"feedEvent":                  {ID: "feedEvent", Data: persist.FeedEventData{CollectionID: "collection"}},
+			"feedEventWithoutCollection": {ID: "feedEventWithoutCollection"},
+		},
+		tokens: map[persist.DBID]coredb.Token{
+			"token":         {ID: "token", Name: sql.NullString{String: "Fidenza #1", Valid: true}, CollectorsNote: sql.NullString{String: "my favorite piece of all time", Valid: true}},
+			"otherToken":    {ID: "otherToken", Name: sql.NullString{String: "Fidenza #2", Valid: true}},
+			"untitledToken": {ID: "untitledToken"},
+		},
+		users: map[persist.DBID]coredb.User{
+			"actor": {ID: "actor", Username: sql.NullString{String: "actor", Valid: true}},
+			"other": {ID: "other", Username: sql.NullString{String: "other", Valid: true}},
+		},
+	}
+}
+
+func (s stubNotificationQueries) GetCollectionById(ctx context.Context, id persist.DBID) (coredb.Collection, error) {
+	return find(s.collections, id)
+}
+
+func (s stubNotificationQueries) GetCommentByCommentID(ctx context.Context, id persist.DBID) (coredb.Comment, error) {
+	return find(s.comments, id)
+}
+
+func (s stubNotificationQueries) GetContractByID(ctx context.Context, id persist.DBID) (coredb.Contract, error) {
+	return find(s.contracts, id)
+}
+
+func (s stubNotificationQueries) GetFeedEventByID(ctx context.Context, id persist.DBID) (coredb.FeedEvent, error) {
+	return find(s.feedEvents, id)
+}
+
+func (s stubNotificationQueries) GetTokenById(ctx context.Context, id persist.DBID) (coredb.Token, error) {
+	return find(s.tokens, id)
+}
+
+func (s stubNotificationQueries) GetUserById(ctx context.Context, id persist.DBID) (coredb.User, error) {
+	return find(s.users, id)
+}
+
+func find[T any](m map[persist.DBID]T, id persist.DBID) (T, error) {
+	if v, ok := m[id]; ok {
+		return v, nil
+	}
+	var zero T
+	return zero, sql.ErrNoRows
+}
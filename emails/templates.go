@@ -0,0 +1,132 @@
+package emails
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io/fs"
+	"path"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+)
+
+// Each email has an HTML and a plain text template. The plain text template also defines the email's subject as
+// "<name>.subject". Short phrases that the emails are built from, like what a notification's actor did, are defined
+// in phrases.txt as "phrase.<key>".
+//
+// Templates live in a directory per locale. The default locale has every template, and the other locales start out
+// as a copy of it, so a locale only needs to override what it translates.
+//
+//go:embed templates
+var templatesFS embed.FS
+
+const defaultLocale = "en"
+
+var templateFuncs = map[string]any{
+	"galleryURL": galleryURL,
+}
+
+type localeTemplates struct {
+	html *htmltemplate.Template
+	text *texttemplate.Template
+}
+
+var locales = mustParseLocales()
+
+func mustParseLocales() map[string]localeTemplates {
+	defaults := localeTemplates{
+		html: htmltemplate.Must(htmltemplate.New("").Funcs(templateFuncs).ParseFS(templatesFS, path.Join("templates", defaultLocale, "*.html"))),
+		text: texttemplate.Must(texttemplate.New("").Funcs(templateFuncs).ParseFS(templatesFS, path.Join("templates", defaultLocale, "*.txt"))),
+	}
+
+	result := map[string]localeTemplates{defaultLocale: defaults}
+
+	dirs, err := fs.ReadDir(templatesFS, "templates")
+	if err != nil {
+		panic(err)
+	}
+
+	for _, dir := range dirs {
+		if !dir.IsDir() || dir.Name() == defaultLocale {
+			continue
+		}
+
+		t := localeTemplates{
+			html: htmltemplate.Must(defaults.html.Clone()),
+			text: texttemplate.Must(defaults.text.Clone()),
+		}
+
+		// ParseFS fails if a pattern doesn't match anything, and a locale may not translate both formats
+		if files, _ := fs.Glob(templatesFS, path.Join("templates", dir.Name(), "*.html")); len(files) > 0 {
+			t.html = htmltemplate.Must(t.html.ParseFS(templatesFS, files...))
+		}
+		if files, _ := fs.Glob(templatesFS, path.Join("templates", dir.Name(), "*.txt")); len(files) > 0 {
+			t.text = texttemplate.Must(t.text.ParseFS(templatesFS, files...))
+		}
+
+		result[dir.Name()] = t
+	}
+
+	return result
+}
+
+// templatesFor returns the templates of a locale. A regional locale without its own templates, e.g. "es-MX", uses its
+// language's templates, and a locale without any uses the default locale's.
+func templatesFor(locale string) localeTemplates {
+	if t, ok := locales[locale]; ok {
+		return t
+	}
+	if language, _, ok := strings.Cut(locale, "-"); ok {
+		if t, ok := locales[language]; ok {
+			return t
+		}
+	}
+	return locales[defaultLocale]
+}
+
+type renderedEmail struct {
+	Subject string
+	HTML    string
+	Text    string
+}
+
+// renderEmail renders the templates of the email called name in the given locale
+func renderEmail(name string, locale string, data any) (renderedEmail, error) {
+	var subject, text, html bytes.Buffer
+
+	t := templatesFor(locale)
+
+	if err := t.text.ExecuteTemplate(&subject, name+".subject", data); err != nil {
+		return renderedEmail{}, err
+	}
+	if err := t.text.ExecuteTemplate(&text, name+".txt", data); err != nil {
+		return renderedEmail{}, err
+	}
+	if err := t.html.ExecuteTemplate(&html, name+".html", data); err != nil {
+		return renderedEmail{}, err
+	}
+
+	return renderedEmail{
+		Subject: strings.TrimSpace(subject.String()),
+		HTML:    html.String(),
+		Text:    text.String(),
+	}, nil
+}
+
+// phrase renders the phrase called key in the given locale. Every phrase exists in the default locale, so failing to
+// render one is a bug; the key is returned instead so that the email still goes out.
+func phrase(locale string, key string, data any) string {
+	var buf bytes.Buffer
+	if err := templatesFor(locale).text.ExecuteTemplate(&buf, "phrase."+key, data); err != nil {
+		logger.For(nil).Errorf("failed to render phrase %s in locale %s: %s", key, locale, err)
+		return key
+	}
+	return buf.String()
+}
+
+func galleryURL(path string) string {
+	return strings.TrimSuffix(env.GetString("GALLERY_HOST"), "/") + path
+}
@@ -1,25 +1,17 @@
 package emails
 
 import (
-	"context"
-	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 
 	"github.com/gin-gonic/gin"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
-	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/mail"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/util"
-	"github.com/sendgrid/sendgrid-go"
 )
 
-func init() {
-	env.RegisterValidation("SENDGRID_DEFAULT_LIST_ID", "required")
-	env.RegisterValidation("SENDGRID_API_KEY", "required")
-}
-
 type VerifyEmailInput struct {
 	JWT string `json:"jwt" binding:"required"`
 }
@@ -46,7 +38,7 @@ const (
 	PreverifyEmailResultValid
 )
 
-func preverifyEmail() gin.HandlerFunc {
+func preverifyEmail(p mail.Provider) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input PreverifyEmailInput
 		err := c.ShouldBindQuery(&input)
@@ -55,7 +47,7 @@ func preverifyEmail() gin.HandlerFunc {
 			return
 		}
 
-		result, err := validateEmail(c, input.Email, input.Source)
+		verdict, err := mail.ValidateEmail(c, p, input.Email.String(), input.Source)
 		if err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
@@ -63,12 +55,12 @@ func preverifyEmail() gin.HandlerFunc {
 
 		var preverifyEmailResult PreverifyEmailResult
 
-		switch strings.ToLower(result.Result.Verdict) {
-		case "valid":
+		switch verdict {
+		case mail.VerdictValid:
 			preverifyEmailResult = PreverifyEmailResultValid
-		case "risky":
+		case mail.VerdictRisky:
 			preverifyEmailResult = PreverifyEmailResultRisky
-		case "invalid":
+		case mail.VerdictInvalid:
 			preverifyEmailResult = PreverifyEmailResultInvalid
 		default:
 			preverifyEmailResult = PreverifyEmailResultInvalid
@@ -81,7 +73,7 @@ func preverifyEmail() gin.HandlerFunc {
 	}
 }
 
-func verifyEmail(queries *coredb.Queries) gin.HandlerFunc {
+func verifyEmail(queries *coredb.Queries, p mail.Provider) gin.HandlerFunc {
 
 	return func(c *gin.Context) {
 		var input VerifyEmailInput
@@ -122,7 +114,7 @@ func verifyEmail(queries *coredb.Queries) gin.HandlerFunc {
 			return
 		}
 
-		err = addEmailToSendgridList(c, userWithPII.PiiEmailAddress.String(), env.GetString("SENDGRID_DEFAULT_LIST_ID"))
+		err = mail.AddContact(c, p, userWithPII.PiiEmailAddress.String())
 		if err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
@@ -134,170 +126,3 @@ func verifyEmail(queries *coredb.Queries) gin.HandlerFunc {
 		})
 	}
 }
-
-/* example
-apiKey := os.Getenv("SENDGRID_API_KEY")
-        host := "https://api.sendgrid.com"
-        request := sendgrid.GetRequest(apiKey, "/v3/marketing/contacts", host)
-        request.Method = "PUT"
-        request.Body = []byte(`{
-  "contacts": [
-    {
-      "email": "ryan39@lee-young.com",
-      "custom_fields": {
-        "w1": "2002-10-02T15:00:00Z",
-        "w33": 9.5,
-        "e2": "Coffee is a beverage that puts one to sleep when not drank."
-      }
-    }
-  ]
-}`)
-        response, err := sendgrid.API(request)
-        if err != nil {
-                log.Println(err)
-        } else {
-                fmt.Println(response.StatusCode)
-                fmt.Println(response.Body)
-                fmt.Println(response.Headers)
-        }
-*/
-
-type sendgridContacts struct {
-	ListIDs  []string          `json:"list_ids"`
-	Contacts []sendgridContact `json:"contacts"`
-}
-
-type sendgridContact struct {
-	Email        string                 `json:"email"`
-	CustomFields map[string]interface{} `json:"custom_fields"`
-}
-
-func addEmailToSendgridList(ctx context.Context, email string, listID string) error {
-
-	request := sendgrid.GetRequest(env.GetString("SENDGRID_API_KEY"), "/v3/marketing/contacts", "https://api.sendgrid.com")
-	request.Method = "PUT"
-
-	contacts := sendgridContacts{
-		ListIDs: []string{listID},
-		Contacts: []sendgridContact{
-			{
-				Email: email,
-			},
-		},
-	}
-
-	body, err := json.Marshal(contacts)
-	if err != nil {
-		return err
-	}
-
-	request.Body = body
-
-	response, err := sendgrid.API(request)
-	if err != nil {
-		return err
-	}
-
-	if response.StatusCode != 202 {
-		return fmt.Errorf("email contact addition failed and returned: %+v", response)
-	}
-
-	return nil
-
-}
-
-type sendgridEmailValidation struct {
-	Email  persist.Email `json:"email"`
-	Source string        `json:"source"`
-}
-
-/*
-{
-   "result":{
-      "email":"bc@gallery.so",
-      "verdict":"Risky",
-      "score":0.21029,
-      "local":"bc",
-      "host":"gallery.so",
-      "checks":{
-         "domain":{
-            "has_valid_address_syntax":true,
-            "has_mx_or_a_record":true,
-            "is_suspected_disposable_address":false
-         },
-         "local_part":{
-            "is_suspected_role_address":false
-         },
-         "additional":{
-            "has_known_bounces":false,
-            "has_suspected_bounces":true
-         }
-      },
-      "source":"SIGNUP",
-      "ip_address":"172.119.250.71"
-   }
-}
-*/
-
-type sendgridEmailValidationResult struct {
-	Result struct {
-		Email   string  `json:"email"`
-		Verdict string  `json:"verdict"`
-		Score   float64 `json:"score"`
-		Local   string  `json:"local"`
-		Host    string  `json:"host"`
-		Checks  struct {
-			Domain struct {
-				HasValidAddressSyntax        bool `json:"has_valid_address_syntax"`
-				HasMxOrARecord               bool `json:"has_mx_or_a_record"`
-				IsSuspectedDisposableAddress bool `json:"is_suspected_disposable_address"`
-			} `json:"domain"`
-			LocalPart struct {
-				IsSuspectedRoleAddress bool `json:"is_suspected_role_address"`
-			} `json:"local_part"`
-			Additional struct {
-				HasKnownBounces     bool `json:"has_known_bounces"`
-				HasSuspectedBounces bool `json:"has_suspected_bounces"`
-			} `json:"additional"`
-		} `json:"checks"`
-		Source    string `json:"source"`
-		IPAddress string `json:"ip_address"`
-	} `json:"result"`
-}
-
-func validateEmail(ctx context.Context, email persist.Email, source string) (sendgridEmailValidationResult, error) {
-
-	var result sendgridEmailValidationResult
-
-	request := sendgrid.GetRequest(env.GetString("SENDGRID_VALIDATION_KEY"), "/v3/validations/email", "https://api.sendgrid.com")
-	request.Method = "POST"
-
-	val := sendgridEmailValidation{
-		Email:  email,
-		Source: source,
-	}
-
-	body, err := json.Marshal(val)
-	if err != nil {
-		return result, err
-	}
-
-	request.Body = body
-
-	response, err := sendgrid.API(request)
-	if err != nil {
-		return result, err
-	}
-
-	if response.StatusCode != 200 {
-		return result, fmt.Errorf("verify email returned: %+v", response)
-	}
-
-	err = json.Unmarshal([]byte(response.Body), &result)
-	if err != nil {
-		return result, err
-	}
-
-	return result, nil
-
-}
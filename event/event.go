@@ -2,15 +2,18 @@ package event
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
 	"github.com/mikeydub/go-gallery/env"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/mikeydub/go-gallery/service/webhook"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/feed"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
@@ -33,6 +36,11 @@ const (
 
 // Register specific event handlers
 func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications.NotificationHandlers, queries *db.Queries, taskClient *cloudtasks.Client) {
+	sender := newSender(disableDataloaderCaching, notif, queries, taskClient)
+	ctx.Set(eventSenderContextKey, sender)
+}
+
+func newSender(disableDataloaderCaching bool, notif *notifications.NotificationHandlers, queries *db.Queries, taskClient *cloudtasks.Client) *eventSender {
 	sender := newEventSender(queries)
 
 	feed := newEventDispatcher()
@@ -50,6 +58,7 @@ func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications
 	sender.addImmediateHandler(feed, persist.ActionCollectorsNoteAddedToCollection, feedHandler)
 	sender.addImmediateHandler(feed, persist.ActionGalleryInfoUpdated, feedHandler)
 	sender.addImmediateHandler(feed, persist.ActionCollectorsNoteAddedToToken, feedHandler)
+	sender.addImmediateHandler(feed, persist.ActionRepostedFeedEvent, feedHandler)
 	sender.addGroupHandler(feed, persist.ActionGalleryUpdated, feedHandler)
 
 	notifications := newEventDispatcher()
@@ -58,10 +67,31 @@ func AddTo(ctx *gin.Context, disableDataloaderCaching bool, notif *notifications
 	sender.addDelayedHandler(notifications, persist.ActionAdmiredFeedEvent, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionViewedGallery, notificationHandler)
 	sender.addDelayedHandler(notifications, persist.ActionCommentedOnFeedEvent, notificationHandler)
+	sender.addImmediateHandler(notifications, persist.ActionRepostedFeedEvent, notificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionMentionedUser, notificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionCommunityMemberJoined, notificationHandler)
+	creatorNotificationHandler := newCreatorNotificationHandler(notif, queries)
+	sender.addDelayedHandler(notifications, persist.ActionCollectionCreated, creatorNotificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionTokensAddedToCollection, creatorNotificationHandler)
+	sender.addDelayedHandler(notifications, persist.ActionCollectorsNoteAddedToToken, creatorNotificationHandler)
+	sender.addImmediateHandler(notifications, persist.ActionCollectionCreated, creatorNotificationHandler)
+	sender.addImmediateHandler(notifications, persist.ActionTokensAddedToCollection, creatorNotificationHandler)
+	sender.addImmediateHandler(notifications, persist.ActionCollectorsNoteAddedToToken, creatorNotificationHandler)
+
+	// Webhooks are sent every event that is dispatched, and filter by action themselves
+	webhooks := newEventDispatcher()
+	webhookHandler := newWebhookHandler(notificationHandler, queries)
+	for action := range sender.registry[delayedKey] {
+		webhooks.addDelayed(action, webhookHandler)
+	}
+	for action := range sender.registry[immediateKey] {
+		webhooks.addImmediate(action, webhookHandler)
+	}
 
 	sender.feed = feed
 	sender.notifications = notifications
-	ctx.Set(eventSenderContextKey, &sender)
+	sender.webhooks = webhooks
+	return &sender
 }
 
 // DispatchDelayed sends the event to all of its registered handlers.
@@ -79,9 +109,15 @@ func DispatchDelayed(ctx context.Context, event db.Event) error {
 		return err
 	}
 
+	held, err := sender.hold(ctx, *persistedEvent)
+	if err != nil || held {
+		return err
+	}
+
 	eg, ctx := errgroup.WithContext(ctx)
 	eg.Go(func() error { return sender.feed.dispatchDelayed(ctx, *persistedEvent) })
 	eg.Go(func() error { return sender.notifications.dispatchDelayed(ctx, *persistedEvent) })
+	eg.Go(func() error { return sender.webhooks.dispatchDelayed(ctx, *persistedEvent) })
 	return eg.Wait()
 }
 
@@ -104,7 +140,17 @@ func DispatchImmediate(ctx context.Context, events []db.Event) (*db.FeedEvent, e
 			return nil, err
 		}
 
-		persistedEvents = append(persistedEvents, *persistedEvent)
+		held, err := sender.hold(ctx, *persistedEvent)
+		if err != nil {
+			return nil, err
+		}
+		if !held {
+			persistedEvents = append(persistedEvents, *persistedEvent)
+		}
+	}
+
+	if len(persistedEvents) == 0 {
+		return nil, nil
 	}
 
 	go func() {
@@ -115,10 +161,15 @@ func DispatchImmediate(ctx context.Context, events []db.Event) (*db.FeedEvent, e
 			sentryutil.ReportError(ctx, err)
 		}
 
+		if _, err := sender.webhooks.dispatchImmediate(ctx, persistedEvents); err != nil {
+			logger.For(ctx).Error(err)
+			sentryutil.ReportError(ctx, err)
+		}
+
 	}()
 
 	feedEvent, err := sender.feed.dispatchImmediate(ctx, persistedEvents)
-	if err != nil {
+	if err != nil || feedEvent == nil {
 		return nil, err
 	}
 
@@ -168,11 +219,93 @@ func For(ctx context.Context) *eventSender {
 	return gc.Value(eventSenderContextKey).(*eventSender)
 }
 
+// HeldEventReleaser dispatches the events that were held back because they were about drafts, once the drafts have
+// been published. Feed events for a published gallery are created when it's published, so released events are only
+// sent to notifications and webhooks.
+type HeldEventReleaser struct {
+	sender       *eventSender
+	repos        *postgres.Repositories
+	pollInterval time.Duration
+}
+
+func NewHeldEventReleaser(notif *notifications.NotificationHandlers, repos *postgres.Repositories, queries *db.Queries, taskClient *cloudtasks.Client) *HeldEventReleaser {
+	return &HeldEventReleaser{
+		sender:       newSender(true, notif, queries, taskClient),
+		repos:        repos,
+		pollInterval: env.GetDuration("HELD_EVENT_POLL_INTERVAL"),
+	}
+}
+
+// Start releases held events in the background until ctx is done.
+func (r *HeldEventReleaser) Start(ctx context.Context) {
+	go r.work(ctx)
+}
+
+func (r *HeldEventReleaser) work(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.release(ctx); err != nil {
+				logger.For(ctx).Errorf("failed to release held events: %s", err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}
+	}
+}
+
+// release dispatches the held events that can be released. The events stay locked until they've been dispatched, and
+// only those that were dispatched are deleted, so the rest are retried on the next poll.
+func (r *HeldEventReleaser) release(ctx context.Context) error {
+	tx, err := r.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	q := r.sender.queries.WithTx(tx)
+
+	events, err := q.GetReleasableHeldEvents(ctx)
+	if err != nil {
+		return err
+	}
+
+	released := make([]string, 0, len(events))
+	for _, event := range events {
+		if err := r.sender.notifications.dispatchDelayed(ctx, event); err != nil {
+			logger.For(ctx).Errorf("failed to send notifications for released event %s: %s", event.ID, err)
+			sentryutil.ReportError(ctx, err)
+			continue
+		}
+		if err := r.sender.webhooks.dispatchDelayed(ctx, event); err != nil {
+			logger.For(ctx).Errorf("failed to send webhooks for released event %s: %s", event.ID, err)
+			sentryutil.ReportError(ctx, err)
+			continue
+		}
+		released = append(released, event.ID.String())
+	}
+
+	if err := q.DeleteHeldEvents(ctx, released); err != nil {
+		return err
+	}
+
+	if err := q.DeleteAbandonedHeldEvents(ctx); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
 type registedActions map[persist.Action]struct{}
 
 type eventSender struct {
 	feed          *eventDispatcher
 	notifications *eventDispatcher
+	webhooks      *eventDispatcher
 	registry      map[sendType]registedActions
 	queries       *db.Queries
 	eventRepo     postgres.EventRepository
@@ -190,6 +323,20 @@ func newEventSender(queries *db.Queries) eventSender {
 	}
 }
 
+// hold holds back an event about a draft gallery or collection so that it isn't dispatched before the draft is
+// published. Held events are dispatched by a HeldEventReleaser once their drafts have been published.
+func (e *eventSender) hold(ctx context.Context, event db.Event) (bool, error) {
+	_, err := e.queries.HoldEventIfDraft(ctx, db.HoldEventIfDraftParams{
+		EventID:       event.ID.String(),
+		CollectionIds: []string{event.CollectionID.String(), event.Data.MentionCollectionID.String()},
+		GalleryID:     event.GalleryID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
 func (e *eventSender) addDelayedHandler(dispatcher *eventDispatcher, action persist.Action, handler delayedHandler) {
 	dispatcher.addDelayed(action, handler)
 	e.registry[delayedKey][action] = struct{}{}
@@ -298,6 +445,7 @@ type groupHandler interface {
 type feedHandler struct {
 	queries      *db.Queries
 	eventBuilder *feed.EventBuilder
+	timeline     *feed.Timeline
 	tc           *cloudtasks.Client
 }
 
@@ -305,6 +453,7 @@ func newFeedHandler(queries *db.Queries, taskClient *cloudtasks.Client) feedHand
 	return feedHandler{
 		queries:      queries,
 		eventBuilder: feed.NewEventBuilder(queries),
+		timeline:     feed.NewTimeline(queries),
 		tc:           taskClient,
 	}
 }
@@ -325,7 +474,17 @@ func (h feedHandler) handleDelayed(ctx context.Context, persistedEvent db.Event)
 
 // handledImmediate sidesteps the Feed service so that an event is immediately available as a feed event.
 func (h feedHandler) handleImmediate(ctx context.Context, persistedEvent db.Event) (interface{}, error) {
-	return h.eventBuilder.NewFeedEventFromEvent(ctx, persistedEvent)
+	feedEvent, err := h.eventBuilder.NewFeedEventFromEvent(ctx, persistedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	if feedEvent != nil {
+		h.fanOut(ctx, *feedEvent)
+		h.sendToRemoteFollowers(ctx, *feedEvent)
+	}
+
+	return feedEvent, nil
 }
 
 // handleGrouped processes a group of events into a single feed event.
@@ -345,6 +504,8 @@ func (h feedHandler) handleGroup(ctx context.Context, groupID string, action per
 	}
 
 	if feedEvent != nil {
+		h.fanOut(ctx, *feedEvent)
+
 		// Send event to feedbot
 		err = task.CreateTaskForFeedbot(ctx,
 			time.Now(), task.FeedbotMessage{FeedEventID: feedEvent.ID, Action: feedEvent.Action}, h.tc,
@@ -352,10 +513,29 @@ func (h feedHandler) handleGroup(ctx context.Context, groupID string, action per
 		if err != nil {
 			logger.For(ctx).Errorf("failed to create task for feedbot: %s", err.Error())
 		}
+
+		h.sendToRemoteFollowers(ctx, *feedEvent)
 	}
 	return feedEvent, nil
 }
 
+// sendToRemoteFollowers creates a task to deliver the feed event to the owner's followers on other servers
+func (h feedHandler) sendToRemoteFollowers(ctx context.Context, feedEvent db.FeedEvent) {
+	err := task.CreateTaskForActivityPub(ctx, time.Now(), task.ActivityPubMessage{FeedEventID: feedEvent.ID}, h.tc)
+	if err != nil {
+		logger.For(ctx).Errorf("failed to create task for activitypub: %s", err.Error())
+	}
+}
+
+// fanOut adds the feed event to the home feeds of the owner's followers. It's fanned out by its own task so that
+// failures are retried, and since the feed event has already been published, errors are reported rather than returned.
+func (h feedHandler) fanOut(ctx context.Context, feedEvent db.FeedEvent) {
+	if err := h.timeline.FanOutLater(ctx, feedEvent, h.tc); err != nil {
+		logger.For(ctx).Errorf("failed to fan out feed event %s: %s", feedEvent.ID, err)
+		sentryutil.ReportError(ctx, err)
+	}
+}
+
 // notificationHandlers handles events for consumption as notifications.
 type notificationHandler struct {
 	dataloaders          *dataloader.Loaders
@@ -385,7 +565,7 @@ func (h notificationHandler) handleDelayed(ctx context.Context, persistedEvent d
 		return nil
 	}
 
-	return h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+	notif := db.Notification{
 		OwnerID:     owner,
 		Action:      persistedEvent.Action,
 		Data:        h.createNotificationDataForEvent(persistedEvent),
@@ -393,7 +573,20 @@ func (h notificationHandler) handleDelayed(ctx context.Context, persistedEvent d
 		GalleryID:   persistedEvent.GalleryID,
 		FeedEventID: persistedEvent.FeedEventID,
 		CommentID:   persistedEvent.CommentID,
-	})
+	}
+
+	// Mentions are user events, so the feed event or comment that the user was mentioned in is kept in the event's data
+	if persistedEvent.Action == persist.ActionMentionedUser {
+		notif.FeedEventID = persistedEvent.Data.MentionFeedEventID
+		notif.CommentID = persistedEvent.Data.MentionCommentID
+	}
+
+	return h.notificationHandlers.Notifications.Dispatch(ctx, notif)
+}
+
+// handleImmediate notifies the owner of the event's resource as soon as the event is dispatched.
+func (h notificationHandler) handleImmediate(ctx context.Context, persistedEvent db.Event) (interface{}, error) {
+	return nil, h.handleDelayed(ctx, persistedEvent)
 }
 
 func (h notificationHandler) createNotificationDataForEvent(event db.Event) (data persist.NotificationData) {
@@ -409,12 +602,24 @@ func (h notificationHandler) createNotificationDataForEvent(event db.Event) (dat
 		if event.ActorID.String != "" {
 			data.AdmirerIDs = []persist.DBID{persist.NullStrToDBID(event.ActorID)}
 		}
+	case persist.ActionRepostedFeedEvent:
+		if event.ActorID.String != "" {
+			data.ReposterIDs = []persist.DBID{persist.NullStrToDBID(event.ActorID)}
+		}
 	case persist.ActionUserFollowedUsers:
 		if event.ActorID.String != "" {
 			data.FollowerIDs = []persist.DBID{persist.NullStrToDBID(event.ActorID)}
 		}
 		data.FollowedBack = persist.NullBool(event.Data.UserFollowedBack)
 		data.Refollowed = persist.NullBool(event.Data.UserRefollowed)
+	case persist.ActionMentionedUser:
+		data.MentionerID = persist.NullStrToDBID(event.ActorID)
+		data.MentionSource = event.Data.MentionSource
+		data.MentionCollectionID = event.Data.MentionCollectionID
+		data.MentionTokenID = event.Data.MentionTokenID
+	case persist.ActionCommunityMemberJoined:
+		data.NewMemberIDs = []persist.DBID{persist.NullStrToDBID(event.ActorID)}
+		data.CommunityContractID = event.Data.CommunityContractID
 	}
 	return
 }
@@ -439,9 +644,155 @@ func (h notificationHandler) findOwnerForNotificationFromEvent(event db.Event) (
 			return "", err
 		}
 		return feedEvent.OwnerID, nil
+	case persist.ResourceTypeFeedEvent:
+		feedEvent, err := h.dataloaders.FeedEventByFeedEventID.Load(event.FeedEventID)
+		if err != nil {
+			return "", err
+		}
+		return feedEvent.OwnerID, nil
 	case persist.ResourceTypeUser:
 		return event.SubjectID, nil
 	}
 
 	return "", fmt.Errorf("no owner found for event: %s", event.Action)
 }
+
+// creatorNotificationHandler notifies the creators of the tokens in an event, e.g. when a collector adds a token that
+// they minted to a gallery. A token's creator is the user whose wallet deployed its contract.
+type creatorNotificationHandler struct {
+	queries              *db.Queries
+	notificationHandlers *notifications.NotificationHandlers
+}
+
+func newCreatorNotificationHandler(notifiers *notifications.NotificationHandlers, queries *db.Queries) *creatorNotificationHandler {
+	return &creatorNotificationHandler{
+		queries:              queries,
+		notificationHandlers: notifiers,
+	}
+}
+
+func (h creatorNotificationHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
+	var action persist.Action
+	var tokenIDs []persist.DBID
+
+	switch persistedEvent.Action {
+	case persist.ActionCollectionCreated, persist.ActionTokensAddedToCollection:
+		action, tokenIDs = persist.ActionMintedTokenAddedToGallery, persistedEvent.Data.CollectionTokenIDs
+	case persist.ActionCollectorsNoteAddedToToken:
+		if persistedEvent.Data.TokenCollectorsNote == "" {
+			return nil
+		}
+		action, tokenIDs = persist.ActionCollectorsNoteAddedToMintedToken, []persist.DBID{persistedEvent.TokenID}
+	default:
+		return nil
+	}
+
+	if len(tokenIDs) == 0 {
+		return nil
+	}
+
+	ids := make([]string, len(tokenIDs))
+	for i, id := range tokenIDs {
+		ids[i] = id.String()
+	}
+
+	creators, err := h.queries.GetTokenCreators(ctx, ids)
+	if err != nil {
+		return err
+	}
+
+	collector := persist.NullStrToDBID(persistedEvent.ActorID)
+	tokensByCreator := make(map[persist.DBID][]persist.DBID)
+	for _, creator := range creators {
+		// Don't notify the user on self events
+		if creator.CreatorID == collector {
+			continue
+		}
+		tokensByCreator[creator.CreatorID] = append(tokensByCreator[creator.CreatorID], creator.TokenID)
+	}
+
+	for creatorID, creatorTokenIDs := range tokensByCreator {
+		err := h.notificationHandlers.Notifications.Dispatch(ctx, db.Notification{
+			OwnerID: creatorID,
+			Action:  action,
+			Data: persist.NotificationData{
+				CollectorIDs: []persist.DBID{collector},
+				TokenIDs:     creatorTokenIDs,
+			},
+			EventIds: persist.DBIDList{persistedEvent.ID},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// handleImmediate notifies the creators as soon as the event is dispatched.
+func (h creatorNotificationHandler) handleImmediate(ctx context.Context, persistedEvent db.Event) (interface{}, error) {
+	return nil, h.handleDelayed(ctx, persistedEvent)
+}
+
+// webhookHandler adds a delivery of an event to the log of each webhook that should be sent it. Admin webhooks are
+// sent every event for their actions. A user's webhooks are sent the user's own activity, and activity that notifies
+// the user if their notification settings allow webhooks for it.
+type webhookHandler struct {
+	queries       *db.Queries
+	notifications *notificationHandler
+}
+
+func newWebhookHandler(notifications *notificationHandler, queries *db.Queries) *webhookHandler {
+	return &webhookHandler{
+		queries:       queries,
+		notifications: notifications,
+	}
+}
+
+func (h webhookHandler) handleDelayed(ctx context.Context, persistedEvent db.Event) error {
+	actorID := persist.DBID(persist.NullStrToStr(persistedEvent.ActorID))
+
+	// Not every event notifies someone, e.g. creating a collection
+	ownerID, err := h.notifications.findOwnerForNotificationFromEvent(persistedEvent)
+	if err != nil {
+		ownerID = ""
+	}
+
+	hooks, err := h.queries.GetWebhooksForEvent(ctx, db.GetWebhooksForEventParams{
+		Action:   string(persistedEvent.Action),
+		OwnerIds: []string{actorID.String(), ownerID.String()},
+	})
+	if err != nil {
+		return err
+	}
+
+	var owner *db.User
+	for _, hook := range hooks {
+		switch {
+		case hook.OwnerID == "", hook.OwnerID == actorID:
+		case hook.OwnerID == ownerID:
+			if owner == nil {
+				u, err := h.queries.GetUserById(ctx, ownerID)
+				if err != nil {
+					return err
+				}
+				owner = &u
+			}
+			if !owner.NotificationSettings.Wants(persistedEvent.Action, persist.NotificationChannelWebhook) {
+				continue
+			}
+		default:
+			continue
+		}
+
+		if _, err := webhook.NewDelivery(ctx, h.queries, hook, persistedEvent); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h webhookHandler) handleImmediate(ctx context.Context, persistedEvent db.Event) (interface{}, error) {
+	return nil, h.handleDelayed(ctx, persistedEvent)
+}
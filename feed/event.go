@@ -7,6 +7,7 @@ import (
 
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
@@ -15,24 +16,6 @@ import (
 )
 
 var (
-	groupingConfig = map[persist.Action]persist.ActionList{
-		persist.ActionGalleryUpdated: {
-			persist.ActionCollectionUpdated,
-			persist.ActionCollectionCreated,
-			persist.ActionTokensAddedToCollection,
-			persist.ActionCollectorsNoteAddedToToken,
-			persist.ActionCollectorsNoteAddedToCollection,
-			persist.ActionGalleryInfoUpdated,
-		},
-	}
-	eventGroups = createEventGroups(groupingConfig)
-)
-
-var (
-	eventSegments = map[persist.Action]segment{
-		persist.ActionUserFollowedUsers: actorActionSegment,
-	}
-
 	// Feed events in this group can contain a collection collector's note
 	collectionCollectorsNoteActions = persist.ActionList{
 		persist.ActionCollectionUpdated,
@@ -48,28 +31,12 @@ var (
 	}
 )
 
-const (
-	noSegment segment = iota
-	actorActionSegment
-	actorSubjectSegment
-	actorSubjectActionSegment
-	actorGallerySegment
-)
-
 var errUnhandledSingleEvent = errors.New("unhandable single event")
 var errUnhandledGroupedEvent = errors.New("unhandable group event")
 
-func createEventGroups(groupingConfig map[persist.Action]persist.ActionList) map[persist.Action]persist.Action {
-	eventGroups := map[persist.Action]persist.Action{}
-	for parent, actions := range groupingConfig {
-		for _, action := range actions {
-			eventGroups[action] = parent
-		}
-	}
-	return eventGroups
-}
-
-type segment int
+// scheduleTolerance is how early a scheduled publish can arrive and still be published, since the task queue's clock
+// may be slightly ahead of ours
+const scheduleTolerance = time.Minute
 
 type EventBuilder struct {
 	queries           *db.Queries
@@ -104,12 +71,24 @@ func (b *EventBuilder) NewFeedEventFromTask(ctx context.Context, message task.Fe
 }
 
 func (b *EventBuilder) NewFeedEventFromEvent(ctx context.Context, event db.Event) (*db.FeedEvent, error) {
+	rule, ok := feedRulesByAction[event.Action]
+	if !ok {
+		return nil, errUnhandledSingleEvent
+	}
 
 	blocked, err := b.feedBlocklistRepo.IsBlocked(ctx, persist.NullStrToDBID(event.ActorID), event.Action)
 	if err != nil || blocked {
 		return nil, err
 	}
 
+	// Blocking a grouped action also blocks the actions that make up the group
+	if rule.Action != event.Action {
+		blocked, err := b.feedBlocklistRepo.IsBlocked(ctx, persist.NullStrToDBID(event.ActorID), rule.Action)
+		if err != nil || blocked {
+			return nil, err
+		}
+	}
+
 	if event.GroupID.String != "" {
 		// if the event is being dispatched immediately, ensure that it is not supposed to be group with other events that are being dispatched immediately
 		wait, err := b.queries.HasLaterGroupedEvent(ctx, db.HasLaterGroupedEventParams{
@@ -124,18 +103,30 @@ func (b *EventBuilder) NewFeedEventFromEvent(ctx context.Context, event db.Event
 		}
 	}
 
-	if can, err := b.canEvent(ctx, event); err != nil || !can {
+	if can, err := b.canEvent(ctx, event, rule); err != nil || !can {
 		return nil, err
 	}
-	_, groupable := eventGroups[event.Action]
-	// Events with a caption are treated as a singular event.
-	if event.Caption.String != "" || !groupable {
-		return b.createFeedEvent(ctx, event)
+
+	events, err := b.eventsToMerge(ctx, event, rule)
+	if err != nil {
+		return nil, err
 	}
-	return b.createGroupedFeedEventFromEvent(ctx, event)
+
+	return b.publish(ctx, rule, events, time.Time{})
 }
 
 func (b *EventBuilder) NewFeedEventFromGroup(ctx context.Context, groupID string, action persist.Action) (*db.FeedEvent, error) {
+	return b.newFeedEventFromGroup(ctx, groupID, action, time.Time{})
+}
+
+// newFeedEventFromGroup merges the events of the group into a feed event. If publishedAt isn't zero, the feed event
+// is stamped with it instead of the time of its events.
+func (b *EventBuilder) newFeedEventFromGroup(ctx context.Context, groupID string, action persist.Action, publishedAt time.Time) (*db.FeedEvent, error) {
+	rule, ok := feedRulesByAction[action]
+	if !ok {
+		return nil, errUnhandledGroupedEvent
+	}
+
 	actor, err := b.queries.GetActorForGroup(ctx, persist.StrToNullStr(&groupID))
 	if err != nil {
 		return nil, err
@@ -149,136 +140,162 @@ func (b *EventBuilder) NewFeedEventFromGroup(ctx context.Context, groupID string
 		return nil, err
 	}
 
-	return b.createGroupedFeedEventFromEvents(ctx, events, action)
+	return b.publish(ctx, rule, events, publishedAt)
 }
 
-func (b *EventBuilder) createGroupedFeedEventFromEvents(ctx context.Context, events []db.Event, action persist.Action) (*db.FeedEvent, error) {
-	if action == persist.ActionGalleryUpdated {
-		return b.createGalleryUpdatedFeedEventFromEvents(ctx, events)
+// NewFeedEventFromScheduledPublish publishes a gallery's drafts along with the feed event of the edit that was scheduled.
+// Nothing is published if the gallery was published since, or if its publish was moved to a later time.
+func (b *EventBuilder) NewFeedEventFromScheduledPublish(ctx context.Context, message task.FeedMessage) (*db.FeedEvent, error) {
+	span, ctx := tracing.StartSpan(ctx, "eventBuilder.NewScheduledPublish", "newScheduledPublish")
+	defer tracing.FinishSpan(span)
+
+	gallery, err := b.queries.GetGalleryById(ctx, message.ID)
+	if err != nil {
+		return nil, err
 	}
-	return nil, errUnhandledGroupedEvent
-}
 
-func (b *EventBuilder) createGroupedFeedEventFromEvent(ctx context.Context, event db.Event) (*db.FeedEvent, error) {
-	if eventGroups[event.Action] == persist.ActionGalleryUpdated {
-		return b.createGalleryUpdatedFeedEvent(ctx, event)
+	if !gallery.PublishAt.Valid || gallery.PublishEditID.String != message.EditID {
+		return nil, nil
 	}
-	return nil, errUnhandledGroupedEvent
-}
 
-func (b *EventBuilder) createFeedEvent(ctx context.Context, event db.Event) (*db.FeedEvent, error) {
-	switch event.Action {
-	case persist.ActionUserFollowedUsers:
-		return b.createUserFollowedUsersFeedEvent(ctx, event)
-	case persist.ActionCollectorsNoteAddedToToken, persist.ActionGalleryInfoUpdated, persist.ActionTokensAddedToCollection, persist.ActionCollectorsNoteAddedToCollection, persist.ActionCollectionCreated:
-		return b.createGalleryUpdatedFeedEvent(ctx, event)
-	default:
-		return nil, errUnhandledSingleEvent
+	if gallery.PublishAt.Time.After(time.Now().Add(scheduleTolerance)) {
+		return nil, nil
 	}
-}
 
-func (b *EventBuilder) canEvent(ctx context.Context, event db.Event) (bool, error) {
-	stillEditing, err := b.isStillEditing(ctx, event)
+	// The feed event is created before the drafts are published so that a failure is retried with the schedule intact
+	exists, err := b.queries.IsFeedEventExistsForGroup(ctx, gallery.PublishEditID)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 
-	logger.For(ctx).Infof("event %s still editing: %t", event.ID, stillEditing)
+	var feedEvent *db.FeedEvent
+	if !exists {
+		// The edit may have been made long before it was published, so the feed event is placed in feeds at the time of the publish
+		feedEvent, err = b.newFeedEventFromGroup(ctx, message.EditID, persist.ActionGalleryUpdated, time.Now())
+		if err != nil {
+			return nil, err
+		}
+	}
 
-	return !stillEditing, nil
+	// The feed service doesn't send notifications, so users mentioned in a scheduled caption aren't notified, but its
+	// hashtags can be browsed like any other caption's
+	if feedEvent != nil && feedEvent.Caption.String != "" {
+		if _, err := entity.Save(ctx, b.queries, entity.SourceCaption, feedEvent.ID, feedEvent.Caption.String); err != nil {
+			logger.For(ctx).Errorf("failed to save entities of caption of %s: %s", feedEvent.ID, err)
+		}
+	}
+
+	if err := b.queries.PublishGalleryDrafts(ctx, gallery.ID); err != nil {
+		return nil, err
+	}
+
+	return feedEvent, nil
 }
-func (b *EventBuilder) isStillEditing(ctx context.Context, event db.Event) (bool, error) {
-	switch getSegment(event.Action) {
-	case actorActionSegment:
-		return b.eventRepo.IsActorActionActive(ctx, event, getActions(event.Action), b.windowSize)
-	case actorSubjectSegment:
-		return b.eventRepo.IsActorSubjectActive(ctx, event, b.windowSize)
-	case actorSubjectActionSegment:
-		return b.eventRepo.IsActorSubjectActionActive(ctx, event, getActions(event.Action), b.windowSize)
-	case actorGallerySegment:
-		return b.eventRepo.IsActorGalleryActive(ctx, event, b.windowSize)
-	case noSegment:
-		return false, nil
+
+// eventsToMerge returns the events that are merged with the event, sorted from oldest to newest.
+func (b *EventBuilder) eventsToMerge(ctx context.Context, event db.Event, rule feedRule) ([]db.Event, error) {
+	if rule.Scope != eventScope && event.GroupID.String != "" {
+		return b.queries.GetEventsInGroup(ctx, event.GroupID)
+	}
+
+	windowSecs := int(rule.window(b.windowSize).Seconds())
+
+	switch rule.Scope {
+	case actorScope:
+		return b.eventRepo.EventsInWindow(ctx, event.ID, windowSecs, rule.Actions, false)
+	case galleryScope:
+		return b.eventRepo.EventsInWindowForGallery(ctx, event.ID, event.GalleryID, windowSecs, rule.Actions, false)
 	default:
-		return false, nil
+		return []db.Event{event}, nil
 	}
 }
 
-func (b *EventBuilder) createUserFollowedUsersFeedEvent(ctx context.Context, event db.Event) (*db.FeedEvent, error) {
-	var events []db.Event
-	var err error
-	if event.GroupID.String != "" {
-		events, err = b.eventRepo.Queries.GetEventsInGroup(ctx, event.GroupID)
-	} else {
-		events, err = b.eventRepo.EventsInWindow(ctx, event.ID, env.GetInt("FEED_WINDOW_SIZE"), persist.ActionList{event.Action}, false)
+// publish merges the events into a feed event according to the rule and saves it. If publishedAt isn't zero, it's used
+// as the time of the feed event.
+func (b *EventBuilder) publish(ctx context.Context, rule feedRule, eventsAsc []db.Event, publishedAt time.Time) (*db.FeedEvent, error) {
+	eligible := rule.eligible(eventsAsc)
+	if len(eligible) == 0 {
+		return nil, nil
+	}
+
+	feedEvent := rule.Merge(eligible)
+	if feedEvent == nil {
+		return nil, nil
 	}
+
+	if !publishedAt.IsZero() {
+		feedEvent.EventTime = publishedAt
+	}
+
+	added, err := b.feedRepo.Add(ctx, *feedEvent)
 	if err != nil {
 		return nil, err
 	}
 
-	merged := mergeFollowEvents(events)
-	if len(merged.followedIDs) < 1 {
-		return nil, nil
+	// The feed event has already been published, so it's still returned if its hashtags can't be saved
+	if notes := collectorsNotes(added.Data); len(notes) > 0 {
+		if err := entity.SaveHashtags(ctx, b.queries, entity.SourceFeedEventCollectorsNotes, added.ID, notes); err != nil {
+			logger.For(ctx).Errorf("failed to save hashtags of collectors notes of %s: %s", added.ID, err)
+		}
 	}
 
-	return b.feedRepo.Add(ctx, db.FeedEvent{
-		ID:        persist.GenerateID(),
-		OwnerID:   persist.NullStrToDBID(merged.event.ActorID),
-		Action:    merged.event.Action,
-		EventTime: merged.event.CreatedAt,
-		EventIds:  merged.eventIDs,
-		Data: persist.FeedEventData{
-			UserFollowedIDs:  merged.followedIDs,
-			UserFollowedBack: merged.followedBack,
-		},
-	})
+	return added, nil
 }
 
-func (b *EventBuilder) createGalleryUpdatedFeedEvent(ctx context.Context, event db.Event) (*db.FeedEvent, error) {
+// collectorsNotes returns the collectors notes that are shown with a feed event
+func collectorsNotes(data persist.FeedEventData) []string {
+	notes := make([]string, 0)
+	add := func(note string) {
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
 
-	var events []db.Event
-	var err error
-	if event.GroupID.String != "" {
-		events, err = b.queries.GetEventsInGroup(ctx, event.GroupID)
-	} else {
-		events, err = b.eventRepo.EventsInWindowForGallery(ctx, event.ID, event.GalleryID, env.GetInt("FEED_WINDOW_SIZE"), groupingConfig[persist.ActionGalleryUpdated], false)
+	add(data.TokenNewCollectorsNote)
+	add(data.CollectionNewCollectorsNote)
+	for _, note := range data.GalleryNewCollectionCollectorsNotes {
+		add(note)
+	}
+	for _, note := range data.GalleryNewTokenCollectorsNotes {
+		add(note)
+	}
+	for _, tokenNotes := range data.GalleryNewCollectionTokenCollectorsNotes {
+		for _, note := range tokenNotes {
+			add(note)
+		}
 	}
+
+	return notes
+}
+
+func (b *EventBuilder) canEvent(ctx context.Context, event db.Event, rule feedRule) (bool, error) {
+	stillEditing, err := b.isStillEditing(ctx, event, rule)
 	if err != nil {
-		return nil, err
+		return false, err
 	}
 
-	return b.createGalleryUpdatedFeedEventFromEvents(ctx, events)
+	logger.For(ctx).Infof("event %s still editing: %t", event.ID, stillEditing)
 
+	return !stillEditing, nil
 }
 
-func (b *EventBuilder) createGalleryUpdatedFeedEventFromEvents(ctx context.Context, events []db.Event) (*db.FeedEvent, error) {
-	if len(events) == 0 {
-		return nil, nil
-	}
+func (b *EventBuilder) isStillEditing(ctx context.Context, event db.Event, rule feedRule) (bool, error) {
+	windowSize := rule.window(b.windowSize)
 
-	merged := mergeGalleryEvents(events)
-	if len(merged.eventIDs) == 0 {
-		return nil, nil
+	switch rule.Segment {
+	case actorActionSegment:
+		return b.eventRepo.IsActorActionActive(ctx, event, rule.Actions, windowSize)
+	case actorSubjectSegment:
+		return b.eventRepo.IsActorSubjectActive(ctx, event, windowSize)
+	case actorSubjectActionSegment:
+		return b.eventRepo.IsActorSubjectActionActive(ctx, event, rule.Actions, windowSize)
+	case actorGallerySegment:
+		return b.eventRepo.IsActorGalleryActive(ctx, event, windowSize)
+	case noSegment:
+		return false, nil
+	default:
+		return false, nil
 	}
-
-	return b.feedRepo.Add(ctx, db.FeedEvent{
-		ID:        persist.GenerateID(),
-		OwnerID:   merged.actorID,
-		Action:    persist.ActionGalleryUpdated,
-		EventTime: merged.eventTime,
-		EventIds:  merged.eventIDs,
-		Caption:   persist.StrToNullStr(merged.caption),
-		GroupID:   persist.StrToNullStr(merged.groupID),
-		Data: persist.FeedEventData{
-			GalleryID:                                merged.galleryID,
-			GalleryName:                              merged.galleryName,
-			GalleryDescription:                       merged.galleryDescription,
-			GalleryNewCollections:                    merged.newCollections,
-			GalleryNewCollectionTokenIDs:             merged.tokensAdded,
-			GalleryNewCollectionCollectorsNotes:      merged.collectionCollectorsNotes,
-			GalleryNewCollectionTokenCollectorsNotes: merged.tokenCollectorsNotes,
-		},
-	})
 }
 
 // getAddedTokens returns the new tokens that were added since the last published feed event.
@@ -312,27 +329,6 @@ func isCollectionCollectorsNoteChanged(ctx context.Context, feedRepo *postgres.F
 	return true, nil
 }
 
-func getActions(action persist.Action) persist.ActionList {
-	// Check if action belongs to a group
-	if actions, ok := groupingConfig[action]; ok {
-		return actions
-	}
-	return persist.ActionList{action}
-}
-
-func getSegment(action persist.Action) segment {
-	// Check if action has a parent action
-	if parent, ok := eventGroups[action]; ok {
-		action = parent
-	}
-
-	eventSegment, ok := eventSegments[action]
-	if !ok {
-		return noSegment
-	}
-	return eventSegment
-}
-
 func newTokens(tokens []persist.DBID, otherTokens []persist.DBID) []persist.DBID {
 	newTokens := make([]persist.DBID, 0)
 
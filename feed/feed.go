@@ -50,11 +50,18 @@ func setDefaults() {
 	viper.SetDefault("POSTGRES_PASSWORD", "")
 	viper.SetDefault("POSTGRES_DB", "postgres")
 	viper.SetDefault("FEED_SECRET", "feed-secret")
+	viper.SetDefault("FEED_URL", "")
+	viper.SetDefault("GCLOUD_FEED_QUEUE", "projects/gallery-local/locations/here/queues/feed-event")
 	viper.SetDefault("SENTRY_DSN", "")
 	viper.SetDefault("TASK_QUEUE_HOST", "localhost:8123")
 	viper.SetDefault("GCLOUD_FEEDBOT_TASK_QUEUE", "projects/gallery-local/locations/here/queues/feedbot")
 	viper.SetDefault("FEEDBOT_SECRET", "feed-bot-secret")
+	viper.SetDefault("ACTIVITYPUB_URL", "")
+	viper.SetDefault("ACTIVITYPUB_SECRET", "activitypub-secret")
+	viper.SetDefault("GCLOUD_ACTIVITYPUB_TASK_QUEUE", "projects/gallery-local/locations/here/queues/activitypub")
 	viper.SetDefault("FEED_WINDOW_SIZE", 20)
+	viper.SetDefault("FEED_FANOUT_MAX_FOLLOWERS", 10000)
+	viper.SetDefault("FEED_TIMELINE_MAX_EVENTS", 1000)
 	viper.SetDefault("GAE_VERSION", "")
 	viper.AutomaticEnv()
 
@@ -37,5 +37,6 @@ func taskRequired() gin.HandlerFunc {
 func handlersInit(router *gin.Engine, queries *db.Queries, taskClient *cloudtasks.Client) *gin.Engine {
 	router.GET("/ping", ping())
 	router.POST("/tasks/feed-event", taskRequired(), handleEvent(queries, taskClient))
+	router.POST("/tasks/fan-out", taskRequired(), handleFanOut(queries))
 	return router
 }
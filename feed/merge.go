@@ -25,6 +25,88 @@ func mergeGalleryEvents(eventsAsc []db.Event) *combinedGalleryEvent {
 	return combined.merge(eventsAsc)
 }
 
+func mergeAcquisitionEvents(eventsAsc []db.Event) *combinedAcquisitionEvent {
+	var combined combinedAcquisitionEvent
+	return combined.merge(eventsAsc)
+}
+
+func mergeFollowFeedEvent(eventsAsc []db.Event) *db.FeedEvent {
+	merged := mergeFollowEvents(eventsAsc)
+	if len(merged.followedIDs) < 1 {
+		return nil
+	}
+
+	return &db.FeedEvent{
+		OwnerID:   persist.NullStrToDBID(merged.event.ActorID),
+		Action:    merged.event.Action,
+		EventTime: merged.event.CreatedAt,
+		EventIds:  merged.eventIDs,
+		Data: persist.FeedEventData{
+			UserFollowedIDs:  merged.followedIDs,
+			UserFollowedBack: merged.followedBack,
+		},
+	}
+}
+
+func mergeGalleryFeedEvent(eventsAsc []db.Event) *db.FeedEvent {
+	merged := mergeGalleryEvents(eventsAsc)
+	if len(merged.eventIDs) == 0 {
+		return nil
+	}
+
+	return &db.FeedEvent{
+		OwnerID:   merged.actorID,
+		Action:    persist.ActionGalleryUpdated,
+		EventTime: merged.eventTime,
+		EventIds:  merged.eventIDs,
+		Caption:   persist.StrToNullStr(merged.caption),
+		GroupID:   persist.StrToNullStr(merged.groupID),
+		Data: persist.FeedEventData{
+			GalleryID:                                merged.galleryID,
+			GalleryName:                              merged.galleryName,
+			GalleryDescription:                       merged.galleryDescription,
+			GalleryNewCollections:                    merged.newCollections,
+			GalleryNewCollectionTokenIDs:             merged.tokensAdded,
+			GalleryNewCollectionCollectorsNotes:      merged.collectionCollectorsNotes,
+			GalleryNewCollectionTokenCollectorsNotes: merged.tokenCollectorsNotes,
+		},
+	}
+}
+
+func mergeAcquisitionFeedEvent(eventsAsc []db.Event) *db.FeedEvent {
+	merged := mergeAcquisitionEvents(eventsAsc)
+	if len(merged.acquiredTokenIDs) == 0 && len(merged.mintedTokenIDs) == 0 {
+		return nil
+	}
+
+	return &db.FeedEvent{
+		OwnerID:   merged.actorID,
+		Action:    persist.ActionTokensAcquired,
+		EventTime: merged.eventTime,
+		EventIds:  merged.eventIDs,
+		Data: persist.FeedEventData{
+			AcquiredTokenIDs: merged.acquiredTokenIDs,
+			MintedTokenIDs:   merged.mintedTokenIDs,
+		},
+	}
+}
+
+// mergeRepostFeedEvent creates a feed event for the reposter that points back to the original feed event.
+// The quote, if any, is kept as the caption of the repost.
+func mergeRepostFeedEvent(eventsAsc []db.Event) *db.FeedEvent {
+	event := eventsAsc[len(eventsAsc)-1]
+	return &db.FeedEvent{
+		OwnerID:   persist.NullStrToDBID(event.ActorID),
+		Action:    persist.ActionRepostedFeedEvent,
+		EventTime: event.CreatedAt,
+		EventIds:  persist.DBIDList{event.ID},
+		Caption:   event.Caption,
+		Data: persist.FeedEventData{
+			RepostedFeedEventID: event.FeedEventID,
+		},
+	}
+}
+
 type combinedFollowEvent struct {
 	event        db.Event
 	eventIDs     []persist.DBID
@@ -34,18 +116,16 @@ type combinedFollowEvent struct {
 
 func (c *combinedFollowEvent) merge(eventsAsc []db.Event) *combinedFollowEvent {
 	for _, other := range eventsAsc {
-		if !other.Data.UserRefollowed {
-			c.event = db.Event{
-				ID:        other.ID,
-				ActorID:   other.ActorID,
-				Action:    other.Action,
-				CreatedAt: other.CreatedAt,
-				GroupID:   other.GroupID,
-			}
-			c.eventIDs = append(c.eventIDs, other.ID)
-			c.followedIDs = append(c.followedIDs, other.SubjectID)
-			c.followedBack = append(c.followedBack, other.Data.UserFollowedBack)
+		c.event = db.Event{
+			ID:        other.ID,
+			ActorID:   other.ActorID,
+			Action:    other.Action,
+			CreatedAt: other.CreatedAt,
+			GroupID:   other.GroupID,
 		}
+		c.eventIDs = append(c.eventIDs, other.ID)
+		c.followedIDs = append(c.followedIDs, other.SubjectID)
+		c.followedBack = append(c.followedBack, other.Data.UserFollowedBack)
 	}
 	return c
 }
@@ -199,3 +279,38 @@ func (c *combinedGalleryEvent) merge(eventsAsc []db.Event) *combinedGalleryEvent
 
 	return c
 }
+
+type combinedAcquisitionEvent struct {
+	eventTime        time.Time
+	actorID          persist.DBID
+	eventIDs         []persist.DBID
+	acquiredTokenIDs []persist.DBID
+	mintedTokenIDs   []persist.DBID
+}
+
+func (c *combinedAcquisitionEvent) merge(eventsAsc []db.Event) *combinedAcquisitionEvent {
+	seen := make(map[persist.DBID]bool)
+
+	for _, event := range eventsAsc {
+		c.eventTime = event.CreatedAt
+		if c.actorID == "" {
+			c.actorID = persist.DBID(event.ActorID.String)
+		}
+		c.eventIDs = append(c.eventIDs, event.ID)
+
+		// The same token can be seen more than once if it was sent away and back again within the window
+		if seen[event.TokenID] {
+			continue
+		}
+		seen[event.TokenID] = true
+
+		switch event.Action {
+		case persist.ActionTokenAcquired:
+			c.acquiredTokenIDs = append(c.acquiredTokenIDs, event.TokenID)
+		case persist.ActionTokenMinted:
+			c.mintedTokenIDs = append(c.mintedTokenIDs, event.TokenID)
+		}
+	}
+
+	return c
+}
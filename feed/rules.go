@@ -0,0 +1,145 @@
+package feed
+
+import (
+	"fmt"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// feedRules configures how events are published to the feed. Each rule merges the events of its actions into
+// a single feed event, and an action that isn't part of any rule never appears in the feed.
+var feedRules = []feedRule{
+	{
+		Action:   persist.ActionUserFollowedUsers,
+		Actions:  persist.ActionList{persist.ActionUserFollowedUsers},
+		Segment:  actorActionSegment,
+		Scope:    actorScope,
+		Eligible: isNotRefollow,
+		Merge:    mergeFollowFeedEvent,
+	},
+	{
+		Action: persist.ActionGalleryUpdated,
+		Actions: persist.ActionList{
+			persist.ActionCollectionUpdated,
+			persist.ActionCollectionCreated,
+			persist.ActionTokensAddedToCollection,
+			persist.ActionCollectorsNoteAddedToToken,
+			persist.ActionCollectorsNoteAddedToCollection,
+			persist.ActionGalleryInfoUpdated,
+		},
+		Segment: noSegment,
+		Scope:   galleryScope,
+		Merge:   mergeGalleryFeedEvent,
+	},
+	{
+		Action: persist.ActionTokensAcquired,
+		Actions: persist.ActionList{
+			persist.ActionTokenAcquired,
+			persist.ActionTokenMinted,
+		},
+		Segment: actorActionSegment,
+		Scope:   actorScope,
+		Merge:   mergeAcquisitionFeedEvent,
+	},
+	{
+		Action:  persist.ActionRepostedFeedEvent,
+		Actions: persist.ActionList{persist.ActionRepostedFeedEvent},
+		Segment: noSegment,
+		Scope:   eventScope,
+		Merge:   mergeRepostFeedEvent,
+	},
+}
+
+var feedRulesByAction = indexFeedRules(feedRules)
+
+const (
+	noSegment segment = iota
+	actorActionSegment
+	actorSubjectSegment
+	actorSubjectActionSegment
+	actorGallerySegment
+)
+
+const (
+	// eventScope publishes the event on its own
+	eventScope scope = iota
+	// actorScope merges the actor's events of the rule's actions that are within the window
+	actorScope
+	// galleryScope merges the actor's events of the rule's actions in the same gallery that are within the window
+	galleryScope
+)
+
+type segment int
+
+// scope determines which events are merged with an event that wasn't sent as part of a group.
+type scope int
+
+type feedRule struct {
+	// Action is the action of the published feed event.
+	Action persist.Action
+	// Actions are the actions of the events that are merged into the feed event.
+	Actions persist.ActionList
+	// Segment determines which of the actor's recent events mean that they're still editing.
+	Segment segment
+	// Scope determines which events are merged together.
+	Scope scope
+	// Window is how long the actor has to keep editing before the feed event is published.
+	// If not set, FEED_WINDOW_SIZE is used instead.
+	Window time.Duration
+	// Eligible filters out events that shouldn't be part of the feed event. If not set, every event is eligible.
+	Eligible func(db.Event) bool
+	// Merge combines eligible events, sorted from oldest to newest, into a feed event. Merge returns nil
+	// if there's nothing worth publishing.
+	Merge func(eventsAsc []db.Event) *db.FeedEvent
+}
+
+// window returns how long to wait for the actor to finish editing.
+func (r feedRule) window(defaultWindow time.Duration) time.Duration {
+	if r.Window > 0 {
+		return r.Window
+	}
+	return defaultWindow
+}
+
+// eligible returns the events that can be merged into the feed event.
+func (r feedRule) eligible(events []db.Event) []db.Event {
+	if r.Eligible == nil {
+		return events
+	}
+
+	eligible := make([]db.Event, 0, len(events))
+	for _, event := range events {
+		if r.Eligible(event) {
+			eligible = append(eligible, event)
+		}
+	}
+
+	return eligible
+}
+
+// indexFeedRules maps both the feed event action and the event actions of each rule to the rule itself.
+func indexFeedRules(rules []feedRule) map[persist.Action]feedRule {
+	index := make(map[persist.Action]feedRule)
+
+	add := func(action persist.Action, rule feedRule) {
+		if existing, ok := index[action]; ok && existing.Action != rule.Action {
+			panic(fmt.Sprintf("action %s is configured for both %s and %s feed events", action, existing.Action, rule.Action))
+		}
+		index[action] = rule
+	}
+
+	for _, rule := range rules {
+		add(rule.Action, rule)
+		for _, action := range rule.Actions {
+			add(action, rule)
+		}
+	}
+
+	return index
+}
+
+func isNotRefollow(event db.Event) bool {
+	return !event.Data.UserRefollowed
+}
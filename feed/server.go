@@ -8,8 +8,10 @@ import (
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
 	"github.com/gin-gonic/gin"
+	"github.com/jackc/pgx/v4"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/service/logger"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/sirupsen/logrus"
@@ -24,8 +26,15 @@ func handleEvent(queries *db.Queries, taskClient *cloudtasks.Client) gin.Handler
 			return
 		}
 
+		var event *db.FeedEvent
+		var err error
+
 		builder := NewEventBuilder(queries)
-		event, err := builder.NewFeedEventFromTask(c.Request.Context(), message)
+		if message.EditID != "" {
+			event, err = builder.NewFeedEventFromScheduledPublish(c.Request.Context(), message)
+		} else {
+			event, err = builder.NewFeedEventFromTask(c.Request.Context(), message)
+		}
 
 		if err != nil {
 			logger.For(c).WithFields(logrus.Fields{"eventID": message.ID}).Debugf("failed to handle event: %s", err)
@@ -45,6 +54,12 @@ func handleEvent(queries *db.Queries, taskClient *cloudtasks.Client) gin.Handler
 			return
 		}
 
+		// The feed event already exists, so fanning out is retried by its own task rather than by publishing it again
+		if err := NewTimeline(queries).FanOutLater(c.Request.Context(), *event, taskClient); err != nil {
+			logger.For(c).WithFields(logrus.Fields{"eventID": message.ID, "feedEventID": event.ID}).Errorf("failed to fan out feed event: %s", err)
+			sentryutil.ReportError(c, err)
+		}
+
 		// Send event to feedbot
 		err = task.CreateTaskForFeedbot(c.Request.Context(),
 			time.Now(), task.FeedbotMessage{FeedEventID: event.ID, Action: event.Action}, taskClient,
@@ -54,11 +69,51 @@ func handleEvent(queries *db.Queries, taskClient *cloudtasks.Client) gin.Handler
 			return
 		}
 
+		// Send event to remote followers. Like fanning out, this isn't worth publishing the event again for.
+		err = task.CreateTaskForActivityPub(c.Request.Context(),
+			time.Now(), task.ActivityPubMessage{FeedEventID: event.ID}, taskClient,
+		)
+		if err != nil {
+			logger.For(c).WithFields(logrus.Fields{"eventID": message.ID, "feedEventID": event.ID}).Errorf("failed to create task for activitypub: %s", err)
+			sentryutil.ReportError(c, err)
+		}
+
 		logger.For(c).WithFields(logrus.Fields{"eventID": message.ID}).Debug("event processed")
 		c.JSON(http.StatusOK, gin.H{"msg": fmt.Sprintf("event=%s processed", message.ID)})
 	}
 }
 
+// handleFanOut adds a published feed event to the home feeds of its owner's followers. Errors are returned so that the
+// task is retried, which is safe since fanning out the same feed event again doesn't add it twice.
+func handleFanOut(queries *db.Queries) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		message := task.FeedFanOutMessage{}
+
+		if err := c.ShouldBindJSON(&message); err != nil {
+			util.ErrResponse(c, http.StatusOK, err)
+			return
+		}
+
+		event, err := queries.GetFeedEventByID(c.Request.Context(), message.FeedEventID)
+		if errors.Is(err, pgx.ErrNoRows) {
+			c.JSON(http.StatusOK, gin.H{"msg": fmt.Sprintf("feed event=%s was deleted", message.FeedEventID)})
+			return
+		}
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		if err := NewTimeline(queries).FanOut(c.Request.Context(), event); err != nil {
+			logger.For(c).WithFields(logrus.Fields{"feedEventID": event.ID}).Errorf("failed to fan out feed event: %s", err)
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"msg": fmt.Sprintf("feed event=%s fanned out", message.FeedEventID)})
+	}
+}
+
 func ping() gin.HandlerFunc {
 	return func(c *gin.Context) {
 		c.JSON(http.StatusOK, gin.H{"ping": "pong"})
@@ -0,0 +1,88 @@
+package feed
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/entity"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPaginateHashtagFeed_Success(t *testing.T) {
+	_, queries, _ := setupTimelineTest(t)
+	ctx := context.Background()
+	start := time.Now().Add(-time.Hour)
+
+	// publish creates a feed event and saves the hashtags of its notes the same way EventBuilder.publish does
+	publish := func(t *testing.T, action persist.Action, data persist.FeedEventData, minutes int) db.FeedEvent {
+		t.Helper()
+		event, err := queries.CreateFeedEvent(ctx, db.CreateFeedEventParams{
+			ID:        persist.GenerateID(),
+			OwnerID:   persist.GenerateID(),
+			Action:    action,
+			Data:      data,
+			EventTime: start.Add(time.Duration(minutes) * time.Minute),
+			EventIds:  persist.DBIDList{persist.GenerateID()},
+		})
+		require.NoError(t, err)
+		require.NoError(t, entity.SaveHashtags(ctx, queries, entity.SourceFeedEventCollectorsNotes, event.ID, collectorsNotes(event.Data)))
+		return event
+	}
+
+	hashtagFeed := func(t *testing.T, hashtag string) []persist.DBID {
+		t.Helper()
+		events, err := queries.PaginateHashtagFeed(ctx, db.PaginateHashtagFeedParams{
+			Hashtag:       hashtag,
+			CurBeforeTime: time.Date(3000, 1, 1, 1, 1, 1, 1, time.UTC),
+			CurAfterTime:  time.Date(1970, 1, 1, 1, 1, 1, 1, time.UTC),
+			Limit:         10,
+		})
+		require.NoError(t, err)
+		ids := make([]persist.DBID, len(events))
+		for i, e := range events {
+			ids[i] = e.ID
+		}
+		return ids
+	}
+
+	t.Run("gallery updates are found by the hashtags of their notes", func(t *testing.T) {
+		collectionID, tokenID := persist.GenerateID(), persist.GenerateID()
+		event := publish(t, persist.ActionGalleryUpdated, persist.FeedEventData{
+			GalleryNewCollectionCollectorsNotes: map[persist.DBID]string{collectionID: "my #grails"},
+			GalleryNewCollectionTokenCollectorsNotes: map[persist.DBID]map[persist.DBID]string{
+				collectionID: {tokenID: "a #oneofone"},
+			},
+		}, 0)
+
+		assert.Equal(t, []persist.DBID{event.ID}, hashtagFeed(t, "grails"))
+		assert.Equal(t, []persist.DBID{event.ID}, hashtagFeed(t, "oneofone"))
+	})
+
+	t.Run("editing a note later doesn't change which feed events are found", func(t *testing.T) {
+		collectionID := persist.GenerateID()
+		_, err := entity.Save(ctx, queries, entity.SourceCollectionCollectorsNote, collectionID, "#before")
+		require.NoError(t, err)
+		event := publish(t, persist.ActionCollectorsNoteAddedToCollection, persist.FeedEventData{
+			CollectionID:                collectionID,
+			CollectionNewCollectorsNote: "#before",
+		}, 1)
+
+		_, err = entity.Save(ctx, queries, entity.SourceCollectionCollectorsNote, collectionID, "#before #after")
+		require.NoError(t, err)
+
+		assert.Equal(t, []persist.DBID{event.ID}, hashtagFeed(t, "before"))
+		assert.Empty(t, hashtagFeed(t, "after"))
+	})
+
+	t.Run("captions are still matched", func(t *testing.T) {
+		event := publish(t, persist.ActionUserCreated, persist.FeedEventData{}, 2)
+		_, err := entity.Save(ctx, queries, entity.SourceCaption, event.ID, "hello #captioned")
+		require.NoError(t, err)
+
+		assert.Equal(t, []persist.DBID{event.ID}, hashtagFeed(t, "captioned"))
+	})
+}
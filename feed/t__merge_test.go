@@ -0,0 +1,55 @@
+package feed
+
+import (
+	"testing"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMergeAcquisitionEvents_Success(t *testing.T) {
+	start := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	at := func(event db.Event, minutes int) db.Event {
+		event.CreatedAt = start.Add(time.Duration(minutes) * time.Minute)
+		return event
+	}
+
+	t.Run("the feed event happens at the last event in the window", func(t *testing.T) {
+		merged := mergeAcquisitionFeedEvent([]db.Event{
+			at(tokenEvent("1", "a", persist.ActionTokenAcquired), 0),
+			at(tokenEvent("2", "b", persist.ActionTokenAcquired), 5),
+		})
+
+		assert.Equal(t, start.Add(5*time.Minute), merged.EventTime)
+		assert.Equal(t, persist.DBID("actor"), merged.OwnerID)
+		assert.Equal(t, persist.DBIDList{"a", "b"}, merged.Data.AcquiredTokenIDs)
+	})
+
+	t.Run("minted and acquired tokens are kept apart", func(t *testing.T) {
+		merged := mergeAcquisitionFeedEvent([]db.Event{
+			at(tokenEvent("1", "a", persist.ActionTokenMinted), 0),
+			at(tokenEvent("2", "b", persist.ActionTokenAcquired), 1),
+			at(tokenEvent("3", "c", persist.ActionTokenMinted), 2),
+		})
+
+		assert.Equal(t, persist.DBIDList{"a", "c"}, merged.Data.MintedTokenIDs)
+		assert.Equal(t, persist.DBIDList{"b"}, merged.Data.AcquiredTokenIDs)
+	})
+
+	t.Run("a token that was received again within the window is only posted once", func(t *testing.T) {
+		merged := mergeAcquisitionFeedEvent([]db.Event{
+			at(tokenEvent("1", "a", persist.ActionTokenMinted), 0),
+			at(tokenEvent("2", "a", persist.ActionTokenAcquired), 1),
+		})
+
+		assert.Equal(t, persist.DBIDList{"1", "2"}, merged.EventIds)
+		assert.Equal(t, persist.DBIDList{"a"}, merged.Data.MintedTokenIDs)
+		assert.Empty(t, merged.Data.AcquiredTokenIDs)
+	})
+
+	t.Run("nothing is posted without tokens", func(t *testing.T) {
+		assert.Nil(t, mergeAcquisitionFeedEvent([]db.Event{{ID: "1", ActorID: actor(), Action: persist.ActionUserFollowedUsers}}))
+	})
+}
@@ -0,0 +1,203 @@
+package feed
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/task"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewFeedEventFromScheduledPublish_Success(t *testing.T) {
+	repos, queries, pgxClient := setupTimelineTest(t)
+	ctx := context.Background()
+	builder := NewEventBuilder(queries)
+
+	// newDraft creates a draft gallery with a draft collection, and the event of the edit that created them
+	newDraft := func(t *testing.T, editID string) (db.Gallery, db.Event) {
+		t.Helper()
+		id := persist.GenerateID().String()
+		userID, err := repos.UserRepository.Create(ctx, persist.CreateUserInput{
+			Username:     "user" + id,
+			ChainAddress: persist.NewChainAddress(persist.Address("0x"+strings.ToLower(id)), persist.ChainETH),
+		})
+		require.NoError(t, err)
+
+		gallery, err := queries.GalleryRepoCreate(ctx, db.GalleryRepoCreateParams{
+			GalleryID:   persist.GenerateID(),
+			OwnerUserID: userID,
+			Position:    "a",
+		})
+		require.NoError(t, err)
+		require.NoError(t, queries.UpdateGalleryInfo(ctx, db.UpdateGalleryInfoParams{ID: gallery.ID, DraftSet: true, Draft: true}))
+
+		tokenIDs := persist.DBIDList{persist.GenerateID()}
+		collectionID, err := queries.CreateCollection(ctx, db.CreateCollectionParams{
+			ID:          persist.GenerateID(),
+			OwnerUserID: userID,
+			GalleryID:   gallery.ID,
+			Nfts:        tokenIDs,
+			Draft:       true,
+		})
+		require.NoError(t, err)
+
+		event, err := queries.CreateCollectionEvent(ctx, db.CreateCollectionEventParams{
+			ID:             persist.GenerateID(),
+			ActorID:        persist.DBIDToNullStr(userID),
+			Action:         persist.ActionCollectionCreated,
+			ResourceTypeID: persist.ResourceTypeCollection,
+			CollectionID:   collectionID,
+			Data:           persist.EventData{CollectionTokenIDs: tokenIDs},
+			GroupID:        persist.StrToNullStr(&editID),
+			GalleryID:      gallery.ID,
+		})
+		require.NoError(t, err)
+
+		return gallery, event
+	}
+
+	schedule := func(t *testing.T, galleryID persist.DBID, editID string, publishAt time.Time) {
+		t.Helper()
+		require.NoError(t, queries.ScheduleGalleryPublish(ctx, db.ScheduleGalleryPublishParams{
+			ID:            galleryID,
+			PublishAt:     sql.NullTime{Time: publishAt, Valid: true},
+			PublishEditID: persist.StrToNullStr(&editID),
+		}))
+	}
+
+	assertDraft := func(t *testing.T, galleryID, collectionID persist.DBID, draft bool) {
+		t.Helper()
+		gallery, err := queries.GetGalleryById(ctx, galleryID)
+		require.NoError(t, err)
+		assert.Equal(t, draft, gallery.Draft)
+		collection, err := queries.GetCollectionById(ctx, collectionID)
+		require.NoError(t, err)
+		assert.Equal(t, draft, collection.Draft)
+	}
+
+	t.Run("drafts are published with the feed event of the edit when the schedule is due", func(t *testing.T) {
+		editID := persist.GenerateID().String()
+		gallery, event := newDraft(t, editID)
+		schedule(t, gallery.ID, editID, time.Now())
+		_, err := pgxClient.Exec(ctx, "update events set created_at = now() - interval '1 day' where id = $1", event.ID)
+		require.NoError(t, err)
+
+		feedEvent, err := builder.NewFeedEventFromScheduledPublish(ctx, task.FeedMessage{ID: gallery.ID, EditID: editID})
+
+		require.NoError(t, err)
+		require.NotNil(t, feedEvent)
+		assert.Equal(t, persist.ActionGalleryUpdated, feedEvent.Action)
+		assert.Equal(t, persist.DBIDList{event.ID}, feedEvent.EventIds)
+		assert.WithinDuration(t, time.Now(), feedEvent.EventTime, time.Minute, "feed event should be placed at the time of the publish, not of the edit")
+		assertDraft(t, gallery.ID, event.CollectionID, false)
+
+		published, err := queries.GetGalleryById(ctx, gallery.ID)
+		require.NoError(t, err)
+		assert.False(t, published.PublishAt.Valid)
+	})
+
+	t.Run("nothing is published if the publish was moved to a later time", func(t *testing.T) {
+		editID := persist.GenerateID().String()
+		gallery, event := newDraft(t, editID)
+		schedule(t, gallery.ID, editID, time.Now().Add(time.Hour))
+
+		feedEvent, err := builder.NewFeedEventFromScheduledPublish(ctx, task.FeedMessage{ID: gallery.ID, EditID: editID})
+
+		require.NoError(t, err)
+		assert.Nil(t, feedEvent)
+		assertDraft(t, gallery.ID, event.CollectionID, true)
+		exists, err := queries.IsFeedEventExistsForGroup(ctx, persist.StrToNullStr(&editID))
+		require.NoError(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("nothing is published if a later edit was scheduled instead", func(t *testing.T) {
+		editID := persist.GenerateID().String()
+		gallery, event := newDraft(t, editID)
+		schedule(t, gallery.ID, "later", time.Now())
+
+		feedEvent, err := builder.NewFeedEventFromScheduledPublish(ctx, task.FeedMessage{ID: gallery.ID, EditID: editID})
+
+		require.NoError(t, err)
+		assert.Nil(t, feedEvent)
+		assertDraft(t, gallery.ID, event.CollectionID, true)
+	})
+
+	t.Run("events about drafts are held until the drafts are published", func(t *testing.T) {
+		editID := persist.GenerateID().String()
+		gallery, event := newDraft(t, editID)
+
+		held, err := queries.HoldEventIfDraft(ctx, db.HoldEventIfDraftParams{
+			EventID:       event.ID.String(),
+			CollectionIds: []string{event.CollectionID.String()},
+			GalleryID:     gallery.ID,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, event.ID, held)
+
+		releasable, err := queries.GetReleasableHeldEvents(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, eventIDs(releasable), event.ID)
+
+		require.NoError(t, queries.PublishGalleryDrafts(ctx, gallery.ID))
+
+		releasable, err = queries.GetReleasableHeldEvents(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, eventIDs(releasable), event.ID)
+
+		// Held events are released again until they're deleted, so that events that failed to dispatch are retried
+		releasable, err = queries.GetReleasableHeldEvents(ctx)
+		require.NoError(t, err)
+		assert.Contains(t, eventIDs(releasable), event.ID)
+
+		require.NoError(t, queries.DeleteHeldEvents(ctx, []string{event.ID.String()}))
+		releasable, err = queries.GetReleasableHeldEvents(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, eventIDs(releasable), event.ID)
+
+		// Events about published galleries aren't held
+		_, err = queries.HoldEventIfDraft(ctx, db.HoldEventIfDraftParams{
+			EventID:       persist.GenerateID().String(),
+			CollectionIds: []string{event.CollectionID.String()},
+			GalleryID:     gallery.ID,
+		})
+		assert.ErrorIs(t, err, pgx.ErrNoRows)
+	})
+
+	t.Run("events about drafts that were deleted are dropped", func(t *testing.T) {
+		editID := persist.GenerateID().String()
+		gallery, event := newDraft(t, editID)
+		_, err := queries.HoldEventIfDraft(ctx, db.HoldEventIfDraftParams{
+			EventID:       event.ID.String(),
+			CollectionIds: []string{event.CollectionID.String()},
+			GalleryID:     gallery.ID,
+		})
+		require.NoError(t, err)
+
+		_, err = pgxClient.Exec(ctx, "update galleries set deleted = true where id = $1", gallery.ID)
+		require.NoError(t, err)
+		releasable, err := queries.GetReleasableHeldEvents(ctx)
+		require.NoError(t, err)
+		assert.NotContains(t, eventIDs(releasable), event.ID)
+
+		require.NoError(t, queries.DeleteAbandonedHeldEvents(ctx))
+		var held bool
+		require.NoError(t, pgxClient.QueryRow(ctx, "select exists(select 1 from held_events where event_id = $1)", event.ID).Scan(&held))
+		assert.False(t, held)
+	})
+}
+
+func eventIDs(events []db.Event) []persist.DBID {
+	ids := make([]persist.DBID, len(events))
+	for i, e := range events {
+		ids[i] = e.ID
+	}
+	return ids
+}
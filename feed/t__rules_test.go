@@ -0,0 +1,145 @@
+package feed
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFeedRules_Success(t *testing.T) {
+	t.Run("every action maps to a rule that publishes it", func(t *testing.T) {
+		for _, rule := range feedRules {
+			assert.Equal(t, rule.Action, feedRulesByAction[rule.Action].Action)
+			for _, action := range rule.Actions {
+				assert.Equal(t, rule.Action, feedRulesByAction[action].Action, "action %s", action)
+			}
+		}
+	})
+
+	t.Run("actions without a rule are not published", func(t *testing.T) {
+		_, ok := feedRulesByAction[persist.ActionCommentedOnFeedEvent]
+		assert.False(t, ok)
+	})
+
+	t.Run("conflicting rules panic", func(t *testing.T) {
+		assert.Panics(t, func() {
+			indexFeedRules([]feedRule{
+				{Action: persist.ActionGalleryUpdated, Actions: persist.ActionList{persist.ActionCollectionCreated}},
+				{Action: persist.ActionTokensAcquired, Actions: persist.ActionList{persist.ActionCollectionCreated}},
+			})
+		})
+	})
+
+	t.Run("window falls back to the default", func(t *testing.T) {
+		assert.Equal(t, time.Minute, feedRule{}.window(time.Minute))
+		assert.Equal(t, time.Hour, feedRule{Window: time.Hour}.window(time.Minute))
+	})
+
+	t.Run("refollows are not eligible", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionUserFollowedUsers]
+		events := []db.Event{
+			followEvent("1", "2", false),
+			followEvent("2", "3", true),
+			followEvent("3", "4", false),
+		}
+
+		eligible := rule.eligible(events)
+
+		assert.Len(t, eligible, 2)
+		assert.Equal(t, persist.DBID("1"), eligible[0].ID)
+		assert.Equal(t, persist.DBID("3"), eligible[1].ID)
+	})
+
+	t.Run("only refollows are not published", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionUserFollowedUsers]
+		assert.Empty(t, rule.eligible([]db.Event{followEvent("1", "2", true)}))
+	})
+
+	t.Run("follows are merged", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionUserFollowedUsers]
+
+		actual := rule.Merge([]db.Event{followEvent("1", "2", false), followEvent("2", "3", false)})
+
+		assert.Equal(t, persist.ActionUserFollowedUsers, actual.Action)
+		assert.Equal(t, persist.DBID("actor"), actual.OwnerID)
+		assert.Equal(t, persist.DBIDList{"1", "2"}, actual.EventIds)
+		assert.Equal(t, persist.DBIDList{"2", "3"}, actual.Data.UserFollowedIDs)
+	})
+
+	t.Run("acquisitions are merged", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionTokenMinted]
+		events := []db.Event{
+			tokenEvent("1", "a", persist.ActionTokenAcquired),
+			tokenEvent("2", "b", persist.ActionTokenMinted),
+			tokenEvent("3", "a", persist.ActionTokenAcquired),
+		}
+
+		actual := rule.Merge(events)
+
+		assert.Equal(t, persist.ActionTokensAcquired, actual.Action)
+		assert.Equal(t, persist.DBIDList{"1", "2", "3"}, actual.EventIds)
+		assert.Equal(t, persist.DBIDList{"a"}, actual.Data.AcquiredTokenIDs)
+		assert.Equal(t, persist.DBIDList{"b"}, actual.Data.MintedTokenIDs)
+	})
+
+	t.Run("gallery updates are merged", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionCollectionCreated]
+		name := "gallery"
+		events := []db.Event{
+			{ID: "1", ActorID: actor(), GalleryID: "g", CollectionID: "c", Action: persist.ActionCollectionCreated, Data: persist.EventData{CollectionTokenIDs: persist.DBIDList{"a"}}},
+			{ID: "2", ActorID: actor(), GalleryID: "g", Action: persist.ActionGalleryInfoUpdated, Data: persist.EventData{GalleryName: &name}},
+		}
+
+		actual := rule.Merge(events)
+
+		assert.Equal(t, persist.ActionGalleryUpdated, actual.Action)
+		assert.Equal(t, persist.DBID("g"), actual.Data.GalleryID)
+		assert.Equal(t, name, actual.Data.GalleryName)
+		assert.Equal(t, persist.DBIDList{"c"}, actual.Data.GalleryNewCollections)
+		assert.Equal(t, persist.DBIDList{"a"}, actual.Data.GalleryNewCollectionTokenIDs["c"])
+	})
+
+	t.Run("reposts are published on their own", func(t *testing.T) {
+		rule := feedRulesByAction[persist.ActionRepostedFeedEvent]
+		event := db.Event{
+			ID:          "1",
+			ActorID:     actor(),
+			Action:      persist.ActionRepostedFeedEvent,
+			FeedEventID: "original",
+			Caption:     sql.NullString{String: "quote", Valid: true},
+		}
+
+		actual := rule.Merge([]db.Event{event})
+
+		assert.Equal(t, eventScope, rule.Scope)
+		assert.Equal(t, persist.DBID("original"), actual.Data.RepostedFeedEventID)
+		assert.Equal(t, "quote", actual.Caption.String)
+	})
+}
+
+func actor() sql.NullString {
+	return sql.NullString{String: "actor", Valid: true}
+}
+
+func followEvent(id, followed persist.DBID, refollowed bool) db.Event {
+	return db.Event{
+		ID:        id,
+		ActorID:   actor(),
+		SubjectID: followed,
+		Action:    persist.ActionUserFollowedUsers,
+		Data:      persist.EventData{UserRefollowed: refollowed},
+	}
+}
+
+func tokenEvent(id, tokenID persist.DBID, action persist.Action) db.Event {
+	return db.Event{
+		ID:      id,
+		ActorID: actor(),
+		TokenID: tokenID,
+		Action:  action,
+	}
+}
@@ -0,0 +1,213 @@
+package feed
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v4/pgxpool"
+	migrate "github.com/mikeydub/go-gallery/db"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/docker"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTimeline_Success(t *testing.T) {
+	repos, queries, pgx := setupTimelineTest(t)
+	ctx := context.Background()
+	timeline := &Timeline{queries: queries, maxFollowers: 100, maxEvents: 2}
+	start := time.Now().Add(-time.Hour)
+
+	newUser := func(t *testing.T) persist.DBID {
+		t.Helper()
+		id := persist.GenerateID().String()
+		userID, err := repos.UserRepository.Create(ctx, persist.CreateUserInput{
+			Username:     "user" + id,
+			ChainAddress: persist.NewChainAddress(persist.Address("0x"+strings.ToLower(id)), persist.ChainETH),
+		})
+		require.NoError(t, err)
+		return userID
+	}
+
+	follow := func(t *testing.T, follower, followee persist.DBID) {
+		t.Helper()
+		_, err := repos.UserRepository.AddFollower(ctx, follower, followee)
+		require.NoError(t, err)
+	}
+
+	newEvent := func(t *testing.T, ownerID persist.DBID, minutes int) db.FeedEvent {
+		t.Helper()
+		event, err := queries.CreateFeedEvent(ctx, db.CreateFeedEventParams{
+			ID:        persist.GenerateID(),
+			OwnerID:   ownerID,
+			Action:    persist.ActionUserCreated,
+			EventTime: start.Add(time.Duration(minutes) * time.Minute),
+			EventIds:  persist.DBIDList{persist.GenerateID()},
+		})
+		require.NoError(t, err)
+		return event
+	}
+
+	timelineOf := func(t *testing.T, userID persist.DBID) []persist.DBID {
+		t.Helper()
+		rows, err := pgx.Query(ctx, "select feed_event_id from feed_timelines where user_id = $1 order by event_time desc, feed_event_id desc", userID)
+		require.NoError(t, err)
+		defer rows.Close()
+		var ids []persist.DBID
+		for rows.Next() {
+			var id persist.DBID
+			require.NoError(t, rows.Scan(&id))
+			ids = append(ids, id)
+		}
+		require.NoError(t, rows.Err())
+		return ids
+	}
+
+	homeFeed := func(t *testing.T, userID persist.DBID) []persist.DBID {
+		t.Helper()
+		var ids []persist.DBID
+		b := queries.PaginatePersonalFeedByUserID(ctx, []db.PaginatePersonalFeedByUserIDParams{{
+			Follower:      userID,
+			CurBeforeTime: time.Date(3000, 1, 1, 1, 1, 1, 1, time.UTC),
+			CurAfterTime:  time.Date(1970, 1, 1, 1, 1, 1, 1, time.UTC),
+			Limit:         10,
+		}})
+		b.Query(func(_ int, events []db.FeedEvent, err error) {
+			require.NoError(t, err)
+			for _, e := range events {
+				ids = append(ids, e.ID)
+			}
+		})
+		return ids
+	}
+
+	t.Run("following a user only adds their events", func(t *testing.T) {
+		user, followee, other := newUser(t), newUser(t), newUser(t)
+		follow(t, user, followee)
+		follow(t, user, other)
+		followeeEvent := newEvent(t, followee, 0)
+		newEvent(t, other, 1)
+
+		err := timeline.AddFollowees(ctx, user, []persist.DBID{followee})
+
+		require.NoError(t, err)
+		assert.Equal(t, []persist.DBID{followeeEvent.ID}, timelineOf(t, user))
+	})
+
+	t.Run("users that were unfollowed again aren't added", func(t *testing.T) {
+		user, followee := newUser(t), newUser(t)
+		follow(t, user, followee)
+		newEvent(t, followee, 0)
+		require.NoError(t, repos.UserRepository.RemoveFollower(ctx, user, followee))
+
+		err := timeline.AddFollowees(ctx, user, []persist.DBID{followee})
+
+		require.NoError(t, err)
+		assert.Empty(t, timelineOf(t, user))
+	})
+
+	t.Run("unfollowing a user only removes their events", func(t *testing.T) {
+		user, followee, other := newUser(t), newUser(t), newUser(t)
+		follow(t, user, followee)
+		follow(t, user, other)
+		newEvent(t, followee, 0)
+		otherEvent := newEvent(t, other, 1)
+		require.NoError(t, timeline.AddFollowees(ctx, user, []persist.DBID{followee, other}))
+		require.NoError(t, repos.UserRepository.RemoveFollower(ctx, user, followee))
+
+		err := timeline.RemoveFollowee(ctx, user, followee)
+
+		require.NoError(t, err)
+		assert.Equal(t, []persist.DBID{otherEvent.ID}, timelineOf(t, user))
+	})
+
+	t.Run("fanning out trims the timelines of followers", func(t *testing.T) {
+		user, followee := newUser(t), newUser(t)
+		follow(t, user, followee)
+		var events []db.FeedEvent
+		for i := 0; i < 3; i++ {
+			event := newEvent(t, followee, i)
+			require.NoError(t, timeline.FanOut(ctx, event))
+			events = append(events, event)
+		}
+
+		assert.Equal(t, []persist.DBID{events[2].ID, events[1].ID}, timelineOf(t, user))
+	})
+
+	t.Run("events older than the timeline are read from the feed", func(t *testing.T) {
+		user, followee := newUser(t), newUser(t)
+		follow(t, user, followee)
+		var events []db.FeedEvent
+		for i := 0; i < 3; i++ {
+			event := newEvent(t, followee, i)
+			require.NoError(t, timeline.FanOut(ctx, event))
+			events = append(events, event)
+		}
+
+		assert.Equal(t, []persist.DBID{events[2].ID, events[1].ID, events[0].ID}, homeFeed(t, user))
+	})
+
+	t.Run("timeline rows of users that were unfollowed aren't read", func(t *testing.T) {
+		user, followee, other := newUser(t), newUser(t), newUser(t)
+		follow(t, user, followee)
+		follow(t, user, other)
+		newEvent(t, followee, 0)
+		otherEvent := newEvent(t, other, 1)
+		require.NoError(t, timeline.AddFollowees(ctx, user, []persist.DBID{followee, other}))
+
+		// The follow is removed without its rows, as if the backfill of the follow ran after the unfollow
+		require.NoError(t, repos.UserRepository.RemoveFollower(ctx, user, followee))
+
+		assert.Equal(t, []persist.DBID{otherEvent.ID}, homeFeed(t, user))
+	})
+
+	t.Run("only the latest repost of an event is read", func(t *testing.T) {
+		user, followee, reposter := newUser(t), newUser(t), newUser(t)
+		follow(t, user, followee)
+		follow(t, user, reposter)
+		original := newEvent(t, followee, 0)
+		repost, err := queries.CreateFeedEvent(ctx, db.CreateFeedEventParams{
+			ID:        persist.GenerateID(),
+			OwnerID:   reposter,
+			Action:    persist.ActionRepostedFeedEvent,
+			Data:      persist.FeedEventData{RepostedFeedEventID: original.ID},
+			EventTime: start.Add(time.Minute),
+			EventIds:  persist.DBIDList{persist.GenerateID()},
+		})
+		require.NoError(t, err)
+		require.NoError(t, timeline.FanOut(ctx, original))
+		require.NoError(t, timeline.FanOut(ctx, repost))
+
+		assert.Equal(t, []persist.DBID{repost.ID}, homeFeed(t, user))
+	})
+
+	t.Run("an empty timeline reads the feed", func(t *testing.T) {
+		user, followee := newUser(t), newUser(t)
+		follow(t, user, followee)
+		first := newEvent(t, followee, 0)
+		second := newEvent(t, followee, 1)
+
+		assert.Equal(t, []persist.DBID{second.ID, first.ID}, homeFeed(t, user))
+	})
+}
+
+func setupTimelineTest(t *testing.T) (*postgres.Repositories, *db.Queries, *pgxpool.Pool) {
+	t.Helper()
+	r, err := docker.StartPostgres()
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+
+	hostAndPort := strings.Split(r.GetHostPort("5432/tcp"), ":")
+	t.Setenv("POSTGRES_HOST", hostAndPort[0])
+	t.Setenv("POSTGRES_PORT", hostAndPort[1])
+
+	err = migrate.RunMigrations(postgres.MustCreateClient(postgres.WithUser("postgres")), "./db/migrations/core")
+	require.NoError(t, err)
+
+	pgx := postgres.NewPgxClient()
+	return postgres.NewRepositories(postgres.MustCreateClient(), pgx), db.New(pgx), pgx
+}
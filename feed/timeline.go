@@ -0,0 +1,132 @@
+package feed
+
+import (
+	"context"
+	"time"
+
+	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/task"
+)
+
+// Timeline maintains the home feed of each user. Feed events are fanned out to the timelines of their owner's
+// followers when they're published, unless the owner has too many followers to write to. Those owners are marked
+// as pull owners instead and their events are read directly from the feed when a home feed is paged. Events older
+// than the oldest event in a timeline are also read directly from the feed, so that trimming a timeline doesn't
+// lose any of its history.
+type Timeline struct {
+	queries *db.Queries
+	// maxFollowers is the most followers an owner can have before their events are no longer fanned out
+	maxFollowers int64
+	// maxEvents is the most events kept in a timeline
+	maxEvents int32
+}
+
+func NewTimeline(queries *db.Queries) *Timeline {
+	return &Timeline{
+		queries:      queries,
+		maxFollowers: int64(env.GetInt("FEED_FANOUT_MAX_FOLLOWERS")),
+		maxEvents:    int32(env.GetInt("FEED_TIMELINE_MAX_EVENTS")),
+	}
+}
+
+// FanOut adds a published feed event to the timelines of its owner's followers.
+func (t *Timeline) FanOut(ctx context.Context, event db.FeedEvent) error {
+	followers, err := t.queries.CountFollowersByUserID(ctx, event.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	if followers > t.maxFollowers {
+		return t.queries.AddFeedPullOwner(ctx, event.OwnerID)
+	}
+
+	pullOwner, err := t.queries.IsFeedPullOwner(ctx, event.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	// The owner's recent events were read from the feed until now, so they're copied to their followers' timelines
+	// before the owner stops being read that way.
+	if pullOwner {
+		err := t.queries.BackfillFeedTimelinesForOwner(ctx, db.BackfillFeedTimelinesForOwnerParams{
+			OwnerID: event.OwnerID,
+			Limit:   t.maxEvents,
+		})
+		if err != nil {
+			return err
+		}
+
+		if err := t.queries.RemoveFeedPullOwner(ctx, event.OwnerID); err != nil {
+			return err
+		}
+	}
+
+	err = t.queries.FanOutFeedEvent(ctx, db.FanOutFeedEventParams{
+		FeedEventID: event.ID,
+		OwnerID:     event.OwnerID,
+		RootID:      rootFeedEventID(event),
+		EventTime:   event.EventTime,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.queries.TrimFeedTimelinesOfFollowers(ctx, db.TrimFeedTimelinesOfFollowersParams{
+		OwnerID: event.OwnerID,
+		Limit:   t.maxEvents,
+	})
+}
+
+// FanOutLater creates a task to fan out a published feed event, so that a fan out that fails is retried without
+// publishing the feed event again. The feed event is fanned out right away instead if the task can't be created.
+func (t *Timeline) FanOutLater(ctx context.Context, event db.FeedEvent, taskClient *cloudtasks.Client) error {
+	err := task.CreateTaskForFeedFanOut(ctx, time.Now(), task.FeedFanOutMessage{FeedEventID: event.ID}, taskClient)
+	if err == nil {
+		return nil
+	}
+
+	logger.For(ctx).Warnf("failed to create task to fan out feed event %s, fanning out now: %s", event.ID, err)
+	return t.FanOut(ctx, event)
+}
+
+// AddFollowees adds the recent events of users that were just followed to the user's timeline.
+func (t *Timeline) AddFollowees(ctx context.Context, userID persist.DBID, followeeIDs []persist.DBID) error {
+	ids := make([]string, len(followeeIDs))
+	for i, id := range followeeIDs {
+		ids[i] = id.String()
+	}
+
+	err := t.queries.BackfillFeedTimelineFromFollowees(ctx, db.BackfillFeedTimelineFromFolloweesParams{
+		UserID:      userID,
+		FolloweeIds: ids,
+		Limit:       t.maxEvents,
+	})
+	if err != nil {
+		return err
+	}
+
+	return t.queries.TrimFeedTimeline(ctx, db.TrimFeedTimelineParams{
+		UserID: userID,
+		Limit:  t.maxEvents,
+	})
+}
+
+// RemoveFollowee removes the events of a user that was just unfollowed from the user's timeline.
+func (t *Timeline) RemoveFollowee(ctx context.Context, userID persist.DBID, followeeID persist.DBID) error {
+	return t.queries.RemoveFolloweeFromFeedTimeline(ctx, db.RemoveFolloweeFromFeedTimelineParams{
+		UserID:     userID,
+		FolloweeID: followeeID,
+	})
+}
+
+// rootFeedEventID returns the event that a repost refers to, or the event itself if it isn't a repost.
+func rootFeedEventID(event db.FeedEvent) persist.DBID {
+	if event.Data.RepostedFeedEventID != "" {
+		return event.Data.RepostedFeedEventID
+	}
+	return event.ID
+}
@@ -49,6 +49,8 @@ func (r *PostRenderer) Render(ctx context.Context, message task.FeedbotMessage)
 		return r.createCollectorsNoteAddedToCollectionPost(ctx, message)
 	case persist.ActionTokensAddedToCollection:
 		return r.createTokensAddedToCollectionPost(ctx, message)
+	case persist.ActionTokensAcquired:
+		return r.createTokensAcquiredPost(ctx, message)
 	default:
 		return "", fmt.Errorf("unknown action=%s; id=%s", message.Action, message.FeedEventID)
 	}
@@ -308,6 +310,57 @@ func (r *PostRenderer) createTokensAddedToCollectionPost(ctx context.Context, me
 	}
 }
 
+func (r *PostRenderer) createTokensAcquiredPost(ctx context.Context, message task.FeedbotMessage) (string, error) {
+	var evt FeedEventQuery
+
+	if err := r.gql.Query(ctx, &evt, map[string]interface{}{
+		"id": message.FeedEventID,
+	}); err != nil {
+		return "", err
+	}
+
+	data := evt.FeedEvent.Event.EventData.TokensAcquired
+	return tokensAcquiredPost(data.Owner, data.AcquiredTokens, data.MintedTokens), nil
+}
+
+// tokensAcquiredPost returns the post for a user that acquired or minted tokens, or an empty string if there's
+// nothing to post about
+func tokensAcquiredPost(owner UserFragment, acquiredTokens, mintedTokens []TokenFragment) string {
+	if owner.Username == "" {
+		return ""
+	}
+
+	// Minting is the more interesting of the two, so it's posted about when the user did both
+	verb := "collected"
+	tokens := acquiredTokens
+	if len(mintedTokens) > 0 {
+		verb = "minted"
+		tokens = mintedTokens
+	}
+
+	if len(tokens) == 0 {
+		return ""
+	}
+
+	var tokenName string
+	for _, token := range tokens {
+		if token.Name != "" {
+			tokenName = token.Name
+			break
+		}
+	}
+
+	if tokenName == "" {
+		return fmt.Sprintf("**%s** %s %v NFT(s): %s", owner.Username, verb, len(tokens), userURL(owner.Username))
+	}
+
+	if len(tokens) == 1 {
+		return fmt.Sprintf("**%s** %s *%s*: %s", owner.Username, verb, tokenName, userURL(owner.Username))
+	}
+
+	return fmt.Sprintf("**%s** %s *%s* and %v other NFT(s): %s", owner.Username, verb, tokenName, len(tokens)-1, userURL(owner.Username))
+}
+
 type PostSender struct{}
 
 func (s *PostSender) Send(ctx context.Context, post string) error {
@@ -387,6 +440,11 @@ type FeedEventQuery struct {
 					}
 					IsPreFeed bool
 				} `graphql:"...on TokensAddedToCollectionFeedEventData"`
+				TokensAcquired struct {
+					Owner          UserFragment
+					AcquiredTokens []TokenFragment
+					MintedTokens   []TokenFragment
+				} `graphql:"...on TokensAcquiredFeedEventData"`
 			}
 		} `graphql:"...on FeedEvent"`
 	} `graphql:"feedEventById(id: $id)"`
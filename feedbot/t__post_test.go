@@ -0,0 +1,33 @@
+package feedbot
+
+import (
+	"testing"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTokensAcquiredPost_Success(t *testing.T) {
+	viper.Set("GALLERY_HOST", "https://gallery.so")
+	alice := UserFragment{Username: "alice"}
+
+	t.Run("posts the tokens that were collected", func(t *testing.T) {
+		post := tokensAcquiredPost(alice, []TokenFragment{{Name: "Punk"}, {Name: "Ape"}}, nil)
+		assert.Equal(t, "**alice** collected *Punk* and 1 other NFT(s): https://gallery.so/alice", post)
+	})
+
+	t.Run("minted tokens are posted instead of collected ones", func(t *testing.T) {
+		post := tokensAcquiredPost(alice, []TokenFragment{{Name: "Punk"}}, []TokenFragment{{Name: "Edition"}})
+		assert.Equal(t, "**alice** minted *Edition*: https://gallery.so/alice", post)
+	})
+
+	t.Run("tokens without names are counted", func(t *testing.T) {
+		post := tokensAcquiredPost(alice, nil, []TokenFragment{{}, {}})
+		assert.Equal(t, "**alice** minted 2 NFT(s): https://gallery.so/alice", post)
+	})
+
+	t.Run("nothing is posted without tokens or an owner", func(t *testing.T) {
+		assert.Empty(t, tokensAcquiredPost(alice, nil, nil))
+		assert.Empty(t, tokensAcquiredPost(UserFragment{}, []TokenFragment{{Name: "Punk"}}, nil))
+	})
+}
@@ -16,7 +16,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
-	"github.com/mikeydub/go-gallery/service/pubsub/gcp"
+	"github.com/mikeydub/go-gallery/service/pubsub"
 	"github.com/mikeydub/go-gallery/service/recommend"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/util"
@@ -110,22 +110,12 @@ func useTokenQueue(t *testing.T) {
 	t.Setenv("TOKEN_PROCESSING_QUEUE", queue.Name)
 }
 
-// useNotificationTopics is a fixture that creates dummy PubSub topics for notifications
+// useNotificationTopics is a fixture that sends notifications through an in-memory PubSub
 func useNotificationTopics(t *testing.T) {
 	t.Helper()
-	usePubSub(t)
-	ctx := context.Background()
-	client := gcp.NewClient(ctx)
-
-	newNotificationsTopic := "new-notifications" + persist.GenerateID().String()
-	_, err := client.CreateTopic(ctx, newNotificationsTopic)
-	require.NoError(t, err)
-	t.Setenv("PUBSUB_TOPIC_NEW_NOTIFICATIONS", newNotificationsTopic)
-
-	updatedNotificationsTopic := "updated-notifications" + persist.GenerateID().String()
-	_, err = client.CreateTopic(ctx, updatedNotificationsTopic)
-	require.NoError(t, err)
-	t.Setenv("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", updatedNotificationsTopic)
+	t.Setenv("PUBSUB_BACKEND", pubsub.BackendMemory)
+	t.Setenv("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "new-notifications"+persist.GenerateID().String())
+	t.Setenv("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "updated-notifications"+persist.GenerateID().String())
 }
 
 // useCloudTasks starts a running Cloud Tasks emulator
@@ -137,15 +127,6 @@ func useCloudTasks(t *testing.T) {
 	t.Cleanup(func() { r.Close() })
 }
 
-// usePubSub starts a running PubSub emulator
-func usePubSub(t *testing.T) {
-	t.Helper()
-	r, err := docker.StartPubSub()
-	require.NoError(t, err)
-	t.Setenv("PUBSUB_EMULATOR_HOST", r.GetHostPort("8085/tcp"))
-	t.Cleanup(func() { r.Close() })
-}
-
 type serverFixture struct {
 	server *httptest.Server
 }
@@ -20,6 +20,8 @@ const (
 	ActionCollectioncreated               Action = "CollectionCreated"
 	ActionCollectorsnoteaddedtocollection Action = "CollectorsNoteAddedToCollection"
 	ActionTokensaddedtocollection         Action = "TokensAddedToCollection"
+	ActionTokensacquired                  Action = "TokensAcquired"
+	ActionRepostedfeedevent               Action = "RepostedFeedEvent"
 )
 
 type AuthMechanism struct {
@@ -117,6 +119,7 @@ type CreateCollectionInGalleryInput struct {
 	TokenSettings  []CollectionTokenSettingsInput `json:"tokenSettings"`
 	Hidden         bool                           `json:"hidden"`
 	GivenID        persist.DBID                   `json:"givenID"`
+	Draft          *bool                          `json:"draft"`
 }
 
 // GetName returns CreateCollectionInGalleryInput.Name, and is useful for accessing the field via an interface.
@@ -142,6 +145,9 @@ func (v *CreateCollectionInGalleryInput) GetHidden() bool { return v.Hidden }
 // GetGivenID returns CreateCollectionInGalleryInput.GivenID, and is useful for accessing the field via an interface.
 func (v *CreateCollectionInGalleryInput) GetGivenID() persist.DBID { return v.GivenID }
 
+// GetDraft returns CreateCollectionInGalleryInput.Draft, and is useful for accessing the field via an interface.
+func (v *CreateCollectionInGalleryInput) GetDraft() *bool { return v.Draft }
+
 type CreateCollectionInput struct {
 	GalleryId      persist.DBID                   `json:"galleryId"`
 	Name           string                         `json:"name"`
@@ -247,6 +253,13 @@ func (v *DebugSocialAuth) GetId() string { return v.Id }
 // GetUsername returns DebugSocialAuth.Username, and is useful for accessing the field via an interface.
 func (v *DebugSocialAuth) GetUsername() string { return v.Username }
 
+type DiscordAuth struct {
+	Code string `json:"code"`
+}
+
+// GetCode returns DiscordAuth.Code, and is useful for accessing the field via an interface.
+func (v *DiscordAuth) GetCode() string { return v.Code }
+
 type EoaAuth struct {
 	ChainPubKey ChainPubKeyInput `json:"chainPubKey"`
 	Nonce       string           `json:"nonce"`
@@ -297,6 +310,7 @@ type PublishGalleryInput struct {
 	GalleryId persist.DBID `json:"galleryId"`
 	EditId    string       `json:"editId"`
 	Caption   *string      `json:"caption"`
+	PublishAt *string      `json:"publishAt"`
 }
 
 // GetGalleryId returns PublishGalleryInput.GalleryId, and is useful for accessing the field via an interface.
@@ -308,6 +322,9 @@ func (v *PublishGalleryInput) GetEditId() string { return v.EditId }
 // GetCaption returns PublishGalleryInput.Caption, and is useful for accessing the field via an interface.
 func (v *PublishGalleryInput) GetCaption() *string { return v.Caption }
 
+// GetPublishAt returns PublishGalleryInput.PublishAt, and is useful for accessing the field via an interface.
+func (v *PublishGalleryInput) GetPublishAt() *string { return v.PublishAt }
+
 type ReportWindow string
 
 const (
@@ -319,20 +336,61 @@ const (
 type SocialAccountType string
 
 const (
-	SocialAccountTypeTwitter SocialAccountType = "Twitter"
+	SocialAccountTypeTwitter  SocialAccountType = "Twitter"
+	SocialAccountTypeTelegram SocialAccountType = "Telegram"
+	SocialAccountTypeDiscord  SocialAccountType = "Discord"
 )
 
 type SocialAuthMechanism struct {
-	Twitter *TwitterAuth     `json:"twitter"`
-	Debug   *DebugSocialAuth `json:"debug"`
+	Twitter  *TwitterAuth     `json:"twitter"`
+	Telegram *TelegramAuth    `json:"telegram"`
+	Discord  *DiscordAuth     `json:"discord"`
+	Debug    *DebugSocialAuth `json:"debug"`
 }
 
 // GetTwitter returns SocialAuthMechanism.Twitter, and is useful for accessing the field via an interface.
 func (v *SocialAuthMechanism) GetTwitter() *TwitterAuth { return v.Twitter }
 
+// GetTelegram returns SocialAuthMechanism.Telegram, and is useful for accessing the field via an interface.
+func (v *SocialAuthMechanism) GetTelegram() *TelegramAuth { return v.Telegram }
+
+// GetDiscord returns SocialAuthMechanism.Discord, and is useful for accessing the field via an interface.
+func (v *SocialAuthMechanism) GetDiscord() *DiscordAuth { return v.Discord }
+
 // GetDebug returns SocialAuthMechanism.Debug, and is useful for accessing the field via an interface.
 func (v *SocialAuthMechanism) GetDebug() *DebugSocialAuth { return v.Debug }
 
+type TelegramAuth struct {
+	Id        string  `json:"id"`
+	FirstName *string `json:"firstName"`
+	LastName  *string `json:"lastName"`
+	Username  *string `json:"username"`
+	PhotoURL  *string `json:"photoURL"`
+	AuthDate  int     `json:"authDate"`
+	Hash      string  `json:"hash"`
+}
+
+// GetId returns TelegramAuth.Id, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetId() string { return v.Id }
+
+// GetFirstName returns TelegramAuth.FirstName, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetFirstName() *string { return v.FirstName }
+
+// GetLastName returns TelegramAuth.LastName, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetLastName() *string { return v.LastName }
+
+// GetUsername returns TelegramAuth.Username, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetUsername() *string { return v.Username }
+
+// GetPhotoURL returns TelegramAuth.PhotoURL, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetPhotoURL() *string { return v.PhotoURL }
+
+// GetAuthDate returns TelegramAuth.AuthDate, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetAuthDate() int { return v.AuthDate }
+
+// GetHash returns TelegramAuth.Hash, and is useful for accessing the field via an interface.
+func (v *TelegramAuth) GetHash() string { return v.Hash }
+
 type TrendingUsersInput struct {
 	Report ReportWindow `json:"report"`
 }
@@ -384,6 +442,7 @@ type UpdateGalleryInput struct {
 	GalleryId          persist.DBID                      `json:"galleryId"`
 	Name               *string                           `json:"name"`
 	Description        *string                           `json:"description"`
+	Draft              *bool                             `json:"draft"`
 	Caption            *string                           `json:"caption"`
 	DeletedCollections []persist.DBID                    `json:"deletedCollections"`
 	UpdatedCollections []*UpdateCollectionInput          `json:"updatedCollections"`
@@ -401,6 +460,9 @@ func (v *UpdateGalleryInput) GetName() *string { return v.Name }
 // GetDescription returns UpdateGalleryInput.Description, and is useful for accessing the field via an interface.
 func (v *UpdateGalleryInput) GetDescription() *string { return v.Description }
 
+// GetDraft returns UpdateGalleryInput.Draft, and is useful for accessing the field via an interface.
+func (v *UpdateGalleryInput) GetDraft() *bool { return v.Draft }
+
 // GetCaption returns UpdateGalleryInput.Caption, and is useful for accessing the field via an interface.
 func (v *UpdateGalleryInput) GetCaption() *string { return v.Caption }
 
@@ -475,6 +537,14 @@ type __admireFeedEventMutationInput struct {
 // GetFeedEventId returns __admireFeedEventMutationInput.FeedEventId, and is useful for accessing the field via an interface.
 func (v *__admireFeedEventMutationInput) GetFeedEventId() persist.DBID { return v.FeedEventId }
 
+// __collectionByIdQueryInput is used internally by genqlient
+type __collectionByIdQueryInput struct {
+	Id persist.DBID `json:"id"`
+}
+
+// GetId returns __collectionByIdQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__collectionByIdQueryInput) GetId() persist.DBID { return v.Id }
+
 // __commentOnFeedEventMutationInput is used internally by genqlient
 type __commentOnFeedEventMutationInput struct {
 	FeedEventId persist.DBID `json:"feedEventId"`
@@ -535,6 +605,22 @@ type __disconnectSocialAccountInput struct {
 // GetAccountType returns __disconnectSocialAccountInput.AccountType, and is useful for accessing the field via an interface.
 func (v *__disconnectSocialAccountInput) GetAccountType() SocialAccountType { return v.AccountType }
 
+// __feedEventByIdQueryInput is used internally by genqlient
+type __feedEventByIdQueryInput struct {
+	Id persist.DBID `json:"id"`
+}
+
+// GetId returns __feedEventByIdQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__feedEventByIdQueryInput) GetId() persist.DBID { return v.Id }
+
+// __galleryByIdQueryInput is used internally by genqlient
+type __galleryByIdQueryInput struct {
+	Id persist.DBID `json:"id"`
+}
+
+// GetId returns __galleryByIdQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__galleryByIdQueryInput) GetId() persist.DBID { return v.Id }
+
 // __getAuthNonceMutationInput is used internally by genqlient
 type __getAuthNonceMutationInput struct {
 	Input ChainAddressInput `json:"input"`
@@ -583,6 +669,30 @@ type __removeUserWalletsMutationInput struct {
 // GetWalletIds returns __removeUserWalletsMutationInput.WalletIds, and is useful for accessing the field via an interface.
 func (v *__removeUserWalletsMutationInput) GetWalletIds() []persist.DBID { return v.WalletIds }
 
+// __repostFeedEventMutationInput is used internally by genqlient
+type __repostFeedEventMutationInput struct {
+	FeedEventId persist.DBID `json:"feedEventId"`
+	Quote       *string      `json:"quote"`
+}
+
+// GetFeedEventId returns __repostFeedEventMutationInput.FeedEventId, and is useful for accessing the field via an interface.
+func (v *__repostFeedEventMutationInput) GetFeedEventId() persist.DBID { return v.FeedEventId }
+
+// GetQuote returns __repostFeedEventMutationInput.Quote, and is useful for accessing the field via an interface.
+func (v *__repostFeedEventMutationInput) GetQuote() *string { return v.Quote }
+
+// __setFeedEventHiddenMutationInput is used internally by genqlient
+type __setFeedEventHiddenMutationInput struct {
+	FeedEventId persist.DBID `json:"feedEventId"`
+	Hidden      bool         `json:"hidden"`
+}
+
+// GetFeedEventId returns __setFeedEventHiddenMutationInput.FeedEventId, and is useful for accessing the field via an interface.
+func (v *__setFeedEventHiddenMutationInput) GetFeedEventId() persist.DBID { return v.FeedEventId }
+
+// GetHidden returns __setFeedEventHiddenMutationInput.Hidden, and is useful for accessing the field via an interface.
+func (v *__setFeedEventHiddenMutationInput) GetHidden() bool { return v.Hidden }
+
 // __syncTokensMutationInput is used internally by genqlient
 type __syncTokensMutationInput struct {
 	Chains []Chain `json:"chains"`
@@ -607,6 +717,18 @@ type __trendingUsersQueryInput struct {
 // GetInput returns __trendingUsersQueryInput.Input, and is useful for accessing the field via an interface.
 func (v *__trendingUsersQueryInput) GetInput() TrendingUsersInput { return v.Input }
 
+// __updateFeedEventCaptionMutationInput is used internally by genqlient
+type __updateFeedEventCaptionMutationInput struct {
+	FeedEventId persist.DBID `json:"feedEventId"`
+	Caption     string       `json:"caption"`
+}
+
+// GetFeedEventId returns __updateFeedEventCaptionMutationInput.FeedEventId, and is useful for accessing the field via an interface.
+func (v *__updateFeedEventCaptionMutationInput) GetFeedEventId() persist.DBID { return v.FeedEventId }
+
+// GetCaption returns __updateFeedEventCaptionMutationInput.Caption, and is useful for accessing the field via an interface.
+func (v *__updateFeedEventCaptionMutationInput) GetCaption() string { return v.Caption }
+
 // __updateGalleryMutationInput is used internally by genqlient
 type __updateGalleryMutationInput struct {
 	Input UpdateGalleryInput `json:"input"`
@@ -657,6 +779,18 @@ type __userByUsernameQueryInput struct {
 // GetUser returns __userByUsernameQueryInput.User, and is useful for accessing the field via an interface.
 func (v *__userByUsernameQueryInput) GetUser() string { return v.User }
 
+// __userFeedQueryInput is used internally by genqlient
+type __userFeedQueryInput struct {
+	Id    persist.DBID `json:"id"`
+	First *int         `json:"first"`
+}
+
+// GetId returns __userFeedQueryInput.Id, and is useful for accessing the field via an interface.
+func (v *__userFeedQueryInput) GetId() persist.DBID { return v.Id }
+
+// GetFirst returns __userFeedQueryInput.First, and is useful for accessing the field via an interface.
+func (v *__userFeedQueryInput) GetFirst() *int { return v.First }
+
 // __viewGalleryMutationInput is used internally by genqlient
 type __viewGalleryMutationInput struct {
 	GalleryId persist.DBID `json:"galleryId"`
@@ -1259,6 +1393,209 @@ func (v *admireFeedEventMutationResponse) __premarshalJSON() (*__premarshaladmir
 	return &retval, nil
 }
 
+// collectionByIdQueryCollectionByIdCollection includes the requested fields of the GraphQL type Collection.
+type collectionByIdQueryCollectionByIdCollection struct {
+	Typename *string      `json:"__typename"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetTypename returns collectionByIdQueryCollectionByIdCollection.Typename, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdCollection) GetTypename() *string { return v.Typename }
+
+// GetDbid returns collectionByIdQueryCollectionByIdCollection.Dbid, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdCollection) GetDbid() persist.DBID { return v.Dbid }
+
+// collectionByIdQueryCollectionByIdCollectionByIdOrError includes the requested fields of the GraphQL interface CollectionByIdOrError.
+//
+// collectionByIdQueryCollectionByIdCollectionByIdOrError is implemented by the following types:
+// collectionByIdQueryCollectionByIdCollection
+// collectionByIdQueryCollectionByIdErrCollectionNotFound
+// collectionByIdQueryCollectionByIdErrInvalidInput
+type collectionByIdQueryCollectionByIdCollectionByIdOrError interface {
+	implementsGraphQLInterfacecollectionByIdQueryCollectionByIdCollectionByIdOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *collectionByIdQueryCollectionByIdCollection) implementsGraphQLInterfacecollectionByIdQueryCollectionByIdCollectionByIdOrError() {
+}
+func (v *collectionByIdQueryCollectionByIdErrCollectionNotFound) implementsGraphQLInterfacecollectionByIdQueryCollectionByIdCollectionByIdOrError() {
+}
+func (v *collectionByIdQueryCollectionByIdErrInvalidInput) implementsGraphQLInterfacecollectionByIdQueryCollectionByIdCollectionByIdOrError() {
+}
+
+func __unmarshalcollectionByIdQueryCollectionByIdCollectionByIdOrError(b []byte, v *collectionByIdQueryCollectionByIdCollectionByIdOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Collection":
+		*v = new(collectionByIdQueryCollectionByIdCollection)
+		return json.Unmarshal(b, *v)
+	case "ErrCollectionNotFound":
+		*v = new(collectionByIdQueryCollectionByIdErrCollectionNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(collectionByIdQueryCollectionByIdErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing CollectionByIdOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for collectionByIdQueryCollectionByIdCollectionByIdOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalcollectionByIdQueryCollectionByIdCollectionByIdOrError(v *collectionByIdQueryCollectionByIdCollectionByIdOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *collectionByIdQueryCollectionByIdCollection:
+		typename = "Collection"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*collectionByIdQueryCollectionByIdCollection
+		}{typename, v}
+		return json.Marshal(result)
+	case *collectionByIdQueryCollectionByIdErrCollectionNotFound:
+		typename = "ErrCollectionNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*collectionByIdQueryCollectionByIdErrCollectionNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *collectionByIdQueryCollectionByIdErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*collectionByIdQueryCollectionByIdErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for collectionByIdQueryCollectionByIdCollectionByIdOrError: "%T"`, v)
+	}
+}
+
+// collectionByIdQueryCollectionByIdErrCollectionNotFound includes the requested fields of the GraphQL type ErrCollectionNotFound.
+type collectionByIdQueryCollectionByIdErrCollectionNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns collectionByIdQueryCollectionByIdErrCollectionNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdErrCollectionNotFound) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns collectionByIdQueryCollectionByIdErrCollectionNotFound.Message, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdErrCollectionNotFound) GetMessage() string {
+	return v.Message
+}
+
+// collectionByIdQueryCollectionByIdErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type collectionByIdQueryCollectionByIdErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns collectionByIdQueryCollectionByIdErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// GetMessage returns collectionByIdQueryCollectionByIdErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryCollectionByIdErrInvalidInput) GetMessage() string { return v.Message }
+
+// collectionByIdQueryResponse is returned by collectionByIdQuery on success.
+type collectionByIdQueryResponse struct {
+	CollectionById *collectionByIdQueryCollectionByIdCollectionByIdOrError `json:"-"`
+}
+
+// GetCollectionById returns collectionByIdQueryResponse.CollectionById, and is useful for accessing the field via an interface.
+func (v *collectionByIdQueryResponse) GetCollectionById() *collectionByIdQueryCollectionByIdCollectionByIdOrError {
+	return v.CollectionById
+}
+
+func (v *collectionByIdQueryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*collectionByIdQueryResponse
+		CollectionById json.RawMessage `json:"collectionById"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.collectionByIdQueryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.CollectionById
+		src := firstPass.CollectionById
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(collectionByIdQueryCollectionByIdCollectionByIdOrError)
+			err = __unmarshalcollectionByIdQueryCollectionByIdCollectionByIdOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal collectionByIdQueryResponse.CollectionById: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalcollectionByIdQueryResponse struct {
+	CollectionById json.RawMessage `json:"collectionById"`
+}
+
+func (v *collectionByIdQueryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *collectionByIdQueryResponse) __premarshalJSON() (*__premarshalcollectionByIdQueryResponse, error) {
+	var retval __premarshalcollectionByIdQueryResponse
+
+	{
+
+		dst := &retval.CollectionById
+		src := v.CollectionById
+		if src != nil {
+			var err error
+			*dst, err = __marshalcollectionByIdQueryCollectionByIdCollectionByIdOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal collectionByIdQueryResponse.CollectionById: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
 // commentOnFeedEventMutationCommentOnFeedEventCommentOnFeedEventPayload includes the requested fields of the GraphQL type CommentOnFeedEventPayload.
 type commentOnFeedEventMutationCommentOnFeedEventCommentOnFeedEventPayload struct {
 	Typename  *string                                                                         `json:"__typename"`
@@ -2847,55 +3184,64 @@ func (v *disconnectSocialAccountResponse) __premarshalJSON() (*__premarshaldisco
 	return &retval, nil
 }
 
-// getAuthNonceMutationGetAuthNonce includes the requested fields of the GraphQL type AuthNonce.
-type getAuthNonceMutationGetAuthNonce struct {
-	Typename   *string `json:"__typename"`
-	Nonce      *string `json:"nonce"`
-	UserExists *bool   `json:"userExists"`
+// feedEventByIdQueryFeedEventByIdErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type feedEventByIdQueryFeedEventByIdErrFeedEventNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns getAuthNonceMutationGetAuthNonce.Typename, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetTypename() *string { return v.Typename }
-
-// GetNonce returns getAuthNonceMutationGetAuthNonce.Nonce, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetNonce() *string { return v.Nonce }
+// GetTypename returns feedEventByIdQueryFeedEventByIdErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdErrFeedEventNotFound) GetTypename() *string {
+	return v.Typename
+}
 
-// GetUserExists returns getAuthNonceMutationGetAuthNonce.UserExists, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonce) GetUserExists() *bool { return v.UserExists }
+// GetMessage returns feedEventByIdQueryFeedEventByIdErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdErrFeedEventNotFound) GetMessage() string { return v.Message }
 
-// getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
-type getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken struct {
+// feedEventByIdQueryFeedEventByIdErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
+type feedEventByIdQueryFeedEventByIdErrUnknownAction struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns feedEventByIdQueryFeedEventByIdErrUnknownAction.Typename, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdErrUnknownAction) GetTypename() *string { return v.Typename }
 
-// GetMessage returns getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) GetMessage() string {
-	return v.Message
+// GetMessage returns feedEventByIdQueryFeedEventByIdErrUnknownAction.Message, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdErrUnknownAction) GetMessage() string { return v.Message }
+
+// feedEventByIdQueryFeedEventByIdFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type feedEventByIdQueryFeedEventByIdFeedEvent struct {
+	Typename *string      `json:"__typename"`
+	Dbid     persist.DBID `json:"dbid"`
 }
 
-// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError includes the requested fields of the GraphQL interface GetAuthNoncePayloadOrError.
+// GetTypename returns feedEventByIdQueryFeedEventByIdFeedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdFeedEvent) GetTypename() *string { return v.Typename }
+
+// GetDbid returns feedEventByIdQueryFeedEventByIdFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryFeedEventByIdFeedEvent) GetDbid() persist.DBID { return v.Dbid }
+
+// feedEventByIdQueryFeedEventByIdFeedEventByIdOrError includes the requested fields of the GraphQL interface FeedEventByIdOrError.
 //
-// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError is implemented by the following types:
-// getAuthNonceMutationGetAuthNonce
-// getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken
-type getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError interface {
-	implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError()
+// feedEventByIdQueryFeedEventByIdFeedEventByIdOrError is implemented by the following types:
+// feedEventByIdQueryFeedEventByIdFeedEvent
+// feedEventByIdQueryFeedEventByIdErrFeedEventNotFound
+// feedEventByIdQueryFeedEventByIdErrUnknownAction
+type feedEventByIdQueryFeedEventByIdFeedEventByIdOrError interface {
+	implementsGraphQLInterfacefeedEventByIdQueryFeedEventByIdFeedEventByIdOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *getAuthNonceMutationGetAuthNonce) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
+func (v *feedEventByIdQueryFeedEventByIdFeedEvent) implementsGraphQLInterfacefeedEventByIdQueryFeedEventByIdFeedEventByIdOrError() {
 }
-func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
+func (v *feedEventByIdQueryFeedEventByIdErrFeedEventNotFound) implementsGraphQLInterfacefeedEventByIdQueryFeedEventByIdFeedEventByIdOrError() {
+}
+func (v *feedEventByIdQueryFeedEventByIdErrUnknownAction) implementsGraphQLInterfacefeedEventByIdQueryFeedEventByIdFeedEventByIdOrError() {
 }
 
-func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []byte, v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) error {
+func __unmarshalfeedEventByIdQueryFeedEventByIdFeedEventByIdOrError(b []byte, v *feedEventByIdQueryFeedEventByIdFeedEventByIdOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -2909,71 +3255,82 @@ func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []b
 	}
 
 	switch tn.TypeName {
-	case "AuthNonce":
-		*v = new(getAuthNonceMutationGetAuthNonce)
+	case "FeedEvent":
+		*v = new(feedEventByIdQueryFeedEventByIdFeedEvent)
 		return json.Unmarshal(b, *v)
-	case "ErrDoesNotOwnRequiredToken":
-		*v = new(getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken)
+	case "ErrFeedEventNotFound":
+		*v = new(feedEventByIdQueryFeedEventByIdErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrUnknownAction":
+		*v = new(feedEventByIdQueryFeedEventByIdErrUnknownAction)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing GetAuthNoncePayloadOrError.__typename")
+			"response was missing FeedEventByIdOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for feedEventByIdQueryFeedEventByIdFeedEventByIdOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) ([]byte, error) {
+func __marshalfeedEventByIdQueryFeedEventByIdFeedEventByIdOrError(v *feedEventByIdQueryFeedEventByIdFeedEventByIdOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *getAuthNonceMutationGetAuthNonce:
-		typename = "AuthNonce"
+	case *feedEventByIdQueryFeedEventByIdFeedEvent:
+		typename = "FeedEvent"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getAuthNonceMutationGetAuthNonce
+			*feedEventByIdQueryFeedEventByIdFeedEvent
 		}{typename, v}
 		return json.Marshal(result)
-	case *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken:
-		typename = "ErrDoesNotOwnRequiredToken"
+	case *feedEventByIdQueryFeedEventByIdErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken
+			*feedEventByIdQueryFeedEventByIdErrFeedEventNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *feedEventByIdQueryFeedEventByIdErrUnknownAction:
+		typename = "ErrUnknownAction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*feedEventByIdQueryFeedEventByIdErrUnknownAction
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%T"`, v)
+			`unexpected concrete type for feedEventByIdQueryFeedEventByIdFeedEventByIdOrError: "%T"`, v)
 	}
 }
 
-// getAuthNonceMutationResponse is returned by getAuthNonceMutation on success.
-type getAuthNonceMutationResponse struct {
-	GetAuthNonce *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError `json:"-"`
+// feedEventByIdQueryResponse is returned by feedEventByIdQuery on success.
+type feedEventByIdQueryResponse struct {
+	FeedEventById *feedEventByIdQueryFeedEventByIdFeedEventByIdOrError `json:"-"`
 }
 
-// GetGetAuthNonce returns getAuthNonceMutationResponse.GetAuthNonce, and is useful for accessing the field via an interface.
-func (v *getAuthNonceMutationResponse) GetGetAuthNonce() *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError {
-	return v.GetAuthNonce
+// GetFeedEventById returns feedEventByIdQueryResponse.FeedEventById, and is useful for accessing the field via an interface.
+func (v *feedEventByIdQueryResponse) GetFeedEventById() *feedEventByIdQueryFeedEventByIdFeedEventByIdOrError {
+	return v.FeedEventById
 }
 
-func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *feedEventByIdQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*getAuthNonceMutationResponse
-		GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+		*feedEventByIdQueryResponse
+		FeedEventById json.RawMessage `json:"feedEventById"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.getAuthNonceMutationResponse = v
+	firstPass.feedEventByIdQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -2981,26 +3338,26 @@ func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.GetAuthNonce
-		src := firstPass.GetAuthNonce
+		dst := &v.FeedEventById
+		src := firstPass.FeedEventById
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError)
-			err = __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+			*dst = new(feedEventByIdQueryFeedEventByIdFeedEventByIdOrError)
+			err = __unmarshalfeedEventByIdQueryFeedEventByIdFeedEventByIdOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+					"Unable to unmarshal feedEventByIdQueryResponse.FeedEventById: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalgetAuthNonceMutationResponse struct {
-	GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+type __premarshalfeedEventByIdQueryResponse struct {
+	FeedEventById json.RawMessage `json:"feedEventById"`
 }
 
-func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *feedEventByIdQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3008,58 +3365,161 @@ func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *getAuthNonceMutationResponse) __premarshalJSON() (*__premarshalgetAuthNonceMutationResponse, error) {
-	var retval __premarshalgetAuthNonceMutationResponse
+func (v *feedEventByIdQueryResponse) __premarshalJSON() (*__premarshalfeedEventByIdQueryResponse, error) {
+	var retval __premarshalfeedEventByIdQueryResponse
 
 	{
 
-		dst := &retval.GetAuthNonce
-		src := v.GetAuthNonce
+		dst := &retval.FeedEventById
+		src := v.FeedEventById
 		if src != nil {
 			var err error
-			*dst, err = __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+			*dst, err = __marshalfeedEventByIdQueryFeedEventByIdFeedEventByIdOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+					"Unable to marshal feedEventByIdQueryResponse.FeedEventById: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// globalFeedQueryGlobalFeedFeedConnection includes the requested fields of the GraphQL type FeedConnection.
-type globalFeedQueryGlobalFeedFeedConnection struct {
-	Edges []*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge `json:"edges"`
+// galleryByIdQueryGalleryByIdErrGalleryNotFound includes the requested fields of the GraphQL type ErrGalleryNotFound.
+type galleryByIdQueryGalleryByIdErrGalleryNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetEdges returns globalFeedQueryGlobalFeedFeedConnection.Edges, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnection) GetEdges() []*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge {
-	return v.Edges
+// GetTypename returns galleryByIdQueryGalleryByIdErrGalleryNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdErrGalleryNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns galleryByIdQueryGalleryByIdErrGalleryNotFound.Message, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdErrGalleryNotFound) GetMessage() string { return v.Message }
+
+// galleryByIdQueryGalleryByIdGallery includes the requested fields of the GraphQL type Gallery.
+type galleryByIdQueryGalleryByIdGallery struct {
+	Typename    *string                                                    `json:"__typename"`
+	Dbid        persist.DBID                                               `json:"dbid"`
+	Collections []*galleryByIdQueryGalleryByIdGalleryCollectionsCollection `json:"collections"`
 }
 
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge includes the requested fields of the GraphQL type FeedEdge.
-type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge struct {
-	Node *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError `json:"-"`
+// GetTypename returns galleryByIdQueryGalleryByIdGallery.Typename, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdGallery) GetTypename() *string { return v.Typename }
+
+// GetDbid returns galleryByIdQueryGalleryByIdGallery.Dbid, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdGallery) GetDbid() persist.DBID { return v.Dbid }
+
+// GetCollections returns galleryByIdQueryGalleryByIdGallery.Collections, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdGallery) GetCollections() []*galleryByIdQueryGalleryByIdGalleryCollectionsCollection {
+	return v.Collections
 }
 
-// GetNode returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) GetNode() *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError {
-	return v.Node
+// galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError includes the requested fields of the GraphQL interface GalleryByIdPayloadOrError.
+//
+// galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError is implemented by the following types:
+// galleryByIdQueryGalleryByIdGallery
+// galleryByIdQueryGalleryByIdErrGalleryNotFound
+type galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError interface {
+	implementsGraphQLInterfacegalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
 }
 
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b []byte) error {
+func (v *galleryByIdQueryGalleryByIdGallery) implementsGraphQLInterfacegalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError() {
+}
+func (v *galleryByIdQueryGalleryByIdErrGalleryNotFound) implementsGraphQLInterfacegalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError() {
+}
+
+func __unmarshalgalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError(b []byte, v *galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "Gallery":
+		*v = new(galleryByIdQueryGalleryByIdGallery)
+		return json.Unmarshal(b, *v)
+	case "ErrGalleryNotFound":
+		*v = new(galleryByIdQueryGalleryByIdErrGalleryNotFound)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing GalleryByIdPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalgalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError(v *galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *galleryByIdQueryGalleryByIdGallery:
+		typename = "Gallery"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*galleryByIdQueryGalleryByIdGallery
+		}{typename, v}
+		return json.Marshal(result)
+	case *galleryByIdQueryGalleryByIdErrGalleryNotFound:
+		typename = "ErrGalleryNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*galleryByIdQueryGalleryByIdErrGalleryNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError: "%T"`, v)
+	}
+}
+
+// galleryByIdQueryGalleryByIdGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
+type galleryByIdQueryGalleryByIdGalleryCollectionsCollection struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns galleryByIdQueryGalleryByIdGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryGalleryByIdGalleryCollectionsCollection) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// galleryByIdQueryResponse is returned by galleryByIdQuery on success.
+type galleryByIdQueryResponse struct {
+	GalleryById *galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError `json:"-"`
+}
+
+// GetGalleryById returns galleryByIdQueryResponse.GalleryById, and is useful for accessing the field via an interface.
+func (v *galleryByIdQueryResponse) GetGalleryById() *galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError {
+	return v.GalleryById
+}
+
+func (v *galleryByIdQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge
-		Node json.RawMessage `json:"node"`
+		*galleryByIdQueryResponse
+		GalleryById json.RawMessage `json:"galleryById"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge = v
+	firstPass.galleryByIdQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3067,26 +3527,26 @@ func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b [
 	}
 
 	{
-		dst := &v.Node
-		src := firstPass.Node
+		dst := &v.GalleryById
+		src := firstPass.GalleryById
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError)
-			err = __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+			*dst = new(galleryByIdQueryGalleryByIdGalleryByIdPayloadOrError)
+			err = __unmarshalgalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+					"Unable to unmarshal galleryByIdQueryResponse.GalleryById: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge struct {
-	Node json.RawMessage `json:"node"`
+type __premarshalgalleryByIdQueryResponse struct {
+	GalleryById json.RawMessage `json:"galleryById"`
 }
 
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]byte, error) {
+func (v *galleryByIdQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3094,94 +3554,75 @@ func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]
 	return json.Marshal(premarshaled)
 }
 
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) __premarshalJSON() (*__premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge, error) {
-	var retval __premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge
+func (v *galleryByIdQueryResponse) __premarshalJSON() (*__premarshalgalleryByIdQueryResponse, error) {
+	var retval __premarshalgalleryByIdQueryResponse
 
 	{
 
-		dst := &retval.Node
-		src := v.Node
+		dst := &retval.GalleryById
+		src := v.GalleryById
 		if src != nil {
 			var err error
-			*dst, err = __marshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+			*dst, err = __marshalgalleryByIdQueryGalleryByIdGalleryByIdPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+					"Unable to marshal galleryByIdQueryResponse.GalleryById: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
-type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound struct {
-	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
+// getAuthNonceMutationGetAuthNonce includes the requested fields of the GraphQL type AuthNonce.
+type getAuthNonceMutationGetAuthNonce struct {
+	Typename   *string `json:"__typename"`
+	Nonce      *string `json:"nonce"`
+	UserExists *bool   `json:"userExists"`
 }
 
-// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns getAuthNonceMutationGetAuthNonce.Typename, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetTypename() *string { return v.Typename }
 
-// GetMessage returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetMessage() string {
-	return v.Message
-}
+// GetNonce returns getAuthNonceMutationGetAuthNonce.Nonce, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetNonce() *string { return v.Nonce }
 
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
-type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction struct {
+// GetUserExists returns getAuthNonceMutationGetAuthNonce.UserExists, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonce) GetUserExists() *bool { return v.UserExists }
+
+// getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
+type getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Typename, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetTypename() *string {
+// GetTypename returns getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Message, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetMessage() string {
+// GetMessage returns getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) GetMessage() string {
 	return v.Message
 }
 
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent includes the requested fields of the GraphQL type FeedEvent.
-type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent struct {
-	Typename *string      `json:"__typename"`
-	Dbid     persist.DBID `json:"dbid"`
-}
-
-// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Typename, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetTypename() *string {
-	return v.Typename
-}
-
-// GetDbid returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Dbid, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetDbid() persist.DBID {
-	return v.Dbid
-}
-
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError includes the requested fields of the GraphQL interface FeedEventOrError.
+// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError includes the requested fields of the GraphQL interface GetAuthNoncePayloadOrError.
 //
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError is implemented by the following types:
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
-// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
-type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError interface {
-	implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError()
+// getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError is implemented by the following types:
+// getAuthNonceMutationGetAuthNonce
+// getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken
+type getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError interface {
+	implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
-}
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+func (v *getAuthNonceMutationGetAuthNonce) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
 }
-func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+func (v *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken) implementsGraphQLInterfacegetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError() {
 }
 
-func __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
+func __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(b []byte, v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3195,143 +3636,1921 @@ func __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEven
 	}
 
 	switch tn.TypeName {
-	case "FeedEvent":
-		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
-		return json.Unmarshal(b, *v)
-	case "ErrFeedEventNotFound":
-		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound)
+	case "AuthNonce":
+		*v = new(getAuthNonceMutationGetAuthNonce)
 		return json.Unmarshal(b, *v)
-	case "ErrUnknownAction":
-		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction)
+	case "ErrDoesNotOwnRequiredToken":
+		*v = new(getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing FeedEventOrError.__typename")
+			"response was missing GetAuthNoncePayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) ([]byte, error) {
+func __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(v *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent:
-		typename = "FeedEvent"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
-		}{typename, v}
-		return json.Marshal(result)
-	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound:
-		typename = "ErrFeedEventNotFound"
+	case *getAuthNonceMutationGetAuthNonce:
+		typename = "AuthNonce"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+			*getAuthNonceMutationGetAuthNonce
 		}{typename, v}
 		return json.Marshal(result)
-	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction:
-		typename = "ErrUnknownAction"
+	case *getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken:
+		typename = "ErrDoesNotOwnRequiredToken"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+			*getAuthNonceMutationGetAuthNonceErrDoesNotOwnRequiredToken
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%T"`, v)
+			`unexpected concrete type for getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError: "%T"`, v)
 	}
 }
 
-// globalFeedQueryResponse is returned by globalFeedQuery on success.
-type globalFeedQueryResponse struct {
-	GlobalFeed *globalFeedQueryGlobalFeedFeedConnection `json:"globalFeed"`
+// getAuthNonceMutationResponse is returned by getAuthNonceMutation on success.
+type getAuthNonceMutationResponse struct {
+	GetAuthNonce *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError `json:"-"`
 }
 
-// GetGlobalFeed returns globalFeedQueryResponse.GlobalFeed, and is useful for accessing the field via an interface.
-func (v *globalFeedQueryResponse) GetGlobalFeed() *globalFeedQueryGlobalFeedFeedConnection {
-	return v.GlobalFeed
+// GetGetAuthNonce returns getAuthNonceMutationResponse.GetAuthNonce, and is useful for accessing the field via an interface.
+func (v *getAuthNonceMutationResponse) GetGetAuthNonce() *getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError {
+	return v.GetAuthNonce
 }
 
-// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
-type loginMutationLoginErrAuthenticationFailed struct {
-	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
-}
+func (v *getAuthNonceMutationResponse) UnmarshalJSON(b []byte) error {
 
-// GetTypename returns loginMutationLoginErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrAuthenticationFailed) GetTypename() *string { return v.Typename }
+	if string(b) == "null" {
+		return nil
+	}
 
-// GetMessage returns loginMutationLoginErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrAuthenticationFailed) GetMessage() string { return v.Message }
+	var firstPass struct {
+		*getAuthNonceMutationResponse
+		GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.getAuthNonceMutationResponse = v
 
-// loginMutationLoginErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
-type loginMutationLoginErrDoesNotOwnRequiredToken struct {
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.GetAuthNonce
+		src := firstPass.GetAuthNonce
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(getAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError)
+			err = __unmarshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalgetAuthNonceMutationResponse struct {
+	GetAuthNonce json.RawMessage `json:"getAuthNonce"`
+}
+
+func (v *getAuthNonceMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *getAuthNonceMutationResponse) __premarshalJSON() (*__premarshalgetAuthNonceMutationResponse, error) {
+	var retval __premarshalgetAuthNonceMutationResponse
+
+	{
+
+		dst := &retval.GetAuthNonce
+		src := v.GetAuthNonce
+		if src != nil {
+			var err error
+			*dst, err = __marshalgetAuthNonceMutationGetAuthNonceGetAuthNoncePayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal getAuthNonceMutationResponse.GetAuthNonce: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// globalFeedQueryGlobalFeedFeedConnection includes the requested fields of the GraphQL type FeedConnection.
+type globalFeedQueryGlobalFeedFeedConnection struct {
+	Edges []*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge `json:"edges"`
+}
+
+// GetEdges returns globalFeedQueryGlobalFeedFeedConnection.Edges, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnection) GetEdges() []*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge {
+	return v.Edges
+}
+
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge includes the requested fields of the GraphQL type FeedEdge.
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge struct {
+	Node *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError `json:"-"`
+}
+
+// GetNode returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) GetNode() *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError {
+	return v.Node
+}
+
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge
+		Node json.RawMessage `json:"node"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Node
+		src := firstPass.Node
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError)
+			err = __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge struct {
+	Node json.RawMessage `json:"node"`
+}
+
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge) __premarshalJSON() (*__premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge, error) {
+	var retval __premarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge
+
+	{
+
+		dst := &retval.Node
+		src := v.Node
+		if src != nil {
+			var err error
+			*dst, err = __marshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetMessage() string {
+	return v.Message
+}
+
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Typename, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Message, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetMessage() string {
+	return v.Message
+}
+
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent struct {
+	Typename *string      `json:"__typename"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetTypename returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetTypename() *string {
+	return v.Typename
+}
+
+// GetDbid returns globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError includes the requested fields of the GraphQL interface FeedEventOrError.
+//
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError is implemented by the following types:
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+// globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+type globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError interface {
+	implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
+func (v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) implementsGraphQLInterfaceglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+}
+
+func __unmarshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FeedEvent":
+		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
+		return json.Unmarshal(b, *v)
+	case "ErrFeedEventNotFound":
+		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrUnknownAction":
+		*v = new(globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing FeedEventOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalglobalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(v *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent:
+		typename = "FeedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction:
+		typename = "ErrUnknownAction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for globalFeedQueryGlobalFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%T"`, v)
+	}
+}
+
+// globalFeedQueryResponse is returned by globalFeedQuery on success.
+type globalFeedQueryResponse struct {
+	GlobalFeed *globalFeedQueryGlobalFeedFeedConnection `json:"globalFeed"`
+}
+
+// GetGlobalFeed returns globalFeedQueryResponse.GlobalFeed, and is useful for accessing the field via an interface.
+func (v *globalFeedQueryResponse) GetGlobalFeed() *globalFeedQueryGlobalFeedFeedConnection {
+	return v.GlobalFeed
+}
+
+// loginMutationLoginErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type loginMutationLoginErrAuthenticationFailed struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns loginMutationLoginErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrAuthenticationFailed) GetTypename() *string { return v.Typename }
+
+// GetMessage returns loginMutationLoginErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrAuthenticationFailed) GetMessage() string { return v.Message }
+
+// loginMutationLoginErrDoesNotOwnRequiredToken includes the requested fields of the GraphQL type ErrDoesNotOwnRequiredToken.
+type loginMutationLoginErrDoesNotOwnRequiredToken struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns loginMutationLoginErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetTypename() *string { return v.Typename }
+
+// GetMessage returns loginMutationLoginErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetMessage() string { return v.Message }
+
+// loginMutationLoginErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type loginMutationLoginErrUserNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns loginMutationLoginErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrUserNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns loginMutationLoginErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginErrUserNotFound) GetMessage() string { return v.Message }
+
+// loginMutationLoginLoginPayload includes the requested fields of the GraphQL type LoginPayload.
+type loginMutationLoginLoginPayload struct {
+	Typename *string                               `json:"__typename"`
+	Viewer   *loginMutationLoginLoginPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns loginMutationLoginLoginPayload.Typename, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayload) GetTypename() *string { return v.Typename }
+
+// GetViewer returns loginMutationLoginLoginPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayload) GetViewer() *loginMutationLoginLoginPayloadViewer {
+	return v.Viewer
+}
+
+// loginMutationLoginLoginPayloadOrError includes the requested fields of the GraphQL interface LoginPayloadOrError.
+//
+// loginMutationLoginLoginPayloadOrError is implemented by the following types:
+// loginMutationLoginLoginPayload
+// loginMutationLoginErrUserNotFound
+// loginMutationLoginErrAuthenticationFailed
+// loginMutationLoginErrDoesNotOwnRequiredToken
+type loginMutationLoginLoginPayloadOrError interface {
+	implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *loginMutationLoginLoginPayload) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginErrUserNotFound) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginErrAuthenticationFailed) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+func (v *loginMutationLoginErrDoesNotOwnRequiredToken) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+}
+
+func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutationLoginLoginPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "LoginPayload":
+		*v = new(loginMutationLoginLoginPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrUserNotFound":
+		*v = new(loginMutationLoginErrUserNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrAuthenticationFailed":
+		*v = new(loginMutationLoginErrAuthenticationFailed)
+		return json.Unmarshal(b, *v)
+	case "ErrDoesNotOwnRequiredToken":
+		*v = new(loginMutationLoginErrDoesNotOwnRequiredToken)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing LoginPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalloginMutationLoginLoginPayloadOrError(v *loginMutationLoginLoginPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *loginMutationLoginLoginPayload:
+		typename = "LoginPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginLoginPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginErrUserNotFound:
+		typename = "ErrUserNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrUserNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrAuthenticationFailed
+		}{typename, v}
+		return json.Marshal(result)
+	case *loginMutationLoginErrDoesNotOwnRequiredToken:
+		typename = "ErrDoesNotOwnRequiredToken"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*loginMutationLoginErrDoesNotOwnRequiredToken
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%T"`, v)
+	}
+}
+
+// loginMutationLoginLoginPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type loginMutationLoginLoginPayloadViewer struct {
+	User *loginMutationLoginLoginPayloadViewerUserGalleryUser `json:"user"`
+}
+
+// GetUser returns loginMutationLoginLoginPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewer) GetUser() *loginMutationLoginLoginPayloadViewerUserGalleryUser {
+	return v.User
+}
+
+// loginMutationLoginLoginPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type loginMutationLoginLoginPayloadViewerUserGalleryUser struct {
+	Username *string      `json:"username"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetUsername returns loginMutationLoginLoginPayloadViewerUserGalleryUser.Username, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewerUserGalleryUser) GetUsername() *string {
+	return v.Username
+}
+
+// GetDbid returns loginMutationLoginLoginPayloadViewerUserGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *loginMutationLoginLoginPayloadViewerUserGalleryUser) GetDbid() persist.DBID { return v.Dbid }
+
+// loginMutationResponse is returned by loginMutation on success.
+type loginMutationResponse struct {
+	Login *loginMutationLoginLoginPayloadOrError `json:"-"`
+}
+
+// GetLogin returns loginMutationResponse.Login, and is useful for accessing the field via an interface.
+func (v *loginMutationResponse) GetLogin() *loginMutationLoginLoginPayloadOrError { return v.Login }
+
+func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*loginMutationResponse
+		Login json.RawMessage `json:"login"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.loginMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.Login
+		src := firstPass.Login
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(loginMutationLoginLoginPayloadOrError)
+			err = __unmarshalloginMutationLoginLoginPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal loginMutationResponse.Login: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalloginMutationResponse struct {
+	Login json.RawMessage `json:"login"`
+}
+
+func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *loginMutationResponse) __premarshalJSON() (*__premarshalloginMutationResponse, error) {
+	var retval __premarshalloginMutationResponse
+
+	{
+
+		dst := &retval.Login
+		src := v.Login
+		if src != nil {
+			var err error
+			*dst, err = __marshalloginMutationLoginLoginPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal loginMutationResponse.Login: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// logoutMutationLogoutLogoutPayload includes the requested fields of the GraphQL type LogoutPayload.
+type logoutMutationLogoutLogoutPayload struct {
+	Viewer *logoutMutationLogoutLogoutPayloadViewer `json:"viewer"`
+}
+
+// GetViewer returns logoutMutationLogoutLogoutPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayload) GetViewer() *logoutMutationLogoutLogoutPayloadViewer {
+	return v.Viewer
+}
+
+// logoutMutationLogoutLogoutPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type logoutMutationLogoutLogoutPayloadViewer struct {
+	User *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser `json:"user"`
+}
+
+// GetUser returns logoutMutationLogoutLogoutPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewer) GetUser() *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser {
+	return v.User
+}
+
+// logoutMutationLogoutLogoutPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type logoutMutationLogoutLogoutPayloadViewerUserGalleryUser struct {
+	Username *string      `json:"username"`
+	Dbid     persist.DBID `json:"dbid"`
+}
+
+// GetUsername returns logoutMutationLogoutLogoutPayloadViewerUserGalleryUser.Username, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser) GetUsername() *string {
+	return v.Username
+}
+
+// GetDbid returns logoutMutationLogoutLogoutPayloadViewerUserGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// logoutMutationResponse is returned by logoutMutation on success.
+type logoutMutationResponse struct {
+	Logout *logoutMutationLogoutLogoutPayload `json:"logout"`
+}
+
+// GetLogout returns logoutMutationResponse.Logout, and is useful for accessing the field via an interface.
+func (v *logoutMutationResponse) GetLogout() *logoutMutationLogoutLogoutPayload { return v.Logout }
+
+// moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload includes the requested fields of the GraphQL type MoveCollectionToGalleryPayload.
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload struct {
+	Typename   *string                                                                                 `json:"__typename"`
+	OldGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery `json:"oldGallery"`
+	NewGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery `json:"newGallery"`
+}
+
+// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.Typename, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetOldGallery returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.OldGallery, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetOldGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery {
+	return v.OldGallery
+}
+
+// GetNewGallery returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.NewGallery, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetNewGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery {
+	return v.NewGallery
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery includes the requested fields of the GraphQL type Gallery.
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery struct {
+	Dbid        persist.DBID                                                                                                   `json:"dbid"`
+	Collections []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection `json:"collections"`
+}
+
+// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery.Dbid, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetCollections returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery.Collections, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery) GetCollections() []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection {
+	return v.Collections
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery includes the requested fields of the GraphQL type Gallery.
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery struct {
+	Dbid        persist.DBID                                                                                                   `json:"dbid"`
+	Collections []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection `json:"collections"`
+}
+
+// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery.Dbid, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetCollections returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery.Collections, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery) GetCollections() []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection {
+	return v.Collections
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError includes the requested fields of the GraphQL interface MoveCollectionToGalleryPayloadOrError.
+//
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError is implemented by the following types:
+// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload
+// moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput
+// moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized
+type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError interface {
+	implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+}
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+}
+func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+}
+
+func __unmarshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(b []byte, v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "MoveCollectionToGalleryPayload":
+		*v = new(moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing MoveCollectionToGalleryPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload:
+		typename = "MoveCollectionToGalleryPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError: "%T"`, v)
+	}
+}
+
+// moveCollectionToGalleryResponse is returned by moveCollectionToGallery on success.
+type moveCollectionToGalleryResponse struct {
+	MoveCollectionToGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError `json:"-"`
+}
+
+// GetMoveCollectionToGallery returns moveCollectionToGalleryResponse.MoveCollectionToGallery, and is useful for accessing the field via an interface.
+func (v *moveCollectionToGalleryResponse) GetMoveCollectionToGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError {
+	return v.MoveCollectionToGallery
+}
+
+func (v *moveCollectionToGalleryResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*moveCollectionToGalleryResponse
+		MoveCollectionToGallery json.RawMessage `json:"moveCollectionToGallery"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.moveCollectionToGalleryResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.MoveCollectionToGallery
+		src := firstPass.MoveCollectionToGallery
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError)
+			err = __unmarshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal moveCollectionToGalleryResponse.MoveCollectionToGallery: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalmoveCollectionToGalleryResponse struct {
+	MoveCollectionToGallery json.RawMessage `json:"moveCollectionToGallery"`
+}
+
+func (v *moveCollectionToGalleryResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *moveCollectionToGalleryResponse) __premarshalJSON() (*__premarshalmoveCollectionToGalleryResponse, error) {
+	var retval __premarshalmoveCollectionToGalleryResponse
+
+	{
+
+		dst := &retval.MoveCollectionToGallery
+		src := v.MoveCollectionToGallery
+		if src != nil {
+			var err error
+			*dst, err = __marshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal moveCollectionToGalleryResponse.MoveCollectionToGallery: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// publishGalleryMutationPublishGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type publishGalleryMutationPublishGalleryErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns publishGalleryMutationPublishGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns publishGalleryMutationPublishGalleryErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryErrInvalidInput) GetMessage() string { return v.Message }
+
+// publishGalleryMutationPublishGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type publishGalleryMutationPublishGalleryErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns publishGalleryMutationPublishGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns publishGalleryMutationPublishGalleryErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) GetMessage() string { return v.Message }
+
+// publishGalleryMutationPublishGalleryPublishGalleryPayload includes the requested fields of the GraphQL type PublishGalleryPayload.
+type publishGalleryMutationPublishGalleryPublishGalleryPayload struct {
+	Typename *string                                                           `json:"__typename"`
+	Gallery  *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery `json:"gallery"`
+}
+
+// GetTypename returns publishGalleryMutationPublishGalleryPublishGalleryPayload.Typename, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetGallery returns publishGalleryMutationPublishGalleryPublishGalleryPayload.Gallery, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) GetGallery() *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery {
+	return v.Gallery
+}
+
+// publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery includes the requested fields of the GraphQL type Gallery.
+type publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery.Dbid, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError includes the requested fields of the GraphQL interface PublishGalleryPayloadOrError.
+//
+// publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError is implemented by the following types:
+// publishGalleryMutationPublishGalleryPublishGalleryPayload
+// publishGalleryMutationPublishGalleryErrInvalidInput
+// publishGalleryMutationPublishGalleryErrNotAuthorized
+type publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError interface {
+	implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+}
+func (v *publishGalleryMutationPublishGalleryErrInvalidInput) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+}
+func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+}
+
+func __unmarshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(b []byte, v *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "PublishGalleryPayload":
+		*v = new(publishGalleryMutationPublishGalleryPublishGalleryPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(publishGalleryMutationPublishGalleryErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(publishGalleryMutationPublishGalleryErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing PublishGalleryPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(v *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *publishGalleryMutationPublishGalleryPublishGalleryPayload:
+		typename = "PublishGalleryPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishGalleryMutationPublishGalleryPublishGalleryPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishGalleryMutationPublishGalleryErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishGalleryMutationPublishGalleryErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *publishGalleryMutationPublishGalleryErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*publishGalleryMutationPublishGalleryErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError: "%T"`, v)
+	}
+}
+
+// publishGalleryMutationResponse is returned by publishGalleryMutation on success.
+type publishGalleryMutationResponse struct {
+	PublishGallery *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError `json:"-"`
+}
+
+// GetPublishGallery returns publishGalleryMutationResponse.PublishGallery, and is useful for accessing the field via an interface.
+func (v *publishGalleryMutationResponse) GetPublishGallery() *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError {
+	return v.PublishGallery
+}
+
+func (v *publishGalleryMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*publishGalleryMutationResponse
+		PublishGallery json.RawMessage `json:"publishGallery"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.publishGalleryMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.PublishGallery
+		src := firstPass.PublishGallery
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError)
+			err = __unmarshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal publishGalleryMutationResponse.PublishGallery: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalpublishGalleryMutationResponse struct {
+	PublishGallery json.RawMessage `json:"publishGallery"`
+}
+
+func (v *publishGalleryMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *publishGalleryMutationResponse) __premarshalJSON() (*__premarshalpublishGalleryMutationResponse, error) {
+	var retval __premarshalpublishGalleryMutationResponse
+
+	{
+
+		dst := &retval.PublishGallery
+		src := v.PublishGallery
+		if src != nil {
+			var err error
+			*dst, err = __marshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal publishGalleryMutationResponse.PublishGallery: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type removeUserWalletsMutationRemoveUserWalletsErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload includes the requested fields of the GraphQL type RemoveUserWalletsPayload.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload struct {
+	Typename *string                                                                   `json:"__typename"`
+	Viewer   *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Typename, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetViewer returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetViewer() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer {
+	return v.Viewer
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError includes the requested fields of the GraphQL interface RemoveUserWalletsPayloadOrError.
+//
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError is implemented by the following types:
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
+// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
+// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError interface {
+	implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+}
+func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+}
+func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+}
+
+func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(b []byte, v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "RemoveUserWalletsPayload":
+		*v = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(removeUserWalletsMutationRemoveUserWalletsErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing RemoveUserWalletsPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload:
+		typename = "RemoveUserWalletsPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%T"`, v)
+	}
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer struct {
+	User *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser `json:"user"`
+}
+
+// GetUser returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer) GetUser() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser {
+	return v.User
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser struct {
+	Wallets []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet `json:"wallets"`
+}
+
+// GetWallets returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser.Wallets, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser) GetWallets() []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet {
+	return v.Wallets
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet includes the requested fields of the GraphQL type Wallet.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet struct {
+	Dbid         persist.DBID                                                                                                      `json:"dbid"`
+	ChainAddress *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress `json:"chainAddress"`
+}
+
+// GetDbid returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet.Dbid, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetChainAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet.ChainAddress, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet) GetChainAddress() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress {
+	return v.ChainAddress
+}
+
+// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress includes the requested fields of the GraphQL type ChainAddress.
+type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress struct {
+	Address *string `json:"address"`
+	Chain   *Chain  `json:"chain"`
+}
+
+// GetAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress.Address, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress) GetAddress() *string {
+	return v.Address
+}
+
+// GetChain returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress.Chain, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress) GetChain() *Chain {
+	return v.Chain
+}
+
+// removeUserWalletsMutationResponse is returned by removeUserWalletsMutation on success.
+type removeUserWalletsMutationResponse struct {
+	RemoveUserWallets *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError `json:"-"`
+}
+
+// GetRemoveUserWallets returns removeUserWalletsMutationResponse.RemoveUserWallets, and is useful for accessing the field via an interface.
+func (v *removeUserWalletsMutationResponse) GetRemoveUserWallets() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError {
+	return v.RemoveUserWallets
+}
+
+func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*removeUserWalletsMutationResponse
+		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.removeUserWalletsMutationResponse = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.RemoveUserWallets
+		src := firstPass.RemoveUserWallets
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
+			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalremoveUserWalletsMutationResponse struct {
+	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+}
+
+func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
+	var retval __premarshalremoveUserWalletsMutationResponse
+
+	{
+
+		dst := &retval.RemoveUserWallets
+		src := v.RemoveUserWallets
+		if src != nil {
+			var err error
+			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// repostFeedEventMutationRepostFeedEventErrAuthenticationFailed includes the requested fields of the GraphQL type ErrAuthenticationFailed.
+type repostFeedEventMutationRepostFeedEventErrAuthenticationFailed struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventErrAuthenticationFailed.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrAuthenticationFailed) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns repostFeedEventMutationRepostFeedEventErrAuthenticationFailed.Message, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrAuthenticationFailed) GetMessage() string {
+	return v.Message
+}
+
+// repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted includes the requested fields of the GraphQL type ErrFeedEventAlreadyReposted.
+type repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted.Message, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted) GetMessage() string {
+	return v.Message
+}
+
+// repostFeedEventMutationRepostFeedEventErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type repostFeedEventMutationRepostFeedEventErrFeedEventNotFound struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventNotFound) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns repostFeedEventMutationRepostFeedEventErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventNotFound) GetMessage() string {
+	return v.Message
+}
+
+// repostFeedEventMutationRepostFeedEventErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type repostFeedEventMutationRepostFeedEventErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns repostFeedEventMutationRepostFeedEventErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventErrInvalidInput) GetMessage() string { return v.Message }
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayload includes the requested fields of the GraphQL type RepostFeedEventPayload.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayload struct {
+	Typename  *string                                                                `json:"__typename"`
+	FeedEvent *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent `json:"feedEvent"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayload.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetFeedEvent returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayload.FeedEvent, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayload) GetFeedEvent() *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent {
+	return v.FeedEvent
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent struct {
+	Dbid      persist.DBID                                                                                 `json:"dbid"`
+	Caption   *string                                                                                      `json:"caption"`
+	EventData *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData `json:"-"`
+}
+
+// GetDbid returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetCaption returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent.Caption, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) GetCaption() *string {
+	return v.Caption
+}
+
+// GetEventData returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent.EventData, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) GetEventData() *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData {
+	return v.EventData
+}
+
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) UnmarshalJSON(b []byte) error {
+
+	if string(b) == "null" {
+		return nil
+	}
+
+	var firstPass struct {
+		*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent
+		EventData json.RawMessage `json:"eventData"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent = v
+
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.EventData
+		src := firstPass.EventData
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData)
+			err = __unmarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent.EventData: %w", err)
+			}
+		}
+	}
+	return nil
+}
+
+type __premarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent struct {
+	Dbid persist.DBID `json:"dbid"`
+
+	Caption *string `json:"caption"`
+
+	EventData json.RawMessage `json:"eventData"`
+}
+
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
+}
+
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent) __premarshalJSON() (*__premarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent, error) {
+	var retval __premarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent
+
+	retval.Dbid = v.Dbid
+	retval.Caption = v.Caption
+	{
+
+		dst := &retval.EventData
+		src := v.EventData
+		if src != nil {
+			var err error
+			*dst, err = __marshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent.EventData: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData includes the requested fields of the GraphQL type CollectionCreatedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData includes the requested fields of the GraphQL type CollectionUpdatedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData includes the requested fields of the GraphQL type CollectorsNoteAddedToCollectionFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData includes the requested fields of the GraphQL type CollectorsNoteAddedToTokenFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData includes the requested fields of the GraphQL interface FeedEventData.
+//
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData is implemented by the following types:
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData interface {
+	implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData() {
+}
+
+func __unmarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData(b []byte, v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "UserCreatedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "UserFollowedUsersFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "CollectorsNoteAddedToTokenFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "CollectionCreatedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "CollectorsNoteAddedToCollectionFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "TokensAddedToCollectionFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "CollectionUpdatedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "GalleryInfoUpdatedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "GalleryUpdatedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "TokensAcquiredFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "RepostedFeedEventData":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing FeedEventData.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData(v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData:
+		typename = "UserCreatedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData:
+		typename = "UserFollowedUsersFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData:
+		typename = "CollectorsNoteAddedToTokenFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToTokenFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData:
+		typename = "CollectionCreatedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionCreatedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData:
+		typename = "CollectorsNoteAddedToCollectionFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectorsNoteAddedToCollectionFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData:
+		typename = "TokensAddedToCollectionFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData:
+		typename = "CollectionUpdatedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataCollectionUpdatedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData:
+		typename = "GalleryInfoUpdatedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData:
+		typename = "GalleryUpdatedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData:
+		typename = "TokensAcquiredFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData:
+		typename = "RepostedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataFeedEventData: "%T"`, v)
+	}
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData includes the requested fields of the GraphQL type GalleryInfoUpdatedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData struct {
 	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
 }
 
-// GetTypename returns loginMutationLoginErrDoesNotOwnRequiredToken.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetTypename() *string { return v.Typename }
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryInfoUpdatedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns loginMutationLoginErrDoesNotOwnRequiredToken.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) GetMessage() string { return v.Message }
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData includes the requested fields of the GraphQL type GalleryUpdatedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
 
-// loginMutationLoginErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
-type loginMutationLoginErrUserNotFound struct {
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataGalleryUpdatedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData includes the requested fields of the GraphQL type RepostedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData struct {
+	Typename      *string                                                                                                                    `json:"__typename"`
+	OriginalEvent *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent `json:"originalEvent"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// GetOriginalEvent returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData.OriginalEvent, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData) GetOriginalEvent() *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent {
+	return v.OriginalEvent
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventDataOriginalEventFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData includes the requested fields of the GraphQL type TokensAcquiredFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData struct {
 	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
 }
 
-// GetTypename returns loginMutationLoginErrUserNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrUserNotFound) GetTypename() *string { return v.Typename }
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAcquiredFeedEventData) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns loginMutationLoginErrUserNotFound.Message, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginErrUserNotFound) GetMessage() string { return v.Message }
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData includes the requested fields of the GraphQL type TokensAddedToCollectionFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
 
-// loginMutationLoginLoginPayload includes the requested fields of the GraphQL type LoginPayload.
-type loginMutationLoginLoginPayload struct {
-	Typename *string                               `json:"__typename"`
-	Viewer   *loginMutationLoginLoginPayloadViewer `json:"viewer"`
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataTokensAddedToCollectionFeedEventData) GetTypename() *string {
+	return v.Typename
 }
 
-// GetTypename returns loginMutationLoginLoginPayload.Typename, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayload) GetTypename() *string { return v.Typename }
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData includes the requested fields of the GraphQL type UserCreatedFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
 
-// GetViewer returns loginMutationLoginLoginPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayload) GetViewer() *loginMutationLoginLoginPayloadViewer {
-	return v.Viewer
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserCreatedFeedEventData) GetTypename() *string {
+	return v.Typename
 }
 
-// loginMutationLoginLoginPayloadOrError includes the requested fields of the GraphQL interface LoginPayloadOrError.
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData includes the requested fields of the GraphQL type UserFollowedUsersFeedEventData.
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataUserFollowedUsersFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError includes the requested fields of the GraphQL interface RepostFeedEventPayloadOrError.
 //
-// loginMutationLoginLoginPayloadOrError is implemented by the following types:
-// loginMutationLoginLoginPayload
-// loginMutationLoginErrUserNotFound
-// loginMutationLoginErrAuthenticationFailed
-// loginMutationLoginErrDoesNotOwnRequiredToken
-type loginMutationLoginLoginPayloadOrError interface {
-	implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError()
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError is implemented by the following types:
+// repostFeedEventMutationRepostFeedEventRepostFeedEventPayload
+// repostFeedEventMutationRepostFeedEventErrAuthenticationFailed
+// repostFeedEventMutationRepostFeedEventErrFeedEventNotFound
+// repostFeedEventMutationRepostFeedEventErrInvalidInput
+// repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted
+type repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError interface {
+	implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *loginMutationLoginLoginPayload) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayload) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError() {
 }
-func (v *loginMutationLoginErrUserNotFound) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *repostFeedEventMutationRepostFeedEventErrAuthenticationFailed) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError() {
 }
-func (v *loginMutationLoginErrAuthenticationFailed) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventNotFound) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError() {
 }
-func (v *loginMutationLoginErrDoesNotOwnRequiredToken) implementsGraphQLInterfaceloginMutationLoginLoginPayloadOrError() {
+func (v *repostFeedEventMutationRepostFeedEventErrInvalidInput) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError() {
+}
+func (v *repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted) implementsGraphQLInterfacerepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError() {
 }
 
-func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutationLoginLoginPayloadOrError) error {
+func __unmarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError(b []byte, v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3345,115 +5564,106 @@ func __unmarshalloginMutationLoginLoginPayloadOrError(b []byte, v *loginMutation
 	}
 
 	switch tn.TypeName {
-	case "LoginPayload":
-		*v = new(loginMutationLoginLoginPayload)
-		return json.Unmarshal(b, *v)
-	case "ErrUserNotFound":
-		*v = new(loginMutationLoginErrUserNotFound)
+	case "RepostFeedEventPayload":
+		*v = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayload)
 		return json.Unmarshal(b, *v)
 	case "ErrAuthenticationFailed":
-		*v = new(loginMutationLoginErrAuthenticationFailed)
+		*v = new(repostFeedEventMutationRepostFeedEventErrAuthenticationFailed)
 		return json.Unmarshal(b, *v)
-	case "ErrDoesNotOwnRequiredToken":
-		*v = new(loginMutationLoginErrDoesNotOwnRequiredToken)
+	case "ErrFeedEventNotFound":
+		*v = new(repostFeedEventMutationRepostFeedEventErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(repostFeedEventMutationRepostFeedEventErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrFeedEventAlreadyReposted":
+		*v = new(repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing LoginPayloadOrError.__typename")
+			"response was missing RepostFeedEventPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalloginMutationLoginLoginPayloadOrError(v *loginMutationLoginLoginPayloadOrError) ([]byte, error) {
+func __marshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError(v *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *loginMutationLoginLoginPayload:
-		typename = "LoginPayload"
+	case *repostFeedEventMutationRepostFeedEventRepostFeedEventPayload:
+		typename = "RepostFeedEventPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginLoginPayload
+			*repostFeedEventMutationRepostFeedEventRepostFeedEventPayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginErrUserNotFound:
-		typename = "ErrUserNotFound"
+	case *repostFeedEventMutationRepostFeedEventErrAuthenticationFailed:
+		typename = "ErrAuthenticationFailed"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrUserNotFound
+			*repostFeedEventMutationRepostFeedEventErrAuthenticationFailed
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginErrAuthenticationFailed:
-		typename = "ErrAuthenticationFailed"
+	case *repostFeedEventMutationRepostFeedEventErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrAuthenticationFailed
+			*repostFeedEventMutationRepostFeedEventErrFeedEventNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *loginMutationLoginErrDoesNotOwnRequiredToken:
-		typename = "ErrDoesNotOwnRequiredToken"
+	case *repostFeedEventMutationRepostFeedEventErrInvalidInput:
+		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*loginMutationLoginErrDoesNotOwnRequiredToken
+			*repostFeedEventMutationRepostFeedEventErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted:
+		typename = "ErrFeedEventAlreadyReposted"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for loginMutationLoginLoginPayloadOrError: "%T"`, v)
+			`unexpected concrete type for repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError: "%T"`, v)
 	}
 }
 
-// loginMutationLoginLoginPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type loginMutationLoginLoginPayloadViewer struct {
-	User *loginMutationLoginLoginPayloadViewerUserGalleryUser `json:"user"`
-}
-
-// GetUser returns loginMutationLoginLoginPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewer) GetUser() *loginMutationLoginLoginPayloadViewerUserGalleryUser {
-	return v.User
-}
-
-// loginMutationLoginLoginPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type loginMutationLoginLoginPayloadViewerUserGalleryUser struct {
-	Username *string      `json:"username"`
-	Dbid     persist.DBID `json:"dbid"`
-}
-
-// GetUsername returns loginMutationLoginLoginPayloadViewerUserGalleryUser.Username, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewerUserGalleryUser) GetUsername() *string {
-	return v.Username
+// repostFeedEventMutationResponse is returned by repostFeedEventMutation on success.
+type repostFeedEventMutationResponse struct {
+	// Shares a feed event with the viewer's followers, with an optional quote. Reposting a repost
+	// shares the original feed event.
+	RepostFeedEvent *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError `json:"-"`
 }
 
-// GetDbid returns loginMutationLoginLoginPayloadViewerUserGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *loginMutationLoginLoginPayloadViewerUserGalleryUser) GetDbid() persist.DBID { return v.Dbid }
-
-// loginMutationResponse is returned by loginMutation on success.
-type loginMutationResponse struct {
-	Login *loginMutationLoginLoginPayloadOrError `json:"-"`
+// GetRepostFeedEvent returns repostFeedEventMutationResponse.RepostFeedEvent, and is useful for accessing the field via an interface.
+func (v *repostFeedEventMutationResponse) GetRepostFeedEvent() *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError {
+	return v.RepostFeedEvent
 }
 
-// GetLogin returns loginMutationResponse.Login, and is useful for accessing the field via an interface.
-func (v *loginMutationResponse) GetLogin() *loginMutationLoginLoginPayloadOrError { return v.Login }
-
-func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *repostFeedEventMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*loginMutationResponse
-		Login json.RawMessage `json:"login"`
+		*repostFeedEventMutationResponse
+		RepostFeedEvent json.RawMessage `json:"repostFeedEvent"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.loginMutationResponse = v
+	firstPass.repostFeedEventMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3461,26 +5671,26 @@ func (v *loginMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.Login
-		src := firstPass.Login
+		dst := &v.RepostFeedEvent
+		src := firstPass.RepostFeedEvent
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(loginMutationLoginLoginPayloadOrError)
-			err = __unmarshalloginMutationLoginLoginPayloadOrError(
+			*dst = new(repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError)
+			err = __unmarshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal loginMutationResponse.Login: %w", err)
+					"Unable to unmarshal repostFeedEventMutationResponse.RepostFeedEvent: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalloginMutationResponse struct {
-	Login json.RawMessage `json:"login"`
+type __premarshalrepostFeedEventMutationResponse struct {
+	RepostFeedEvent json.RawMessage `json:"repostFeedEvent"`
 }
 
-func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *repostFeedEventMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3488,196 +5698,207 @@ func (v *loginMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *loginMutationResponse) __premarshalJSON() (*__premarshalloginMutationResponse, error) {
-	var retval __premarshalloginMutationResponse
+func (v *repostFeedEventMutationResponse) __premarshalJSON() (*__premarshalrepostFeedEventMutationResponse, error) {
+	var retval __premarshalrepostFeedEventMutationResponse
 
 	{
 
-		dst := &retval.Login
-		src := v.Login
+		dst := &retval.RepostFeedEvent
+		src := v.RepostFeedEvent
 		if src != nil {
 			var err error
-			*dst, err = __marshalloginMutationLoginLoginPayloadOrError(
+			*dst, err = __marshalrepostFeedEventMutationRepostFeedEventRepostFeedEventPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal loginMutationResponse.Login: %w", err)
+					"Unable to marshal repostFeedEventMutationResponse.RepostFeedEvent: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// logoutMutationLogoutLogoutPayload includes the requested fields of the GraphQL type LogoutPayload.
-type logoutMutationLogoutLogoutPayload struct {
-	Viewer *logoutMutationLogoutLogoutPayloadViewer `json:"viewer"`
+// setFeedEventHiddenMutationResponse is returned by setFeedEventHiddenMutation on success.
+type setFeedEventHiddenMutationResponse struct {
+	// Hides or unhides one of the viewer's own feed events. Hidden feed events are left out of every feed
+	// except the viewer's own.
+	SetFeedEventHidden *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError `json:"-"`
 }
 
-// GetViewer returns logoutMutationLogoutLogoutPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayload) GetViewer() *logoutMutationLogoutLogoutPayloadViewer {
-	return v.Viewer
+// GetSetFeedEventHidden returns setFeedEventHiddenMutationResponse.SetFeedEventHidden, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationResponse) GetSetFeedEventHidden() *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError {
+	return v.SetFeedEventHidden
 }
 
-// logoutMutationLogoutLogoutPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type logoutMutationLogoutLogoutPayloadViewer struct {
-	User *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser `json:"user"`
-}
+func (v *setFeedEventHiddenMutationResponse) UnmarshalJSON(b []byte) error {
 
-// GetUser returns logoutMutationLogoutLogoutPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewer) GetUser() *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser {
-	return v.User
-}
+	if string(b) == "null" {
+		return nil
+	}
 
-// logoutMutationLogoutLogoutPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type logoutMutationLogoutLogoutPayloadViewerUserGalleryUser struct {
-	Username *string      `json:"username"`
-	Dbid     persist.DBID `json:"dbid"`
-}
+	var firstPass struct {
+		*setFeedEventHiddenMutationResponse
+		SetFeedEventHidden json.RawMessage `json:"setFeedEventHidden"`
+		graphql.NoUnmarshalJSON
+	}
+	firstPass.setFeedEventHiddenMutationResponse = v
 
-// GetUsername returns logoutMutationLogoutLogoutPayloadViewerUserGalleryUser.Username, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser) GetUsername() *string {
-	return v.Username
+	err := json.Unmarshal(b, &firstPass)
+	if err != nil {
+		return err
+	}
+
+	{
+		dst := &v.SetFeedEventHidden
+		src := firstPass.SetFeedEventHidden
+		if len(src) != 0 && string(src) != "null" {
+			*dst = new(setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError)
+			err = __unmarshalsetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError(
+				src, *dst)
+			if err != nil {
+				return fmt.Errorf(
+					"Unable to unmarshal setFeedEventHiddenMutationResponse.SetFeedEventHidden: %w", err)
+			}
+		}
+	}
+	return nil
 }
 
-// GetDbid returns logoutMutationLogoutLogoutPayloadViewerUserGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *logoutMutationLogoutLogoutPayloadViewerUserGalleryUser) GetDbid() persist.DBID {
-	return v.Dbid
+type __premarshalsetFeedEventHiddenMutationResponse struct {
+	SetFeedEventHidden json.RawMessage `json:"setFeedEventHidden"`
 }
 
-// logoutMutationResponse is returned by logoutMutation on success.
-type logoutMutationResponse struct {
-	Logout *logoutMutationLogoutLogoutPayload `json:"logout"`
+func (v *setFeedEventHiddenMutationResponse) MarshalJSON() ([]byte, error) {
+	premarshaled, err := v.__premarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(premarshaled)
 }
 
-// GetLogout returns logoutMutationResponse.Logout, and is useful for accessing the field via an interface.
-func (v *logoutMutationResponse) GetLogout() *logoutMutationLogoutLogoutPayload { return v.Logout }
+func (v *setFeedEventHiddenMutationResponse) __premarshalJSON() (*__premarshalsetFeedEventHiddenMutationResponse, error) {
+	var retval __premarshalsetFeedEventHiddenMutationResponse
 
-// moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput struct {
+	{
+
+		dst := &retval.SetFeedEventHidden
+		src := v.SetFeedEventHidden
+		if src != nil {
+			var err error
+			*dst, err = __marshalsetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError(
+				src)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"Unable to marshal setFeedEventHiddenMutationResponse.SetFeedEventHidden: %w", err)
+			}
+		}
+	}
+	return &retval, nil
+}
+
+// setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) GetTypename() *string {
+// GetTypename returns setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) GetMessage() string {
+// GetMessage returns setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound) GetMessage() string {
 	return v.Message
 }
 
-// moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized struct {
+// setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) GetTypename() *string {
+// GetTypename returns setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) GetMessage() string {
+// GetMessage returns setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput) GetMessage() string {
 	return v.Message
 }
 
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload includes the requested fields of the GraphQL type MoveCollectionToGalleryPayload.
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload struct {
-	Typename   *string                                                                                 `json:"__typename"`
-	OldGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery `json:"oldGallery"`
-	NewGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery `json:"newGallery"`
+// setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.Typename, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetTypename() *string {
+// GetTypename returns setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized) GetTypename() *string {
 	return v.Typename
 }
 
-// GetOldGallery returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.OldGallery, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetOldGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery {
-	return v.OldGallery
-}
-
-// GetNewGallery returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload.NewGallery, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) GetNewGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery {
-	return v.NewGallery
-}
-
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery includes the requested fields of the GraphQL type Gallery.
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery struct {
-	Dbid        persist.DBID                                                                                                   `json:"dbid"`
-	Collections []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection `json:"collections"`
-}
-
-// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery.Dbid, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery) GetDbid() persist.DBID {
-	return v.Dbid
+// GetMessage returns setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized) GetMessage() string {
+	return v.Message
 }
 
-// GetCollections returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery.Collections, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGallery) GetCollections() []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection {
-	return v.Collections
+// setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload includes the requested fields of the GraphQL type SetFeedEventHiddenPayload.
+type setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload struct {
+	Typename  *string                                                                         `json:"__typename"`
+	FeedEvent *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent `json:"feedEvent"`
 }
 
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection struct {
-	Dbid persist.DBID `json:"dbid"`
+// GetTypename returns setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload.Typename, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload) GetTypename() *string {
+	return v.Typename
 }
 
-// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadNewGalleryCollectionsCollection) GetDbid() persist.DBID {
-	return v.Dbid
+// GetFeedEvent returns setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload.FeedEvent, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload) GetFeedEvent() *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent {
+	return v.FeedEvent
 }
 
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery includes the requested fields of the GraphQL type Gallery.
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery struct {
-	Dbid        persist.DBID                                                                                                   `json:"dbid"`
-	Collections []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection `json:"collections"`
+// setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent struct {
+	Dbid   persist.DBID `json:"dbid"`
+	Hidden *bool        `json:"hidden"`
 }
 
-// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery.Dbid, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery) GetDbid() persist.DBID {
+// GetDbid returns setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent) GetDbid() persist.DBID {
 	return v.Dbid
 }
 
-// GetCollections returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery.Collections, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGallery) GetCollections() []*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection {
-	return v.Collections
-}
-
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection struct {
-	Dbid persist.DBID `json:"dbid"`
-}
-
-// GetDbid returns moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOldGalleryCollectionsCollection) GetDbid() persist.DBID {
-	return v.Dbid
+// GetHidden returns setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent.Hidden, and is useful for accessing the field via an interface.
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadFeedEvent) GetHidden() *bool {
+	return v.Hidden
 }
 
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError includes the requested fields of the GraphQL interface MoveCollectionToGalleryPayloadOrError.
+// setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError includes the requested fields of the GraphQL interface SetFeedEventHiddenPayloadOrError.
 //
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError is implemented by the following types:
-// moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload
-// moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput
-// moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized
-type moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError interface {
-	implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError()
+// setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError is implemented by the following types:
+// setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload
+// setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized
+// setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound
+// setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput
+type setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError interface {
+	implementsGraphQLInterfacesetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload) implementsGraphQLInterfacesetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError() {
 }
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized) implementsGraphQLInterfacesetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError() {
 }
-func (v *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized) implementsGraphQLInterfacemoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError() {
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound) implementsGraphQLInterfacesetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError() {
+}
+func (v *setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput) implementsGraphQLInterfacesetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError() {
 }
 
-func __unmarshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(b []byte, v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError) error {
+func __unmarshalsetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError(b []byte, v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3691,82 +5912,93 @@ func __unmarshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGa
 	}
 
 	switch tn.TypeName {
-	case "MoveCollectionToGalleryPayload":
-		*v = new(moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload)
-		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput)
+	case "SetFeedEventHiddenPayload":
+		*v = new(setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized)
+		*v = new(setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrFeedEventNotFound":
+		*v = new(setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing MoveCollectionToGalleryPayloadOrError.__typename")
+			"response was missing SetFeedEventHiddenPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(v *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError) ([]byte, error) {
+func __marshalsetFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError(v *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload:
-		typename = "MoveCollectionToGalleryPayload"
+	case *setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload:
+		typename = "SetFeedEventHiddenPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayload
+			*setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput:
-		typename = "ErrInvalidInput"
+	case *setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized:
+		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*moveCollectionToGalleryMoveCollectionToGalleryErrInvalidInput
+			*setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized:
-		typename = "ErrNotAuthorized"
+	case *setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*moveCollectionToGalleryMoveCollectionToGalleryErrNotAuthorized
+			*setFeedEventHiddenMutationSetFeedEventHiddenErrFeedEventNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*setFeedEventHiddenMutationSetFeedEventHiddenErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError: "%T"`, v)
+			`unexpected concrete type for setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayloadOrError: "%T"`, v)
 	}
 }
 
-// moveCollectionToGalleryResponse is returned by moveCollectionToGallery on success.
-type moveCollectionToGalleryResponse struct {
-	MoveCollectionToGallery *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError `json:"-"`
+// syncTokensMutationResponse is returned by syncTokensMutation on success.
+type syncTokensMutationResponse struct {
+	SyncTokens *syncTokensMutationSyncTokensSyncTokensPayloadOrError `json:"-"`
 }
 
-// GetMoveCollectionToGallery returns moveCollectionToGalleryResponse.MoveCollectionToGallery, and is useful for accessing the field via an interface.
-func (v *moveCollectionToGalleryResponse) GetMoveCollectionToGallery() *moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError {
-	return v.MoveCollectionToGallery
+// GetSyncTokens returns syncTokensMutationResponse.SyncTokens, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationResponse) GetSyncTokens() *syncTokensMutationSyncTokensSyncTokensPayloadOrError {
+	return v.SyncTokens
 }
 
-func (v *moveCollectionToGalleryResponse) UnmarshalJSON(b []byte) error {
+func (v *syncTokensMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*moveCollectionToGalleryResponse
-		MoveCollectionToGallery json.RawMessage `json:"moveCollectionToGallery"`
+		*syncTokensMutationResponse
+		SyncTokens json.RawMessage `json:"syncTokens"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.moveCollectionToGalleryResponse = v
+	firstPass.syncTokensMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3774,26 +6006,26 @@ func (v *moveCollectionToGalleryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.MoveCollectionToGallery
-		src := firstPass.MoveCollectionToGallery
+		dst := &v.SyncTokens
+		src := firstPass.SyncTokens
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(moveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError)
-			err = __unmarshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(
+			*dst = new(syncTokensMutationSyncTokensSyncTokensPayloadOrError)
+			err = __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal moveCollectionToGalleryResponse.MoveCollectionToGallery: %w", err)
+					"Unable to unmarshal syncTokensMutationResponse.SyncTokens: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalmoveCollectionToGalleryResponse struct {
-	MoveCollectionToGallery json.RawMessage `json:"moveCollectionToGallery"`
+type __premarshalsyncTokensMutationResponse struct {
+	SyncTokens json.RawMessage `json:"syncTokens"`
 }
 
-func (v *moveCollectionToGalleryResponse) MarshalJSON() ([]byte, error) {
+func (v *syncTokensMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -3801,100 +6033,84 @@ func (v *moveCollectionToGalleryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *moveCollectionToGalleryResponse) __premarshalJSON() (*__premarshalmoveCollectionToGalleryResponse, error) {
-	var retval __premarshalmoveCollectionToGalleryResponse
+func (v *syncTokensMutationResponse) __premarshalJSON() (*__premarshalsyncTokensMutationResponse, error) {
+	var retval __premarshalsyncTokensMutationResponse
 
 	{
 
-		dst := &retval.MoveCollectionToGallery
-		src := v.MoveCollectionToGallery
+		dst := &retval.SyncTokens
+		src := v.SyncTokens
 		if src != nil {
 			var err error
-			*dst, err = __marshalmoveCollectionToGalleryMoveCollectionToGalleryMoveCollectionToGalleryPayloadOrError(
+			*dst, err = __marshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal moveCollectionToGalleryResponse.MoveCollectionToGallery: %w", err)
+					"Unable to marshal syncTokensMutationResponse.SyncTokens: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// publishGalleryMutationPublishGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type publishGalleryMutationPublishGalleryErrInvalidInput struct {
+// syncTokensMutationSyncTokensErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type syncTokensMutationSyncTokensErrNotAuthorized struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns publishGalleryMutationPublishGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryErrInvalidInput) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns syncTokensMutationSyncTokensErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetTypename() *string { return v.Typename }
 
-// GetMessage returns publishGalleryMutationPublishGalleryErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryErrInvalidInput) GetMessage() string { return v.Message }
+// GetMessage returns syncTokensMutationSyncTokensErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetMessage() string { return v.Message }
 
-// publishGalleryMutationPublishGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type publishGalleryMutationPublishGalleryErrNotAuthorized struct {
+// syncTokensMutationSyncTokensErrSyncFailed includes the requested fields of the GraphQL type ErrSyncFailed.
+type syncTokensMutationSyncTokensErrSyncFailed struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns publishGalleryMutationPublishGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) GetTypename() *string {
-	return v.Typename
-}
-
-// GetMessage returns publishGalleryMutationPublishGalleryErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) GetMessage() string { return v.Message }
-
-// publishGalleryMutationPublishGalleryPublishGalleryPayload includes the requested fields of the GraphQL type PublishGalleryPayload.
-type publishGalleryMutationPublishGalleryPublishGalleryPayload struct {
-	Typename *string                                                           `json:"__typename"`
-	Gallery  *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery `json:"gallery"`
-}
+// GetTypename returns syncTokensMutationSyncTokensErrSyncFailed.Typename, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrSyncFailed) GetTypename() *string { return v.Typename }
 
-// GetTypename returns publishGalleryMutationPublishGalleryPublishGalleryPayload.Typename, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) GetTypename() *string {
-	return v.Typename
-}
+// GetMessage returns syncTokensMutationSyncTokensErrSyncFailed.Message, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensErrSyncFailed) GetMessage() string { return v.Message }
 
-// GetGallery returns publishGalleryMutationPublishGalleryPublishGalleryPayload.Gallery, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) GetGallery() *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery {
-	return v.Gallery
+// syncTokensMutationSyncTokensSyncTokensPayload includes the requested fields of the GraphQL type SyncTokensPayload.
+type syncTokensMutationSyncTokensSyncTokensPayload struct {
+	Typename *string                                              `json:"__typename"`
+	Viewer   *syncTokensMutationSyncTokensSyncTokensPayloadViewer `json:"viewer"`
 }
 
-// publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery includes the requested fields of the GraphQL type Gallery.
-type publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery struct {
-	Dbid persist.DBID `json:"dbid"`
-}
+// GetTypename returns syncTokensMutationSyncTokensSyncTokensPayload.Typename, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayload) GetTypename() *string { return v.Typename }
 
-// GetDbid returns publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery.Dbid, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationPublishGalleryPublishGalleryPayloadGallery) GetDbid() persist.DBID {
-	return v.Dbid
+// GetViewer returns syncTokensMutationSyncTokensSyncTokensPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayload) GetViewer() *syncTokensMutationSyncTokensSyncTokensPayloadViewer {
+	return v.Viewer
 }
-
-// publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError includes the requested fields of the GraphQL interface PublishGalleryPayloadOrError.
-//
-// publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError is implemented by the following types:
-// publishGalleryMutationPublishGalleryPublishGalleryPayload
-// publishGalleryMutationPublishGalleryErrInvalidInput
-// publishGalleryMutationPublishGalleryErrNotAuthorized
-type publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError interface {
-	implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError()
+
+// syncTokensMutationSyncTokensSyncTokensPayloadOrError includes the requested fields of the GraphQL interface SyncTokensPayloadOrError.
+//
+// syncTokensMutationSyncTokensSyncTokensPayloadOrError is implemented by the following types:
+// syncTokensMutationSyncTokensSyncTokensPayload
+// syncTokensMutationSyncTokensErrNotAuthorized
+// syncTokensMutationSyncTokensErrSyncFailed
+type syncTokensMutationSyncTokensSyncTokensPayloadOrError interface {
+	implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *publishGalleryMutationPublishGalleryPublishGalleryPayload) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+func (v *syncTokensMutationSyncTokensSyncTokensPayload) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
 }
-func (v *publishGalleryMutationPublishGalleryErrInvalidInput) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+func (v *syncTokensMutationSyncTokensErrNotAuthorized) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
 }
-func (v *publishGalleryMutationPublishGalleryErrNotAuthorized) implementsGraphQLInterfacepublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError() {
+func (v *syncTokensMutationSyncTokensErrSyncFailed) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
 }
 
-func __unmarshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(b []byte, v *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError) error {
+func __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(b []byte, v *syncTokensMutationSyncTokensSyncTokensPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -3908,82 +6124,144 @@ func __unmarshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError
 	}
 
 	switch tn.TypeName {
-	case "PublishGalleryPayload":
-		*v = new(publishGalleryMutationPublishGalleryPublishGalleryPayload)
-		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(publishGalleryMutationPublishGalleryErrInvalidInput)
+	case "SyncTokensPayload":
+		*v = new(syncTokensMutationSyncTokensSyncTokensPayload)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(publishGalleryMutationPublishGalleryErrNotAuthorized)
+		*v = new(syncTokensMutationSyncTokensErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrSyncFailed":
+		*v = new(syncTokensMutationSyncTokensErrSyncFailed)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing PublishGalleryPayloadOrError.__typename")
+			"response was missing SyncTokensPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for syncTokensMutationSyncTokensSyncTokensPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(v *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError) ([]byte, error) {
+func __marshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(v *syncTokensMutationSyncTokensSyncTokensPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *publishGalleryMutationPublishGalleryPublishGalleryPayload:
-		typename = "PublishGalleryPayload"
+	case *syncTokensMutationSyncTokensSyncTokensPayload:
+		typename = "SyncTokensPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishGalleryMutationPublishGalleryPublishGalleryPayload
+			*syncTokensMutationSyncTokensSyncTokensPayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *publishGalleryMutationPublishGalleryErrInvalidInput:
-		typename = "ErrInvalidInput"
+	case *syncTokensMutationSyncTokensErrNotAuthorized:
+		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishGalleryMutationPublishGalleryErrInvalidInput
+			*syncTokensMutationSyncTokensErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *publishGalleryMutationPublishGalleryErrNotAuthorized:
-		typename = "ErrNotAuthorized"
+	case *syncTokensMutationSyncTokensErrSyncFailed:
+		typename = "ErrSyncFailed"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*publishGalleryMutationPublishGalleryErrNotAuthorized
+			*syncTokensMutationSyncTokensErrSyncFailed
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError: "%T"`, v)
+			`unexpected concrete type for syncTokensMutationSyncTokensSyncTokensPayloadOrError: "%T"`, v)
 	}
 }
 
-// publishGalleryMutationResponse is returned by publishGalleryMutation on success.
-type publishGalleryMutationResponse struct {
-	PublishGallery *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError `json:"-"`
+// syncTokensMutationSyncTokensSyncTokensPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type syncTokensMutationSyncTokensSyncTokensPayloadViewer struct {
+	User *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser `json:"user"`
 }
 
-// GetPublishGallery returns publishGalleryMutationResponse.PublishGallery, and is useful for accessing the field via an interface.
-func (v *publishGalleryMutationResponse) GetPublishGallery() *publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError {
-	return v.PublishGallery
+// GetUser returns syncTokensMutationSyncTokensSyncTokensPayloadViewer.User, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewer) GetUser() *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser {
+	return v.User
 }
 
-func (v *publishGalleryMutationResponse) UnmarshalJSON(b []byte) error {
+// syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser struct {
+	Tokens []*syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken `json:"tokens"`
+}
+
+// GetTokens returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser.Tokens, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser) GetTokens() []*syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken {
+	return v.Tokens
+}
+
+// syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken includes the requested fields of the GraphQL type Token.
+type syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken struct {
+	Chain   *Chain       `json:"chain"`
+	Dbid    persist.DBID `json:"dbid"`
+	TokenId *string      `json:"tokenId"`
+}
+
+// GetChain returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.Chain, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetChain() *Chain {
+	return v.Chain
+}
+
+// GetDbid returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.Dbid, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetTokenId returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.TokenId, and is useful for accessing the field via an interface.
+func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetTokenId() *string {
+	return v.TokenId
+}
+
+// trendingFeedQueryResponse is returned by trendingFeedQuery on success.
+type trendingFeedQueryResponse struct {
+	TrendingFeed *trendingFeedQueryTrendingFeedFeedConnection `json:"trendingFeed"`
+}
+
+// GetTrendingFeed returns trendingFeedQueryResponse.TrendingFeed, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryResponse) GetTrendingFeed() *trendingFeedQueryTrendingFeedFeedConnection {
+	return v.TrendingFeed
+}
+
+// trendingFeedQueryTrendingFeedFeedConnection includes the requested fields of the GraphQL type FeedConnection.
+type trendingFeedQueryTrendingFeedFeedConnection struct {
+	Edges []*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge `json:"edges"`
+}
+
+// GetEdges returns trendingFeedQueryTrendingFeedFeedConnection.Edges, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnection) GetEdges() []*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge {
+	return v.Edges
+}
+
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge includes the requested fields of the GraphQL type FeedEdge.
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge struct {
+	Node *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError `json:"-"`
+}
+
+// GetNode returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) GetNode() *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError {
+	return v.Node
+}
+
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*publishGalleryMutationResponse
-		PublishGallery json.RawMessage `json:"publishGallery"`
+		*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge
+		Node json.RawMessage `json:"node"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.publishGalleryMutationResponse = v
+	firstPass.trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -3991,26 +6269,26 @@ func (v *publishGalleryMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.PublishGallery
-		src := firstPass.PublishGallery
+		dst := &v.Node
+		src := firstPass.Node
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(publishGalleryMutationPublishGalleryPublishGalleryPayloadOrError)
-			err = __unmarshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(
+			*dst = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError)
+			err = __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal publishGalleryMutationResponse.PublishGallery: %w", err)
+					"Unable to unmarshal trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalpublishGalleryMutationResponse struct {
-	PublishGallery json.RawMessage `json:"publishGallery"`
+type __premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge struct {
+	Node json.RawMessage `json:"node"`
 }
 
-func (v *publishGalleryMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4018,94 +6296,94 @@ func (v *publishGalleryMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *publishGalleryMutationResponse) __premarshalJSON() (*__premarshalpublishGalleryMutationResponse, error) {
-	var retval __premarshalpublishGalleryMutationResponse
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) __premarshalJSON() (*__premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge, error) {
+	var retval __premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge
 
 	{
 
-		dst := &retval.PublishGallery
-		src := v.PublishGallery
+		dst := &retval.Node
+		src := v.Node
 		if src != nil {
 			var err error
-			*dst, err = __marshalpublishGalleryMutationPublishGalleryPublishGalleryPayloadOrError(
+			*dst, err = __marshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal publishGalleryMutationResponse.PublishGallery: %w", err)
+					"Unable to marshal trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type removeUserWalletsMutationRemoveUserWalletsErrInvalidInput struct {
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetTypename() *string {
+// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) GetMessage() string {
+// GetMessage returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetMessage() string {
 	return v.Message
 }
 
-// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized struct {
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetTypename() *string {
+// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Typename, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) GetMessage() string {
+// GetMessage returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Message, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetMessage() string {
 	return v.Message
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload includes the requested fields of the GraphQL type RemoveUserWalletsPayload.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload struct {
-	Typename *string                                                                   `json:"__typename"`
-	Viewer   *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer `json:"viewer"`
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent struct {
+	Typename *string      `json:"__typename"`
+	Dbid     persist.DBID `json:"dbid"`
 }
 
-// GetTypename returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Typename, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetTypename() *string {
+// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetTypename() *string {
 	return v.Typename
 }
 
-// GetViewer returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) GetViewer() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer {
-	return v.Viewer
+// GetDbid returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError includes the requested fields of the GraphQL interface RemoveUserWalletsPayloadOrError.
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError includes the requested fields of the GraphQL interface FeedEventOrError.
 //
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError is implemented by the following types:
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
-// removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
-// removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError interface {
-	implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError()
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError is implemented by the following types:
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError interface {
+	implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
-func (v *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
-func (v *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput) implementsGraphQLInterfaceremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError() {
+func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
 
-func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(b []byte, v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) error {
+func __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -4119,134 +6397,82 @@ func __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPaylo
 	}
 
 	switch tn.TypeName {
-	case "RemoveUserWalletsPayload":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload)
+	case "FeedEvent":
+		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
 		return json.Unmarshal(b, *v)
-	case "ErrNotAuthorized":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized)
+	case "ErrFeedEventNotFound":
+		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound)
 		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(removeUserWalletsMutationRemoveUserWalletsErrInvalidInput)
+	case "ErrUnknownAction":
+		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing RemoveUserWalletsPayloadOrError.__typename")
+			"response was missing FeedEventOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError) ([]byte, error) {
+func __marshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload:
-		typename = "RemoveUserWalletsPayload"
+	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent:
+		typename = "FeedEvent"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayload
+			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
 		}{typename, v}
-		return json.Marshal(result)
-	case *removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized:
-		typename = "ErrNotAuthorized"
+		return json.Marshal(result)
+	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsErrNotAuthorized
+			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *removeUserWalletsMutationRemoveUserWalletsErrInvalidInput:
-		typename = "ErrInvalidInput"
+	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction:
+		typename = "ErrUnknownAction"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*removeUserWalletsMutationRemoveUserWalletsErrInvalidInput
+			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError: "%T"`, v)
+			`unexpected concrete type for trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%T"`, v)
 	}
 }
 
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer struct {
-	User *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser `json:"user"`
-}
-
-// GetUser returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewer) GetUser() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser {
-	return v.User
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser struct {
-	Wallets []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet `json:"wallets"`
-}
-
-// GetWallets returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser.Wallets, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUser) GetWallets() []*removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet {
-	return v.Wallets
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet includes the requested fields of the GraphQL type Wallet.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet struct {
-	Dbid         persist.DBID                                                                                                      `json:"dbid"`
-	ChainAddress *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress `json:"chainAddress"`
-}
-
-// GetDbid returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet.Dbid, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet) GetDbid() persist.DBID {
-	return v.Dbid
-}
-
-// GetChainAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet.ChainAddress, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWallet) GetChainAddress() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress {
-	return v.ChainAddress
-}
-
-// removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress includes the requested fields of the GraphQL type ChainAddress.
-type removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress struct {
-	Address *string `json:"address"`
-	Chain   *Chain  `json:"chain"`
-}
-
-// GetAddress returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress.Address, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress) GetAddress() *string {
-	return v.Address
-}
-
-// GetChain returns removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress.Chain, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadViewerUserGalleryUserWalletsWalletChainAddress) GetChain() *Chain {
-	return v.Chain
-}
-
-// removeUserWalletsMutationResponse is returned by removeUserWalletsMutation on success.
-type removeUserWalletsMutationResponse struct {
-	RemoveUserWallets *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError `json:"-"`
+// trendingUsersQueryResponse is returned by trendingUsersQuery on success.
+type trendingUsersQueryResponse struct {
+	TrendingUsers *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError `json:"-"`
 }
 
-// GetRemoveUserWallets returns removeUserWalletsMutationResponse.RemoveUserWallets, and is useful for accessing the field via an interface.
-func (v *removeUserWalletsMutationResponse) GetRemoveUserWallets() *removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError {
-	return v.RemoveUserWallets
+// GetTrendingUsers returns trendingUsersQueryResponse.TrendingUsers, and is useful for accessing the field via an interface.
+func (v *trendingUsersQueryResponse) GetTrendingUsers() *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError {
+	return v.TrendingUsers
 }
 
-func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
+func (v *trendingUsersQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*removeUserWalletsMutationResponse
-		RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+		*trendingUsersQueryResponse
+		TrendingUsers json.RawMessage `json:"trendingUsers"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.removeUserWalletsMutationResponse = v
+	firstPass.trendingUsersQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4254,26 +6480,26 @@ func (v *removeUserWalletsMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.RemoveUserWallets
-		src := firstPass.RemoveUserWallets
+		dst := &v.TrendingUsers
+		src := firstPass.TrendingUsers
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(removeUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError)
-			err = __unmarshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+			*dst = new(trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError)
+			err = __unmarshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+					"Unable to unmarshal trendingUsersQueryResponse.TrendingUsers: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalremoveUserWalletsMutationResponse struct {
-	RemoveUserWallets json.RawMessage `json:"removeUserWallets"`
+type __premarshaltrendingUsersQueryResponse struct {
+	TrendingUsers json.RawMessage `json:"trendingUsers"`
 }
 
-func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *trendingUsersQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4281,48 +6507,135 @@ func (v *removeUserWalletsMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *removeUserWalletsMutationResponse) __premarshalJSON() (*__premarshalremoveUserWalletsMutationResponse, error) {
-	var retval __premarshalremoveUserWalletsMutationResponse
+func (v *trendingUsersQueryResponse) __premarshalJSON() (*__premarshaltrendingUsersQueryResponse, error) {
+	var retval __premarshaltrendingUsersQueryResponse
 
 	{
 
-		dst := &retval.RemoveUserWallets
-		src := v.RemoveUserWallets
+		dst := &retval.TrendingUsers
+		src := v.TrendingUsers
 		if src != nil {
 			var err error
-			*dst, err = __marshalremoveUserWalletsMutationRemoveUserWalletsRemoveUserWalletsPayloadOrError(
+			*dst, err = __marshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal removeUserWalletsMutationResponse.RemoveUserWallets: %w", err)
+					"Unable to marshal trendingUsersQueryResponse.TrendingUsers: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// syncTokensMutationResponse is returned by syncTokensMutation on success.
-type syncTokensMutationResponse struct {
-	SyncTokens *syncTokensMutationSyncTokensSyncTokensPayloadOrError `json:"-"`
+// trendingUsersQueryTrendingUsersTrendingUsersPayload includes the requested fields of the GraphQL type TrendingUsersPayload.
+type trendingUsersQueryTrendingUsersTrendingUsersPayload struct {
+	Typename *string                                                               `json:"__typename"`
+	Users    []trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser `json:"users"`
 }
 
-// GetSyncTokens returns syncTokensMutationResponse.SyncTokens, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationResponse) GetSyncTokens() *syncTokensMutationSyncTokensSyncTokensPayloadOrError {
-	return v.SyncTokens
+// GetTypename returns trendingUsersQueryTrendingUsersTrendingUsersPayload.Typename, and is useful for accessing the field via an interface.
+func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) GetTypename() *string {
+	return v.Typename
 }
 
-func (v *syncTokensMutationResponse) UnmarshalJSON(b []byte) error {
+// GetUsers returns trendingUsersQueryTrendingUsersTrendingUsersPayload.Users, and is useful for accessing the field via an interface.
+func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) GetUsers() []trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser {
+	return v.Users
+}
+
+// trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError includes the requested fields of the GraphQL interface TrendingUsersPayloadOrError.
+//
+// trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError is implemented by the following types:
+// trendingUsersQueryTrendingUsersTrendingUsersPayload
+type trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError interface {
+	implementsGraphQLInterfacetrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) implementsGraphQLInterfacetrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError() {
+}
+
+func __unmarshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(b []byte, v *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "TrendingUsersPayload":
+		*v = new(trendingUsersQueryTrendingUsersTrendingUsersPayload)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing TrendingUsersPayloadOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(v *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *trendingUsersQueryTrendingUsersTrendingUsersPayload:
+		typename = "TrendingUsersPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*trendingUsersQueryTrendingUsersTrendingUsersPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError: "%T"`, v)
+	}
+}
+
+// trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser struct {
+	Dbid persist.DBID `json:"dbid"`
+}
+
+// GetDbid returns trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// updateFeedEventCaptionMutationResponse is returned by updateFeedEventCaptionMutation on success.
+type updateFeedEventCaptionMutationResponse struct {
+	// Changes the caption of one of the viewer's own feed events. Captions can only be changed for a
+	// short while after the event is posted. An empty caption removes it.
+	UpdateFeedEventCaption *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError `json:"-"`
+}
+
+// GetUpdateFeedEventCaption returns updateFeedEventCaptionMutationResponse.UpdateFeedEventCaption, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationResponse) GetUpdateFeedEventCaption() *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError {
+	return v.UpdateFeedEventCaption
+}
+
+func (v *updateFeedEventCaptionMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*syncTokensMutationResponse
-		SyncTokens json.RawMessage `json:"syncTokens"`
+		*updateFeedEventCaptionMutationResponse
+		UpdateFeedEventCaption json.RawMessage `json:"updateFeedEventCaption"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.syncTokensMutationResponse = v
+	firstPass.updateFeedEventCaptionMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4330,26 +6643,26 @@ func (v *syncTokensMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.SyncTokens
-		src := firstPass.SyncTokens
+		dst := &v.UpdateFeedEventCaption
+		src := firstPass.UpdateFeedEventCaption
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(syncTokensMutationSyncTokensSyncTokensPayloadOrError)
-			err = __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(
+			*dst = new(updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError)
+			err = __unmarshalupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal syncTokensMutationResponse.SyncTokens: %w", err)
+					"Unable to unmarshal updateFeedEventCaptionMutationResponse.UpdateFeedEventCaption: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalsyncTokensMutationResponse struct {
-	SyncTokens json.RawMessage `json:"syncTokens"`
+type __premarshalupdateFeedEventCaptionMutationResponse struct {
+	UpdateFeedEventCaption json.RawMessage `json:"updateFeedEventCaption"`
 }
 
-func (v *syncTokensMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *updateFeedEventCaptionMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4357,84 +6670,129 @@ func (v *syncTokensMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *syncTokensMutationResponse) __premarshalJSON() (*__premarshalsyncTokensMutationResponse, error) {
-	var retval __premarshalsyncTokensMutationResponse
+func (v *updateFeedEventCaptionMutationResponse) __premarshalJSON() (*__premarshalupdateFeedEventCaptionMutationResponse, error) {
+	var retval __premarshalupdateFeedEventCaptionMutationResponse
 
 	{
 
-		dst := &retval.SyncTokens
-		src := v.SyncTokens
+		dst := &retval.UpdateFeedEventCaption
+		src := v.UpdateFeedEventCaption
 		if src != nil {
 			var err error
-			*dst, err = __marshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(
+			*dst, err = __marshalupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal syncTokensMutationResponse.SyncTokens: %w", err)
+					"Unable to marshal updateFeedEventCaptionMutationResponse.UpdateFeedEventCaption: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// syncTokensMutationSyncTokensErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type syncTokensMutationSyncTokensErrNotAuthorized struct {
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns syncTokensMutationSyncTokensErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetTypename() *string { return v.Typename }
+// GetTypename returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns syncTokensMutationSyncTokensErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensErrNotAuthorized) GetMessage() string { return v.Message }
+// GetMessage returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound) GetMessage() string {
+	return v.Message
+}
 
-// syncTokensMutationSyncTokensErrSyncFailed includes the requested fields of the GraphQL type ErrSyncFailed.
-type syncTokensMutationSyncTokensErrSyncFailed struct {
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns syncTokensMutationSyncTokensErrSyncFailed.Typename, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensErrSyncFailed) GetTypename() *string { return v.Typename }
+// GetTypename returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput) GetMessage() string {
+	return v.Message
+}
+
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload includes the requested fields of the GraphQL type UpdateFeedEventCaptionPayload.
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload struct {
+	Typename  *string                                                                                     `json:"__typename"`
+	FeedEvent *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent `json:"feedEvent"`
+}
+
+// GetTypename returns updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload.Typename, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload) GetTypename() *string {
+	return v.Typename
+}
 
-// GetMessage returns syncTokensMutationSyncTokensErrSyncFailed.Message, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensErrSyncFailed) GetMessage() string { return v.Message }
+// GetFeedEvent returns updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload.FeedEvent, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload) GetFeedEvent() *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent {
+	return v.FeedEvent
+}
 
-// syncTokensMutationSyncTokensSyncTokensPayload includes the requested fields of the GraphQL type SyncTokensPayload.
-type syncTokensMutationSyncTokensSyncTokensPayload struct {
-	Typename *string                                              `json:"__typename"`
-	Viewer   *syncTokensMutationSyncTokensSyncTokensPayloadViewer `json:"viewer"`
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent struct {
+	Dbid    persist.DBID `json:"dbid"`
+	Caption *string      `json:"caption"`
 }
 
-// GetTypename returns syncTokensMutationSyncTokensSyncTokensPayload.Typename, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayload) GetTypename() *string { return v.Typename }
+// GetDbid returns updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
+}
 
-// GetViewer returns syncTokensMutationSyncTokensSyncTokensPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayload) GetViewer() *syncTokensMutationSyncTokensSyncTokensPayloadViewer {
-	return v.Viewer
+// GetCaption returns updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent.Caption, and is useful for accessing the field via an interface.
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadFeedEvent) GetCaption() *string {
+	return v.Caption
 }
 
-// syncTokensMutationSyncTokensSyncTokensPayloadOrError includes the requested fields of the GraphQL interface SyncTokensPayloadOrError.
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError includes the requested fields of the GraphQL interface UpdateFeedEventCaptionPayloadOrError.
 //
-// syncTokensMutationSyncTokensSyncTokensPayloadOrError is implemented by the following types:
-// syncTokensMutationSyncTokensSyncTokensPayload
-// syncTokensMutationSyncTokensErrNotAuthorized
-// syncTokensMutationSyncTokensErrSyncFailed
-type syncTokensMutationSyncTokensSyncTokensPayloadOrError interface {
-	implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError()
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError is implemented by the following types:
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound
+// updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput
+type updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError interface {
+	implementsGraphQLInterfaceupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *syncTokensMutationSyncTokensSyncTokensPayload) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload) implementsGraphQLInterfaceupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError() {
 }
-func (v *syncTokensMutationSyncTokensErrNotAuthorized) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized) implementsGraphQLInterfaceupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError() {
 }
-func (v *syncTokensMutationSyncTokensErrSyncFailed) implementsGraphQLInterfacesyncTokensMutationSyncTokensSyncTokensPayloadOrError() {
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound) implementsGraphQLInterfaceupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError() {
+}
+func (v *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput) implementsGraphQLInterfaceupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError() {
 }
 
-func __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(b []byte, v *syncTokensMutationSyncTokensSyncTokensPayloadOrError) error {
+func __unmarshalupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError(b []byte, v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -4448,144 +6806,93 @@ func __unmarshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(b []byte, v
 	}
 
 	switch tn.TypeName {
-	case "SyncTokensPayload":
-		*v = new(syncTokensMutationSyncTokensSyncTokensPayload)
+	case "UpdateFeedEventCaptionPayload":
+		*v = new(updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(syncTokensMutationSyncTokensErrNotAuthorized)
+		*v = new(updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized)
 		return json.Unmarshal(b, *v)
-	case "ErrSyncFailed":
-		*v = new(syncTokensMutationSyncTokensErrSyncFailed)
+	case "ErrFeedEventNotFound":
+		*v = new(updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing SyncTokensPayloadOrError.__typename")
+			"response was missing UpdateFeedEventCaptionPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for syncTokensMutationSyncTokensSyncTokensPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalsyncTokensMutationSyncTokensSyncTokensPayloadOrError(v *syncTokensMutationSyncTokensSyncTokensPayloadOrError) ([]byte, error) {
+func __marshalupdateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError(v *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *syncTokensMutationSyncTokensSyncTokensPayload:
-		typename = "SyncTokensPayload"
+	case *updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload:
+		typename = "UpdateFeedEventCaptionPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*syncTokensMutationSyncTokensSyncTokensPayload
+			*updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *syncTokensMutationSyncTokensErrNotAuthorized:
+	case *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized:
 		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*syncTokensMutationSyncTokensErrNotAuthorized
+			*updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
-	case *syncTokensMutationSyncTokensErrSyncFailed:
-		typename = "ErrSyncFailed"
+	case *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*syncTokensMutationSyncTokensErrSyncFailed
+			*updateFeedEventCaptionMutationUpdateFeedEventCaptionErrFeedEventNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updateFeedEventCaptionMutationUpdateFeedEventCaptionErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for syncTokensMutationSyncTokensSyncTokensPayloadOrError: "%T"`, v)
+			`unexpected concrete type for updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayloadOrError: "%T"`, v)
 	}
 }
 
-// syncTokensMutationSyncTokensSyncTokensPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type syncTokensMutationSyncTokensSyncTokensPayloadViewer struct {
-	User *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser `json:"user"`
-}
-
-// GetUser returns syncTokensMutationSyncTokensSyncTokensPayloadViewer.User, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewer) GetUser() *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser {
-	return v.User
-}
-
-// syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser struct {
-	Tokens []*syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken `json:"tokens"`
-}
-
-// GetTokens returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser.Tokens, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUser) GetTokens() []*syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken {
-	return v.Tokens
-}
-
-// syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken includes the requested fields of the GraphQL type Token.
-type syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken struct {
-	Chain   *Chain       `json:"chain"`
-	Dbid    persist.DBID `json:"dbid"`
-	TokenId *string      `json:"tokenId"`
-}
-
-// GetChain returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.Chain, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetChain() *Chain {
-	return v.Chain
-}
-
-// GetDbid returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.Dbid, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetDbid() persist.DBID {
-	return v.Dbid
-}
-
-// GetTokenId returns syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken.TokenId, and is useful for accessing the field via an interface.
-func (v *syncTokensMutationSyncTokensSyncTokensPayloadViewerUserGalleryUserTokensToken) GetTokenId() *string {
-	return v.TokenId
-}
-
-// trendingFeedQueryResponse is returned by trendingFeedQuery on success.
-type trendingFeedQueryResponse struct {
-	TrendingFeed *trendingFeedQueryTrendingFeedFeedConnection `json:"trendingFeed"`
-}
-
-// GetTrendingFeed returns trendingFeedQueryResponse.TrendingFeed, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryResponse) GetTrendingFeed() *trendingFeedQueryTrendingFeedFeedConnection {
-	return v.TrendingFeed
-}
-
-// trendingFeedQueryTrendingFeedFeedConnection includes the requested fields of the GraphQL type FeedConnection.
-type trendingFeedQueryTrendingFeedFeedConnection struct {
-	Edges []*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge `json:"edges"`
-}
-
-// GetEdges returns trendingFeedQueryTrendingFeedFeedConnection.Edges, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnection) GetEdges() []*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge {
-	return v.Edges
-}
-
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge includes the requested fields of the GraphQL type FeedEdge.
-type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge struct {
-	Node *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError `json:"-"`
+// updateGalleryMutationResponse is returned by updateGalleryMutation on success.
+type updateGalleryMutationResponse struct {
+	UpdateGallery *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError `json:"-"`
 }
 
-// GetNode returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) GetNode() *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError {
-	return v.Node
+// GetUpdateGallery returns updateGalleryMutationResponse.UpdateGallery, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationResponse) GetUpdateGallery() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError {
+	return v.UpdateGallery
 }
 
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b []byte) error {
+func (v *updateGalleryMutationResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge
-		Node json.RawMessage `json:"node"`
+		*updateGalleryMutationResponse
+		UpdateGallery json.RawMessage `json:"updateGallery"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge = v
+	firstPass.updateGalleryMutationResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4593,26 +6900,26 @@ func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON
 	}
 
 	{
-		dst := &v.Node
-		src := firstPass.Node
+		dst := &v.UpdateGallery
+		src := firstPass.UpdateGallery
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError)
-			err = __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+			*dst = new(updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError)
+			err = __unmarshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+					"Unable to unmarshal updateGalleryMutationResponse.UpdateGallery: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge struct {
-	Node json.RawMessage `json:"node"`
+type __premarshalupdateGalleryMutationResponse struct {
+	UpdateGallery json.RawMessage `json:"updateGallery"`
 }
 
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]byte, error) {
+func (v *updateGalleryMutationResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4620,94 +6927,148 @@ func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) MarshalJSON()
 	return json.Marshal(premarshaled)
 }
 
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge) __premarshalJSON() (*__premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge, error) {
-	var retval __premarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge
+func (v *updateGalleryMutationResponse) __premarshalJSON() (*__premarshalupdateGalleryMutationResponse, error) {
+	var retval __premarshalupdateGalleryMutationResponse
 
 	{
 
-		dst := &retval.Node
-		src := v.Node
+		dst := &retval.UpdateGallery
+		src := v.UpdateGallery
 		if src != nil {
 			var err error
-			*dst, err = __marshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
+			*dst, err = __marshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
+					"Unable to marshal updateGalleryMutationResponse.UpdateGallery: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
-type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound struct {
+// updateGalleryMutationUpdateGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type updateGalleryMutationUpdateGalleryErrInvalidInput struct {
 	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
 }
 
-// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetTypename() *string {
+// GetTypename returns updateGalleryMutationUpdateGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryErrInvalidInput) GetTypename() *string { return v.Typename }
+
+// updateGalleryMutationUpdateGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type updateGalleryMutationUpdateGalleryErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+}
+
+// GetTypename returns updateGalleryMutationUpdateGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryErrNotAuthorized) GetTypename() *string { return v.Typename }
+
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayload includes the requested fields of the GraphQL type UpdateGalleryPayload.
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayload struct {
+	Typename *string                                                        `json:"__typename"`
+	Gallery  *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery `json:"gallery"`
+}
+
+// GetTypename returns updateGalleryMutationUpdateGalleryUpdateGalleryPayload.Typename, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) GetTypename() *string {
 	return v.Typename
 }
 
-// GetMessage returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Message, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetMessage() string {
-	return v.Message
+// GetGallery returns updateGalleryMutationUpdateGalleryUpdateGalleryPayload.Gallery, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) GetGallery() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery {
+	return v.Gallery
 }
 
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
-type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction struct {
-	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery includes the requested fields of the GraphQL type Gallery.
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery struct {
+	Dbid        persist.DBID                                                                          `json:"dbid"`
+	Name        *string                                                                               `json:"name"`
+	Description *string                                                                               `json:"description"`
+	Collections []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection `json:"collections"`
 }
 
-// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Typename, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetTypename() *string {
-	return v.Typename
+// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Dbid, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetDbid() persist.DBID {
+	return v.Dbid
 }
 
-// GetMessage returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Message, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetMessage() string {
-	return v.Message
+// GetName returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Name, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetName() *string {
+	return v.Name
+}
+
+// GetDescription returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Description, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetDescription() *string {
+	return v.Description
+}
+
+// GetCollections returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Collections, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetCollections() []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection {
+	return v.Collections
+}
+
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection struct {
+	Dbid   persist.DBID                                                                                               `json:"dbid"`
+	Name   *string                                                                                                    `json:"name"`
+	Tokens []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken `json:"tokens"`
+}
+
+// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetDbid() persist.DBID {
+	return v.Dbid
+}
+
+// GetName returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Name, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetName() *string {
+	return v.Name
+}
+
+// GetTokens returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Tokens, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetTokens() []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken {
+	return v.Tokens
+}
+
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken includes the requested fields of the GraphQL type CollectionToken.
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken struct {
+	Token *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken `json:"token"`
 }
 
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent includes the requested fields of the GraphQL type FeedEvent.
-type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent struct {
-	Typename *string      `json:"__typename"`
-	Dbid     persist.DBID `json:"dbid"`
+// GetToken returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken.Token, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken) GetToken() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken {
+	return v.Token
 }
 
-// GetTypename returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Typename, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetTypename() *string {
-	return v.Typename
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken includes the requested fields of the GraphQL type Token.
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken struct {
+	Dbid persist.DBID `json:"dbid"`
 }
 
-// GetDbid returns trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Dbid, and is useful for accessing the field via an interface.
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetDbid() persist.DBID {
+// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken.Dbid, and is useful for accessing the field via an interface.
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken) GetDbid() persist.DBID {
 	return v.Dbid
 }
 
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError includes the requested fields of the GraphQL interface FeedEventOrError.
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError includes the requested fields of the GraphQL interface UpdateGalleryPayloadOrError.
 //
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError is implemented by the following types:
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
-// trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
-type trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError interface {
-	implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError()
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError is implemented by the following types:
+// updateGalleryMutationUpdateGalleryUpdateGalleryPayload
+// updateGalleryMutationUpdateGalleryErrInvalidInput
+// updateGalleryMutationUpdateGalleryErrNotAuthorized
+type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError interface {
+	implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
 }
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+func (v *updateGalleryMutationUpdateGalleryErrInvalidInput) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
 }
-func (v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) implementsGraphQLInterfacetrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
+func (v *updateGalleryMutationUpdateGalleryErrNotAuthorized) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
 }
 
-func __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
+func __unmarshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(b []byte, v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -4721,82 +7082,82 @@ func __unmarshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeed
 	}
 
 	switch tn.TypeName {
-	case "FeedEvent":
-		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
+	case "UpdateGalleryPayload":
+		*v = new(updateGalleryMutationUpdateGalleryUpdateGalleryPayload)
 		return json.Unmarshal(b, *v)
-	case "ErrFeedEventNotFound":
-		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound)
+	case "ErrInvalidInput":
+		*v = new(updateGalleryMutationUpdateGalleryErrInvalidInput)
 		return json.Unmarshal(b, *v)
-	case "ErrUnknownAction":
-		*v = new(trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction)
+	case "ErrNotAuthorized":
+		*v = new(updateGalleryMutationUpdateGalleryErrNotAuthorized)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing FeedEventOrError.__typename")
+			"response was missing UpdateGalleryPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshaltrendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(v *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) ([]byte, error) {
+func __marshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent:
-		typename = "FeedEvent"
+	case *updateGalleryMutationUpdateGalleryUpdateGalleryPayload:
+		typename = "UpdateGalleryPayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+			*updateGalleryMutationUpdateGalleryUpdateGalleryPayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound:
-		typename = "ErrFeedEventNotFound"
+	case *updateGalleryMutationUpdateGalleryErrInvalidInput:
+		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+			*updateGalleryMutationUpdateGalleryErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction:
-		typename = "ErrUnknownAction"
+	case *updateGalleryMutationUpdateGalleryErrNotAuthorized:
+		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+			*updateGalleryMutationUpdateGalleryErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for trendingFeedQueryTrendingFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%T"`, v)
+			`unexpected concrete type for updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError: "%T"`, v)
 	}
 }
 
-// trendingUsersQueryResponse is returned by trendingUsersQuery on success.
-type trendingUsersQueryResponse struct {
-	TrendingUsers *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError `json:"-"`
+// updateSocialAccountDisplayedResponse is returned by updateSocialAccountDisplayed on success.
+type updateSocialAccountDisplayedResponse struct {
+	UpdateSocialAccountDisplayed *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError `json:"-"`
 }
 
-// GetTrendingUsers returns trendingUsersQueryResponse.TrendingUsers, and is useful for accessing the field via an interface.
-func (v *trendingUsersQueryResponse) GetTrendingUsers() *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError {
-	return v.TrendingUsers
+// GetUpdateSocialAccountDisplayed returns updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedResponse) GetUpdateSocialAccountDisplayed() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError {
+	return v.UpdateSocialAccountDisplayed
 }
 
-func (v *trendingUsersQueryResponse) UnmarshalJSON(b []byte) error {
+func (v *updateSocialAccountDisplayedResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*trendingUsersQueryResponse
-		TrendingUsers json.RawMessage `json:"trendingUsers"`
+		*updateSocialAccountDisplayedResponse
+		UpdateSocialAccountDisplayed json.RawMessage `json:"updateSocialAccountDisplayed"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.trendingUsersQueryResponse = v
+	firstPass.updateSocialAccountDisplayedResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4804,26 +7165,26 @@ func (v *trendingUsersQueryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.TrendingUsers
-		src := firstPass.TrendingUsers
+		dst := &v.UpdateSocialAccountDisplayed
+		src := firstPass.UpdateSocialAccountDisplayed
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError)
-			err = __unmarshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(
+			*dst = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError)
+			err = __unmarshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal trendingUsersQueryResponse.TrendingUsers: %w", err)
+					"Unable to unmarshal updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshaltrendingUsersQueryResponse struct {
-	TrendingUsers json.RawMessage `json:"trendingUsers"`
+type __premarshalupdateSocialAccountDisplayedResponse struct {
+	UpdateSocialAccountDisplayed json.RawMessage `json:"updateSocialAccountDisplayed"`
 }
 
-func (v *trendingUsersQueryResponse) MarshalJSON() ([]byte, error) {
+func (v *updateSocialAccountDisplayedResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4831,56 +7192,113 @@ func (v *trendingUsersQueryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *trendingUsersQueryResponse) __premarshalJSON() (*__premarshaltrendingUsersQueryResponse, error) {
-	var retval __premarshaltrendingUsersQueryResponse
+func (v *updateSocialAccountDisplayedResponse) __premarshalJSON() (*__premarshalupdateSocialAccountDisplayedResponse, error) {
+	var retval __premarshalupdateSocialAccountDisplayedResponse
 
 	{
 
-		dst := &retval.TrendingUsers
-		src := v.TrendingUsers
+		dst := &retval.UpdateSocialAccountDisplayed
+		src := v.UpdateSocialAccountDisplayed
 		if src != nil {
 			var err error
-			*dst, err = __marshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(
+			*dst, err = __marshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal trendingUsersQueryResponse.TrendingUsers: %w", err)
+					"Unable to marshal updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// trendingUsersQueryTrendingUsersTrendingUsersPayload includes the requested fields of the GraphQL type TrendingUsersPayload.
-type trendingUsersQueryTrendingUsersTrendingUsersPayload struct {
-	Typename *string                                                               `json:"__typename"`
-	Users    []trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser `json:"users"`
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns trendingUsersQueryTrendingUsersTrendingUsersPayload.Typename, and is useful for accessing the field via an interface.
-func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) GetTypename() *string {
+// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) GetTypename() *string {
 	return v.Typename
 }
 
-// GetUsers returns trendingUsersQueryTrendingUsersTrendingUsersPayload.Users, and is useful for accessing the field via an interface.
-func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) GetUsers() []trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser {
-	return v.Users
+// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) GetMessage() string {
+	return v.Message
 }
 
-// trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError includes the requested fields of the GraphQL interface TrendingUsersPayloadOrError.
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial includes the requested fields of the GraphQL type ErrNeedsToReconnectSocial.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial.Typename, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial.Message, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) GetMessage() string {
+	return v.Message
+}
+
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
+}
+
+// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) GetTypename() *string {
+	return v.Typename
+}
+
+// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) GetMessage() string {
+	return v.Message
+}
+
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload includes the requested fields of the GraphQL type UpdateSocialAccountDisplayedPayload.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload struct {
+	Typename *string                                                                                            `json:"__typename"`
+	Viewer   *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer `json:"viewer"`
+}
+
+// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload.Typename, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) GetTypename() *string {
+	return v.Typename
+}
+
+// GetViewer returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload.Viewer, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) GetViewer() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer {
+	return v.Viewer
+}
+
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError includes the requested fields of the GraphQL interface UpdateSocialAccountDisplayedPayloadOrError.
 //
-// trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError is implemented by the following types:
-// trendingUsersQueryTrendingUsersTrendingUsersPayload
-type trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError interface {
-	implementsGraphQLInterfacetrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError()
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError is implemented by the following types:
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError interface {
+	implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *trendingUsersQueryTrendingUsersTrendingUsersPayload) implementsGraphQLInterfacetrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError() {
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+}
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+}
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+}
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
 }
 
-func __unmarshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(b []byte, v *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError) error {
+func __unmarshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(b []byte, v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -4894,70 +7312,129 @@ func __unmarshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(b []b
 	}
 
 	switch tn.TypeName {
-	case "TrendingUsersPayload":
-		*v = new(trendingUsersQueryTrendingUsersTrendingUsersPayload)
+	case "UpdateSocialAccountDisplayedPayload":
+		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload)
+		return json.Unmarshal(b, *v)
+	case "ErrInvalidInput":
+		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput)
+		return json.Unmarshal(b, *v)
+	case "ErrNotAuthorized":
+		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized)
+		return json.Unmarshal(b, *v)
+	case "ErrNeedsToReconnectSocial":
+		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing TrendingUsersPayloadOrError.__typename")
+			"response was missing UpdateSocialAccountDisplayedPayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshaltrendingUsersQueryTrendingUsersTrendingUsersPayloadOrError(v *trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError) ([]byte, error) {
+func __marshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *trendingUsersQueryTrendingUsersTrendingUsersPayload:
-		typename = "TrendingUsersPayload"
+	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload:
+		typename = "UpdateSocialAccountDisplayedPayload"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload
+		}{typename, v}
+		return json.Marshal(result)
+	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput:
+		typename = "ErrInvalidInput"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized:
+		typename = "ErrNotAuthorized"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized
+		}{typename, v}
+		return json.Marshal(result)
+	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial:
+		typename = "ErrNeedsToReconnectSocial"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*trendingUsersQueryTrendingUsersTrendingUsersPayload
+			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for trendingUsersQueryTrendingUsersTrendingUsersPayloadOrError: "%T"`, v)
+			`unexpected concrete type for updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError: "%T"`, v)
 	}
 }
 
-// trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser struct {
-	Dbid persist.DBID `json:"dbid"`
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer includes the requested fields of the GraphQL type Viewer.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer struct {
+	SocialAccounts *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts `json:"socialAccounts"`
 }
 
-// GetDbid returns trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *trendingUsersQueryTrendingUsersTrendingUsersPayloadUsersGalleryUser) GetDbid() persist.DBID {
-	return v.Dbid
+// GetSocialAccounts returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer.SocialAccounts, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer) GetSocialAccounts() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts {
+	return v.SocialAccounts
 }
 
-// updateGalleryMutationResponse is returned by updateGalleryMutation on success.
-type updateGalleryMutationResponse struct {
-	UpdateGallery *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError `json:"-"`
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts includes the requested fields of the GraphQL type SocialAccounts.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts struct {
+	Twitter *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount `json:"twitter"`
 }
 
-// GetUpdateGallery returns updateGalleryMutationResponse.UpdateGallery, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationResponse) GetUpdateGallery() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError {
-	return v.UpdateGallery
+// GetTwitter returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts.Twitter, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts) GetTwitter() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount {
+	return v.Twitter
 }
 
-func (v *updateGalleryMutationResponse) UnmarshalJSON(b []byte) error {
+// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount includes the requested fields of the GraphQL type TwitterSocialAccount.
+type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount struct {
+	Username string `json:"username"`
+	Display  bool   `json:"display"`
+}
+
+// GetUsername returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount.Username, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount) GetUsername() string {
+	return v.Username
+}
+
+// GetDisplay returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount.Display, and is useful for accessing the field via an interface.
+func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount) GetDisplay() bool {
+	return v.Display
+}
+
+// updateUserExperienceResponse is returned by updateUserExperience on success.
+type updateUserExperienceResponse struct {
+	UpdateUserExperience *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError `json:"-"`
+}
+
+// GetUpdateUserExperience returns updateUserExperienceResponse.UpdateUserExperience, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceResponse) GetUpdateUserExperience() *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError {
+	return v.UpdateUserExperience
+}
+
+func (v *updateUserExperienceResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateGalleryMutationResponse
-		UpdateGallery json.RawMessage `json:"updateGallery"`
+		*updateUserExperienceResponse
+		UpdateUserExperience json.RawMessage `json:"updateUserExperience"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateGalleryMutationResponse = v
+	firstPass.updateUserExperienceResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -4965,26 +7442,26 @@ func (v *updateGalleryMutationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.UpdateGallery
-		src := firstPass.UpdateGallery
+		dst := &v.UpdateUserExperience
+		src := firstPass.UpdateUserExperience
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError)
-			err = __unmarshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(
+			*dst = new(updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError)
+			err = __unmarshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal updateGalleryMutationResponse.UpdateGallery: %w", err)
+					"Unable to unmarshal updateUserExperienceResponse.UpdateUserExperience: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalupdateGalleryMutationResponse struct {
-	UpdateGallery json.RawMessage `json:"updateGallery"`
+type __premarshalupdateUserExperienceResponse struct {
+	UpdateUserExperience json.RawMessage `json:"updateUserExperience"`
 }
 
-func (v *updateGalleryMutationResponse) MarshalJSON() ([]byte, error) {
+func (v *updateUserExperienceResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -4992,148 +7469,94 @@ func (v *updateGalleryMutationResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateGalleryMutationResponse) __premarshalJSON() (*__premarshalupdateGalleryMutationResponse, error) {
-	var retval __premarshalupdateGalleryMutationResponse
+func (v *updateUserExperienceResponse) __premarshalJSON() (*__premarshalupdateUserExperienceResponse, error) {
+	var retval __premarshalupdateUserExperienceResponse
 
 	{
 
-		dst := &retval.UpdateGallery
-		src := v.UpdateGallery
+		dst := &retval.UpdateUserExperience
+		src := v.UpdateUserExperience
 		if src != nil {
 			var err error
-			*dst, err = __marshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(
+			*dst, err = __marshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal updateGalleryMutationResponse.UpdateGallery: %w", err)
+					"Unable to marshal updateUserExperienceResponse.UpdateUserExperience: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// updateGalleryMutationUpdateGalleryErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type updateGalleryMutationUpdateGalleryErrInvalidInput struct {
-	Typename *string `json:"__typename"`
-}
-
-// GetTypename returns updateGalleryMutationUpdateGalleryErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryErrInvalidInput) GetTypename() *string { return v.Typename }
-
-// updateGalleryMutationUpdateGalleryErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type updateGalleryMutationUpdateGalleryErrNotAuthorized struct {
+// updateUserExperienceUpdateUserExperienceErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type updateUserExperienceUpdateUserExperienceErrInvalidInput struct {
 	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTypename returns updateGalleryMutationUpdateGalleryErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryErrNotAuthorized) GetTypename() *string { return v.Typename }
-
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayload includes the requested fields of the GraphQL type UpdateGalleryPayload.
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayload struct {
-	Typename *string                                                        `json:"__typename"`
-	Gallery  *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery `json:"gallery"`
-}
-
-// GetTypename returns updateGalleryMutationUpdateGalleryUpdateGalleryPayload.Typename, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) GetTypename() *string {
+// GetTypename returns updateUserExperienceUpdateUserExperienceErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) GetTypename() *string {
 	return v.Typename
 }
 
-// GetGallery returns updateGalleryMutationUpdateGalleryUpdateGalleryPayload.Gallery, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) GetGallery() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery {
-	return v.Gallery
-}
-
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery includes the requested fields of the GraphQL type Gallery.
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery struct {
-	Dbid        persist.DBID                                                                          `json:"dbid"`
-	Name        *string                                                                               `json:"name"`
-	Description *string                                                                               `json:"description"`
-	Collections []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection `json:"collections"`
-}
-
-// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Dbid, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetDbid() persist.DBID {
-	return v.Dbid
-}
-
-// GetName returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Name, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetName() *string {
-	return v.Name
-}
-
-// GetDescription returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Description, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetDescription() *string {
-	return v.Description
-}
-
-// GetCollections returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery.Collections, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGallery) GetCollections() []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection {
-	return v.Collections
-}
-
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection includes the requested fields of the GraphQL type Collection.
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection struct {
-	Dbid   persist.DBID                                                                                               `json:"dbid"`
-	Name   *string                                                                                                    `json:"name"`
-	Tokens []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken `json:"tokens"`
-}
-
-// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Dbid, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetDbid() persist.DBID {
-	return v.Dbid
+// GetMessage returns updateUserExperienceUpdateUserExperienceErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) GetMessage() string {
+	return v.Message
 }
 
-// GetName returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Name, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetName() *string {
-	return v.Name
+// updateUserExperienceUpdateUserExperienceErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
+type updateUserExperienceUpdateUserExperienceErrNotAuthorized struct {
+	Typename *string `json:"__typename"`
+	Message  string  `json:"message"`
 }
 
-// GetTokens returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection.Tokens, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollection) GetTokens() []*updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken {
-	return v.Tokens
+// GetTypename returns updateUserExperienceUpdateUserExperienceErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) GetTypename() *string {
+	return v.Typename
 }
 
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken includes the requested fields of the GraphQL type CollectionToken.
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken struct {
-	Token *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken `json:"token"`
+// GetMessage returns updateUserExperienceUpdateUserExperienceErrNotAuthorized.Message, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) GetMessage() string {
+	return v.Message
 }
 
-// GetToken returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken.Token, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionToken) GetToken() *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken {
-	return v.Token
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload includes the requested fields of the GraphQL type UpdateUserExperiencePayload.
+type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload struct {
+	Typename *string                                                                    `json:"__typename"`
+	Viewer   *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer `json:"viewer"`
 }
 
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken includes the requested fields of the GraphQL type Token.
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken struct {
-	Dbid persist.DBID `json:"dbid"`
+// GetTypename returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload.Typename, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) GetTypename() *string {
+	return v.Typename
 }
 
-// GetDbid returns updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken.Dbid, and is useful for accessing the field via an interface.
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadGalleryCollectionsCollectionTokensCollectionTokenToken) GetDbid() persist.DBID {
-	return v.Dbid
+// GetViewer returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload.Viewer, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) GetViewer() *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer {
+	return v.Viewer
 }
 
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError includes the requested fields of the GraphQL interface UpdateGalleryPayloadOrError.
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError includes the requested fields of the GraphQL interface UpdateUserExperiencePayloadOrError.
 //
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError is implemented by the following types:
-// updateGalleryMutationUpdateGalleryUpdateGalleryPayload
-// updateGalleryMutationUpdateGalleryErrInvalidInput
-// updateGalleryMutationUpdateGalleryErrNotAuthorized
-type updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError interface {
-	implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError()
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError is implemented by the following types:
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload
+// updateUserExperienceUpdateUserExperienceErrInvalidInput
+// updateUserExperienceUpdateUserExperienceErrNotAuthorized
+type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError interface {
+	implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *updateGalleryMutationUpdateGalleryUpdateGalleryPayload) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
 }
-func (v *updateGalleryMutationUpdateGalleryErrInvalidInput) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
+func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
 }
-func (v *updateGalleryMutationUpdateGalleryErrNotAuthorized) implementsGraphQLInterfaceupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError() {
+func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
 }
 
-func __unmarshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(b []byte, v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError) error {
+func __unmarshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(b []byte, v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -5147,82 +7570,108 @@ func __unmarshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(b
 	}
 
 	switch tn.TypeName {
-	case "UpdateGalleryPayload":
-		*v = new(updateGalleryMutationUpdateGalleryUpdateGalleryPayload)
+	case "UpdateUserExperiencePayload":
+		*v = new(updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload)
 		return json.Unmarshal(b, *v)
 	case "ErrInvalidInput":
-		*v = new(updateGalleryMutationUpdateGalleryErrInvalidInput)
+		*v = new(updateUserExperienceUpdateUserExperienceErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "ErrNotAuthorized":
-		*v = new(updateGalleryMutationUpdateGalleryErrNotAuthorized)
+		*v = new(updateUserExperienceUpdateUserExperienceErrNotAuthorized)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing UpdateGalleryPayloadOrError.__typename")
+			"response was missing UpdateUserExperiencePayloadOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalupdateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError(v *updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError) ([]byte, error) {
+func __marshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *updateGalleryMutationUpdateGalleryUpdateGalleryPayload:
-		typename = "UpdateGalleryPayload"
+	case *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload:
+		typename = "UpdateUserExperiencePayload"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateGalleryMutationUpdateGalleryUpdateGalleryPayload
+			*updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload
 		}{typename, v}
 		return json.Marshal(result)
-	case *updateGalleryMutationUpdateGalleryErrInvalidInput:
+	case *updateUserExperienceUpdateUserExperienceErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateGalleryMutationUpdateGalleryErrInvalidInput
+			*updateUserExperienceUpdateUserExperienceErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
-	case *updateGalleryMutationUpdateGalleryErrNotAuthorized:
+	case *updateUserExperienceUpdateUserExperienceErrNotAuthorized:
 		typename = "ErrNotAuthorized"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateGalleryMutationUpdateGalleryErrNotAuthorized
+			*updateUserExperienceUpdateUserExperienceErrNotAuthorized
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for updateGalleryMutationUpdateGalleryUpdateGalleryPayloadOrError: "%T"`, v)
+			`unexpected concrete type for updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError: "%T"`, v)
 	}
 }
 
-// updateSocialAccountDisplayedResponse is returned by updateSocialAccountDisplayed on success.
-type updateSocialAccountDisplayedResponse struct {
-	UpdateSocialAccountDisplayed *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError `json:"-"`
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer includes the requested fields of the GraphQL type Viewer.
+type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer struct {
+	UserExperiences []updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience `json:"userExperiences"`
 }
 
-// GetUpdateSocialAccountDisplayed returns updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedResponse) GetUpdateSocialAccountDisplayed() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError {
-	return v.UpdateSocialAccountDisplayed
+// GetUserExperiences returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer.UserExperiences, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer) GetUserExperiences() []updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience {
+	return v.UserExperiences
 }
 
-func (v *updateSocialAccountDisplayedResponse) UnmarshalJSON(b []byte) error {
+// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience includes the requested fields of the GraphQL type UserExperience.
+type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience struct {
+	Type        UserExperienceType `json:"type"`
+	Experienced bool               `json:"experienced"`
+}
+
+// GetType returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience.Type, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience) GetType() UserExperienceType {
+	return v.Type
+}
+
+// GetExperienced returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience.Experienced, and is useful for accessing the field via an interface.
+func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience) GetExperienced() bool {
+	return v.Experienced
+}
+
+// userByAddressQueryResponse is returned by userByAddressQuery on success.
+type userByAddressQueryResponse struct {
+	UserByAddress *userByAddressQueryUserByAddressUserByAddressOrError `json:"-"`
+}
+
+// GetUserByAddress returns userByAddressQueryResponse.UserByAddress, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryResponse) GetUserByAddress() *userByAddressQueryUserByAddressUserByAddressOrError {
+	return v.UserByAddress
+}
+
+func (v *userByAddressQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateSocialAccountDisplayedResponse
-		UpdateSocialAccountDisplayed json.RawMessage `json:"updateSocialAccountDisplayed"`
+		*userByAddressQueryResponse
+		UserByAddress json.RawMessage `json:"userByAddress"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateSocialAccountDisplayedResponse = v
+	firstPass.userByAddressQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -5230,26 +7679,26 @@ func (v *updateSocialAccountDisplayedResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.UpdateSocialAccountDisplayed
-		src := firstPass.UpdateSocialAccountDisplayed
+		dst := &v.UserByAddress
+		src := firstPass.UserByAddress
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError)
-			err = __unmarshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(
+			*dst = new(userByAddressQueryUserByAddressUserByAddressOrError)
+			err = __unmarshaluserByAddressQueryUserByAddressUserByAddressOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed: %w", err)
+					"Unable to unmarshal userByAddressQueryResponse.UserByAddress: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalupdateSocialAccountDisplayedResponse struct {
-	UpdateSocialAccountDisplayed json.RawMessage `json:"updateSocialAccountDisplayed"`
+type __premarshaluserByAddressQueryResponse struct {
+	UserByAddress json.RawMessage `json:"userByAddress"`
 }
 
-func (v *updateSocialAccountDisplayedResponse) MarshalJSON() ([]byte, error) {
+func (v *userByAddressQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -5257,113 +7706,86 @@ func (v *updateSocialAccountDisplayedResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateSocialAccountDisplayedResponse) __premarshalJSON() (*__premarshalupdateSocialAccountDisplayedResponse, error) {
-	var retval __premarshalupdateSocialAccountDisplayedResponse
+func (v *userByAddressQueryResponse) __premarshalJSON() (*__premarshaluserByAddressQueryResponse, error) {
+	var retval __premarshaluserByAddressQueryResponse
 
 	{
 
-		dst := &retval.UpdateSocialAccountDisplayed
-		src := v.UpdateSocialAccountDisplayed
+		dst := &retval.UserByAddress
+		src := v.UserByAddress
 		if src != nil {
 			var err error
-			*dst, err = __marshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(
+			*dst, err = __marshaluserByAddressQueryUserByAddressUserByAddressOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal updateSocialAccountDisplayedResponse.UpdateSocialAccountDisplayed: %w", err)
+					"Unable to marshal userByAddressQueryResponse.UserByAddress: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput struct {
+// userByAddressQueryUserByAddressErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type userByAddressQueryUserByAddressErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns userByAddressQueryUserByAddressErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressErrInvalidInput) GetTypename() *string { return v.Typename }
 
-// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) GetMessage() string {
-	return v.Message
-}
+// GetMessage returns userByAddressQueryUserByAddressErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressErrInvalidInput) GetMessage() string { return v.Message }
 
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial includes the requested fields of the GraphQL type ErrNeedsToReconnectSocial.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial struct {
+// userByAddressQueryUserByAddressErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type userByAddressQueryUserByAddressErrUserNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial.Typename, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) GetTypename() *string {
-	return v.Typename
-}
-
-// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial.Message, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) GetMessage() string {
-	return v.Message
-}
-
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized struct {
-	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
-}
+// GetTypename returns userByAddressQueryUserByAddressErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressErrUserNotFound) GetTypename() *string { return v.Typename }
 
-// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) GetTypename() *string {
-	return v.Typename
-}
+// GetMessage returns userByAddressQueryUserByAddressErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressErrUserNotFound) GetMessage() string { return v.Message }
 
-// GetMessage returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) GetMessage() string {
-	return v.Message
+// userByAddressQueryUserByAddressGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type userByAddressQueryUserByAddressGalleryUser struct {
+	Typename *string      `json:"__typename"`
+	Username *string      `json:"username"`
+	Dbid     persist.DBID `json:"dbid"`
 }
 
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload includes the requested fields of the GraphQL type UpdateSocialAccountDisplayedPayload.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload struct {
-	Typename *string                                                                                            `json:"__typename"`
-	Viewer   *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer `json:"viewer"`
-}
+// GetTypename returns userByAddressQueryUserByAddressGalleryUser.Typename, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressGalleryUser) GetTypename() *string { return v.Typename }
 
-// GetTypename returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload.Typename, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) GetTypename() *string {
-	return v.Typename
-}
+// GetUsername returns userByAddressQueryUserByAddressGalleryUser.Username, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressGalleryUser) GetUsername() *string { return v.Username }
 
-// GetViewer returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload.Viewer, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) GetViewer() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer {
-	return v.Viewer
-}
+// GetDbid returns userByAddressQueryUserByAddressGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *userByAddressQueryUserByAddressGalleryUser) GetDbid() persist.DBID { return v.Dbid }
 
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError includes the requested fields of the GraphQL interface UpdateSocialAccountDisplayedPayloadOrError.
+// userByAddressQueryUserByAddressUserByAddressOrError includes the requested fields of the GraphQL interface UserByAddressOrError.
 //
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError is implemented by the following types:
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError interface {
-	implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError()
+// userByAddressQueryUserByAddressUserByAddressOrError is implemented by the following types:
+// userByAddressQueryUserByAddressGalleryUser
+// userByAddressQueryUserByAddressErrUserNotFound
+// userByAddressQueryUserByAddressErrInvalidInput
+type userByAddressQueryUserByAddressUserByAddressOrError interface {
+	implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
-}
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+func (v *userByAddressQueryUserByAddressGalleryUser) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
 }
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+func (v *userByAddressQueryUserByAddressErrUserNotFound) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
 }
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial) implementsGraphQLInterfaceupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError() {
+func (v *userByAddressQueryUserByAddressErrInvalidInput) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
 }
 
-func __unmarshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(b []byte, v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError) error {
+func __unmarshaluserByAddressQueryUserByAddressUserByAddressOrError(b []byte, v *userByAddressQueryUserByAddressUserByAddressOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -5377,129 +7799,82 @@ func __unmarshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSo
 	}
 
 	switch tn.TypeName {
-	case "UpdateSocialAccountDisplayedPayload":
-		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload)
-		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput)
+	case "GalleryUser":
+		*v = new(userByAddressQueryUserByAddressGalleryUser)
 		return json.Unmarshal(b, *v)
-	case "ErrNotAuthorized":
-		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized)
+	case "ErrUserNotFound":
+		*v = new(userByAddressQueryUserByAddressErrUserNotFound)
 		return json.Unmarshal(b, *v)
-	case "ErrNeedsToReconnectSocial":
-		*v = new(updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial)
+	case "ErrInvalidInput":
+		*v = new(userByAddressQueryUserByAddressErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing UpdateSocialAccountDisplayedPayloadOrError.__typename")
+			"response was missing UserByAddressOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for userByAddressQueryUserByAddressUserByAddressOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalupdateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError(v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError) ([]byte, error) {
+func __marshaluserByAddressQueryUserByAddressUserByAddressOrError(v *userByAddressQueryUserByAddressUserByAddressOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload:
-		typename = "UpdateSocialAccountDisplayedPayload"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayload
-		}{typename, v}
-		return json.Marshal(result)
-	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput:
-		typename = "ErrInvalidInput"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrInvalidInput
-		}{typename, v}
-		return json.Marshal(result)
-	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized:
-		typename = "ErrNotAuthorized"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNotAuthorized
-		}{typename, v}
-		return json.Marshal(result)
-	case *updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial:
-		typename = "ErrNeedsToReconnectSocial"
+	case *userByAddressQueryUserByAddressGalleryUser:
+		typename = "GalleryUser"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateSocialAccountDisplayedUpdateSocialAccountDisplayedErrNeedsToReconnectSocial
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadOrError: "%T"`, v)
-	}
-}
-
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer includes the requested fields of the GraphQL type Viewer.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer struct {
-	SocialAccounts *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts `json:"socialAccounts"`
-}
-
-// GetSocialAccounts returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer.SocialAccounts, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewer) GetSocialAccounts() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts {
-	return v.SocialAccounts
-}
-
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts includes the requested fields of the GraphQL type SocialAccounts.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts struct {
-	Twitter *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount `json:"twitter"`
-}
-
-// GetTwitter returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts.Twitter, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccounts) GetTwitter() *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount {
-	return v.Twitter
-}
-
-// updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount includes the requested fields of the GraphQL type TwitterSocialAccount.
-type updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount struct {
-	Username string `json:"username"`
-	Display  bool   `json:"display"`
-}
+			*userByAddressQueryUserByAddressGalleryUser
+		}{typename, v}
+		return json.Marshal(result)
+	case *userByAddressQueryUserByAddressErrUserNotFound:
+		typename = "ErrUserNotFound"
 
-// GetUsername returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount.Username, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount) GetUsername() string {
-	return v.Username
-}
+		result := struct {
+			TypeName string `json:"__typename"`
+			*userByAddressQueryUserByAddressErrUserNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *userByAddressQueryUserByAddressErrInvalidInput:
+		typename = "ErrInvalidInput"
 
-// GetDisplay returns updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount.Display, and is useful for accessing the field via an interface.
-func (v *updateSocialAccountDisplayedUpdateSocialAccountDisplayedUpdateSocialAccountDisplayedPayloadViewerSocialAccountsTwitterTwitterSocialAccount) GetDisplay() bool {
-	return v.Display
+		result := struct {
+			TypeName string `json:"__typename"`
+			*userByAddressQueryUserByAddressErrInvalidInput
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for userByAddressQueryUserByAddressUserByAddressOrError: "%T"`, v)
+	}
 }
 
-// updateUserExperienceResponse is returned by updateUserExperience on success.
-type updateUserExperienceResponse struct {
-	UpdateUserExperience *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError `json:"-"`
+// userByIdQueryResponse is returned by userByIdQuery on success.
+type userByIdQueryResponse struct {
+	UserById *userByIdQueryUserByIdUserByIdOrError `json:"-"`
 }
 
-// GetUpdateUserExperience returns updateUserExperienceResponse.UpdateUserExperience, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceResponse) GetUpdateUserExperience() *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError {
-	return v.UpdateUserExperience
+// GetUserById returns userByIdQueryResponse.UserById, and is useful for accessing the field via an interface.
+func (v *userByIdQueryResponse) GetUserById() *userByIdQueryUserByIdUserByIdOrError {
+	return v.UserById
 }
 
-func (v *updateUserExperienceResponse) UnmarshalJSON(b []byte) error {
+func (v *userByIdQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*updateUserExperienceResponse
-		UpdateUserExperience json.RawMessage `json:"updateUserExperience"`
+		*userByIdQueryResponse
+		UserById json.RawMessage `json:"userById"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.updateUserExperienceResponse = v
+	firstPass.userByIdQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -5507,26 +7882,26 @@ func (v *updateUserExperienceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.UpdateUserExperience
-		src := firstPass.UpdateUserExperience
+		dst := &v.UserById
+		src := firstPass.UserById
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError)
-			err = __unmarshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(
+			*dst = new(userByIdQueryUserByIdUserByIdOrError)
+			err = __unmarshaluserByIdQueryUserByIdUserByIdOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal updateUserExperienceResponse.UpdateUserExperience: %w", err)
+					"Unable to unmarshal userByIdQueryResponse.UserById: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshalupdateUserExperienceResponse struct {
-	UpdateUserExperience json.RawMessage `json:"updateUserExperience"`
+type __premarshaluserByIdQueryResponse struct {
+	UserById json.RawMessage `json:"userById"`
 }
 
-func (v *updateUserExperienceResponse) MarshalJSON() ([]byte, error) {
+func (v *userByIdQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -5534,94 +7909,112 @@ func (v *updateUserExperienceResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *updateUserExperienceResponse) __premarshalJSON() (*__premarshalupdateUserExperienceResponse, error) {
-	var retval __premarshalupdateUserExperienceResponse
+func (v *userByIdQueryResponse) __premarshalJSON() (*__premarshaluserByIdQueryResponse, error) {
+	var retval __premarshaluserByIdQueryResponse
 
 	{
 
-		dst := &retval.UpdateUserExperience
-		src := v.UpdateUserExperience
+		dst := &retval.UserById
+		src := v.UserById
 		if src != nil {
 			var err error
-			*dst, err = __marshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(
+			*dst, err = __marshaluserByIdQueryUserByIdUserByIdOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal updateUserExperienceResponse.UpdateUserExperience: %w", err)
+					"Unable to marshal userByIdQueryResponse.UserById: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// updateUserExperienceUpdateUserExperienceErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type updateUserExperienceUpdateUserExperienceErrInvalidInput struct {
+// userByIdQueryUserByIdErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type userByIdQueryUserByIdErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns updateUserExperienceUpdateUserExperienceErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) GetTypename() *string {
-	return v.Typename
-}
+// GetTypename returns userByIdQueryUserByIdErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdErrInvalidInput) GetTypename() *string { return v.Typename }
 
-// GetMessage returns updateUserExperienceUpdateUserExperienceErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) GetMessage() string {
-	return v.Message
-}
+// GetMessage returns userByIdQueryUserByIdErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdErrInvalidInput) GetMessage() string { return v.Message }
 
-// updateUserExperienceUpdateUserExperienceErrNotAuthorized includes the requested fields of the GraphQL type ErrNotAuthorized.
-type updateUserExperienceUpdateUserExperienceErrNotAuthorized struct {
+// userByIdQueryUserByIdErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type userByIdQueryUserByIdErrUserNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns updateUserExperienceUpdateUserExperienceErrNotAuthorized.Typename, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) GetTypename() *string {
-	return v.Typename
+// GetTypename returns userByIdQueryUserByIdErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdErrUserNotFound) GetTypename() *string { return v.Typename }
+
+// GetMessage returns userByIdQueryUserByIdErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdErrUserNotFound) GetMessage() string { return v.Message }
+
+// userByIdQueryUserByIdGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type userByIdQueryUserByIdGalleryUser struct {
+	Typename       *string                                         `json:"__typename"`
+	Username       *string                                         `json:"username"`
+	Dbid           persist.DBID                                    `json:"dbid"`
+	SocialAccounts *userByIdQueryUserByIdGalleryUserSocialAccounts `json:"socialAccounts"`
 }
 
-// GetMessage returns updateUserExperienceUpdateUserExperienceErrNotAuthorized.Message, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) GetMessage() string {
-	return v.Message
+// GetTypename returns userByIdQueryUserByIdGalleryUser.Typename, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUser) GetTypename() *string { return v.Typename }
+
+// GetUsername returns userByIdQueryUserByIdGalleryUser.Username, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUser) GetUsername() *string { return v.Username }
+
+// GetDbid returns userByIdQueryUserByIdGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUser) GetDbid() persist.DBID { return v.Dbid }
+
+// GetSocialAccounts returns userByIdQueryUserByIdGalleryUser.SocialAccounts, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUser) GetSocialAccounts() *userByIdQueryUserByIdGalleryUserSocialAccounts {
+	return v.SocialAccounts
 }
 
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload includes the requested fields of the GraphQL type UpdateUserExperiencePayload.
-type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload struct {
-	Typename *string                                                                    `json:"__typename"`
-	Viewer   *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer `json:"viewer"`
+// userByIdQueryUserByIdGalleryUserSocialAccounts includes the requested fields of the GraphQL type SocialAccounts.
+type userByIdQueryUserByIdGalleryUserSocialAccounts struct {
+	Twitter *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount `json:"twitter"`
 }
 
-// GetTypename returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload.Typename, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) GetTypename() *string {
-	return v.Typename
+// GetTwitter returns userByIdQueryUserByIdGalleryUserSocialAccounts.Twitter, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUserSocialAccounts) GetTwitter() *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount {
+	return v.Twitter
 }
 
-// GetViewer returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload.Viewer, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) GetViewer() *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer {
-	return v.Viewer
+// userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount includes the requested fields of the GraphQL type TwitterSocialAccount.
+type userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount struct {
+	Username string `json:"username"`
 }
 
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError includes the requested fields of the GraphQL interface UpdateUserExperiencePayloadOrError.
+// GetUsername returns userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount.Username, and is useful for accessing the field via an interface.
+func (v *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount) GetUsername() string {
+	return v.Username
+}
+
+// userByIdQueryUserByIdUserByIdOrError includes the requested fields of the GraphQL interface UserByIdOrError.
 //
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError is implemented by the following types:
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload
-// updateUserExperienceUpdateUserExperienceErrInvalidInput
-// updateUserExperienceUpdateUserExperienceErrNotAuthorized
-type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError interface {
-	implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError()
+// userByIdQueryUserByIdUserByIdOrError is implemented by the following types:
+// userByIdQueryUserByIdGalleryUser
+// userByIdQueryUserByIdErrUserNotFound
+// userByIdQueryUserByIdErrInvalidInput
+type userByIdQueryUserByIdUserByIdOrError interface {
+	implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
+func (v *userByIdQueryUserByIdGalleryUser) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
 }
-func (v *updateUserExperienceUpdateUserExperienceErrInvalidInput) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
+func (v *userByIdQueryUserByIdErrUserNotFound) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
 }
-func (v *updateUserExperienceUpdateUserExperienceErrNotAuthorized) implementsGraphQLInterfaceupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError() {
+func (v *userByIdQueryUserByIdErrInvalidInput) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
 }
 
-func __unmarshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(b []byte, v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError) error {
+func __unmarshaluserByIdQueryUserByIdUserByIdOrError(b []byte, v *userByIdQueryUserByIdUserByIdOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -5635,108 +8028,82 @@ func __unmarshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayl
 	}
 
 	switch tn.TypeName {
-	case "UpdateUserExperiencePayload":
-		*v = new(updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload)
+	case "GalleryUser":
+		*v = new(userByIdQueryUserByIdGalleryUser)
 		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(updateUserExperienceUpdateUserExperienceErrInvalidInput)
+	case "ErrUserNotFound":
+		*v = new(userByIdQueryUserByIdErrUserNotFound)
 		return json.Unmarshal(b, *v)
-	case "ErrNotAuthorized":
-		*v = new(updateUserExperienceUpdateUserExperienceErrNotAuthorized)
+	case "ErrInvalidInput":
+		*v = new(userByIdQueryUserByIdErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing UpdateUserExperiencePayloadOrError.__typename")
+			"response was missing UserByIdOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for userByIdQueryUserByIdUserByIdOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshalupdateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError(v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError) ([]byte, error) {
+func __marshaluserByIdQueryUserByIdUserByIdOrError(v *userByIdQueryUserByIdUserByIdOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload:
-		typename = "UpdateUserExperiencePayload"
+	case *userByIdQueryUserByIdGalleryUser:
+		typename = "GalleryUser"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayload
+			*userByIdQueryUserByIdGalleryUser
 		}{typename, v}
 		return json.Marshal(result)
-	case *updateUserExperienceUpdateUserExperienceErrInvalidInput:
-		typename = "ErrInvalidInput"
+	case *userByIdQueryUserByIdErrUserNotFound:
+		typename = "ErrUserNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateUserExperienceUpdateUserExperienceErrInvalidInput
+			*userByIdQueryUserByIdErrUserNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *updateUserExperienceUpdateUserExperienceErrNotAuthorized:
-		typename = "ErrNotAuthorized"
+	case *userByIdQueryUserByIdErrInvalidInput:
+		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*updateUserExperienceUpdateUserExperienceErrNotAuthorized
+			*userByIdQueryUserByIdErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadOrError: "%T"`, v)
+			`unexpected concrete type for userByIdQueryUserByIdUserByIdOrError: "%T"`, v)
 	}
 }
 
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer includes the requested fields of the GraphQL type Viewer.
-type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer struct {
-	UserExperiences []updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience `json:"userExperiences"`
-}
-
-// GetUserExperiences returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer.UserExperiences, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewer) GetUserExperiences() []updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience {
-	return v.UserExperiences
-}
-
-// updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience includes the requested fields of the GraphQL type UserExperience.
-type updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience struct {
-	Type        UserExperienceType `json:"type"`
-	Experienced bool               `json:"experienced"`
-}
-
-// GetType returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience.Type, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience) GetType() UserExperienceType {
-	return v.Type
-}
-
-// GetExperienced returns updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience.Experienced, and is useful for accessing the field via an interface.
-func (v *updateUserExperienceUpdateUserExperienceUpdateUserExperiencePayloadViewerUserExperiencesUserExperience) GetExperienced() bool {
-	return v.Experienced
-}
-
-// userByAddressQueryResponse is returned by userByAddressQuery on success.
-type userByAddressQueryResponse struct {
-	UserByAddress *userByAddressQueryUserByAddressUserByAddressOrError `json:"-"`
+// userByUsernameQueryResponse is returned by userByUsernameQuery on success.
+type userByUsernameQueryResponse struct {
+	UserByUsername *userByUsernameQueryUserByUsernameUserByUsernameOrError `json:"-"`
 }
 
-// GetUserByAddress returns userByAddressQueryResponse.UserByAddress, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryResponse) GetUserByAddress() *userByAddressQueryUserByAddressUserByAddressOrError {
-	return v.UserByAddress
+// GetUserByUsername returns userByUsernameQueryResponse.UserByUsername, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryResponse) GetUserByUsername() *userByUsernameQueryUserByUsernameUserByUsernameOrError {
+	return v.UserByUsername
 }
 
-func (v *userByAddressQueryResponse) UnmarshalJSON(b []byte) error {
+func (v *userByUsernameQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*userByAddressQueryResponse
-		UserByAddress json.RawMessage `json:"userByAddress"`
+		*userByUsernameQueryResponse
+		UserByUsername json.RawMessage `json:"userByUsername"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.userByAddressQueryResponse = v
+	firstPass.userByUsernameQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -5744,26 +8111,26 @@ func (v *userByAddressQueryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.UserByAddress
-		src := firstPass.UserByAddress
+		dst := &v.UserByUsername
+		src := firstPass.UserByUsername
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(userByAddressQueryUserByAddressUserByAddressOrError)
-			err = __unmarshaluserByAddressQueryUserByAddressUserByAddressOrError(
+			*dst = new(userByUsernameQueryUserByUsernameUserByUsernameOrError)
+			err = __unmarshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal userByAddressQueryResponse.UserByAddress: %w", err)
+					"Unable to unmarshal userByUsernameQueryResponse.UserByUsername: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshaluserByAddressQueryResponse struct {
-	UserByAddress json.RawMessage `json:"userByAddress"`
+type __premarshaluserByUsernameQueryResponse struct {
+	UserByUsername json.RawMessage `json:"userByUsername"`
 }
 
-func (v *userByAddressQueryResponse) MarshalJSON() ([]byte, error) {
+func (v *userByUsernameQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -5771,86 +8138,86 @@ func (v *userByAddressQueryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *userByAddressQueryResponse) __premarshalJSON() (*__premarshaluserByAddressQueryResponse, error) {
-	var retval __premarshaluserByAddressQueryResponse
+func (v *userByUsernameQueryResponse) __premarshalJSON() (*__premarshaluserByUsernameQueryResponse, error) {
+	var retval __premarshaluserByUsernameQueryResponse
 
 	{
 
-		dst := &retval.UserByAddress
-		src := v.UserByAddress
+		dst := &retval.UserByUsername
+		src := v.UserByUsername
 		if src != nil {
 			var err error
-			*dst, err = __marshaluserByAddressQueryUserByAddressUserByAddressOrError(
+			*dst, err = __marshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal userByAddressQueryResponse.UserByAddress: %w", err)
+					"Unable to marshal userByUsernameQueryResponse.UserByUsername: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// userByAddressQueryUserByAddressErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type userByAddressQueryUserByAddressErrInvalidInput struct {
+// userByUsernameQueryUserByUsernameErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type userByUsernameQueryUserByUsernameErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByAddressQueryUserByAddressErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressErrInvalidInput) GetTypename() *string { return v.Typename }
+// GetTypename returns userByUsernameQueryUserByUsernameErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameErrInvalidInput) GetTypename() *string { return v.Typename }
 
-// GetMessage returns userByAddressQueryUserByAddressErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressErrInvalidInput) GetMessage() string { return v.Message }
+// GetMessage returns userByUsernameQueryUserByUsernameErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameErrInvalidInput) GetMessage() string { return v.Message }
 
-// userByAddressQueryUserByAddressErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
-type userByAddressQueryUserByAddressErrUserNotFound struct {
+// userByUsernameQueryUserByUsernameErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type userByUsernameQueryUserByUsernameErrUserNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByAddressQueryUserByAddressErrUserNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressErrUserNotFound) GetTypename() *string { return v.Typename }
+// GetTypename returns userByUsernameQueryUserByUsernameErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameErrUserNotFound) GetTypename() *string { return v.Typename }
 
-// GetMessage returns userByAddressQueryUserByAddressErrUserNotFound.Message, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressErrUserNotFound) GetMessage() string { return v.Message }
+// GetMessage returns userByUsernameQueryUserByUsernameErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameErrUserNotFound) GetMessage() string { return v.Message }
 
-// userByAddressQueryUserByAddressGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type userByAddressQueryUserByAddressGalleryUser struct {
+// userByUsernameQueryUserByUsernameGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type userByUsernameQueryUserByUsernameGalleryUser struct {
 	Typename *string      `json:"__typename"`
 	Username *string      `json:"username"`
 	Dbid     persist.DBID `json:"dbid"`
 }
 
-// GetTypename returns userByAddressQueryUserByAddressGalleryUser.Typename, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressGalleryUser) GetTypename() *string { return v.Typename }
+// GetTypename returns userByUsernameQueryUserByUsernameGalleryUser.Typename, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameGalleryUser) GetTypename() *string { return v.Typename }
 
-// GetUsername returns userByAddressQueryUserByAddressGalleryUser.Username, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressGalleryUser) GetUsername() *string { return v.Username }
+// GetUsername returns userByUsernameQueryUserByUsernameGalleryUser.Username, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameGalleryUser) GetUsername() *string { return v.Username }
 
-// GetDbid returns userByAddressQueryUserByAddressGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *userByAddressQueryUserByAddressGalleryUser) GetDbid() persist.DBID { return v.Dbid }
+// GetDbid returns userByUsernameQueryUserByUsernameGalleryUser.Dbid, and is useful for accessing the field via an interface.
+func (v *userByUsernameQueryUserByUsernameGalleryUser) GetDbid() persist.DBID { return v.Dbid }
 
-// userByAddressQueryUserByAddressUserByAddressOrError includes the requested fields of the GraphQL interface UserByAddressOrError.
+// userByUsernameQueryUserByUsernameUserByUsernameOrError includes the requested fields of the GraphQL interface UserByUsernameOrError.
 //
-// userByAddressQueryUserByAddressUserByAddressOrError is implemented by the following types:
-// userByAddressQueryUserByAddressGalleryUser
-// userByAddressQueryUserByAddressErrUserNotFound
-// userByAddressQueryUserByAddressErrInvalidInput
-type userByAddressQueryUserByAddressUserByAddressOrError interface {
-	implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError()
+// userByUsernameQueryUserByUsernameUserByUsernameOrError is implemented by the following types:
+// userByUsernameQueryUserByUsernameGalleryUser
+// userByUsernameQueryUserByUsernameErrUserNotFound
+// userByUsernameQueryUserByUsernameErrInvalidInput
+type userByUsernameQueryUserByUsernameUserByUsernameOrError interface {
+	implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *userByAddressQueryUserByAddressGalleryUser) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
+func (v *userByUsernameQueryUserByUsernameGalleryUser) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
 }
-func (v *userByAddressQueryUserByAddressErrUserNotFound) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
+func (v *userByUsernameQueryUserByUsernameErrUserNotFound) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
 }
-func (v *userByAddressQueryUserByAddressErrInvalidInput) implementsGraphQLInterfaceuserByAddressQueryUserByAddressUserByAddressOrError() {
+func (v *userByUsernameQueryUserByUsernameErrInvalidInput) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
 }
 
-func __unmarshaluserByAddressQueryUserByAddressUserByAddressOrError(b []byte, v *userByAddressQueryUserByAddressUserByAddressOrError) error {
+func __unmarshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(b []byte, v *userByUsernameQueryUserByUsernameUserByUsernameOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -5865,81 +8232,81 @@ func __unmarshaluserByAddressQueryUserByAddressUserByAddressOrError(b []byte, v
 
 	switch tn.TypeName {
 	case "GalleryUser":
-		*v = new(userByAddressQueryUserByAddressGalleryUser)
+		*v = new(userByUsernameQueryUserByUsernameGalleryUser)
 		return json.Unmarshal(b, *v)
 	case "ErrUserNotFound":
-		*v = new(userByAddressQueryUserByAddressErrUserNotFound)
+		*v = new(userByUsernameQueryUserByUsernameErrUserNotFound)
 		return json.Unmarshal(b, *v)
 	case "ErrInvalidInput":
-		*v = new(userByAddressQueryUserByAddressErrInvalidInput)
+		*v = new(userByUsernameQueryUserByUsernameErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing UserByAddressOrError.__typename")
+			"response was missing UserByUsernameOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for userByAddressQueryUserByAddressUserByAddressOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for userByUsernameQueryUserByUsernameUserByUsernameOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshaluserByAddressQueryUserByAddressUserByAddressOrError(v *userByAddressQueryUserByAddressUserByAddressOrError) ([]byte, error) {
+func __marshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(v *userByUsernameQueryUserByUsernameUserByUsernameOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *userByAddressQueryUserByAddressGalleryUser:
+	case *userByUsernameQueryUserByUsernameGalleryUser:
 		typename = "GalleryUser"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByAddressQueryUserByAddressGalleryUser
+			*userByUsernameQueryUserByUsernameGalleryUser
 		}{typename, v}
 		return json.Marshal(result)
-	case *userByAddressQueryUserByAddressErrUserNotFound:
+	case *userByUsernameQueryUserByUsernameErrUserNotFound:
 		typename = "ErrUserNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByAddressQueryUserByAddressErrUserNotFound
+			*userByUsernameQueryUserByUsernameErrUserNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *userByAddressQueryUserByAddressErrInvalidInput:
+	case *userByUsernameQueryUserByUsernameErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByAddressQueryUserByAddressErrInvalidInput
+			*userByUsernameQueryUserByUsernameErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for userByAddressQueryUserByAddressUserByAddressOrError: "%T"`, v)
+			`unexpected concrete type for userByUsernameQueryUserByUsernameUserByUsernameOrError: "%T"`, v)
 	}
 }
 
-// userByIdQueryResponse is returned by userByIdQuery on success.
-type userByIdQueryResponse struct {
-	UserById *userByIdQueryUserByIdUserByIdOrError `json:"-"`
+// userFeedQueryResponse is returned by userFeedQuery on success.
+type userFeedQueryResponse struct {
+	UserById *userFeedQueryUserByIdUserByIdOrError `json:"-"`
 }
 
-// GetUserById returns userByIdQueryResponse.UserById, and is useful for accessing the field via an interface.
-func (v *userByIdQueryResponse) GetUserById() *userByIdQueryUserByIdUserByIdOrError {
+// GetUserById returns userFeedQueryResponse.UserById, and is useful for accessing the field via an interface.
+func (v *userFeedQueryResponse) GetUserById() *userFeedQueryUserByIdUserByIdOrError {
 	return v.UserById
 }
 
-func (v *userByIdQueryResponse) UnmarshalJSON(b []byte) error {
+func (v *userFeedQueryResponse) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*userByIdQueryResponse
+		*userFeedQueryResponse
 		UserById json.RawMessage `json:"userById"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.userByIdQueryResponse = v
+	firstPass.userFeedQueryResponse = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -5950,23 +8317,23 @@ func (v *userByIdQueryResponse) UnmarshalJSON(b []byte) error {
 		dst := &v.UserById
 		src := firstPass.UserById
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(userByIdQueryUserByIdUserByIdOrError)
-			err = __unmarshaluserByIdQueryUserByIdUserByIdOrError(
+			*dst = new(userFeedQueryUserByIdUserByIdOrError)
+			err = __unmarshaluserFeedQueryUserByIdUserByIdOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal userByIdQueryResponse.UserById: %w", err)
+					"Unable to unmarshal userFeedQueryResponse.UserById: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshaluserByIdQueryResponse struct {
+type __premarshaluserFeedQueryResponse struct {
 	UserById json.RawMessage `json:"userById"`
 }
 
-func (v *userByIdQueryResponse) MarshalJSON() ([]byte, error) {
+func (v *userFeedQueryResponse) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -5974,8 +8341,8 @@ func (v *userByIdQueryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *userByIdQueryResponse) __premarshalJSON() (*__premarshaluserByIdQueryResponse, error) {
-	var retval __premarshaluserByIdQueryResponse
+func (v *userFeedQueryResponse) __premarshalJSON() (*__premarshaluserFeedQueryResponse, error) {
+	var retval __premarshaluserFeedQueryResponse
 
 	{
 
@@ -5983,192 +8350,87 @@ func (v *userByIdQueryResponse) __premarshalJSON() (*__premarshaluserByIdQueryRe
 		src := v.UserById
 		if src != nil {
 			var err error
-			*dst, err = __marshaluserByIdQueryUserByIdUserByIdOrError(
+			*dst, err = __marshaluserFeedQueryUserByIdUserByIdOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal userByIdQueryResponse.UserById: %w", err)
+					"Unable to marshal userFeedQueryResponse.UserById: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// userByIdQueryUserByIdErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type userByIdQueryUserByIdErrInvalidInput struct {
+// userFeedQueryUserByIdErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
+type userFeedQueryUserByIdErrInvalidInput struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByIdQueryUserByIdErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdErrInvalidInput) GetTypename() *string { return v.Typename }
+// GetTypename returns userFeedQueryUserByIdErrInvalidInput.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdErrInvalidInput) GetTypename() *string { return v.Typename }
 
-// GetMessage returns userByIdQueryUserByIdErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdErrInvalidInput) GetMessage() string { return v.Message }
+// GetMessage returns userFeedQueryUserByIdErrInvalidInput.Message, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdErrInvalidInput) GetMessage() string { return v.Message }
 
-// userByIdQueryUserByIdErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
-type userByIdQueryUserByIdErrUserNotFound struct {
+// userFeedQueryUserByIdErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
+type userFeedQueryUserByIdErrUserNotFound struct {
 	Typename *string `json:"__typename"`
 	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByIdQueryUserByIdErrUserNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdErrUserNotFound) GetTypename() *string { return v.Typename }
-
-// GetMessage returns userByIdQueryUserByIdErrUserNotFound.Message, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdErrUserNotFound) GetMessage() string { return v.Message }
-
-// userByIdQueryUserByIdGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type userByIdQueryUserByIdGalleryUser struct {
-	Typename       *string                                         `json:"__typename"`
-	Username       *string                                         `json:"username"`
-	Dbid           persist.DBID                                    `json:"dbid"`
-	SocialAccounts *userByIdQueryUserByIdGalleryUserSocialAccounts `json:"socialAccounts"`
-}
-
-// GetTypename returns userByIdQueryUserByIdGalleryUser.Typename, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUser) GetTypename() *string { return v.Typename }
-
-// GetUsername returns userByIdQueryUserByIdGalleryUser.Username, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUser) GetUsername() *string { return v.Username }
-
-// GetDbid returns userByIdQueryUserByIdGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUser) GetDbid() persist.DBID { return v.Dbid }
-
-// GetSocialAccounts returns userByIdQueryUserByIdGalleryUser.SocialAccounts, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUser) GetSocialAccounts() *userByIdQueryUserByIdGalleryUserSocialAccounts {
-	return v.SocialAccounts
-}
-
-// userByIdQueryUserByIdGalleryUserSocialAccounts includes the requested fields of the GraphQL type SocialAccounts.
-type userByIdQueryUserByIdGalleryUserSocialAccounts struct {
-	Twitter *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount `json:"twitter"`
-}
-
-// GetTwitter returns userByIdQueryUserByIdGalleryUserSocialAccounts.Twitter, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUserSocialAccounts) GetTwitter() *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount {
-	return v.Twitter
-}
-
-// userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount includes the requested fields of the GraphQL type TwitterSocialAccount.
-type userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount struct {
-	Username string `json:"username"`
-}
-
-// GetUsername returns userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount.Username, and is useful for accessing the field via an interface.
-func (v *userByIdQueryUserByIdGalleryUserSocialAccountsTwitterTwitterSocialAccount) GetUsername() string {
-	return v.Username
-}
-
-// userByIdQueryUserByIdUserByIdOrError includes the requested fields of the GraphQL interface UserByIdOrError.
-//
-// userByIdQueryUserByIdUserByIdOrError is implemented by the following types:
-// userByIdQueryUserByIdGalleryUser
-// userByIdQueryUserByIdErrUserNotFound
-// userByIdQueryUserByIdErrInvalidInput
-type userByIdQueryUserByIdUserByIdOrError interface {
-	implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError()
-	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
-	GetTypename() *string
-}
-
-func (v *userByIdQueryUserByIdGalleryUser) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
-}
-func (v *userByIdQueryUserByIdErrUserNotFound) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
-}
-func (v *userByIdQueryUserByIdErrInvalidInput) implementsGraphQLInterfaceuserByIdQueryUserByIdUserByIdOrError() {
-}
-
-func __unmarshaluserByIdQueryUserByIdUserByIdOrError(b []byte, v *userByIdQueryUserByIdUserByIdOrError) error {
-	if string(b) == "null" {
-		return nil
-	}
-
-	var tn struct {
-		TypeName string `json:"__typename"`
-	}
-	err := json.Unmarshal(b, &tn)
-	if err != nil {
-		return err
-	}
-
-	switch tn.TypeName {
-	case "GalleryUser":
-		*v = new(userByIdQueryUserByIdGalleryUser)
-		return json.Unmarshal(b, *v)
-	case "ErrUserNotFound":
-		*v = new(userByIdQueryUserByIdErrUserNotFound)
-		return json.Unmarshal(b, *v)
-	case "ErrInvalidInput":
-		*v = new(userByIdQueryUserByIdErrInvalidInput)
-		return json.Unmarshal(b, *v)
-	case "":
-		return fmt.Errorf(
-			"response was missing UserByIdOrError.__typename")
-	default:
-		return fmt.Errorf(
-			`unexpected concrete type for userByIdQueryUserByIdUserByIdOrError: "%v"`, tn.TypeName)
-	}
-}
-
-func __marshaluserByIdQueryUserByIdUserByIdOrError(v *userByIdQueryUserByIdUserByIdOrError) ([]byte, error) {
-
-	var typename string
-	switch v := (*v).(type) {
-	case *userByIdQueryUserByIdGalleryUser:
-		typename = "GalleryUser"
-
-		result := struct {
-			TypeName string `json:"__typename"`
-			*userByIdQueryUserByIdGalleryUser
-		}{typename, v}
-		return json.Marshal(result)
-	case *userByIdQueryUserByIdErrUserNotFound:
-		typename = "ErrUserNotFound"
+// GetTypename returns userFeedQueryUserByIdErrUserNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdErrUserNotFound) GetTypename() *string { return v.Typename }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*userByIdQueryUserByIdErrUserNotFound
-		}{typename, v}
-		return json.Marshal(result)
-	case *userByIdQueryUserByIdErrInvalidInput:
-		typename = "ErrInvalidInput"
+// GetMessage returns userFeedQueryUserByIdErrUserNotFound.Message, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdErrUserNotFound) GetMessage() string { return v.Message }
 
-		result := struct {
-			TypeName string `json:"__typename"`
-			*userByIdQueryUserByIdErrInvalidInput
-		}{typename, v}
-		return json.Marshal(result)
-	case nil:
-		return []byte("null"), nil
-	default:
-		return nil, fmt.Errorf(
-			`unexpected concrete type for userByIdQueryUserByIdUserByIdOrError: "%T"`, v)
-	}
+// userFeedQueryUserByIdGalleryUser includes the requested fields of the GraphQL type GalleryUser.
+type userFeedQueryUserByIdGalleryUser struct {
+	Typename *string                                             `json:"__typename"`
+	Feed     *userFeedQueryUserByIdGalleryUserFeedFeedConnection `json:"feed"`
 }
 
-// userByUsernameQueryResponse is returned by userByUsernameQuery on success.
-type userByUsernameQueryResponse struct {
-	UserByUsername *userByUsernameQueryUserByUsernameUserByUsernameOrError `json:"-"`
+// GetTypename returns userFeedQueryUserByIdGalleryUser.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUser) GetTypename() *string { return v.Typename }
+
+// GetFeed returns userFeedQueryUserByIdGalleryUser.Feed, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUser) GetFeed() *userFeedQueryUserByIdGalleryUserFeedFeedConnection {
+	return v.Feed
 }
 
-// GetUserByUsername returns userByUsernameQueryResponse.UserByUsername, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryResponse) GetUserByUsername() *userByUsernameQueryUserByUsernameUserByUsernameOrError {
-	return v.UserByUsername
+// userFeedQueryUserByIdGalleryUserFeedFeedConnection includes the requested fields of the GraphQL type FeedConnection.
+type userFeedQueryUserByIdGalleryUserFeedFeedConnection struct {
+	Edges []*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge `json:"edges"`
 }
 
-func (v *userByUsernameQueryResponse) UnmarshalJSON(b []byte) error {
+// GetEdges returns userFeedQueryUserByIdGalleryUserFeedFeedConnection.Edges, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnection) GetEdges() []*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge {
+	return v.Edges
+}
+
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge includes the requested fields of the GraphQL type FeedEdge.
+type userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge struct {
+	Node *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError `json:"-"`
+}
+
+// GetNode returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge.Node, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge) GetNode() *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError {
+	return v.Node
+}
+
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge) UnmarshalJSON(b []byte) error {
 
 	if string(b) == "null" {
 		return nil
 	}
 
 	var firstPass struct {
-		*userByUsernameQueryResponse
-		UserByUsername json.RawMessage `json:"userByUsername"`
+		*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge
+		Node json.RawMessage `json:"node"`
 		graphql.NoUnmarshalJSON
 	}
-	firstPass.userByUsernameQueryResponse = v
+	firstPass.userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge = v
 
 	err := json.Unmarshal(b, &firstPass)
 	if err != nil {
@@ -6176,26 +8438,26 @@ func (v *userByUsernameQueryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	{
-		dst := &v.UserByUsername
-		src := firstPass.UserByUsername
+		dst := &v.Node
+		src := firstPass.Node
 		if len(src) != 0 && string(src) != "null" {
-			*dst = new(userByUsernameQueryUserByUsernameUserByUsernameOrError)
-			err = __unmarshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(
+			*dst = new(userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError)
+			err = __unmarshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
 				src, *dst)
 			if err != nil {
 				return fmt.Errorf(
-					"Unable to unmarshal userByUsernameQueryResponse.UserByUsername: %w", err)
+					"Unable to unmarshal userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
 			}
 		}
 	}
 	return nil
 }
 
-type __premarshaluserByUsernameQueryResponse struct {
-	UserByUsername json.RawMessage `json:"userByUsername"`
+type __premarshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge struct {
+	Node json.RawMessage `json:"node"`
 }
 
-func (v *userByUsernameQueryResponse) MarshalJSON() ([]byte, error) {
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge) MarshalJSON() ([]byte, error) {
 	premarshaled, err := v.__premarshalJSON()
 	if err != nil {
 		return nil, err
@@ -6203,86 +8465,175 @@ func (v *userByUsernameQueryResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(premarshaled)
 }
 
-func (v *userByUsernameQueryResponse) __premarshalJSON() (*__premarshaluserByUsernameQueryResponse, error) {
-	var retval __premarshaluserByUsernameQueryResponse
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge) __premarshalJSON() (*__premarshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge, error) {
+	var retval __premarshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge
 
 	{
 
-		dst := &retval.UserByUsername
-		src := v.UserByUsername
+		dst := &retval.Node
+		src := v.Node
 		if src != nil {
 			var err error
-			*dst, err = __marshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(
+			*dst, err = __marshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(
 				src)
 			if err != nil {
 				return nil, fmt.Errorf(
-					"Unable to marshal userByUsernameQueryResponse.UserByUsername: %w", err)
+					"Unable to marshal userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdge.Node: %w", err)
 			}
 		}
 	}
 	return &retval, nil
 }
 
-// userByUsernameQueryUserByUsernameErrInvalidInput includes the requested fields of the GraphQL type ErrInvalidInput.
-type userByUsernameQueryUserByUsernameErrInvalidInput struct {
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound includes the requested fields of the GraphQL type ErrFeedEventNotFound.
+type userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound struct {
 	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByUsernameQueryUserByUsernameErrInvalidInput.Typename, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameErrInvalidInput) GetTypename() *string { return v.Typename }
-
-// GetMessage returns userByUsernameQueryUserByUsernameErrInvalidInput.Message, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameErrInvalidInput) GetMessage() string { return v.Message }
+// GetTypename returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) GetTypename() *string {
+	return v.Typename
+}
 
-// userByUsernameQueryUserByUsernameErrUserNotFound includes the requested fields of the GraphQL type ErrUserNotFound.
-type userByUsernameQueryUserByUsernameErrUserNotFound struct {
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction includes the requested fields of the GraphQL type ErrUnknownAction.
+type userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction struct {
 	Typename *string `json:"__typename"`
-	Message  string  `json:"message"`
 }
 
-// GetTypename returns userByUsernameQueryUserByUsernameErrUserNotFound.Typename, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameErrUserNotFound) GetTypename() *string { return v.Typename }
-
-// GetMessage returns userByUsernameQueryUserByUsernameErrUserNotFound.Message, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameErrUserNotFound) GetMessage() string { return v.Message }
+// GetTypename returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) GetTypename() *string {
+	return v.Typename
+}
 
-// userByUsernameQueryUserByUsernameGalleryUser includes the requested fields of the GraphQL type GalleryUser.
-type userByUsernameQueryUserByUsernameGalleryUser struct {
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent includes the requested fields of the GraphQL type FeedEvent.
+type userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent struct {
 	Typename *string      `json:"__typename"`
-	Username *string      `json:"username"`
 	Dbid     persist.DBID `json:"dbid"`
+	Hidden   *bool        `json:"hidden"`
 }
 
-// GetTypename returns userByUsernameQueryUserByUsernameGalleryUser.Typename, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameGalleryUser) GetTypename() *string { return v.Typename }
+// GetTypename returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Typename, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetTypename() *string {
+	return v.Typename
+}
 
-// GetUsername returns userByUsernameQueryUserByUsernameGalleryUser.Username, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameGalleryUser) GetUsername() *string { return v.Username }
+// GetDbid returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Dbid, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetDbid() persist.DBID {
+	return v.Dbid
+}
 
-// GetDbid returns userByUsernameQueryUserByUsernameGalleryUser.Dbid, and is useful for accessing the field via an interface.
-func (v *userByUsernameQueryUserByUsernameGalleryUser) GetDbid() persist.DBID { return v.Dbid }
+// GetHidden returns userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent.Hidden, and is useful for accessing the field via an interface.
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) GetHidden() *bool {
+	return v.Hidden
+}
 
-// userByUsernameQueryUserByUsernameUserByUsernameOrError includes the requested fields of the GraphQL interface UserByUsernameOrError.
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError includes the requested fields of the GraphQL interface FeedEventOrError.
 //
-// userByUsernameQueryUserByUsernameUserByUsernameOrError is implemented by the following types:
-// userByUsernameQueryUserByUsernameGalleryUser
-// userByUsernameQueryUserByUsernameErrUserNotFound
-// userByUsernameQueryUserByUsernameErrInvalidInput
-type userByUsernameQueryUserByUsernameUserByUsernameOrError interface {
-	implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError()
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError is implemented by the following types:
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+// userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+type userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError interface {
+	implementsGraphQLInterfaceuserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
 	GetTypename() *string
 }
 
-func (v *userByUsernameQueryUserByUsernameGalleryUser) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent) implementsGraphQLInterfaceuserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
-func (v *userByUsernameQueryUserByUsernameErrUserNotFound) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound) implementsGraphQLInterfaceuserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
-func (v *userByUsernameQueryUserByUsernameErrInvalidInput) implementsGraphQLInterfaceuserByUsernameQueryUserByUsernameUserByUsernameOrError() {
+func (v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction) implementsGraphQLInterfaceuserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError() {
 }
 
-func __unmarshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(b []byte, v *userByUsernameQueryUserByUsernameUserByUsernameOrError) error {
+func __unmarshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(b []byte, v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) error {
+	if string(b) == "null" {
+		return nil
+	}
+
+	var tn struct {
+		TypeName string `json:"__typename"`
+	}
+	err := json.Unmarshal(b, &tn)
+	if err != nil {
+		return err
+	}
+
+	switch tn.TypeName {
+	case "FeedEvent":
+		*v = new(userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
+		return json.Unmarshal(b, *v)
+	case "ErrFeedEventNotFound":
+		*v = new(userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound)
+		return json.Unmarshal(b, *v)
+	case "ErrUnknownAction":
+		*v = new(userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction)
+		return json.Unmarshal(b, *v)
+	case "":
+		return fmt.Errorf(
+			"response was missing FeedEventOrError.__typename")
+	default:
+		return fmt.Errorf(
+			`unexpected concrete type for userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%v"`, tn.TypeName)
+	}
+}
+
+func __marshaluserFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError(v *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError) ([]byte, error) {
+
+	var typename string
+	switch v := (*v).(type) {
+	case *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent:
+		typename = "FeedEvent"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent
+		}{typename, v}
+		return json.Marshal(result)
+	case *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound:
+		typename = "ErrFeedEventNotFound"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrFeedEventNotFound
+		}{typename, v}
+		return json.Marshal(result)
+	case *userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction:
+		typename = "ErrUnknownAction"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeErrUnknownAction
+		}{typename, v}
+		return json.Marshal(result)
+	case nil:
+		return []byte("null"), nil
+	default:
+		return nil, fmt.Errorf(
+			`unexpected concrete type for userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventOrError: "%T"`, v)
+	}
+}
+
+// userFeedQueryUserByIdUserByIdOrError includes the requested fields of the GraphQL interface UserByIdOrError.
+//
+// userFeedQueryUserByIdUserByIdOrError is implemented by the following types:
+// userFeedQueryUserByIdGalleryUser
+// userFeedQueryUserByIdErrUserNotFound
+// userFeedQueryUserByIdErrInvalidInput
+type userFeedQueryUserByIdUserByIdOrError interface {
+	implementsGraphQLInterfaceuserFeedQueryUserByIdUserByIdOrError()
+	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
+	GetTypename() *string
+}
+
+func (v *userFeedQueryUserByIdGalleryUser) implementsGraphQLInterfaceuserFeedQueryUserByIdUserByIdOrError() {
+}
+func (v *userFeedQueryUserByIdErrUserNotFound) implementsGraphQLInterfaceuserFeedQueryUserByIdUserByIdOrError() {
+}
+func (v *userFeedQueryUserByIdErrInvalidInput) implementsGraphQLInterfaceuserFeedQueryUserByIdUserByIdOrError() {
+}
+
+func __unmarshaluserFeedQueryUserByIdUserByIdOrError(b []byte, v *userFeedQueryUserByIdUserByIdOrError) error {
 	if string(b) == "null" {
 		return nil
 	}
@@ -6297,56 +8648,56 @@ func __unmarshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(b []byte,
 
 	switch tn.TypeName {
 	case "GalleryUser":
-		*v = new(userByUsernameQueryUserByUsernameGalleryUser)
+		*v = new(userFeedQueryUserByIdGalleryUser)
 		return json.Unmarshal(b, *v)
 	case "ErrUserNotFound":
-		*v = new(userByUsernameQueryUserByUsernameErrUserNotFound)
+		*v = new(userFeedQueryUserByIdErrUserNotFound)
 		return json.Unmarshal(b, *v)
 	case "ErrInvalidInput":
-		*v = new(userByUsernameQueryUserByUsernameErrInvalidInput)
+		*v = new(userFeedQueryUserByIdErrInvalidInput)
 		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
-			"response was missing UserByUsernameOrError.__typename")
+			"response was missing UserByIdOrError.__typename")
 	default:
 		return fmt.Errorf(
-			`unexpected concrete type for userByUsernameQueryUserByUsernameUserByUsernameOrError: "%v"`, tn.TypeName)
+			`unexpected concrete type for userFeedQueryUserByIdUserByIdOrError: "%v"`, tn.TypeName)
 	}
 }
 
-func __marshaluserByUsernameQueryUserByUsernameUserByUsernameOrError(v *userByUsernameQueryUserByUsernameUserByUsernameOrError) ([]byte, error) {
+func __marshaluserFeedQueryUserByIdUserByIdOrError(v *userFeedQueryUserByIdUserByIdOrError) ([]byte, error) {
 
 	var typename string
 	switch v := (*v).(type) {
-	case *userByUsernameQueryUserByUsernameGalleryUser:
+	case *userFeedQueryUserByIdGalleryUser:
 		typename = "GalleryUser"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByUsernameQueryUserByUsernameGalleryUser
+			*userFeedQueryUserByIdGalleryUser
 		}{typename, v}
 		return json.Marshal(result)
-	case *userByUsernameQueryUserByUsernameErrUserNotFound:
+	case *userFeedQueryUserByIdErrUserNotFound:
 		typename = "ErrUserNotFound"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByUsernameQueryUserByUsernameErrUserNotFound
+			*userFeedQueryUserByIdErrUserNotFound
 		}{typename, v}
 		return json.Marshal(result)
-	case *userByUsernameQueryUserByUsernameErrInvalidInput:
+	case *userFeedQueryUserByIdErrInvalidInput:
 		typename = "ErrInvalidInput"
 
 		result := struct {
 			TypeName string `json:"__typename"`
-			*userByUsernameQueryUserByUsernameErrInvalidInput
+			*userFeedQueryUserByIdErrInvalidInput
 		}{typename, v}
 		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
 		return nil, fmt.Errorf(
-			`unexpected concrete type for userByUsernameQueryUserByUsernameUserByUsernameOrError: "%T"`, v)
+			`unexpected concrete type for userFeedQueryUserByIdUserByIdOrError: "%T"`, v)
 	}
 }
 
@@ -7010,6 +9361,8 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataCollectionUpdatedFeedEventData
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryInfoUpdatedFeedEventData
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventData
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData
 type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData interface {
 	implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
@@ -7036,6 +9389,10 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 }
 func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData() {
 }
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData() {
+}
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData() {
+}
 
 func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData(b []byte, v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataFeedEventData) error {
 	if string(b) == "null" {
@@ -7078,6 +9435,12 @@ func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeN
 	case "GalleryUpdatedFeedEventData":
 		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventData)
 		return json.Unmarshal(b, *v)
+	case "TokensAcquiredFeedEventData":
+		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "RepostedFeedEventData":
+		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing FeedEventData.__typename")
@@ -7167,6 +9530,22 @@ func __marshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNod
 			*__premarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventData
 		}{typename, premarshaled}
 		return json.Marshal(result)
+	case *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData:
+		typename = "TokensAcquiredFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData:
+		typename = "RepostedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -7402,6 +9781,8 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasCollectionUpdatedFeedEventData
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasGalleryInfoUpdatedFeedEventData
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasGalleryUpdatedFeedEventData
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData
 type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData interface {
 	implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData()
 	// GetTypename returns the receiver's concrete GraphQL type-name (see interface doc for possible values).
@@ -7428,6 +9809,10 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 }
 func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasGalleryUpdatedFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData() {
 }
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData() {
+}
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData) implementsGraphQLInterfaceviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData() {
+}
 
 func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData(b []byte, v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasFeedEventData) error {
 	if string(b) == "null" {
@@ -7470,6 +9855,12 @@ func __unmarshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeN
 	case "GalleryUpdatedFeedEventData":
 		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasGalleryUpdatedFeedEventData)
 		return json.Unmarshal(b, *v)
+	case "TokensAcquiredFeedEventData":
+		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData)
+		return json.Unmarshal(b, *v)
+	case "RepostedFeedEventData":
+		*v = new(viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData)
+		return json.Unmarshal(b, *v)
 	case "":
 		return fmt.Errorf(
 			"response was missing FeedEventData.__typename")
@@ -7555,6 +9946,22 @@ func __marshalviewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNod
 			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasGalleryUpdatedFeedEventData
 		}{typename, v}
 		return json.Marshal(result)
+	case *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData:
+		typename = "TokensAcquiredFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
+	case *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData:
+		typename = "RepostedFeedEventData"
+
+		result := struct {
+			TypeName string `json:"__typename"`
+			*viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData
+		}{typename, v}
+		return json.Marshal(result)
 	case nil:
 		return []byte("null"), nil
 	default:
@@ -7607,6 +10014,38 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 	return v.Action
 }
 
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData includes the requested fields of the GraphQL type RepostedFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData struct {
+	Typename *string `json:"__typename"`
+	Action   *Action `json:"action"`
+}
+
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasRepostedFeedEventData) GetAction() *Action {
+	return v.Action
+}
+
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData includes the requested fields of the GraphQL type TokensAcquiredFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData struct {
+	Typename *string `json:"__typename"`
+	Action   *Action `json:"action"`
+}
+
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAcquiredFeedEventData) GetAction() *Action {
+	return v.Action
+}
+
 // viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAddedToCollectionFeedEventData includes the requested fields of the GraphQL type TokensAddedToCollectionFeedEventData.
 type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasTokensAddedToCollectionFeedEventData struct {
 	Typename  *string                                                                                                                                                                                      `json:"__typename"`
@@ -7655,35 +10094,67 @@ func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeed
 	return v.Name
 }
 
-// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData includes the requested fields of the GraphQL type UserCreatedFeedEventData.
-type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData struct {
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData includes the requested fields of the GraphQL type UserCreatedFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData struct {
+	Typename *string `json:"__typename"`
+	Action   *Action `json:"action"`
+}
+
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData) GetAction() *Action {
+	return v.Action
+}
+
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData includes the requested fields of the GraphQL type UserFollowedUsersFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData struct {
+	Typename *string `json:"__typename"`
+	Action   *Action `json:"action"`
+}
+
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData) GetTypename() *string {
+	return v.Typename
+}
+
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData) GetAction() *Action {
+	return v.Action
+}
+
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData includes the requested fields of the GraphQL type RepostedFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData struct {
 	Typename *string `json:"__typename"`
 	Action   *Action `json:"action"`
 }
 
-// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData.Typename, and is useful for accessing the field via an interface.
-func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData) GetTypename() *string {
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData) GetTypename() *string {
 	return v.Typename
 }
 
-// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData.Action, and is useful for accessing the field via an interface.
-func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserCreatedFeedEventData) GetAction() *Action {
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataRepostedFeedEventData) GetAction() *Action {
 	return v.Action
 }
 
-// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData includes the requested fields of the GraphQL type UserFollowedUsersFeedEventData.
-type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData struct {
+// viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData includes the requested fields of the GraphQL type TokensAcquiredFeedEventData.
+type viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData struct {
 	Typename *string `json:"__typename"`
 	Action   *Action `json:"action"`
 }
 
-// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData.Typename, and is useful for accessing the field via an interface.
-func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData) GetTypename() *string {
+// GetTypename returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData.Typename, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData) GetTypename() *string {
 	return v.Typename
 }
 
-// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData.Action, and is useful for accessing the field via an interface.
-func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataGalleryUpdatedFeedEventDataSubEventDatasUserFollowedUsersFeedEventData) GetAction() *Action {
+// GetAction returns viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData.Action, and is useful for accessing the field via an interface.
+func (v *viewerQueryViewerUserGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEventEventDataTokensAcquiredFeedEventData) GetAction() *Action {
 	return v.Action
 }
 
@@ -8010,6 +10481,45 @@ mutation admireFeedEventMutation ($feedEventId: DBID!) {
 	return &data, err
 }
 
+func collectionByIdQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id persist.DBID,
+) (*collectionByIdQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "collectionByIdQuery",
+		Query: `
+query collectionByIdQuery ($id: DBID!) {
+	collectionById(id: $id) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on Collection {
+			dbid
+		}
+	}
+}
+`,
+		Variables: &__collectionByIdQueryInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data collectionByIdQueryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func commentOnFeedEventMutation(
 	ctx context.Context,
 	client graphql.Client,
@@ -8287,6 +10797,87 @@ mutation disconnectSocialAccount ($accountType: SocialAccountType!) {
 	return &data, err
 }
 
+func feedEventByIdQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id persist.DBID,
+) (*feedEventByIdQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "feedEventByIdQuery",
+		Query: `
+query feedEventByIdQuery ($id: DBID!) {
+	feedEventById(id: $id) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on FeedEvent {
+			dbid
+		}
+	}
+}
+`,
+		Variables: &__feedEventByIdQueryInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data feedEventByIdQueryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func galleryByIdQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id persist.DBID,
+) (*galleryByIdQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "galleryByIdQuery",
+		Query: `
+query galleryByIdQuery ($id: DBID!) {
+	galleryById(id: $id) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on Gallery {
+			dbid
+			collections {
+				dbid
+			}
+		}
+	}
+}
+`,
+		Variables: &__galleryByIdQueryInput{
+			Id: id,
+		},
+	}
+	var err error
+
+	var data galleryByIdQueryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func getAuthNonceMutation(
 	ctx context.Context,
 	client graphql.Client,
@@ -8587,6 +11178,102 @@ mutation removeUserWalletsMutation ($walletIds: [DBID!]!) {
 	return &data, err
 }
 
+func repostFeedEventMutation(
+	ctx context.Context,
+	client graphql.Client,
+	feedEventId persist.DBID,
+	quote *string,
+) (*repostFeedEventMutationResponse, error) {
+	req := &graphql.Request{
+		OpName: "repostFeedEventMutation",
+		Query: `
+mutation repostFeedEventMutation ($feedEventId: DBID!, $quote: String) {
+	repostFeedEvent(feedEventId: $feedEventId, quote: $quote) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on RepostFeedEventPayload {
+			feedEvent {
+				dbid
+				caption
+				eventData {
+					__typename
+					... on RepostedFeedEventData {
+						originalEvent {
+							dbid
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__repostFeedEventMutationInput{
+			FeedEventId: feedEventId,
+			Quote:       quote,
+		},
+	}
+	var err error
+
+	var data repostFeedEventMutationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
+func setFeedEventHiddenMutation(
+	ctx context.Context,
+	client graphql.Client,
+	feedEventId persist.DBID,
+	hidden bool,
+) (*setFeedEventHiddenMutationResponse, error) {
+	req := &graphql.Request{
+		OpName: "setFeedEventHiddenMutation",
+		Query: `
+mutation setFeedEventHiddenMutation ($feedEventId: DBID!, $hidden: Boolean!) {
+	setFeedEventHidden(feedEventId: $feedEventId, hidden: $hidden) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on SetFeedEventHiddenPayload {
+			feedEvent {
+				dbid
+				hidden
+			}
+		}
+	}
+}
+`,
+		Variables: &__setFeedEventHiddenMutationInput{
+			FeedEventId: feedEventId,
+			Hidden:      hidden,
+		},
+	}
+	var err error
+
+	var data setFeedEventHiddenMutationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func syncTokensMutation(
 	ctx context.Context,
 	client graphql.Client,
@@ -8714,6 +11401,50 @@ query trendingUsersQuery ($input: TrendingUsersInput!) {
 	return &data, err
 }
 
+func updateFeedEventCaptionMutation(
+	ctx context.Context,
+	client graphql.Client,
+	feedEventId persist.DBID,
+	caption string,
+) (*updateFeedEventCaptionMutationResponse, error) {
+	req := &graphql.Request{
+		OpName: "updateFeedEventCaptionMutation",
+		Query: `
+mutation updateFeedEventCaptionMutation ($feedEventId: DBID!, $caption: String!) {
+	updateFeedEventCaption(feedEventId: $feedEventId, caption: $caption) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on UpdateFeedEventCaptionPayload {
+			feedEvent {
+				dbid
+				caption
+			}
+		}
+	}
+}
+`,
+		Variables: &__updateFeedEventCaptionMutationInput{
+			FeedEventId: feedEventId,
+			Caption:     caption,
+		},
+	}
+	var err error
+
+	var data updateFeedEventCaptionMutationResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func updateGalleryMutation(
 	ctx context.Context,
 	client graphql.Client,
@@ -8977,6 +11708,57 @@ query userByUsernameQuery ($user: String!) {
 	return &data, err
 }
 
+func userFeedQuery(
+	ctx context.Context,
+	client graphql.Client,
+	id persist.DBID,
+	first *int,
+) (*userFeedQueryResponse, error) {
+	req := &graphql.Request{
+		OpName: "userFeedQuery",
+		Query: `
+query userFeedQuery ($id: DBID!, $first: Int) {
+	userById(id: $id) {
+		__typename
+		... on Error {
+			__typename
+			message
+		}
+		... on GalleryUser {
+			feed(first: $first) {
+				edges {
+					node {
+						__typename
+						... on FeedEvent {
+							dbid
+							hidden
+						}
+					}
+				}
+			}
+		}
+	}
+}
+`,
+		Variables: &__userFeedQueryInput{
+			Id:    id,
+			First: first,
+		},
+	}
+	var err error
+
+	var data userFeedQueryResponse
+	resp := &graphql.Response{Data: &data}
+
+	err = client.MakeRequest(
+		ctx,
+		req,
+		resp,
+	)
+
+	return &data, err
+}
+
 func viewGalleryMutation(
 	ctx context.Context,
 	client graphql.Client,
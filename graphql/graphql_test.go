@@ -24,6 +24,7 @@ import (
 	"github.com/mikeydub/go-gallery/service/auth"
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
 	"github.com/mikeydub/go-gallery/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -66,8 +67,12 @@ func testGraphQL(t *testing.T) {
 		{title: "update gallery and create a feed event", run: testUpdateGalleryWithPublish},
 		{title: "update gallery and ensure name still gets set when not sent in update", run: testUpdateGalleryWithNoNameChange},
 		{title: "update gallery with a new collection", run: testUpdateGalleryWithNewCollection},
+		{title: "drafts are only visible to their owner until published", run: testDraftVisibility},
 		{title: "should get trending users", run: testTrendingUsers, fixtures: []fixture{usePostgres, useRedis}},
 		{title: "should get trending feed events", run: testTrendingFeedEvents},
+		{title: "should repost feed event", run: testRepostFeedEvent},
+		{title: "should update feed event caption", run: testUpdateFeedEventCaption},
+		{title: "should hide feed event", run: testSetFeedEventHidden},
 		{title: "should delete collection in gallery update", run: testUpdateGalleryDeleteCollection},
 		{title: "should update user experiences", run: testUpdateUserExperiences},
 		{title: "should create gallery", run: testCreateGallery},
@@ -643,6 +648,115 @@ func testUpdateGalleryWithNewCollection(t *testing.T) {
 	assert.Len(t, payload.Gallery.Collections[0].Tokens, 1)
 }
 
+func testDraftVisibility(t *testing.T) {
+	userF := newUserWithTokensFixture(t)
+	other := newUserFixture(t)
+	ctx := context.Background()
+	owner := authedHandlerClient(t, userF.id)
+	clients := map[string]*handlerClient{
+		"anonymous": defaultHandlerClient(t),
+		"other":     authedHandlerClient(t, other.id),
+	}
+
+	response, err := updateGalleryMutation(ctx, owner, UpdateGalleryInput{
+		GalleryId: userF.galleryID,
+		CreatedCollections: []*CreateCollectionInGalleryInput{
+			{
+				GivenID:       "draft",
+				Name:          "draft",
+				Tokens:        userF.tokenIDs[:1],
+				Layout:        defaultLayout(),
+				TokenSettings: defaultTokenSettings(userF.tokenIDs[:1]),
+				Draft:         util.ToPointer(true),
+			},
+		},
+		Order:  []persist.DBID{"draft"},
+		EditId: util.ToPointer("draft_edit"),
+	})
+	require.NoError(t, err)
+	payload, ok := (*response.UpdateGallery).(*updateGalleryMutationUpdateGalleryUpdateGalleryPayload)
+	require.True(t, ok)
+	require.Len(t, payload.Gallery.Collections, 1)
+	collectionID := payload.Gallery.Collections[0].Dbid
+
+	// Only the collection is a draft, so everyone can see the gallery without it
+	assertGalleryVisible(t, owner, userF.galleryID, collectionID)
+	assertCollectionVisible(t, owner, collectionID)
+	for name, c := range clients {
+		t.Run(name+" can't see the draft collection", func(t *testing.T) {
+			assertGalleryVisible(t, c, userF.galleryID)
+			assertCollectionNotFound(t, c, collectionID)
+		})
+	}
+
+	_, err = updateGalleryMutation(ctx, owner, UpdateGalleryInput{
+		GalleryId: userF.galleryID,
+		Draft:     util.ToPointer(true),
+		EditId:    util.ToPointer("draft_edit"),
+	})
+	require.NoError(t, err)
+
+	assertGalleryVisible(t, owner, userF.galleryID, collectionID)
+	for name, c := range clients {
+		t.Run(name+" can't see the draft gallery", func(t *testing.T) {
+			assertGalleryNotFound(t, c, userF.galleryID)
+			assertCollectionNotFound(t, c, collectionID)
+		})
+	}
+
+	publishResponse, err := publishGalleryMutation(ctx, owner, PublishGalleryInput{
+		GalleryId: userF.galleryID,
+		EditId:    "draft_edit",
+	})
+	require.NoError(t, err)
+	_, ok = (*publishResponse.PublishGallery).(*publishGalleryMutationPublishGalleryPublishGalleryPayload)
+	require.True(t, ok)
+
+	for name, c := range clients {
+		t.Run(name+" can see the published gallery", func(t *testing.T) {
+			assertGalleryVisible(t, c, userF.galleryID, collectionID)
+			assertCollectionVisible(t, c, collectionID)
+		})
+	}
+}
+
+func assertGalleryVisible(t *testing.T, c *handlerClient, galleryID persist.DBID, collectionIDs ...persist.DBID) {
+	t.Helper()
+	response, err := galleryByIdQuery(context.Background(), c, galleryID)
+	require.NoError(t, err)
+	gallery, ok := (*response.GalleryById).(*galleryByIdQueryGalleryByIdGallery)
+	require.True(t, ok, "expected gallery %s to be visible", galleryID)
+	ids := make([]persist.DBID, 0, len(gallery.Collections))
+	for _, collection := range gallery.Collections {
+		ids = append(ids, collection.Dbid)
+	}
+	assert.ElementsMatch(t, collectionIDs, ids)
+}
+
+func assertGalleryNotFound(t *testing.T, c *handlerClient, galleryID persist.DBID) {
+	t.Helper()
+	response, err := galleryByIdQuery(context.Background(), c, galleryID)
+	require.NoError(t, err)
+	_, ok := (*response.GalleryById).(*galleryByIdQueryGalleryByIdErrGalleryNotFound)
+	assert.True(t, ok, "expected gallery %s to be hidden", galleryID)
+}
+
+func assertCollectionVisible(t *testing.T, c *handlerClient, collectionID persist.DBID) {
+	t.Helper()
+	response, err := collectionByIdQuery(context.Background(), c, collectionID)
+	require.NoError(t, err)
+	_, ok := (*response.CollectionById).(*collectionByIdQueryCollectionByIdCollection)
+	assert.True(t, ok, "expected collection %s to be visible", collectionID)
+}
+
+func assertCollectionNotFound(t *testing.T, c *handlerClient, collectionID persist.DBID) {
+	t.Helper()
+	response, err := collectionByIdQuery(context.Background(), c, collectionID)
+	require.NoError(t, err)
+	_, ok := (*response.CollectionById).(*collectionByIdQueryCollectionByIdErrCollectionNotFound)
+	assert.True(t, ok, "expected collection %s to be hidden", collectionID)
+}
+
 func testViewsAreRolledUp(t *testing.T) {
 	serverF := newServerFixture(t)
 	userF := newUserFixture(t)
@@ -738,6 +852,135 @@ func testTrendingFeedEvents(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
+func testRepostFeedEvent(t *testing.T) {
+	ctx := context.Background()
+	userF := newUserWithFeedEventsFixture(t)
+	alice := newUserFixture(t)
+	bob := newUserFixture(t)
+	aliceC := authedHandlerClient(t, alice.id)
+	bobC := authedHandlerClient(t, bob.id)
+	originalID := userF.feedEventIDs[0]
+
+	repost := repostFeedEvent(t, ctx, aliceC, originalID, util.ToPointer("  so good  "))
+
+	t.Run("repost points to the original feed event", func(t *testing.T) {
+		data := (*repost.EventData).(*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData)
+		assert.Equal(t, originalID, data.OriginalEvent.Dbid)
+	})
+
+	t.Run("quote is trimmed and kept as the caption", func(t *testing.T) {
+		assert.Equal(t, "so good", *repost.Caption)
+	})
+
+	t.Run("reposting twice is rejected", func(t *testing.T) {
+		resp, err := repostFeedEventMutation(ctx, aliceC, originalID, nil)
+
+		require.NoError(t, err)
+		_ = (*resp.RepostFeedEvent).(*repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted)
+	})
+
+	t.Run("reposting a repost reposts the original feed event", func(t *testing.T) {
+		reposted := repostFeedEvent(t, ctx, bobC, repost.Dbid, util.ToPointer("   "))
+
+		data := (*reposted.EventData).(*repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEventEventDataRepostedFeedEventData)
+		assert.Equal(t, originalID, data.OriginalEvent.Dbid)
+		assert.Nil(t, reposted.Caption, "a blank quote isn't kept")
+	})
+
+	t.Run("reposting the original after its repost is rejected", func(t *testing.T) {
+		resp, err := repostFeedEventMutation(ctx, bobC, originalID, nil)
+
+		require.NoError(t, err)
+		_ = (*resp.RepostFeedEvent).(*repostFeedEventMutationRepostFeedEventErrFeedEventAlreadyReposted)
+	})
+}
+
+func testUpdateFeedEventCaption(t *testing.T) {
+	ctx := context.Background()
+	userF := newUserWithFeedEventsFixture(t)
+	c := authedHandlerClient(t, userF.id)
+
+	t.Run("owner can change the caption", func(t *testing.T) {
+		resp, err := updateFeedEventCaptionMutation(ctx, c, userF.feedEventIDs[0], "a new caption")
+
+		require.NoError(t, err)
+		payload := (*resp.UpdateFeedEventCaption).(*updateFeedEventCaptionMutationUpdateFeedEventCaptionUpdateFeedEventCaptionPayload)
+		assert.Equal(t, "a new caption", *payload.FeedEvent.Caption)
+	})
+
+	t.Run("other users can't change the caption", func(t *testing.T) {
+		other := newUserFixture(t)
+		c := authedHandlerClient(t, other.id)
+
+		resp, err := updateFeedEventCaptionMutation(ctx, c, userF.feedEventIDs[0], "not my caption")
+
+		require.NoError(t, err)
+		_ = (*resp.UpdateFeedEventCaption).(*updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized)
+	})
+
+	t.Run("caption can't be changed after the edit window", func(t *testing.T) {
+		_, err := postgres.NewPgxClient().Exec(ctx, "update feed_events set created_at = now() - interval '2 hours' where id = $1", userF.feedEventIDs[1])
+		require.NoError(t, err)
+
+		resp, err := updateFeedEventCaptionMutation(ctx, c, userF.feedEventIDs[1], "too late")
+
+		require.NoError(t, err)
+		_ = (*resp.UpdateFeedEventCaption).(*updateFeedEventCaptionMutationUpdateFeedEventCaptionErrNotAuthorized)
+	})
+}
+
+func testSetFeedEventHidden(t *testing.T) {
+	ctx := context.Background()
+	userF := newUserWithFeedEventsFixture(t)
+	other := newUserFixture(t)
+	c := authedHandlerClient(t, userF.id)
+	otherC := authedHandlerClient(t, other.id)
+	hiddenID := userF.feedEventIDs[0]
+
+	resp, err := setFeedEventHiddenMutation(ctx, c, hiddenID, true)
+	require.NoError(t, err)
+	payload := (*resp.SetFeedEventHidden).(*setFeedEventHiddenMutationSetFeedEventHiddenSetFeedEventHiddenPayload)
+	assert.True(t, *payload.FeedEvent.Hidden)
+
+	t.Run("hidden feed events are left out of other users' feeds", func(t *testing.T) {
+		assert.NotContains(t, globalFeedEvents(t, ctx, otherC, 10), hiddenID)
+		assert.NotContains(t, userFeedEvents(t, ctx, otherC, userF.id, 10), hiddenID)
+	})
+
+	t.Run("hidden feed events can't be fetched by other users", func(t *testing.T) {
+		for _, client := range []*handlerClient{otherC, defaultHandlerClient(t)} {
+			resp, err := feedEventByIdQuery(ctx, client, hiddenID)
+			require.NoError(t, err)
+			_, ok := (*resp.FeedEventById).(*feedEventByIdQueryFeedEventByIdErrFeedEventNotFound)
+			assert.True(t, ok)
+		}
+	})
+
+	t.Run("owners still see their hidden feed events", func(t *testing.T) {
+		assert.Contains(t, userFeedEvents(t, ctx, c, userF.id, 10), hiddenID)
+
+		resp, err := feedEventByIdQuery(ctx, c, hiddenID)
+		require.NoError(t, err)
+		_, ok := (*resp.FeedEventById).(*feedEventByIdQueryFeedEventByIdFeedEvent)
+		assert.True(t, ok)
+	})
+
+	t.Run("other users can't hide the feed event", func(t *testing.T) {
+		resp, err := setFeedEventHiddenMutation(ctx, otherC, userF.feedEventIDs[1], true)
+
+		require.NoError(t, err)
+		_ = (*resp.SetFeedEventHidden).(*setFeedEventHiddenMutationSetFeedEventHiddenErrNotAuthorized)
+		assert.Contains(t, globalFeedEvents(t, ctx, otherC, 10), userF.feedEventIDs[1])
+	})
+
+	t.Run("unhidden feed events are shown again", func(t *testing.T) {
+		_, err := setFeedEventHiddenMutation(ctx, c, hiddenID, false)
+		require.NoError(t, err)
+
+		assert.Contains(t, globalFeedEvents(t, ctx, otherC, 10), hiddenID)
+	})
+}
+
 // authMechanismInput signs a nonce with an ethereum wallet
 func authMechanismInput(w wallet, nonce string) AuthMechanism {
 	return AuthMechanism{
@@ -860,6 +1103,20 @@ func globalFeedEvents(t *testing.T, ctx context.Context, c graphql.Client, limit
 	return feedEvents
 }
 
+// userFeedEvents makes a GraphQL request to return a user's feed events
+func userFeedEvents(t *testing.T, ctx context.Context, c graphql.Client, userID persist.DBID, limit int) []persist.DBID {
+	t.Helper()
+	resp, err := userFeedQuery(ctx, c, userID, &limit)
+	require.NoError(t, err)
+	user := (*resp.UserById).(*userFeedQueryUserByIdGalleryUser)
+	feedEvents := make([]persist.DBID, len(user.Feed.Edges))
+	for i, event := range user.Feed.Edges {
+		e := (*event.Node).(*userFeedQueryUserByIdGalleryUserFeedFeedConnectionEdgesFeedEdgeNodeFeedEvent)
+		feedEvents[i] = e.Dbid
+	}
+	return feedEvents
+}
+
 // trendingFeedEvents makes a GraphQL request to return trending feedEvents
 func trendingFeedEvents(t *testing.T, ctx context.Context, c graphql.Client, limit int) []persist.DBID {
 	t.Helper()
@@ -882,6 +1139,15 @@ func admireFeedEvent(t *testing.T, ctx context.Context, c graphql.Client, feedEv
 	_ = (*resp.AdmireFeedEvent).(*admireFeedEventMutationAdmireFeedEventAdmireFeedEventPayload)
 }
 
+// repostFeedEvent makes a GraphQL request to repost a feed event
+func repostFeedEvent(t *testing.T, ctx context.Context, c graphql.Client, feedEventID persist.DBID, quote *string) *repostFeedEventMutationRepostFeedEventRepostFeedEventPayloadFeedEvent {
+	t.Helper()
+	resp, err := repostFeedEventMutation(ctx, c, feedEventID, quote)
+	require.NoError(t, err)
+	payload := (*resp.RepostFeedEvent).(*repostFeedEventMutationRepostFeedEventRepostFeedEventPayload)
+	return payload.FeedEvent
+}
+
 // commentOnFeedEvent makes a GraphQL request to admire a feed event
 func commentOnFeedEvent(t *testing.T, ctx context.Context, c graphql.Client, feedEventID persist.DBID, comment string) {
 	t.Helper()
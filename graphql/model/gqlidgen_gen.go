@@ -50,6 +50,10 @@ func (r *Community) ID() GqlID {
 	return GqlID(fmt.Sprintf("Community:%s:%s", r.GetGqlIDField_ContractAddress(), r.GetGqlIDField_Chain()))
 }
 
+func (r *CommunityMemberJoinedNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("CommunityMemberJoinedNotification:%s", r.Dbid))
+}
+
 func (r *Contract) ID() GqlID {
 	return GqlID(fmt.Sprintf("Contract:%s", r.Dbid))
 }
@@ -82,6 +86,10 @@ func (r *SocialConnection) ID() GqlID {
 	return GqlID(fmt.Sprintf("SocialConnection:%s:%s", r.SocialID, r.SocialType))
 }
 
+func (r *SomeoneAddedYourMintedTokenNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SomeoneAddedYourMintedTokenNotification:%s", r.Dbid))
+}
+
 func (r *SomeoneAdmiredYourFeedEventNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("SomeoneAdmiredYourFeedEventNotification:%s", r.Dbid))
 }
@@ -98,14 +106,30 @@ func (r *SomeoneFollowedYouNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("SomeoneFollowedYouNotification:%s", r.Dbid))
 }
 
+func (r *SomeoneMentionedYouNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SomeoneMentionedYouNotification:%s", r.Dbid))
+}
+
+func (r *SomeoneRepostedYourFeedEventNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SomeoneRepostedYourFeedEventNotification:%s", r.Dbid))
+}
+
 func (r *SomeoneViewedYourGalleryNotification) ID() GqlID {
 	return GqlID(fmt.Sprintf("SomeoneViewedYourGalleryNotification:%s", r.Dbid))
 }
 
+func (r *SomeoneWroteANoteOnYourTokenNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("SomeoneWroteANoteOnYourTokenNotification:%s", r.Dbid))
+}
+
 func (r *Token) ID() GqlID {
 	return GqlID(fmt.Sprintf("Token:%s", r.Dbid))
 }
 
+func (r *TokenMediaProcessedNotification) ID() GqlID {
+	return GqlID(fmt.Sprintf("TokenMediaProcessedNotification:%s", r.Dbid))
+}
+
 func (r *Viewer) ID() GqlID {
 	//-----------------------------------------------------------------------------------------------
 	//-----------------------------------------------------------------------------------------------
@@ -129,6 +153,7 @@ type NodeFetcher struct {
 	OnCollectionToken                             func(ctx context.Context, tokenId string, collectionId string) (*CollectionToken, error)
 	OnComment                                     func(ctx context.Context, dbid persist.DBID) (*Comment, error)
 	OnCommunity                                   func(ctx context.Context, contractAddress string, chain string) (*Community, error)
+	OnCommunityMemberJoinedNotification           func(ctx context.Context, dbid persist.DBID) (*CommunityMemberJoinedNotification, error)
 	OnContract                                    func(ctx context.Context, dbid persist.DBID) (*Contract, error)
 	OnDeletedNode                                 func(ctx context.Context, dbid persist.DBID) (*DeletedNode, error)
 	OnFeedEvent                                   func(ctx context.Context, dbid persist.DBID) (*FeedEvent, error)
@@ -137,12 +162,17 @@ type NodeFetcher struct {
 	OnMembershipTier                              func(ctx context.Context, dbid persist.DBID) (*MembershipTier, error)
 	OnMerchToken                                  func(ctx context.Context, tokenId string) (*MerchToken, error)
 	OnSocialConnection                            func(ctx context.Context, socialId string, socialType persist.SocialProvider) (*SocialConnection, error)
+	OnSomeoneAddedYourMintedTokenNotification     func(ctx context.Context, dbid persist.DBID) (*SomeoneAddedYourMintedTokenNotification, error)
 	OnSomeoneAdmiredYourFeedEventNotification     func(ctx context.Context, dbid persist.DBID) (*SomeoneAdmiredYourFeedEventNotification, error)
 	OnSomeoneCommentedOnYourFeedEventNotification func(ctx context.Context, dbid persist.DBID) (*SomeoneCommentedOnYourFeedEventNotification, error)
 	OnSomeoneFollowedYouBackNotification          func(ctx context.Context, dbid persist.DBID) (*SomeoneFollowedYouBackNotification, error)
 	OnSomeoneFollowedYouNotification              func(ctx context.Context, dbid persist.DBID) (*SomeoneFollowedYouNotification, error)
+	OnSomeoneMentionedYouNotification             func(ctx context.Context, dbid persist.DBID) (*SomeoneMentionedYouNotification, error)
+	OnSomeoneRepostedYourFeedEventNotification    func(ctx context.Context, dbid persist.DBID) (*SomeoneRepostedYourFeedEventNotification, error)
 	OnSomeoneViewedYourGalleryNotification        func(ctx context.Context, dbid persist.DBID) (*SomeoneViewedYourGalleryNotification, error)
+	OnSomeoneWroteANoteOnYourTokenNotification    func(ctx context.Context, dbid persist.DBID) (*SomeoneWroteANoteOnYourTokenNotification, error)
 	OnToken                                       func(ctx context.Context, dbid persist.DBID) (*Token, error)
+	OnTokenMediaProcessedNotification             func(ctx context.Context, dbid persist.DBID) (*TokenMediaProcessedNotification, error)
 	OnViewer                                      func(ctx context.Context, userId string) (*Viewer, error)
 	OnWallet                                      func(ctx context.Context, dbid persist.DBID) (*Wallet, error)
 }
@@ -182,6 +212,11 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Community' type requires 2 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnCommunity(ctx, string(ids[0]), string(ids[1]))
+	case "CommunityMemberJoinedNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'CommunityMemberJoinedNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnCommunityMemberJoinedNotification(ctx, persist.DBID(ids[0]))
 	case "Contract":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Contract' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -222,6 +257,11 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SocialConnection' type requires 2 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnSocialConnection(ctx, string(ids[0]), persist.SocialProvider(ids[1]))
+	case "SomeoneAddedYourMintedTokenNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneAddedYourMintedTokenNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSomeoneAddedYourMintedTokenNotification(ctx, persist.DBID(ids[0]))
 	case "SomeoneAdmiredYourFeedEventNotification":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneAdmiredYourFeedEventNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -242,16 +282,36 @@ func (n *NodeFetcher) GetNodeByGqlID(ctx context.Context, id GqlID) (Node, error
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneFollowedYouNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnSomeoneFollowedYouNotification(ctx, persist.DBID(ids[0]))
+	case "SomeoneMentionedYouNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneMentionedYouNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSomeoneMentionedYouNotification(ctx, persist.DBID(ids[0]))
+	case "SomeoneRepostedYourFeedEventNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneRepostedYourFeedEventNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSomeoneRepostedYourFeedEventNotification(ctx, persist.DBID(ids[0]))
 	case "SomeoneViewedYourGalleryNotification":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneViewedYourGalleryNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnSomeoneViewedYourGalleryNotification(ctx, persist.DBID(ids[0]))
+	case "SomeoneWroteANoteOnYourTokenNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'SomeoneWroteANoteOnYourTokenNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnSomeoneWroteANoteOnYourTokenNotification(ctx, persist.DBID(ids[0]))
 	case "Token":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Token' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
 		}
 		return n.OnToken(ctx, persist.DBID(ids[0]))
+	case "TokenMediaProcessedNotification":
+		if len(ids) != 1 {
+			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'TokenMediaProcessedNotification' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
+		}
+		return n.OnTokenMediaProcessedNotification(ctx, persist.DBID(ids[0]))
 	case "Viewer":
 		if len(ids) != 1 {
 			return nil, ErrInvalidIDFormat{message: fmt.Sprintf("'Viewer' type requires 1 ID component(s) (%d component(s) supplied)", len(ids))}
@@ -279,6 +339,8 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnComment")
 	case n.OnCommunity == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnCommunity")
+	case n.OnCommunityMemberJoinedNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnCommunityMemberJoinedNotification")
 	case n.OnContract == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnContract")
 	case n.OnDeletedNode == nil:
@@ -295,6 +357,8 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnMerchToken")
 	case n.OnSocialConnection == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSocialConnection")
+	case n.OnSomeoneAddedYourMintedTokenNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneAddedYourMintedTokenNotification")
 	case n.OnSomeoneAdmiredYourFeedEventNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneAdmiredYourFeedEventNotification")
 	case n.OnSomeoneCommentedOnYourFeedEventNotification == nil:
@@ -303,10 +367,18 @@ func (n *NodeFetcher) ValidateHandlers() {
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneFollowedYouBackNotification")
 	case n.OnSomeoneFollowedYouNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneFollowedYouNotification")
+	case n.OnSomeoneMentionedYouNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneMentionedYouNotification")
+	case n.OnSomeoneRepostedYourFeedEventNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneRepostedYourFeedEventNotification")
 	case n.OnSomeoneViewedYourGalleryNotification == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneViewedYourGalleryNotification")
+	case n.OnSomeoneWroteANoteOnYourTokenNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnSomeoneWroteANoteOnYourTokenNotification")
 	case n.OnToken == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnToken")
+	case n.OnTokenMediaProcessedNotification == nil:
+		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnTokenMediaProcessedNotification")
 	case n.OnViewer == nil:
 		panic("NodeFetcher handler validation failed: no handler set for NodeFetcher.OnViewer")
 	case n.OnWallet == nil:
@@ -44,6 +44,10 @@ type HelperViewerData struct {
 	UserId persist.DBID
 }
 
+type HelperTokenModerationData struct {
+	TokenIdentifiers persist.TokenIdentifiers
+}
+
 type HelperCommunityData struct {
 	ForceRefresh *bool
 }
@@ -53,6 +57,15 @@ type HelperTokensAddedToCollectionFeedEventDataData struct {
 	CollectionID persist.DBID
 }
 
+type HelperTokensAcquiredFeedEventDataData struct {
+	AcquiredTokenIDs persist.DBIDList
+	MintedTokenIDs   persist.DBIDList
+}
+
+type HelperRepostedFeedEventDataData struct {
+	FeedEventID persist.DBID
+}
+
 type HelperCollectionCreatedFeedEventDataData struct {
 	TokenIDs     persist.DBIDList
 	CollectionID persist.DBID
@@ -96,6 +109,43 @@ type HelperSomeoneAdmiredYourFeedEventNotificationData struct {
 	NotificationData persist.NotificationData
 }
 
+type HelperSomeoneRepostedYourFeedEventNotificationData struct {
+	OwnerID          persist.DBID
+	FeedEventID      persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperSomeoneMentionedYouNotificationData struct {
+	OwnerID          persist.DBID
+	FeedEventID      persist.DBID
+	CommentID        persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperSomeoneAddedYourMintedTokenNotificationData struct {
+	OwnerID          persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperSomeoneWroteANoteOnYourTokenNotificationData struct {
+	OwnerID          persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperCommunityMemberJoinedNotificationData struct {
+	OwnerID          persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperTokenMediaProcessedNotificationData struct {
+	OwnerID          persist.DBID
+	NotificationData persist.NotificationData
+}
+
+type HelperTextEntityData struct {
+	UserID persist.DBID
+}
+
 type HelperNotificationsConnectionData struct {
 	UserId persist.DBID
 }
@@ -182,3 +232,7 @@ func (w Window) MarshalGQL(wt io.Writer) {
 		panic(fmt.Sprintf("unknown window: %v", w))
 	}
 }
+
+type HelperWebhookData struct {
+	OwnerID persist.DBID
+}
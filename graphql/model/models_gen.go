@@ -67,6 +67,10 @@ type CreateUserPayloadOrError interface {
 	IsCreateUserPayloadOrError()
 }
 
+type CreateWebhookPayloadOrError interface {
+	IsCreateWebhookPayloadOrError()
+}
+
 type DeepRefreshPayloadOrError interface {
 	IsDeepRefreshPayloadOrError()
 }
@@ -75,10 +79,18 @@ type DeleteCollectionPayloadOrError interface {
 	IsDeleteCollectionPayloadOrError()
 }
 
+type DeleteFeedEventPayloadOrError interface {
+	IsDeleteFeedEventPayloadOrError()
+}
+
 type DeleteGalleryPayloadOrError interface {
 	IsDeleteGalleryPayloadOrError()
 }
 
+type DeleteWebhookPayloadOrError interface {
+	IsDeleteWebhookPayloadOrError()
+}
+
 type DisconnectSocialAccountPayloadOrError interface {
 	IsDisconnectSocialAccountPayloadOrError()
 }
@@ -137,6 +149,10 @@ type LoginPayloadOrError interface {
 	IsLoginPayloadOrError()
 }
 
+type MarkAllNotificationsReadPayloadOrError interface {
+	IsMarkAllNotificationsReadPayloadOrError()
+}
+
 type Media interface {
 	IsMedia()
 }
@@ -190,6 +206,10 @@ type RefreshTokenPayloadOrError interface {
 	IsRefreshTokenPayloadOrError()
 }
 
+type RegisterPushDevicePayloadOrError interface {
+	IsRegisterPushDevicePayloadOrError()
+}
+
 type RemoveAdmirePayloadOrError interface {
 	IsRemoveAdmirePayloadOrError()
 }
@@ -202,10 +222,22 @@ type RemoveUserWalletsPayloadOrError interface {
 	IsRemoveUserWalletsPayloadOrError()
 }
 
+type ReplayWebhookDeliveryPayloadOrError interface {
+	IsReplayWebhookDeliveryPayloadOrError()
+}
+
+type RepostFeedEventPayloadOrError interface {
+	IsRepostFeedEventPayloadOrError()
+}
+
 type ResendVerificationEmailPayloadOrError interface {
 	IsResendVerificationEmailPayloadOrError()
 }
 
+type ReviewTokenModerationPayloadOrError interface {
+	IsReviewTokenModerationPayloadOrError()
+}
+
 type RevokeRolesFromUserPayloadOrError interface {
 	IsRevokeRolesFromUserPayloadOrError()
 }
@@ -222,6 +254,10 @@ type SearchUsersPayloadOrError interface {
 	IsSearchUsersPayloadOrError()
 }
 
+type SetFeedEventHiddenPayloadOrError interface {
+	IsSetFeedEventHiddenPayloadOrError()
+}
+
 type SetSpamPreferencePayloadOrError interface {
 	IsSetSpamPreferencePayloadOrError()
 }
@@ -262,6 +298,10 @@ type UnfollowUserPayloadOrError interface {
 	IsUnfollowUserPayloadOrError()
 }
 
+type UnregisterPushDevicePayloadOrError interface {
+	IsUnregisterPushDevicePayloadOrError()
+}
+
 type UnsubscribeFromEmailTypePayloadOrError interface {
 	IsUnsubscribeFromEmailTypePayloadOrError()
 }
@@ -290,6 +330,10 @@ type UpdateFeaturedGalleryPayloadOrError interface {
 	IsUpdateFeaturedGalleryPayloadOrError()
 }
 
+type UpdateFeedEventCaptionPayloadOrError interface {
+	IsUpdateFeedEventCaptionPayloadOrError()
+}
+
 type UpdateGalleryCollectionsPayloadOrError interface {
 	IsUpdateGalleryCollectionsPayloadOrError()
 }
@@ -310,6 +354,10 @@ type UpdateGalleryPayloadOrError interface {
 	IsUpdateGalleryPayloadOrError()
 }
 
+type UpdateNotificationPayloadOrError interface {
+	IsUpdateNotificationPayloadOrError()
+}
+
 type UpdatePrimaryWalletPayloadOrError interface {
 	IsUpdatePrimaryWalletPayloadOrError()
 }
@@ -445,14 +493,16 @@ type ClearAllNotificationsPayload struct {
 }
 
 type Collection struct {
-	Dbid           persist.DBID       `json:"dbid"`
-	Version        *int               `json:"version"`
-	Name           *string            `json:"name"`
-	CollectorsNote *string            `json:"collectorsNote"`
-	Gallery        *Gallery           `json:"gallery"`
-	Layout         *CollectionLayout  `json:"layout"`
-	Hidden         *bool              `json:"hidden"`
-	Tokens         []*CollectionToken `json:"tokens"`
+	Dbid                   persist.DBID       `json:"dbid"`
+	Version                *int               `json:"version"`
+	Name                   *string            `json:"name"`
+	CollectorsNote         *string            `json:"collectorsNote"`
+	CollectorsNoteEntities []*TextEntity      `json:"collectorsNoteEntities"`
+	Gallery                *Gallery           `json:"gallery"`
+	Layout                 *CollectionLayout  `json:"layout"`
+	Hidden                 *bool              `json:"hidden"`
+	Draft                  *bool              `json:"draft"`
+	Tokens                 []*CollectionToken `json:"tokens"`
 }
 
 func (Collection) IsNode()                  {}
@@ -542,12 +592,13 @@ type CollectorsNoteAddedToTokenFeedEventData struct {
 func (CollectorsNoteAddedToTokenFeedEventData) IsFeedEventData() {}
 
 type Comment struct {
-	Dbid         persist.DBID `json:"dbid"`
-	CreationTime *time.Time   `json:"creationTime"`
-	LastUpdated  *time.Time   `json:"lastUpdated"`
-	ReplyTo      *Comment     `json:"replyTo"`
-	Commenter    *GalleryUser `json:"commenter"`
-	Comment      *string      `json:"comment"`
+	Dbid         persist.DBID  `json:"dbid"`
+	CreationTime *time.Time    `json:"creationTime"`
+	LastUpdated  *time.Time    `json:"lastUpdated"`
+	ReplyTo      *Comment      `json:"replyTo"`
+	Commenter    *GalleryUser  `json:"commenter"`
+	Comment      *string       `json:"comment"`
+	Entities     []*TextEntity `json:"entities"`
 }
 
 func (Comment) IsNode()        {}
@@ -592,6 +643,22 @@ type CommunityEdge struct {
 	Cursor *string    `json:"cursor"`
 }
 
+type CommunityMemberJoinedNotification struct {
+	HelperCommunityMemberJoinedNotificationData
+	Dbid         persist.DBID                      `json:"dbid"`
+	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
+	CreationTime *time.Time                        `json:"creationTime"`
+	UpdatedTime  *time.Time                        `json:"updatedTime"`
+	Count        *int                              `json:"count"`
+	Community    *Community                        `json:"community"`
+	NewMembers   *GroupNotificationUsersConnection `json:"newMembers"`
+}
+
+func (CommunityMemberJoinedNotification) IsNotification()        {}
+func (CommunityMemberJoinedNotification) IsNode()                {}
+func (CommunityMemberJoinedNotification) IsGroupedNotification() {}
+
 type CommunitySearchResult struct {
 	Community *Community `json:"community"`
 }
@@ -624,6 +691,7 @@ type CreateCollectionInGalleryInput struct {
 	TokenSettings  []*CollectionTokenSettingsInput `json:"tokenSettings"`
 	Hidden         bool                            `json:"hidden"`
 	GivenID        persist.DBID                    `json:"givenID"`
+	Draft          *bool                           `json:"draft"`
 }
 
 type CreateCollectionInput struct {
@@ -672,6 +740,18 @@ type CreateUserPayload struct {
 
 func (CreateUserPayload) IsCreateUserPayloadOrError() {}
 
+type CreateWebhookInput struct {
+	URL     string   `json:"url"`
+	Actions []string `json:"actions"`
+}
+
+type CreateWebhookPayload struct {
+	Webhook *Webhook `json:"webhook"`
+	Secret  *string  `json:"secret"`
+}
+
+func (CreateWebhookPayload) IsCreateWebhookPayloadOrError() {}
+
 type DebugAuth struct {
 	AsUsername     *string                 `json:"asUsername"`
 	UserID         *persist.DBID           `json:"userId"`
@@ -701,12 +781,24 @@ type DeleteCollectionPayload struct {
 
 func (DeleteCollectionPayload) IsDeleteCollectionPayloadOrError() {}
 
+type DeleteFeedEventPayload struct {
+	DeletedID *DeletedNode `json:"deletedId"`
+}
+
+func (DeleteFeedEventPayload) IsDeleteFeedEventPayloadOrError() {}
+
 type DeleteGalleryPayload struct {
 	DeletedID *DeletedNode `json:"deletedId"`
 }
 
 func (DeleteGalleryPayload) IsDeleteGalleryPayloadOrError() {}
 
+type DeleteWebhookPayload struct {
+	WebhookID *persist.DBID `json:"webhookId"`
+}
+
+func (DeleteWebhookPayload) IsDeleteWebhookPayloadOrError() {}
+
 type DeletedNode struct {
 	Dbid persist.DBID `json:"dbid"`
 }
@@ -719,9 +811,27 @@ type DisconnectSocialAccountPayload struct {
 
 func (DisconnectSocialAccountPayload) IsDisconnectSocialAccountPayloadOrError() {}
 
+type DiscordAuth struct {
+	Code string `json:"code"`
+}
+
+type DiscordSocialAccount struct {
+	Type            persist.SocialProvider `json:"type"`
+	SocialID        string                 `json:"social_id"`
+	Name            string                 `json:"name"`
+	Username        string                 `json:"username"`
+	ProfileImageURL string                 `json:"profileImageURL"`
+	Display         bool                   `json:"display"`
+}
+
+func (DiscordSocialAccount) IsSocialAccount() {}
+
 type EmailNotificationSettings struct {
-	UnsubscribedFromAll           bool `json:"unsubscribedFromAll"`
-	UnsubscribedFromNotifications bool `json:"unsubscribedFromNotifications"`
+	UnsubscribedFromAll           bool    `json:"unsubscribedFromAll"`
+	UnsubscribedFromNotifications bool    `json:"unsubscribedFromNotifications"`
+	UnsubscribedFromDigest        bool    `json:"unsubscribedFromDigest"`
+	DigestTimezone                *string `json:"digestTimezone"`
+	Locale                        *string `json:"locale"`
 }
 
 type EoaAuth struct {
@@ -730,6 +840,13 @@ type EoaAuth struct {
 	Signature   string               `json:"signature"`
 }
 
+type ErrActionNotAllowed struct {
+	Message string `json:"message"`
+}
+
+func (ErrActionNotAllowed) IsAuthorizationError() {}
+func (ErrActionNotAllowed) IsError()              {}
+
 type ErrAddressOwnedByUser struct {
 	Message string `json:"message"`
 }
@@ -766,6 +883,7 @@ func (ErrAuthenticationFailed) IsAdmireFeedEventPayloadOrError()    {}
 func (ErrAuthenticationFailed) IsRemoveAdmirePayloadOrError()       {}
 func (ErrAuthenticationFailed) IsCommentOnFeedEventPayloadOrError() {}
 func (ErrAuthenticationFailed) IsRemoveCommentPayloadOrError()      {}
+func (ErrAuthenticationFailed) IsRepostFeedEventPayloadOrError()    {}
 func (ErrAuthenticationFailed) IsViewGalleryPayloadOrError()        {}
 
 type ErrCollectionNotFound struct {
@@ -801,17 +919,28 @@ func (ErrDoesNotOwnRequiredToken) IsError()                      {}
 func (ErrDoesNotOwnRequiredToken) IsLoginPayloadOrError()        {}
 func (ErrDoesNotOwnRequiredToken) IsCreateUserPayloadOrError()   {}
 
+type ErrFeedEventAlreadyReposted struct {
+	Message string `json:"message"`
+}
+
+func (ErrFeedEventAlreadyReposted) IsError()                         {}
+func (ErrFeedEventAlreadyReposted) IsRepostFeedEventPayloadOrError() {}
+
 type ErrFeedEventNotFound struct {
 	Message string `json:"message"`
 }
 
-func (ErrFeedEventNotFound) IsError()                            {}
-func (ErrFeedEventNotFound) IsFeedEventOrError()                 {}
-func (ErrFeedEventNotFound) IsFeedEventByIDOrError()             {}
-func (ErrFeedEventNotFound) IsAdmireFeedEventPayloadOrError()    {}
-func (ErrFeedEventNotFound) IsRemoveAdmirePayloadOrError()       {}
-func (ErrFeedEventNotFound) IsCommentOnFeedEventPayloadOrError() {}
-func (ErrFeedEventNotFound) IsRemoveCommentPayloadOrError()      {}
+func (ErrFeedEventNotFound) IsError()                                {}
+func (ErrFeedEventNotFound) IsFeedEventOrError()                     {}
+func (ErrFeedEventNotFound) IsFeedEventByIDOrError()                 {}
+func (ErrFeedEventNotFound) IsAdmireFeedEventPayloadOrError()        {}
+func (ErrFeedEventNotFound) IsRemoveAdmirePayloadOrError()           {}
+func (ErrFeedEventNotFound) IsCommentOnFeedEventPayloadOrError()     {}
+func (ErrFeedEventNotFound) IsRemoveCommentPayloadOrError()          {}
+func (ErrFeedEventNotFound) IsUpdateFeedEventCaptionPayloadOrError() {}
+func (ErrFeedEventNotFound) IsSetFeedEventHiddenPayloadOrError()     {}
+func (ErrFeedEventNotFound) IsDeleteFeedEventPayloadOrError()        {}
+func (ErrFeedEventNotFound) IsRepostFeedEventPayloadOrError()        {}
 
 type ErrGalleryNotFound struct {
 	Message string `json:"message"`
@@ -858,6 +987,17 @@ func (ErrInvalidInput) IsAdmireFeedEventPayloadOrError()                 {}
 func (ErrInvalidInput) IsRemoveAdmirePayloadOrError()                    {}
 func (ErrInvalidInput) IsCommentOnFeedEventPayloadOrError()              {}
 func (ErrInvalidInput) IsRemoveCommentPayloadOrError()                   {}
+func (ErrInvalidInput) IsUpdateFeedEventCaptionPayloadOrError()          {}
+func (ErrInvalidInput) IsSetFeedEventHiddenPayloadOrError()              {}
+func (ErrInvalidInput) IsDeleteFeedEventPayloadOrError()                 {}
+func (ErrInvalidInput) IsRepostFeedEventPayloadOrError()                 {}
+func (ErrInvalidInput) IsCreateWebhookPayloadOrError()                   {}
+func (ErrInvalidInput) IsDeleteWebhookPayloadOrError()                   {}
+func (ErrInvalidInput) IsReplayWebhookDeliveryPayloadOrError()           {}
+func (ErrInvalidInput) IsRegisterPushDevicePayloadOrError()              {}
+func (ErrInvalidInput) IsUnregisterPushDevicePayloadOrError()            {}
+func (ErrInvalidInput) IsUpdateNotificationPayloadOrError()              {}
+func (ErrInvalidInput) IsMarkAllNotificationsReadPayloadOrError()        {}
 func (ErrInvalidInput) IsVerifyEmailPayloadOrError()                     {}
 func (ErrInvalidInput) IsPreverifyEmailPayloadOrError()                  {}
 func (ErrInvalidInput) IsUpdateEmailPayloadOrError()                     {}
@@ -865,6 +1005,7 @@ func (ErrInvalidInput) IsResendVerificationEmailPayloadOrError()         {}
 func (ErrInvalidInput) IsUpdateEmailNotificationSettingsPayloadOrError() {}
 func (ErrInvalidInput) IsUnsubscribeFromEmailTypePayloadOrError()        {}
 func (ErrInvalidInput) IsRedeemMerchPayloadOrError()                     {}
+func (ErrInvalidInput) IsReviewTokenModerationPayloadOrError()           {}
 func (ErrInvalidInput) IsCreateGalleryPayloadOrError()                   {}
 func (ErrInvalidInput) IsUpdateGalleryInfoPayloadOrError()               {}
 func (ErrInvalidInput) IsUpdateGalleryHiddenPayloadOrError()             {}
@@ -929,12 +1070,23 @@ func (ErrNotAuthorized) IsUpdateUserInfoPayloadOrError()               {}
 func (ErrNotAuthorized) IsSyncTokensPayloadOrError()                   {}
 func (ErrNotAuthorized) IsError()                                      {}
 func (ErrNotAuthorized) IsDeepRefreshPayloadOrError()                  {}
+func (ErrNotAuthorized) IsUpdateFeedEventCaptionPayloadOrError()       {}
+func (ErrNotAuthorized) IsSetFeedEventHiddenPayloadOrError()           {}
+func (ErrNotAuthorized) IsDeleteFeedEventPayloadOrError()              {}
+func (ErrNotAuthorized) IsCreateWebhookPayloadOrError()                {}
+func (ErrNotAuthorized) IsDeleteWebhookPayloadOrError()                {}
+func (ErrNotAuthorized) IsReplayWebhookDeliveryPayloadOrError()        {}
+func (ErrNotAuthorized) IsRegisterPushDevicePayloadOrError()           {}
+func (ErrNotAuthorized) IsUnregisterPushDevicePayloadOrError()         {}
+func (ErrNotAuthorized) IsUpdateNotificationPayloadOrError()           {}
+func (ErrNotAuthorized) IsMarkAllNotificationsReadPayloadOrError()     {}
 func (ErrNotAuthorized) IsAddRolesToUserPayloadOrError()               {}
 func (ErrNotAuthorized) IsRevokeRolesFromUserPayloadOrError()          {}
 func (ErrNotAuthorized) IsUploadPersistedQueriesPayloadOrError()       {}
 func (ErrNotAuthorized) IsSyncTokensForUsernamePayloadOrError()        {}
 func (ErrNotAuthorized) IsBanUserFromFeedPayloadOrError()              {}
 func (ErrNotAuthorized) IsUnbanUserFromFeedPayloadOrError()            {}
+func (ErrNotAuthorized) IsReviewTokenModerationPayloadOrError()        {}
 func (ErrNotAuthorized) IsCreateGalleryPayloadOrError()                {}
 func (ErrNotAuthorized) IsUpdateGalleryInfoPayloadOrError()            {}
 func (ErrNotAuthorized) IsUpdateGalleryHiddenPayloadOrError()          {}
@@ -953,6 +1105,13 @@ func (ErrNotAuthorized) IsMintPremiumCardToWalletPayloadOrError()      {}
 func (ErrNotAuthorized) IsDisconnectSocialAccountPayloadOrError()      {}
 func (ErrNotAuthorized) IsFollowAllSocialConnectionsPayloadOrError()   {}
 
+type ErrNotificationNotFound struct {
+	Message string `json:"message"`
+}
+
+func (ErrNotificationNotFound) IsError()                            {}
+func (ErrNotificationNotFound) IsUpdateNotificationPayloadOrError() {}
+
 type ErrSyncFailed struct {
 	Message string `json:"message"`
 }
@@ -1024,6 +1183,8 @@ type FeedEvent struct {
 	Admires               *FeedEventAdmiresConnection      `json:"admires"`
 	Comments              *FeedEventCommentsConnection     `json:"comments"`
 	Caption               *string                          `json:"caption"`
+	CaptionEntities       []*TextEntity                    `json:"captionEntities"`
+	Hidden                *bool                            `json:"hidden"`
 	Interactions          *FeedEventInteractionsConnection `json:"interactions"`
 	ViewerAdmire          *Admire                          `json:"viewerAdmire"`
 	HasViewerAdmiredEvent *bool                            `json:"hasViewerAdmiredEvent"`
@@ -1102,6 +1263,8 @@ type Gallery struct {
 	Description   *string          `json:"description"`
 	Position      *string          `json:"position"`
 	Hidden        *bool            `json:"hidden"`
+	Draft         *bool            `json:"draft"`
+	PublishAt     *time.Time       `json:"publishAt"`
 	TokenPreviews []*PreviewURLSet `json:"tokenPreviews"`
 	Owner         *GalleryUser     `json:"owner"`
 	Collections   []*Collection    `json:"collections"`
@@ -1261,6 +1424,13 @@ type MagicLinkAuth struct {
 	Token string `json:"token"`
 }
 
+type MarkAllNotificationsReadPayload struct {
+	Notifications []Notification `json:"notifications"`
+	UnseenCount   *int           `json:"unseenCount"`
+}
+
+func (MarkAllNotificationsReadPayload) IsMarkAllNotificationsReadPayloadOrError() {}
+
 type MediaDimensions struct {
 	Width       *int     `json:"width"`
 	Height      *int     `json:"height"`
@@ -1325,18 +1495,46 @@ type NotificationEdge struct {
 	Cursor *string      `json:"cursor"`
 }
 
+type NotificationPreference struct {
+	Type     NotificationType     `json:"type"`
+	InApp    bool                 `json:"inApp"`
+	Email    bool                 `json:"email"`
+	Push     bool                 `json:"push"`
+	Webhook  bool                 `json:"webhook"`
+	Telegram bool                 `json:"telegram"`
+	Discord  bool                 `json:"discord"`
+	Delivery NotificationDelivery `json:"delivery"`
+}
+
+type NotificationPreferenceInput struct {
+	Type     NotificationType      `json:"type"`
+	InApp    *bool                 `json:"inApp"`
+	Email    *bool                 `json:"email"`
+	Push     *bool                 `json:"push"`
+	Webhook  *bool                 `json:"webhook"`
+	Telegram *bool                 `json:"telegram"`
+	Discord  *bool                 `json:"discord"`
+	Delivery *NotificationDelivery `json:"delivery"`
+}
+
 type NotificationSettings struct {
-	SomeoneFollowedYou           *bool `json:"someoneFollowedYou"`
-	SomeoneAdmiredYourUpdate     *bool `json:"someoneAdmiredYourUpdate"`
-	SomeoneCommentedOnYourUpdate *bool `json:"someoneCommentedOnYourUpdate"`
-	SomeoneViewedYourGallery     *bool `json:"someoneViewedYourGallery"`
+	SomeoneFollowedYou           *bool                     `json:"someoneFollowedYou"`
+	SomeoneAdmiredYourUpdate     *bool                     `json:"someoneAdmiredYourUpdate"`
+	SomeoneCommentedOnYourUpdate *bool                     `json:"someoneCommentedOnYourUpdate"`
+	SomeoneViewedYourGallery     *bool                     `json:"someoneViewedYourGallery"`
+	SomeoneMentionedYou          *bool                     `json:"someoneMentionedYou"`
+	Preferences                  []*NotificationPreference `json:"preferences"`
+	QuietHours                   *QuietHours               `json:"quietHours"`
 }
 
 type NotificationSettingsInput struct {
-	SomeoneFollowedYou           *bool `json:"someoneFollowedYou"`
-	SomeoneAdmiredYourUpdate     *bool `json:"someoneAdmiredYourUpdate"`
-	SomeoneCommentedOnYourUpdate *bool `json:"someoneCommentedOnYourUpdate"`
-	SomeoneViewedYourGallery     *bool `json:"someoneViewedYourGallery"`
+	SomeoneFollowedYou           *bool                          `json:"someoneFollowedYou"`
+	SomeoneAdmiredYourUpdate     *bool                          `json:"someoneAdmiredYourUpdate"`
+	SomeoneCommentedOnYourUpdate *bool                          `json:"someoneCommentedOnYourUpdate"`
+	SomeoneViewedYourGallery     *bool                          `json:"someoneViewedYourGallery"`
+	SomeoneMentionedYou          *bool                          `json:"someoneMentionedYou"`
+	Preferences                  []*NotificationPreferenceInput `json:"preferences"`
+	QuietHours                   *QuietHoursInput               `json:"quietHours"`
 }
 
 type NotificationsConnection struct {
@@ -1397,6 +1595,7 @@ type PublishGalleryInput struct {
 	GalleryID persist.DBID `json:"galleryId"`
 	EditID    string       `json:"editId"`
 	Caption   *string      `json:"caption"`
+	PublishAt *time.Time   `json:"publishAt"`
 }
 
 type PublishGalleryPayload struct {
@@ -1405,6 +1604,24 @@ type PublishGalleryPayload struct {
 
 func (PublishGalleryPayload) IsPublishGalleryPayloadOrError() {}
 
+type PushDevice struct {
+	Dbid         persist.DBID  `json:"dbid"`
+	Platform     *PushPlatform `json:"platform"`
+	CreationTime *time.Time    `json:"creationTime"`
+}
+
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"`
+}
+
+type QuietHoursInput struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"`
+}
+
 type RedeemMerchInput struct {
 	TokenIds   []string              `json:"tokenIds"`
 	Address    *persist.ChainAddress `json:"address"`
@@ -1436,6 +1653,19 @@ type RefreshTokenPayload struct {
 
 func (RefreshTokenPayload) IsRefreshTokenPayloadOrError() {}
 
+type RegisterPushDeviceInput struct {
+	Platform PushPlatform `json:"platform"`
+	Token    string       `json:"token"`
+	P256dh   *string      `json:"p256dh"`
+	Auth     *string      `json:"auth"`
+}
+
+type RegisterPushDevicePayload struct {
+	Device *PushDevice `json:"device"`
+}
+
+func (RegisterPushDevicePayload) IsRegisterPushDevicePayloadOrError() {}
+
 type RemoveAdmirePayload struct {
 	Viewer    *Viewer       `json:"viewer"`
 	AdmireID  *persist.DBID `json:"admireID"`
@@ -1457,12 +1687,41 @@ type RemoveUserWalletsPayload struct {
 
 func (RemoveUserWalletsPayload) IsRemoveUserWalletsPayloadOrError() {}
 
+type ReplayWebhookDeliveryPayload struct {
+	Delivery *WebhookDelivery `json:"delivery"`
+}
+
+func (ReplayWebhookDeliveryPayload) IsReplayWebhookDeliveryPayloadOrError() {}
+
+type RepostFeedEventPayload struct {
+	Viewer    *Viewer    `json:"viewer"`
+	FeedEvent *FeedEvent `json:"feedEvent"`
+}
+
+func (RepostFeedEventPayload) IsRepostFeedEventPayloadOrError() {}
+
+type RepostedFeedEventData struct {
+	HelperRepostedFeedEventDataData
+	EventTime     *time.Time      `json:"eventTime"`
+	Owner         *GalleryUser    `json:"owner"`
+	Action        *persist.Action `json:"action"`
+	OriginalEvent *FeedEvent      `json:"originalEvent"`
+}
+
+func (RepostedFeedEventData) IsFeedEventData() {}
+
 type ResendVerificationEmailPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
 
 func (ResendVerificationEmailPayload) IsResendVerificationEmailPayloadOrError() {}
 
+type ReviewTokenModerationPayload struct {
+	Moderation *TokenModeration `json:"moderation"`
+}
+
+func (ReviewTokenModerationPayload) IsReviewTokenModerationPayloadOrError() {}
+
 type SearchCommunitiesPayload struct {
 	Results []*CommunitySearchResult `json:"results"`
 }
@@ -1481,6 +1740,12 @@ type SearchUsersPayload struct {
 
 func (SearchUsersPayload) IsSearchUsersPayloadOrError() {}
 
+type SetFeedEventHiddenPayload struct {
+	FeedEvent *FeedEvent `json:"feedEvent"`
+}
+
+func (SetFeedEventHiddenPayload) IsSetFeedEventHiddenPayloadOrError() {}
+
 type SetSpamPreferenceInput struct {
 	Tokens []persist.DBID `json:"tokens"`
 	IsSpam bool           `json:"isSpam"`
@@ -1493,12 +1758,16 @@ type SetSpamPreferencePayload struct {
 func (SetSpamPreferencePayload) IsSetSpamPreferencePayloadOrError() {}
 
 type SocialAccounts struct {
-	Twitter *TwitterSocialAccount `json:"twitter"`
+	Twitter  *TwitterSocialAccount  `json:"twitter"`
+	Telegram *TelegramSocialAccount `json:"telegram"`
+	Discord  *DiscordSocialAccount  `json:"discord"`
 }
 
 type SocialAuthMechanism struct {
-	Twitter *TwitterAuth     `json:"twitter"`
-	Debug   *DebugSocialAuth `json:"debug"`
+	Twitter  *TwitterAuth     `json:"twitter"`
+	Telegram *TelegramAuth    `json:"telegram"`
+	Discord  *DiscordAuth     `json:"discord"`
+	Debug    *DebugSocialAuth `json:"debug"`
 }
 
 type SocialConnection struct {
@@ -1531,10 +1800,27 @@ type SocialQueries struct {
 
 func (SocialQueries) IsSocialQueriesOrError() {}
 
+type SomeoneAddedYourMintedTokenNotification struct {
+	HelperSomeoneAddedYourMintedTokenNotificationData
+	Dbid         persist.DBID                      `json:"dbid"`
+	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
+	CreationTime *time.Time                        `json:"creationTime"`
+	UpdatedTime  *time.Time                        `json:"updatedTime"`
+	Count        *int                              `json:"count"`
+	Collectors   *GroupNotificationUsersConnection `json:"collectors"`
+	Tokens       []*Token                          `json:"tokens"`
+}
+
+func (SomeoneAddedYourMintedTokenNotification) IsNotification()        {}
+func (SomeoneAddedYourMintedTokenNotification) IsNode()                {}
+func (SomeoneAddedYourMintedTokenNotification) IsGroupedNotification() {}
+
 type SomeoneAdmiredYourFeedEventNotification struct {
 	HelperSomeoneAdmiredYourFeedEventNotificationData
 	Dbid         persist.DBID                      `json:"dbid"`
 	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
 	CreationTime *time.Time                        `json:"creationTime"`
 	UpdatedTime  *time.Time                        `json:"updatedTime"`
 	Count        *int                              `json:"count"`
@@ -1550,6 +1836,7 @@ type SomeoneCommentedOnYourFeedEventNotification struct {
 	HelperSomeoneCommentedOnYourFeedEventNotificationData
 	Dbid         persist.DBID `json:"dbid"`
 	Seen         *bool        `json:"seen"`
+	Archived     *bool        `json:"archived"`
 	CreationTime *time.Time   `json:"creationTime"`
 	UpdatedTime  *time.Time   `json:"updatedTime"`
 	Comment      *Comment     `json:"comment"`
@@ -1563,6 +1850,7 @@ type SomeoneFollowedYouBackNotification struct {
 	HelperSomeoneFollowedYouBackNotificationData
 	Dbid         persist.DBID                      `json:"dbid"`
 	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
 	CreationTime *time.Time                        `json:"creationTime"`
 	UpdatedTime  *time.Time                        `json:"updatedTime"`
 	Count        *int                              `json:"count"`
@@ -1577,6 +1865,7 @@ type SomeoneFollowedYouNotification struct {
 	HelperSomeoneFollowedYouNotificationData
 	Dbid         persist.DBID                      `json:"dbid"`
 	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
 	CreationTime *time.Time                        `json:"creationTime"`
 	UpdatedTime  *time.Time                        `json:"updatedTime"`
 	Count        *int                              `json:"count"`
@@ -1587,10 +1876,45 @@ func (SomeoneFollowedYouNotification) IsNotification()        {}
 func (SomeoneFollowedYouNotification) IsNode()                {}
 func (SomeoneFollowedYouNotification) IsGroupedNotification() {}
 
+type SomeoneMentionedYouNotification struct {
+	HelperSomeoneMentionedYouNotificationData
+	Dbid         persist.DBID   `json:"dbid"`
+	Seen         *bool          `json:"seen"`
+	Archived     *bool          `json:"archived"`
+	CreationTime *time.Time     `json:"creationTime"`
+	UpdatedTime  *time.Time     `json:"updatedTime"`
+	Mentioner    *GalleryUser   `json:"mentioner"`
+	Source       *MentionSource `json:"source"`
+	FeedEvent    *FeedEvent     `json:"feedEvent"`
+	Comment      *Comment       `json:"comment"`
+	Collection   *Collection    `json:"collection"`
+	Token        *Token         `json:"token"`
+}
+
+func (SomeoneMentionedYouNotification) IsNotification() {}
+func (SomeoneMentionedYouNotification) IsNode()         {}
+
+type SomeoneRepostedYourFeedEventNotification struct {
+	HelperSomeoneRepostedYourFeedEventNotificationData
+	Dbid         persist.DBID                      `json:"dbid"`
+	Seen         *bool                             `json:"seen"`
+	Archived     *bool                             `json:"archived"`
+	CreationTime *time.Time                        `json:"creationTime"`
+	UpdatedTime  *time.Time                        `json:"updatedTime"`
+	Count        *int                              `json:"count"`
+	FeedEvent    *FeedEvent                        `json:"feedEvent"`
+	Reposters    *GroupNotificationUsersConnection `json:"reposters"`
+}
+
+func (SomeoneRepostedYourFeedEventNotification) IsNotification()        {}
+func (SomeoneRepostedYourFeedEventNotification) IsNode()                {}
+func (SomeoneRepostedYourFeedEventNotification) IsGroupedNotification() {}
+
 type SomeoneViewedYourGalleryNotification struct {
 	HelperSomeoneViewedYourGalleryNotificationData
 	Dbid               persist.DBID                      `json:"dbid"`
 	Seen               *bool                             `json:"seen"`
+	Archived           *bool                             `json:"archived"`
 	CreationTime       *time.Time                        `json:"creationTime"`
 	UpdatedTime        *time.Time                        `json:"updatedTime"`
 	Count              *int                              `json:"count"`
@@ -1603,6 +1927,20 @@ func (SomeoneViewedYourGalleryNotification) IsNotification()        {}
 func (SomeoneViewedYourGalleryNotification) IsNode()                {}
 func (SomeoneViewedYourGalleryNotification) IsGroupedNotification() {}
 
+type SomeoneWroteANoteOnYourTokenNotification struct {
+	HelperSomeoneWroteANoteOnYourTokenNotificationData
+	Dbid         persist.DBID `json:"dbid"`
+	Seen         *bool        `json:"seen"`
+	Archived     *bool        `json:"archived"`
+	CreationTime *time.Time   `json:"creationTime"`
+	UpdatedTime  *time.Time   `json:"updatedTime"`
+	Collector    *GalleryUser `json:"collector"`
+	Token        *Token       `json:"token"`
+}
+
+func (SomeoneWroteANoteOnYourTokenNotification) IsNotification() {}
+func (SomeoneWroteANoteOnYourTokenNotification) IsNode()         {}
+
 type SyncTokensForUsernamePayload struct {
 	Message string `json:"message"`
 }
@@ -1626,6 +1964,36 @@ type SyncingMedia struct {
 func (SyncingMedia) IsMediaSubtype() {}
 func (SyncingMedia) IsMedia()        {}
 
+type TelegramAuth struct {
+	ID        string  `json:"id"`
+	FirstName *string `json:"firstName"`
+	LastName  *string `json:"lastName"`
+	Username  *string `json:"username"`
+	PhotoURL  *string `json:"photoURL"`
+	AuthDate  int     `json:"authDate"`
+	Hash      string  `json:"hash"`
+}
+
+type TelegramSocialAccount struct {
+	Type            persist.SocialProvider `json:"type"`
+	SocialID        string                 `json:"social_id"`
+	Name            string                 `json:"name"`
+	Username        string                 `json:"username"`
+	ProfileImageURL string                 `json:"profileImageURL"`
+	Display         bool                   `json:"display"`
+}
+
+func (TelegramSocialAccount) IsSocialAccount() {}
+
+type TextEntity struct {
+	HelperTextEntityData
+	Kind    *TextEntityKind `json:"kind"`
+	Start   *int            `json:"start"`
+	Length  *int            `json:"length"`
+	Hashtag *string         `json:"hashtag"`
+	User    *GalleryUser    `json:"user"`
+}
+
 type TextMedia struct {
 	PreviewURLs      *PreviewURLSet   `json:"previewURLs"`
 	MediaURL         *string          `json:"mediaURL"`
@@ -1638,29 +2006,32 @@ func (TextMedia) IsMediaSubtype() {}
 func (TextMedia) IsMedia()        {}
 
 type Token struct {
-	Dbid                  persist.DBID          `json:"dbid"`
-	CreationTime          *time.Time            `json:"creationTime"`
-	LastUpdated           *time.Time            `json:"lastUpdated"`
-	CollectorsNote        *string               `json:"collectorsNote"`
-	Media                 MediaSubtype          `json:"media"`
-	TokenType             *TokenType            `json:"tokenType"`
-	Chain                 *persist.Chain        `json:"chain"`
-	Name                  *string               `json:"name"`
-	Description           *string               `json:"description"`
-	TokenID               *string               `json:"tokenId"`
-	Quantity              *string               `json:"quantity"`
-	Owner                 *GalleryUser          `json:"owner"`
-	OwnedByWallets        []*Wallet             `json:"ownedByWallets"`
-	OwnershipHistory      []*OwnerAtBlock       `json:"ownershipHistory"`
-	TokenMetadata         *string               `json:"tokenMetadata"`
-	Contract              *Contract             `json:"contract"`
-	ExternalURL           *string               `json:"externalUrl"`
-	BlockNumber           *string               `json:"blockNumber"`
-	IsSpamByUser          *bool                 `json:"isSpamByUser"`
-	IsSpamByProvider      *bool                 `json:"isSpamByProvider"`
-	CreatorAddress        *persist.ChainAddress `json:"creatorAddress"`
-	OpenseaCollectionName *string               `json:"openseaCollectionName"`
-	OpenseaID             *int                  `json:"openseaId"`
+	Dbid                   persist.DBID                    `json:"dbid"`
+	CreationTime           *time.Time                      `json:"creationTime"`
+	LastUpdated            *time.Time                      `json:"lastUpdated"`
+	CollectorsNote         *string                         `json:"collectorsNote"`
+	CollectorsNoteEntities []*TextEntity                   `json:"collectorsNoteEntities"`
+	Media                  MediaSubtype                    `json:"media"`
+	TokenType              *TokenType                      `json:"tokenType"`
+	Chain                  *persist.Chain                  `json:"chain"`
+	Name                   *string                         `json:"name"`
+	Description            *string                         `json:"description"`
+	TokenID                *string                         `json:"tokenId"`
+	Quantity               *string                         `json:"quantity"`
+	Owner                  *GalleryUser                    `json:"owner"`
+	OwnedByWallets         []*Wallet                       `json:"ownedByWallets"`
+	OwnershipHistory       []*OwnerAtBlock                 `json:"ownershipHistory"`
+	TokenMetadata          *string                         `json:"tokenMetadata"`
+	Contract               *Contract                       `json:"contract"`
+	ExternalURL            *string                         `json:"externalUrl"`
+	BlockNumber            *string                         `json:"blockNumber"`
+	IsSpamByUser           *bool                           `json:"isSpamByUser"`
+	IsSpamByProvider       *bool                           `json:"isSpamByProvider"`
+	CreatorAddress         *persist.ChainAddress           `json:"creatorAddress"`
+	OpenseaCollectionName  *string                         `json:"openseaCollectionName"`
+	OpenseaID              *int                            `json:"openseaId"`
+	ProcessingStatus       *TokenProcessingStatus          `json:"processingStatus"`
+	MetadataHistory        *TokenMetadataHistoryConnection `json:"metadataHistory"`
 }
 
 func (Token) IsNode()             {}
@@ -1689,6 +2060,67 @@ type TokenHoldersConnection struct {
 	PageInfo *PageInfo          `json:"pageInfo"`
 }
 
+type TokenMediaProcessedNotification struct {
+	HelperTokenMediaProcessedNotificationData
+	Dbid         persist.DBID `json:"dbid"`
+	Seen         *bool        `json:"seen"`
+	Archived     *bool        `json:"archived"`
+	CreationTime *time.Time   `json:"creationTime"`
+	UpdatedTime  *time.Time   `json:"updatedTime"`
+	Succeeded    *bool        `json:"succeeded"`
+	Token        *Token       `json:"token"`
+}
+
+func (TokenMediaProcessedNotification) IsNotification() {}
+func (TokenMediaProcessedNotification) IsNode()         {}
+
+type TokenMetadataHistoryConnection struct {
+	Edges    []*TokenMetadataHistoryEdge `json:"edges"`
+	PageInfo *PageInfo                   `json:"pageInfo"`
+}
+
+type TokenMetadataHistoryEdge struct {
+	Node   *TokenMetadataSnapshot `json:"node"`
+	Cursor *string                `json:"cursor"`
+}
+
+type TokenMetadataSnapshot struct {
+	Dbid          persist.DBID `json:"dbid"`
+	TokenMetadata *string      `json:"tokenMetadata"`
+	Media         MediaSubtype `json:"media"`
+	CreationTime  *time.Time   `json:"creationTime"`
+}
+
+type TokenModeration struct {
+	HelperTokenModerationData
+	Dbid         persist.DBID                   `json:"dbid"`
+	Token        *Token                         `json:"token"`
+	Flags        []string                       `json:"flags"`
+	Status       *persist.TokenModerationStatus `json:"status"`
+	ReviewedAt   *time.Time                     `json:"reviewedAt"`
+	CreationTime *time.Time                     `json:"creationTime"`
+	LastUpdated  *time.Time                     `json:"lastUpdated"`
+}
+
+type TokenProcessingStatus struct {
+	Status        *persist.TokenProcessingJobStatus `json:"status"`
+	Attempts      *int                              `json:"attempts"`
+	LastError     *string                           `json:"lastError"`
+	NextAttemptAt *time.Time                        `json:"nextAttemptAt"`
+	LastUpdated   *time.Time                        `json:"lastUpdated"`
+}
+
+type TokensAcquiredFeedEventData struct {
+	HelperTokensAcquiredFeedEventDataData
+	EventTime      *time.Time      `json:"eventTime"`
+	Owner          *GalleryUser    `json:"owner"`
+	Action         *persist.Action `json:"action"`
+	AcquiredTokens []*Token        `json:"acquiredTokens"`
+	MintedTokens   []*Token        `json:"mintedTokens"`
+}
+
+func (TokensAcquiredFeedEventData) IsFeedEventData() {}
+
 type TokensAddedToCollectionFeedEventData struct {
 	HelperTokensAddedToCollectionFeedEventDataData
 	EventTime  *time.Time         `json:"eventTime"`
@@ -1755,6 +2187,17 @@ type UnknownMedia struct {
 func (UnknownMedia) IsMediaSubtype() {}
 func (UnknownMedia) IsMedia()        {}
 
+type UnregisterPushDeviceInput struct {
+	Platform PushPlatform `json:"platform"`
+	Token    string       `json:"token"`
+}
+
+type UnregisterPushDevicePayload struct {
+	Viewer *Viewer `json:"viewer"`
+}
+
+func (UnregisterPushDevicePayload) IsUnregisterPushDevicePayloadOrError() {}
+
 type UnsubscribeFromEmailTypeInput struct {
 	Type  EmailUnsubscriptionType `json:"type"`
 	Token string                  `json:"token"`
@@ -1819,8 +2262,11 @@ type UpdateEmailInput struct {
 }
 
 type UpdateEmailNotificationSettingsInput struct {
-	UnsubscribedFromAll           bool `json:"unsubscribedFromAll"`
-	UnsubscribedFromNotifications bool `json:"unsubscribedFromNotifications"`
+	UnsubscribedFromAll           bool    `json:"unsubscribedFromAll"`
+	UnsubscribedFromNotifications bool    `json:"unsubscribedFromNotifications"`
+	UnsubscribedFromDigest        *bool   `json:"unsubscribedFromDigest"`
+	DigestTimezone                *string `json:"digestTimezone"`
+	Locale                        *string `json:"locale"`
 }
 
 type UpdateEmailNotificationSettingsPayload struct {
@@ -1841,6 +2287,12 @@ type UpdateFeaturedGalleryPayload struct {
 
 func (UpdateFeaturedGalleryPayload) IsUpdateFeaturedGalleryPayloadOrError() {}
 
+type UpdateFeedEventCaptionPayload struct {
+	FeedEvent *FeedEvent `json:"feedEvent"`
+}
+
+func (UpdateFeedEventCaptionPayload) IsUpdateFeedEventCaptionPayloadOrError() {}
+
 type UpdateGalleryCollectionsInput struct {
 	GalleryID   persist.DBID   `json:"galleryId"`
 	Collections []persist.DBID `json:"collections"`
@@ -1879,6 +2331,7 @@ type UpdateGalleryInput struct {
 	GalleryID          persist.DBID                      `json:"galleryId"`
 	Name               *string                           `json:"name"`
 	Description        *string                           `json:"description"`
+	Draft              *bool                             `json:"draft"`
 	Caption            *string                           `json:"caption"`
 	DeletedCollections []persist.DBID                    `json:"deletedCollections"`
 	UpdatedCollections []*UpdateCollectionInput          `json:"updatedCollections"`
@@ -1903,6 +2356,13 @@ type UpdateGalleryPayload struct {
 
 func (UpdateGalleryPayload) IsUpdateGalleryPayloadOrError() {}
 
+type UpdateNotificationPayload struct {
+	Notification Notification `json:"notification"`
+	UnseenCount  *int         `json:"unseenCount"`
+}
+
+func (UpdateNotificationPayload) IsUpdateNotificationPayloadOrError() {}
+
 type UpdatePrimaryWalletPayload struct {
 	Viewer *Viewer `json:"viewer"`
 }
@@ -2046,11 +2506,15 @@ type Viewer struct {
 	SocialAccounts  *SocialAccounts  `json:"socialAccounts"`
 	ViewerGalleries []*ViewerGallery `json:"viewerGalleries"`
 	Feed            *FeedConnection  `json:"feed"`
-	Email           *UserEmail       `json:"email"`
+	// Returns the viewer's feed ranked by relevance, with the most relevant events last.
+	// Cursors are tied to the point in time the feed was ranked, so pages stay consistent while paging.
+	ForYouFeed *FeedConnection `json:"forYouFeed"`
+	Email      *UserEmail      `json:"email"`
 	// Returns a list of notifications in reverse chronological order.
 	// Seen notifications come after unseen notifications
 	Notifications        *NotificationsConnection `json:"notifications"`
 	NotificationSettings *NotificationSettings    `json:"notificationSettings"`
+	Webhooks             []*Webhook               `json:"webhooks"`
 	UserExperiences      []*UserExperience        `json:"userExperiences"`
 	SuggestedUsers       *UsersConnection         `json:"suggestedUsers"`
 }
@@ -2075,21 +2539,47 @@ type Wallet struct {
 func (Wallet) IsNode()                {}
 func (Wallet) IsGalleryUserOrWallet() {}
 
+type Webhook struct {
+	HelperWebhookData
+	Dbid         persist.DBID       `json:"dbid"`
+	URL          *string            `json:"url"`
+	Actions      []string           `json:"actions"`
+	Active       *bool              `json:"active"`
+	CreationTime *time.Time         `json:"creationTime"`
+	Deliveries   []*WebhookDelivery `json:"deliveries"`
+}
+
+type WebhookDelivery struct {
+	Dbid           persist.DBID                   `json:"dbid"`
+	Action         *string                        `json:"action"`
+	Status         *persist.WebhookDeliveryStatus `json:"status"`
+	Attempts       *int                           `json:"attempts"`
+	ResponseStatus *int                           `json:"responseStatus"`
+	LastError      *string                        `json:"lastError"`
+	Payload        *string                        `json:"payload"`
+	ReplayOf       *persist.DBID                  `json:"replayOf"`
+	CreationTime   *time.Time                     `json:"creationTime"`
+	NextAttemptAt  *time.Time                     `json:"nextAttemptAt"`
+	DeliveredAt    *time.Time                     `json:"deliveredAt"`
+}
+
 type EmailUnsubscriptionType string
 
 const (
 	EmailUnsubscriptionTypeAll           EmailUnsubscriptionType = "All"
 	EmailUnsubscriptionTypeNotifications EmailUnsubscriptionType = "Notifications"
+	EmailUnsubscriptionTypeDigest        EmailUnsubscriptionType = "Digest"
 )
 
 var AllEmailUnsubscriptionType = []EmailUnsubscriptionType{
 	EmailUnsubscriptionTypeAll,
 	EmailUnsubscriptionTypeNotifications,
+	EmailUnsubscriptionTypeDigest,
 }
 
 func (e EmailUnsubscriptionType) IsValid() bool {
 	switch e {
-	case EmailUnsubscriptionTypeAll, EmailUnsubscriptionTypeNotifications:
+	case EmailUnsubscriptionTypeAll, EmailUnsubscriptionTypeNotifications, EmailUnsubscriptionTypeDigest:
 		return true
 	}
 	return false
@@ -2116,6 +2606,51 @@ func (e EmailUnsubscriptionType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type MentionSource string
+
+const (
+	MentionSourceComment                  MentionSource = "Comment"
+	MentionSourceCaption                  MentionSource = "Caption"
+	MentionSourceCollectionCollectorsNote MentionSource = "CollectionCollectorsNote"
+	MentionSourceTokenCollectorsNote      MentionSource = "TokenCollectorsNote"
+)
+
+var AllMentionSource = []MentionSource{
+	MentionSourceComment,
+	MentionSourceCaption,
+	MentionSourceCollectionCollectorsNote,
+	MentionSourceTokenCollectorsNote,
+}
+
+func (e MentionSource) IsValid() bool {
+	switch e {
+	case MentionSourceComment, MentionSourceCaption, MentionSourceCollectionCollectorsNote, MentionSourceTokenCollectorsNote:
+		return true
+	}
+	return false
+}
+
+func (e MentionSource) String() string {
+	return string(e)
+}
+
+func (e *MentionSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = MentionSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid MentionSource", str)
+	}
+	return nil
+}
+
+func (e MentionSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type MerchType string
 
 const (
@@ -2159,6 +2694,104 @@ func (e MerchType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type NotificationDelivery string
+
+const (
+	NotificationDeliveryInstant NotificationDelivery = "Instant"
+	NotificationDeliveryDigest  NotificationDelivery = "Digest"
+)
+
+var AllNotificationDelivery = []NotificationDelivery{
+	NotificationDeliveryInstant,
+	NotificationDeliveryDigest,
+}
+
+func (e NotificationDelivery) IsValid() bool {
+	switch e {
+	case NotificationDeliveryInstant, NotificationDeliveryDigest:
+		return true
+	}
+	return false
+}
+
+func (e NotificationDelivery) String() string {
+	return string(e)
+}
+
+func (e *NotificationDelivery) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationDelivery(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationDelivery", str)
+	}
+	return nil
+}
+
+func (e NotificationDelivery) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type NotificationType string
+
+const (
+	NotificationTypeSomeoneFollowedYou           NotificationType = "SomeoneFollowedYou"
+	NotificationTypeSomeoneAdmiredYourUpdate     NotificationType = "SomeoneAdmiredYourUpdate"
+	NotificationTypeSomeoneRepostedYourUpdate    NotificationType = "SomeoneRepostedYourUpdate"
+	NotificationTypeSomeoneCommentedOnYourUpdate NotificationType = "SomeoneCommentedOnYourUpdate"
+	NotificationTypeSomeoneViewedYourGallery     NotificationType = "SomeoneViewedYourGallery"
+	NotificationTypeSomeoneMentionedYou          NotificationType = "SomeoneMentionedYou"
+	NotificationTypeMintedTokenAddedToGallery    NotificationType = "MintedTokenAddedToGallery"
+	NotificationTypeCollectorsNoteOnYourToken    NotificationType = "CollectorsNoteOnYourToken"
+	NotificationTypeCommunityMemberJoined        NotificationType = "CommunityMemberJoined"
+	NotificationTypeTokenMediaProcessed          NotificationType = "TokenMediaProcessed"
+)
+
+var AllNotificationType = []NotificationType{
+	NotificationTypeSomeoneFollowedYou,
+	NotificationTypeSomeoneAdmiredYourUpdate,
+	NotificationTypeSomeoneRepostedYourUpdate,
+	NotificationTypeSomeoneCommentedOnYourUpdate,
+	NotificationTypeSomeoneViewedYourGallery,
+	NotificationTypeSomeoneMentionedYou,
+	NotificationTypeMintedTokenAddedToGallery,
+	NotificationTypeCollectorsNoteOnYourToken,
+	NotificationTypeCommunityMemberJoined,
+	NotificationTypeTokenMediaProcessed,
+}
+
+func (e NotificationType) IsValid() bool {
+	switch e {
+	case NotificationTypeSomeoneFollowedYou, NotificationTypeSomeoneAdmiredYourUpdate, NotificationTypeSomeoneRepostedYourUpdate, NotificationTypeSomeoneCommentedOnYourUpdate, NotificationTypeSomeoneViewedYourGallery, NotificationTypeSomeoneMentionedYou, NotificationTypeMintedTokenAddedToGallery, NotificationTypeCollectorsNoteOnYourToken, NotificationTypeCommunityMemberJoined, NotificationTypeTokenMediaProcessed:
+		return true
+	}
+	return false
+}
+
+func (e NotificationType) String() string {
+	return string(e)
+}
+
+func (e *NotificationType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = NotificationType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid NotificationType", str)
+	}
+	return nil
+}
+
+func (e NotificationType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type PreverifyEmailResult string
 
 const (
@@ -2202,6 +2835,90 @@ func (e PreverifyEmailResult) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type PushPlatform string
+
+const (
+	PushPlatformWeb     PushPlatform = "Web"
+	PushPlatformIos     PushPlatform = "IOS"
+	PushPlatformAndroid PushPlatform = "Android"
+)
+
+var AllPushPlatform = []PushPlatform{
+	PushPlatformWeb,
+	PushPlatformIos,
+	PushPlatformAndroid,
+}
+
+func (e PushPlatform) IsValid() bool {
+	switch e {
+	case PushPlatformWeb, PushPlatformIos, PushPlatformAndroid:
+		return true
+	}
+	return false
+}
+
+func (e PushPlatform) String() string {
+	return string(e)
+}
+
+func (e *PushPlatform) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = PushPlatform(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid PushPlatform", str)
+	}
+	return nil
+}
+
+func (e PushPlatform) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type TextEntityKind string
+
+const (
+	TextEntityKindMention TextEntityKind = "Mention"
+	TextEntityKindHashtag TextEntityKind = "Hashtag"
+)
+
+var AllTextEntityKind = []TextEntityKind{
+	TextEntityKindMention,
+	TextEntityKindHashtag,
+}
+
+func (e TextEntityKind) IsValid() bool {
+	switch e {
+	case TextEntityKindMention, TextEntityKindHashtag:
+		return true
+	}
+	return false
+}
+
+func (e TextEntityKind) String() string {
+	return string(e)
+}
+
+func (e *TextEntityKind) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TextEntityKind(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TextEntityKind", str)
+	}
+	return nil
+}
+
+func (e TextEntityKind) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type TokenType string
 
 const (
@@ -73,6 +73,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"CreateWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(CreateWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"DeepRefreshPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DeepRefreshPayloadOrError)
 		return obj, ok
@@ -83,11 +88,21 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"DeleteFeedEventPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteFeedEventPayloadOrError)
+		return obj, ok
+	},
+
 	"DeleteGalleryPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DeleteGalleryPayloadOrError)
 		return obj, ok
 	},
 
+	"DeleteWebhookPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(DeleteWebhookPayloadOrError)
+		return obj, ok
+	},
+
 	"DisconnectSocialAccountPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(DisconnectSocialAccountPayloadOrError)
 		return obj, ok
@@ -158,6 +173,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"MarkAllNotificationsReadPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(MarkAllNotificationsReadPayloadOrError)
+		return obj, ok
+	},
+
 	"Media": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(Media)
 		return obj, ok
@@ -223,6 +243,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"RegisterPushDevicePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RegisterPushDevicePayloadOrError)
+		return obj, ok
+	},
+
 	"RemoveAdmirePayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(RemoveAdmirePayloadOrError)
 		return obj, ok
@@ -238,11 +263,26 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"ReplayWebhookDeliveryPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReplayWebhookDeliveryPayloadOrError)
+		return obj, ok
+	},
+
+	"RepostFeedEventPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(RepostFeedEventPayloadOrError)
+		return obj, ok
+	},
+
 	"ResendVerificationEmailPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(ResendVerificationEmailPayloadOrError)
 		return obj, ok
 	},
 
+	"ReviewTokenModerationPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(ReviewTokenModerationPayloadOrError)
+		return obj, ok
+	},
+
 	"RevokeRolesFromUserPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(RevokeRolesFromUserPayloadOrError)
 		return obj, ok
@@ -263,6 +303,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"SetFeedEventHiddenPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(SetFeedEventHiddenPayloadOrError)
+		return obj, ok
+	},
+
 	"SetSpamPreferencePayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(SetSpamPreferencePayloadOrError)
 		return obj, ok
@@ -313,6 +358,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UnregisterPushDevicePayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UnregisterPushDevicePayloadOrError)
+		return obj, ok
+	},
+
 	"UnsubscribeFromEmailTypePayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UnsubscribeFromEmailTypePayloadOrError)
 		return obj, ok
@@ -348,6 +398,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdateFeedEventCaptionPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateFeedEventCaptionPayloadOrError)
+		return obj, ok
+	},
+
 	"UpdateGalleryCollectionsPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UpdateGalleryCollectionsPayloadOrError)
 		return obj, ok
@@ -373,6 +428,11 @@ var typeConversionMap = map[string]func(object interface{}) (objectAsType interf
 		return obj, ok
 	},
 
+	"UpdateNotificationPayloadOrError": func(object interface{}) (interface{}, bool) {
+		obj, ok := object.(UpdateNotificationPayloadOrError)
+		return obj, ok
+	},
+
 	"UpdatePrimaryWalletPayloadOrError": func(object interface{}) (interface{}, bool) {
 		obj, ok := object.(UpdatePrimaryWalletPayloadOrError)
 		return obj, ok
@@ -7,6 +7,7 @@ package graphql
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
@@ -15,6 +16,7 @@ import (
 	"github.com/mikeydub/go-gallery/graphql/model"
 	"github.com/mikeydub/go-gallery/publicapi"
 	"github.com/mikeydub/go-gallery/service/emails"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/mediamapper"
 	"github.com/mikeydub/go-gallery/service/persist"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
@@ -41,6 +43,11 @@ func (r *admireFeedEventPayloadResolver) FeedEvent(ctx context.Context, obj *mod
 	return resolveFeedEventByEventID(ctx, admire.FeedEventID)
 }
 
+// CollectorsNoteEntities is the resolver for the collectorsNoteEntities field.
+func (r *collectionResolver) CollectorsNoteEntities(ctx context.Context, obj *model.Collection) ([]*model.TextEntity, error) {
+	return resolveTextEntitiesBySource(ctx, entity.SourceCollectionCollectorsNote, obj.Dbid)
+}
+
 // Gallery is the resolver for the gallery field.
 func (r *collectionResolver) Gallery(ctx context.Context, obj *model.Collection) (*model.Gallery, error) {
 	gallery, err := publicapi.For(ctx).Gallery.GetGalleryByCollectionId(ctx, obj.Dbid)
@@ -151,6 +158,11 @@ func (r *commentResolver) Commenter(ctx context.Context, obj *model.Comment) (*m
 	return resolveGalleryUserByUserID(ctx, obj.Commenter.Dbid)
 }
 
+// Entities is the resolver for the entities field.
+func (r *commentResolver) Entities(ctx context.Context, obj *model.Comment) ([]*model.TextEntity, error) {
+	return resolveTextEntitiesBySource(ctx, entity.SourceComment, obj.Dbid)
+}
+
 // Comment is the resolver for the comment field.
 func (r *commentOnFeedEventPayloadResolver) Comment(ctx context.Context, obj *model.CommentOnFeedEventPayload) (*model.Comment, error) {
 	return resolveCommentByCommentID(ctx, obj.Comment.Dbid)
@@ -197,6 +209,16 @@ func (r *communityResolver) Owners(ctx context.Context, obj *model.Community, be
 	return resolveCommunityOwnersByContractID(ctx, obj.Dbid, before, after, first, last, onlyGalleryUsers)
 }
 
+// Community is the resolver for the community field.
+func (r *communityMemberJoinedNotificationResolver) Community(ctx context.Context, obj *model.CommunityMemberJoinedNotification) (*model.Community, error) {
+	return resolveCommunityByContractID(ctx, obj.NotificationData.CommunityContractID)
+}
+
+// NewMembers is the resolver for the newMembers field.
+func (r *communityMemberJoinedNotificationResolver) NewMembers(ctx context.Context, obj *model.CommunityMemberJoinedNotification, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
+	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.NewMemberIDs, before, after, first, last)
+}
+
 // FeedEvent is the resolver for the feedEvent field.
 func (r *createCollectionPayloadResolver) FeedEvent(ctx context.Context, obj *model.CreateCollectionPayload) (*model.FeedEvent, error) {
 	if obj.FeedEvent.Dbid == "" {
@@ -252,6 +274,11 @@ func (r *feedEventResolver) Comments(ctx context.Context, obj *model.FeedEvent,
 	}, nil
 }
 
+// CaptionEntities is the resolver for the captionEntities field.
+func (r *feedEventResolver) CaptionEntities(ctx context.Context, obj *model.FeedEvent) ([]*model.TextEntity, error) {
+	return resolveTextEntitiesBySource(ctx, entity.SourceCaption, obj.Dbid)
+}
+
 // Interactions is the resolver for the interactions field.
 func (r *feedEventResolver) Interactions(ctx context.Context, obj *model.FeedEvent, before *string, after *string, first *int, last *int, typeFilter []persist.InteractionType) (*model.FeedEventInteractionsConnection, error) {
 	interactions, pageInfo, err := publicapi.For(ctx).Interaction.PaginateInteractionsByFeedEventID(ctx, obj.Dbid, before, after, first, last, typeFilter)
@@ -1130,6 +1157,82 @@ func (r *mutationResolver) RemoveComment(ctx context.Context, commentID persist.
 	return output, nil
 }
 
+// UpdateFeedEventCaption is the resolver for the updateFeedEventCaption field.
+func (r *mutationResolver) UpdateFeedEventCaption(ctx context.Context, feedEventID persist.DBID, caption string) (model.UpdateFeedEventCaptionPayloadOrError, error) {
+	feedEvent, err := publicapi.For(ctx).Feed.UpdateFeedEventCaption(ctx, feedEventID, caption)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := feedEventToModel(feedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.UpdateFeedEventCaptionPayload{
+		FeedEvent: event,
+	}
+
+	return output, nil
+}
+
+// SetFeedEventHidden is the resolver for the setFeedEventHidden field.
+func (r *mutationResolver) SetFeedEventHidden(ctx context.Context, feedEventID persist.DBID, hidden bool) (model.SetFeedEventHiddenPayloadOrError, error) {
+	feedEvent, err := publicapi.For(ctx).Feed.SetFeedEventHidden(ctx, feedEventID, hidden)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := feedEventToModel(feedEvent)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.SetFeedEventHiddenPayload{
+		FeedEvent: event,
+	}
+
+	return output, nil
+}
+
+// DeleteFeedEvent is the resolver for the deleteFeedEvent field.
+func (r *mutationResolver) DeleteFeedEvent(ctx context.Context, feedEventID persist.DBID) (model.DeleteFeedEventPayloadOrError, error) {
+	err := publicapi.For(ctx).Feed.DeleteFeedEvent(ctx, feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.DeleteFeedEventPayload{
+		DeletedID: &model.DeletedNode{
+			Dbid: feedEventID,
+		},
+	}
+
+	return output, nil
+}
+
+// RepostFeedEvent is the resolver for the repostFeedEvent field.
+func (r *mutationResolver) RepostFeedEvent(ctx context.Context, feedEventID persist.DBID, quote *string) (model.RepostFeedEventPayloadOrError, error) {
+	feedEvent, err := publicapi.For(ctx).Interaction.RepostFeedEvent(ctx, feedEventID, quote)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.RepostFeedEventPayload{
+		Viewer: resolveViewer(ctx),
+	}
+
+	// The repost won't have a feed event if the viewer is blocked from posting to the feed
+	if feedEvent != nil {
+		output.FeedEvent, err = feedEventToModel(feedEvent)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return output, nil
+}
+
 // ViewGallery is the resolver for the viewGallery field.
 func (r *mutationResolver) ViewGallery(ctx context.Context, galleryID persist.DBID) (model.ViewGalleryPayloadOrError, error) {
 	gallery, err := publicapi.For(ctx).Gallery.ViewGallery(ctx, galleryID)
@@ -1272,13 +1375,9 @@ func (r *mutationResolver) ClearAllNotifications(ctx context.Context) (*model.Cl
 		return nil, err
 	}
 
-	models := make([]model.Notification, len(notifications))
-	for i, n := range notifications {
-		model, err := notificationToModel(n)
-		if err != nil {
-			return nil, err
-		}
-		models[i] = model
+	models, err := notificationsToModels(notifications)
+	if err != nil {
+		return nil, err
 	}
 
 	output := &model.ClearAllNotificationsPayload{
@@ -1287,6 +1386,63 @@ func (r *mutationResolver) ClearAllNotifications(ctx context.Context) (*model.Cl
 	return output, nil
 }
 
+// MarkNotificationRead is the resolver for the markNotificationRead field.
+func (r *mutationResolver) MarkNotificationRead(ctx context.Context, notificationID persist.DBID) (model.UpdateNotificationPayloadOrError, error) {
+	notif, err := publicapi.For(ctx).Notifications.SetNotificationSeen(ctx, notificationID, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateNotificationPayload(ctx, *notif)
+}
+
+// MarkNotificationUnread is the resolver for the markNotificationUnread field.
+func (r *mutationResolver) MarkNotificationUnread(ctx context.Context, notificationID persist.DBID) (model.UpdateNotificationPayloadOrError, error) {
+	notif, err := publicapi.For(ctx).Notifications.SetNotificationSeen(ctx, notificationID, false)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateNotificationPayload(ctx, *notif)
+}
+
+// ArchiveNotification is the resolver for the archiveNotification field.
+func (r *mutationResolver) ArchiveNotification(ctx context.Context, notificationID persist.DBID) (model.UpdateNotificationPayloadOrError, error) {
+	notif, err := publicapi.For(ctx).Notifications.ArchiveNotification(ctx, notificationID)
+	if err != nil {
+		return nil, err
+	}
+
+	return updateNotificationPayload(ctx, *notif)
+}
+
+// MarkAllNotificationsReadBefore is the resolver for the markAllNotificationsReadBefore field.
+func (r *mutationResolver) MarkAllNotificationsReadBefore(ctx context.Context, before time.Time) (model.MarkAllNotificationsReadPayloadOrError, error) {
+	api := publicapi.For(ctx).Notifications
+
+	notifications, err := api.MarkNotificationsSeenBefore(ctx, before)
+	if err != nil {
+		return nil, err
+	}
+
+	models, err := notificationsToModels(notifications)
+	if err != nil {
+		return nil, err
+	}
+
+	unseenCount, err := api.GetViewerUnseenNotificationCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.MarkAllNotificationsReadPayload{
+		Notifications: models,
+		UnseenCount:   &unseenCount,
+	}
+
+	return output, nil
+}
+
 // UpdateNotificationSettings is the resolver for the updateNotificationSettings field.
 func (r *mutationResolver) UpdateNotificationSettings(ctx context.Context, settings *model.NotificationSettingsInput) (*model.NotificationSettings, error) {
 	err := publicapi.For(ctx).User.UpdateUserNotificationSettings(ctx, persist.UserNotificationSettings{
@@ -1294,6 +1450,9 @@ func (r *mutationResolver) UpdateNotificationSettings(ctx context.Context, setti
 		SomeoneAdmiredYourUpdate:     settings.SomeoneAdmiredYourUpdate,
 		SomeoneCommentedOnYourUpdate: settings.SomeoneCommentedOnYourUpdate,
 		SomeoneViewedYourGallery:     settings.SomeoneViewedYourGallery,
+		SomeoneMentionedYou:          settings.SomeoneMentionedYou,
+		Preferences:                  notificationPreferencesFromInput(settings.Preferences),
+		QuietHours:                   quietHoursFromInput(settings.QuietHours),
 	})
 	if err != nil {
 		return nil, err
@@ -1301,6 +1460,64 @@ func (r *mutationResolver) UpdateNotificationSettings(ctx context.Context, setti
 	return resolveViewerNotificationSettings(ctx)
 }
 
+// RegisterPushDevice is the resolver for the registerPushDevice field.
+func (r *mutationResolver) RegisterPushDevice(ctx context.Context, input model.RegisterPushDeviceInput) (model.RegisterPushDevicePayloadOrError, error) {
+	device, err := publicapi.For(ctx).Notifications.RegisterPushDevice(ctx, pushPlatforms[input.Platform], input.Token, util.FromPointer(input.P256dh), util.FromPointer(input.Auth))
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.RegisterPushDevicePayload{
+		Device: pushDeviceToModel(*device),
+	}
+
+	return output, nil
+}
+
+// UnregisterPushDevice is the resolver for the unregisterPushDevice field.
+func (r *mutationResolver) UnregisterPushDevice(ctx context.Context, input model.UnregisterPushDeviceInput) (model.UnregisterPushDevicePayloadOrError, error) {
+	err := publicapi.For(ctx).Notifications.UnregisterPushDevice(ctx, pushPlatforms[input.Platform], input.Token)
+	if err != nil {
+		return nil, err
+	}
+
+	output := &model.UnregisterPushDevicePayload{
+		Viewer: resolveViewer(ctx),
+	}
+
+	return output, nil
+}
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, input model.CreateWebhookInput) (model.CreateWebhookPayloadOrError, error) {
+	hook, err := publicapi.For(ctx).Webhook.CreateWebhook(ctx, input.URL, webhookActionsFromModel(input.Actions))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CreateWebhookPayload{Webhook: webhookToModel(*hook), Secret: &hook.Secret}, nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, webhookID persist.DBID) (model.DeleteWebhookPayloadOrError, error) {
+	err := publicapi.For(ctx).Webhook.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DeleteWebhookPayload{WebhookID: &webhookID}, nil
+}
+
+// ReplayWebhookDelivery is the resolver for the replayWebhookDelivery field.
+func (r *mutationResolver) ReplayWebhookDelivery(ctx context.Context, deliveryID persist.DBID) (model.ReplayWebhookDeliveryPayloadOrError, error) {
+	delivery, err := publicapi.For(ctx).Webhook.ReplayWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ReplayWebhookDeliveryPayload{Delivery: webhookDeliveryToModel(*delivery)}, nil
+}
+
 // PreverifyEmail is the resolver for the preverifyEmail field.
 func (r *mutationResolver) PreverifyEmail(ctx context.Context, input model.PreverifyEmailInput) (model.PreverifyEmailPayloadOrError, error) {
 	// todo we could have the frontend send the source? right now I don't see any other sources of verification other than signing up
@@ -1433,6 +1650,36 @@ func (r *mutationResolver) BanUserFromFeed(ctx context.Context, username string,
 	return model.BanUserFromFeedPayload{User: userToModel(ctx, *user)}, nil
 }
 
+// CreateAdminWebhook is the resolver for the createAdminWebhook field.
+func (r *mutationResolver) CreateAdminWebhook(ctx context.Context, input model.CreateWebhookInput) (model.CreateWebhookPayloadOrError, error) {
+	hook, err := publicapi.For(ctx).Admin.CreateWebhook(ctx, input.URL, webhookActionsFromModel(input.Actions))
+	if err != nil {
+		return nil, err
+	}
+
+	return model.CreateWebhookPayload{Webhook: webhookToModel(*hook), Secret: &hook.Secret}, nil
+}
+
+// DeleteAdminWebhook is the resolver for the deleteAdminWebhook field.
+func (r *mutationResolver) DeleteAdminWebhook(ctx context.Context, webhookID persist.DBID) (model.DeleteWebhookPayloadOrError, error) {
+	err := publicapi.For(ctx).Admin.DeleteWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.DeleteWebhookPayload{WebhookID: &webhookID}, nil
+}
+
+// ReplayAdminWebhookDelivery is the resolver for the replayAdminWebhookDelivery field.
+func (r *mutationResolver) ReplayAdminWebhookDelivery(ctx context.Context, deliveryID persist.DBID) (model.ReplayWebhookDeliveryPayloadOrError, error) {
+	delivery, err := publicapi.For(ctx).Admin.ReplayWebhookDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ReplayWebhookDeliveryPayload{Delivery: webhookDeliveryToModel(*delivery)}, nil
+}
+
 // UnbanUserFromFeed is the resolver for the unbanUserFromFeed field.
 func (r *mutationResolver) UnbanUserFromFeed(ctx context.Context, username string) (model.UnbanUserFromFeedPayloadOrError, error) {
 	user, err := publicapi.For(ctx).User.GetUserByUsername(ctx, username)
@@ -1450,6 +1697,16 @@ func (r *mutationResolver) UnbanUserFromFeed(ctx context.Context, username strin
 	return model.UnbanUserFromFeedPayload{User: userToModel(ctx, *user)}, nil
 }
 
+// ReviewTokenModeration is the resolver for the reviewTokenModeration field.
+func (r *mutationResolver) ReviewTokenModeration(ctx context.Context, moderationID persist.DBID, approved bool) (model.ReviewTokenModerationPayloadOrError, error) {
+	moderation, err := publicapi.For(ctx).Admin.ReviewTokenModeration(ctx, moderationID, approved)
+	if err != nil {
+		return nil, err
+	}
+
+	return model.ReviewTokenModerationPayload{Moderation: tokenModerationToModel(*moderation)}, nil
+}
+
 // MintPremiumCardToWallet is the resolver for the mintPremiumCardToWallet field.
 func (r *mutationResolver) MintPremiumCardToWallet(ctx context.Context, input model.MintPremiumCardToWalletInput) (model.MintPremiumCardToWalletPayloadOrError, error) {
 	tx, err := publicapi.For(ctx).Card.MintPremiumCardToWallet(ctx, input)
@@ -1660,6 +1917,29 @@ func (r *queryResolver) GlobalFeed(ctx context.Context, before *string, after *s
 	}, nil
 }
 
+// WebPushPublicKey is the resolver for the webPushPublicKey field.
+func (r *queryResolver) WebPushPublicKey(ctx context.Context) (*string, error) {
+	return resolveWebPushPublicKey(), nil
+}
+
+// HashtagFeed is the resolver for the hashtagFeed field.
+func (r *queryResolver) HashtagFeed(ctx context.Context, hashtag string, before *string, after *string, first *int, last *int) (*model.FeedConnection, error) {
+	events, pageInfo, err := publicapi.For(ctx).Feed.PaginateHashtagFeed(ctx, hashtag, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges, err := eventsToFeedEdges(events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FeedConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
 // TrendingFeed is the resolver for the trendingFeed field.
 func (r *queryResolver) TrendingFeed(ctx context.Context, before *string, after *string, first *int, last *int) (*model.FeedConnection, error) {
 	events, pageInfo, err := publicapi.For(ctx).Feed.PaginateTrendingFeed(ctx, before, after, first, last)
@@ -1812,6 +2092,31 @@ func (r *queryResolver) UsersByRole(ctx context.Context, role persist.Role, befo
 	}, nil
 }
 
+// TokenModerationQueue is the resolver for the tokenModerationQueue field.
+func (r *queryResolver) TokenModerationQueue(ctx context.Context, limit *int) ([]*model.TokenModeration, error) {
+	moderations, err := publicapi.For(ctx).Admin.GetTokenModerationQueue(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.TokenModeration, len(moderations))
+	for i, moderation := range moderations {
+		models[i] = tokenModerationToModel(moderation)
+	}
+
+	return models, nil
+}
+
+// AdminWebhooks is the resolver for the adminWebhooks field.
+func (r *queryResolver) AdminWebhooks(ctx context.Context) ([]*model.Webhook, error) {
+	hooks, err := publicapi.For(ctx).Admin.GetWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooksToModels(hooks), nil
+}
+
 // SocialConnections is the resolver for the socialConnections field.
 func (r *queryResolver) SocialConnections(ctx context.Context, socialAccountType persist.SocialProvider, excludeAlreadyFollowing *bool, before *string, after *string, first *int, last *int) (*model.SocialConnectionsConnection, error) {
 	connections, pageInfo, err := publicapi.For(ctx).Social.GetConnectionsPaginate(ctx, socialAccountType, before, after, first, last, excludeAlreadyFollowing)
@@ -1845,6 +2150,23 @@ func (r *removeCommentPayloadResolver) FeedEvent(ctx context.Context, obj *model
 	return resolveFeedEventByEventID(ctx, obj.FeedEvent.Dbid)
 }
 
+// Owner is the resolver for the owner field.
+func (r *repostedFeedEventDataResolver) Owner(ctx context.Context, obj *model.RepostedFeedEventData) (*model.GalleryUser, error) {
+	return resolveGalleryUserByUserID(ctx, obj.Owner.Dbid)
+}
+
+// OriginalEvent is the resolver for the originalEvent field.
+func (r *repostedFeedEventDataResolver) OriginalEvent(ctx context.Context, obj *model.RepostedFeedEventData) (*model.FeedEvent, error) {
+	event, err := resolveFeedEventByEventID(ctx, obj.FeedEventID)
+
+	// The original may have been deleted or hidden since it was reposted
+	if _, ok := err.(persist.ErrFeedEventNotFoundByID); ok {
+		return nil, nil
+	}
+
+	return event, err
+}
+
 // Tokens is the resolver for the tokens field.
 func (r *setSpamPreferencePayloadResolver) Tokens(ctx context.Context, obj *model.SetSpamPreferencePayload) ([]*model.Token, error) {
 	tokenIDs := make([]persist.DBID, len(obj.Tokens))
@@ -1883,6 +2205,16 @@ func (r *socialQueriesResolver) SocialConnections(ctx context.Context, obj *mode
 	}, nil
 }
 
+// Collectors is the resolver for the collectors field.
+func (r *someoneAddedYourMintedTokenNotificationResolver) Collectors(ctx context.Context, obj *model.SomeoneAddedYourMintedTokenNotification, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
+	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.CollectorIDs, before, after, first, last)
+}
+
+// Tokens is the resolver for the tokens field.
+func (r *someoneAddedYourMintedTokenNotificationResolver) Tokens(ctx context.Context, obj *model.SomeoneAddedYourMintedTokenNotification) ([]*model.Token, error) {
+	return resolveTokensByTokenIDs(ctx, obj.NotificationData.TokenIDs)
+}
+
 // FeedEvent is the resolver for the feedEvent field.
 func (r *someoneAdmiredYourFeedEventNotificationResolver) FeedEvent(ctx context.Context, obj *model.SomeoneAdmiredYourFeedEventNotification) (*model.FeedEvent, error) {
 	return resolveFeedEventByEventID(ctx, obj.FeedEventID)
@@ -1913,6 +2245,53 @@ func (r *someoneFollowedYouNotificationResolver) Followers(ctx context.Context,
 	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.FollowerIDs, before, after, first, last)
 }
 
+// Mentioner is the resolver for the mentioner field.
+func (r *someoneMentionedYouNotificationResolver) Mentioner(ctx context.Context, obj *model.SomeoneMentionedYouNotification) (*model.GalleryUser, error) {
+	return resolveGalleryUserByUserID(ctx, obj.NotificationData.MentionerID)
+}
+
+// FeedEvent is the resolver for the feedEvent field.
+func (r *someoneMentionedYouNotificationResolver) FeedEvent(ctx context.Context, obj *model.SomeoneMentionedYouNotification) (*model.FeedEvent, error) {
+	if obj.HelperSomeoneMentionedYouNotificationData.FeedEventID == "" {
+		return nil, nil
+	}
+	return resolveFeedEventByEventID(ctx, obj.HelperSomeoneMentionedYouNotificationData.FeedEventID)
+}
+
+// Comment is the resolver for the comment field.
+func (r *someoneMentionedYouNotificationResolver) Comment(ctx context.Context, obj *model.SomeoneMentionedYouNotification) (*model.Comment, error) {
+	if obj.CommentID == "" {
+		return nil, nil
+	}
+	return resolveCommentByCommentID(ctx, obj.CommentID)
+}
+
+// Collection is the resolver for the collection field.
+func (r *someoneMentionedYouNotificationResolver) Collection(ctx context.Context, obj *model.SomeoneMentionedYouNotification) (*model.Collection, error) {
+	if obj.NotificationData.MentionCollectionID == "" {
+		return nil, nil
+	}
+	return resolveCollectionByCollectionID(ctx, obj.NotificationData.MentionCollectionID)
+}
+
+// Token is the resolver for the token field.
+func (r *someoneMentionedYouNotificationResolver) Token(ctx context.Context, obj *model.SomeoneMentionedYouNotification) (*model.Token, error) {
+	if obj.NotificationData.MentionTokenID == "" {
+		return nil, nil
+	}
+	return resolveTokenByTokenID(ctx, obj.NotificationData.MentionTokenID)
+}
+
+// FeedEvent is the resolver for the feedEvent field.
+func (r *someoneRepostedYourFeedEventNotificationResolver) FeedEvent(ctx context.Context, obj *model.SomeoneRepostedYourFeedEventNotification) (*model.FeedEvent, error) {
+	return resolveFeedEventByEventID(ctx, obj.FeedEventID)
+}
+
+// Reposters is the resolver for the reposters field.
+func (r *someoneRepostedYourFeedEventNotificationResolver) Reposters(ctx context.Context, obj *model.SomeoneRepostedYourFeedEventNotification, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
+	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.ReposterIDs, before, after, first, last)
+}
+
 // UserViewers is the resolver for the userViewers field.
 func (r *someoneViewedYourGalleryNotificationResolver) UserViewers(ctx context.Context, obj *model.SomeoneViewedYourGalleryNotification, before *string, after *string, first *int, last *int) (*model.GroupNotificationUsersConnection, error) {
 	return resolveGroupNotificationUsersConnectionByUserIDs(ctx, obj.NotificationData.AuthedViewerIDs, before, after, first, last)
@@ -1923,6 +2302,22 @@ func (r *someoneViewedYourGalleryNotificationResolver) Gallery(ctx context.Conte
 	return resolveGalleryByGalleryID(ctx, obj.GalleryID)
 }
 
+// Collector is the resolver for the collector field.
+func (r *someoneWroteANoteOnYourTokenNotificationResolver) Collector(ctx context.Context, obj *model.SomeoneWroteANoteOnYourTokenNotification) (*model.GalleryUser, error) {
+	if len(obj.NotificationData.CollectorIDs) == 0 {
+		return nil, nil
+	}
+	return resolveGalleryUserByUserID(ctx, obj.NotificationData.CollectorIDs[0])
+}
+
+// Token is the resolver for the token field.
+func (r *someoneWroteANoteOnYourTokenNotificationResolver) Token(ctx context.Context, obj *model.SomeoneWroteANoteOnYourTokenNotification) (*model.Token, error) {
+	if len(obj.NotificationData.TokenIDs) == 0 {
+		return nil, nil
+	}
+	return resolveTokenByTokenID(ctx, obj.NotificationData.TokenIDs[0])
+}
+
 // NewNotification is the resolver for the newNotification field.
 func (r *subscriptionResolver) NewNotification(ctx context.Context) (<-chan model.Notification, error) {
 	return resolveNewNotificationSubscription(ctx), nil
@@ -1933,6 +2328,19 @@ func (r *subscriptionResolver) NotificationUpdated(ctx context.Context) (<-chan
 	return resolveUpdatedNotificationSubscription(ctx), nil
 }
 
+// User is the resolver for the user field.
+func (r *textEntityResolver) User(ctx context.Context, obj *model.TextEntity) (*model.GalleryUser, error) {
+	if obj.UserID == "" {
+		return nil, nil
+	}
+	return resolveGalleryUserByUserID(ctx, obj.UserID)
+}
+
+// CollectorsNoteEntities is the resolver for the collectorsNoteEntities field.
+func (r *tokenResolver) CollectorsNoteEntities(ctx context.Context, obj *model.Token) ([]*model.TextEntity, error) {
+	return resolveTextEntitiesBySource(ctx, entity.SourceTokenCollectorsNote, obj.Dbid)
+}
+
 // Owner is the resolver for the owner field.
 func (r *tokenResolver) Owner(ctx context.Context, obj *model.Token) (*model.GalleryUser, error) {
 	return resolveTokenOwnerByTokenID(ctx, obj.Dbid)
@@ -1961,6 +2369,31 @@ func (r *tokenResolver) Contract(ctx context.Context, obj *model.Token) (*model.
 	return resolveContractByTokenID(ctx, obj.Dbid)
 }
 
+// ProcessingStatus is the resolver for the processingStatus field.
+func (r *tokenResolver) ProcessingStatus(ctx context.Context, obj *model.Token) (*model.TokenProcessingStatus, error) {
+	return resolveTokenProcessingStatusByTokenID(ctx, obj.Dbid)
+}
+
+// MetadataHistory is the resolver for the metadataHistory field.
+func (r *tokenResolver) MetadataHistory(ctx context.Context, obj *model.Token, before *string, after *string, first *int, last *int) (*model.TokenMetadataHistoryConnection, error) {
+	snapshots, pageInfo, err := publicapi.For(ctx).Token.PaginateTokenMetadataHistory(ctx, obj.Dbid, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges := make([]*model.TokenMetadataHistoryEdge, len(snapshots))
+	for i, snapshot := range snapshots {
+		edges[i] = &model.TokenMetadataHistoryEdge{
+			Node: tokenMetadataSnapshotToModel(ctx, snapshot),
+		}
+	}
+
+	return &model.TokenMetadataHistoryConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
 // Wallets is the resolver for the wallets field.
 func (r *tokenHolderResolver) Wallets(ctx context.Context, obj *model.TokenHolder) ([]*model.Wallet, error) {
 	wallets := make([]*model.Wallet, 0, len(obj.WalletIds))
@@ -1979,6 +2412,39 @@ func (r *tokenHolderResolver) User(ctx context.Context, obj *model.TokenHolder)
 	return resolveGalleryUserByUserID(ctx, obj.UserId)
 }
 
+// Token is the resolver for the token field.
+func (r *tokenMediaProcessedNotificationResolver) Token(ctx context.Context, obj *model.TokenMediaProcessedNotification) (*model.Token, error) {
+	if len(obj.NotificationData.TokenIDs) == 0 {
+		return nil, nil
+	}
+	return resolveTokenByTokenID(ctx, obj.NotificationData.TokenIDs[0])
+}
+
+// Token is the resolver for the token field.
+func (r *tokenModerationResolver) Token(ctx context.Context, obj *model.TokenModeration) (*model.Token, error) {
+	token, err := publicapi.For(ctx).Admin.GetModeratedToken(ctx, obj.HelperTokenModerationData.TokenIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokenToModel(ctx, *token), nil
+}
+
+// Owner is the resolver for the owner field.
+func (r *tokensAcquiredFeedEventDataResolver) Owner(ctx context.Context, obj *model.TokensAcquiredFeedEventData) (*model.GalleryUser, error) {
+	return resolveGalleryUserByUserID(ctx, obj.Owner.Dbid)
+}
+
+// AcquiredTokens is the resolver for the acquiredTokens field.
+func (r *tokensAcquiredFeedEventDataResolver) AcquiredTokens(ctx context.Context, obj *model.TokensAcquiredFeedEventData) ([]*model.Token, error) {
+	return resolveTokensByTokenIDs(ctx, obj.AcquiredTokenIDs)
+}
+
+// MintedTokens is the resolver for the mintedTokens field.
+func (r *tokensAcquiredFeedEventDataResolver) MintedTokens(ctx context.Context, obj *model.TokensAcquiredFeedEventData) ([]*model.Token, error) {
+	return resolveTokensByTokenIDs(ctx, obj.MintedTokenIDs)
+}
+
 // Owner is the resolver for the owner field.
 func (r *tokensAddedToCollectionFeedEventDataResolver) Owner(ctx context.Context, obj *model.TokensAddedToCollectionFeedEventData) (*model.GalleryUser, error) {
 	return resolveGalleryUserByUserID(ctx, obj.Owner.Dbid)
@@ -2065,6 +2531,24 @@ func (r *viewerResolver) Feed(ctx context.Context, obj *model.Viewer, before *st
 	}, nil
 }
 
+// ForYouFeed is the resolver for the forYouFeed field.
+func (r *viewerResolver) ForYouFeed(ctx context.Context, obj *model.Viewer, before *string, after *string, first *int, last *int) (*model.FeedConnection, error) {
+	events, pageInfo, err := publicapi.For(ctx).Feed.PaginatePersonalizedFeed(ctx, before, after, first, last)
+	if err != nil {
+		return nil, err
+	}
+
+	edges, err := eventsToFeedEdges(events)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.FeedConnection{
+		Edges:    edges,
+		PageInfo: pageInfoToModel(ctx, pageInfo),
+	}, nil
+}
+
 // Email is the resolver for the email field.
 func (r *viewerResolver) Email(ctx context.Context, obj *model.Viewer) (*model.UserEmail, error) {
 	return resolveViewerEmail(ctx), nil
@@ -2080,6 +2564,16 @@ func (r *viewerResolver) NotificationSettings(ctx context.Context, obj *model.Vi
 	return resolveViewerNotificationSettings(ctx)
 }
 
+// Webhooks is the resolver for the webhooks field.
+func (r *viewerResolver) Webhooks(ctx context.Context, obj *model.Viewer) ([]*model.Webhook, error) {
+	hooks, err := publicapi.For(ctx).Webhook.GetViewerWebhooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return webhooksToModels(hooks), nil
+}
+
 // UserExperiences is the resolver for the userExperiences field.
 func (r *viewerResolver) UserExperiences(ctx context.Context, obj *model.Viewer) ([]*model.UserExperience, error) {
 	return resolveViewerExperiencesByUserID(ctx, obj.UserId)
@@ -2103,6 +2597,11 @@ func (r *walletResolver) Tokens(ctx context.Context, obj *model.Wallet) ([]*mode
 	return resolveTokensByWalletID(ctx, obj.Dbid)
 }
 
+// Deliveries is the resolver for the deliveries field.
+func (r *webhookResolver) Deliveries(ctx context.Context, obj *model.Webhook, limit *int) ([]*model.WebhookDelivery, error) {
+	return resolveWebhookDeliveries(ctx, obj.Dbid, obj.OwnerID, limit)
+}
+
 // Address is the resolver for the address field.
 func (r *chainAddressInputResolver) Address(ctx context.Context, obj *persist.ChainAddress, data persist.Address) error {
 	return obj.GQLSetAddressFromResolver(data)
@@ -2170,6 +2669,11 @@ func (r *Resolver) CommentOnFeedEventPayload() generated.CommentOnFeedEventPaylo
 // Community returns generated.CommunityResolver implementation.
 func (r *Resolver) Community() generated.CommunityResolver { return &communityResolver{r} }
 
+// CommunityMemberJoinedNotification returns generated.CommunityMemberJoinedNotificationResolver implementation.
+func (r *Resolver) CommunityMemberJoinedNotification() generated.CommunityMemberJoinedNotificationResolver {
+	return &communityMemberJoinedNotificationResolver{r}
+}
+
 // CreateCollectionPayload returns generated.CreateCollectionPayloadResolver implementation.
 func (r *Resolver) CreateCollectionPayload() generated.CreateCollectionPayloadResolver {
 	return &createCollectionPayloadResolver{r}
@@ -2224,6 +2728,11 @@ func (r *Resolver) RemoveCommentPayload() generated.RemoveCommentPayloadResolver
 	return &removeCommentPayloadResolver{r}
 }
 
+// RepostedFeedEventData returns generated.RepostedFeedEventDataResolver implementation.
+func (r *Resolver) RepostedFeedEventData() generated.RepostedFeedEventDataResolver {
+	return &repostedFeedEventDataResolver{r}
+}
+
 // SetSpamPreferencePayload returns generated.SetSpamPreferencePayloadResolver implementation.
 func (r *Resolver) SetSpamPreferencePayload() generated.SetSpamPreferencePayloadResolver {
 	return &setSpamPreferencePayloadResolver{r}
@@ -2237,6 +2746,11 @@ func (r *Resolver) SocialConnection() generated.SocialConnectionResolver {
 // SocialQueries returns generated.SocialQueriesResolver implementation.
 func (r *Resolver) SocialQueries() generated.SocialQueriesResolver { return &socialQueriesResolver{r} }
 
+// SomeoneAddedYourMintedTokenNotification returns generated.SomeoneAddedYourMintedTokenNotificationResolver implementation.
+func (r *Resolver) SomeoneAddedYourMintedTokenNotification() generated.SomeoneAddedYourMintedTokenNotificationResolver {
+	return &someoneAddedYourMintedTokenNotificationResolver{r}
+}
+
 // SomeoneAdmiredYourFeedEventNotification returns generated.SomeoneAdmiredYourFeedEventNotificationResolver implementation.
 func (r *Resolver) SomeoneAdmiredYourFeedEventNotification() generated.SomeoneAdmiredYourFeedEventNotificationResolver {
 	return &someoneAdmiredYourFeedEventNotificationResolver{r}
@@ -2257,20 +2771,53 @@ func (r *Resolver) SomeoneFollowedYouNotification() generated.SomeoneFollowedYou
 	return &someoneFollowedYouNotificationResolver{r}
 }
 
+// SomeoneMentionedYouNotification returns generated.SomeoneMentionedYouNotificationResolver implementation.
+func (r *Resolver) SomeoneMentionedYouNotification() generated.SomeoneMentionedYouNotificationResolver {
+	return &someoneMentionedYouNotificationResolver{r}
+}
+
+// SomeoneRepostedYourFeedEventNotification returns generated.SomeoneRepostedYourFeedEventNotificationResolver implementation.
+func (r *Resolver) SomeoneRepostedYourFeedEventNotification() generated.SomeoneRepostedYourFeedEventNotificationResolver {
+	return &someoneRepostedYourFeedEventNotificationResolver{r}
+}
+
 // SomeoneViewedYourGalleryNotification returns generated.SomeoneViewedYourGalleryNotificationResolver implementation.
 func (r *Resolver) SomeoneViewedYourGalleryNotification() generated.SomeoneViewedYourGalleryNotificationResolver {
 	return &someoneViewedYourGalleryNotificationResolver{r}
 }
 
+// SomeoneWroteANoteOnYourTokenNotification returns generated.SomeoneWroteANoteOnYourTokenNotificationResolver implementation.
+func (r *Resolver) SomeoneWroteANoteOnYourTokenNotification() generated.SomeoneWroteANoteOnYourTokenNotificationResolver {
+	return &someoneWroteANoteOnYourTokenNotificationResolver{r}
+}
+
 // Subscription returns generated.SubscriptionResolver implementation.
 func (r *Resolver) Subscription() generated.SubscriptionResolver { return &subscriptionResolver{r} }
 
+// TextEntity returns generated.TextEntityResolver implementation.
+func (r *Resolver) TextEntity() generated.TextEntityResolver { return &textEntityResolver{r} }
+
 // Token returns generated.TokenResolver implementation.
 func (r *Resolver) Token() generated.TokenResolver { return &tokenResolver{r} }
 
 // TokenHolder returns generated.TokenHolderResolver implementation.
 func (r *Resolver) TokenHolder() generated.TokenHolderResolver { return &tokenHolderResolver{r} }
 
+// TokenMediaProcessedNotification returns generated.TokenMediaProcessedNotificationResolver implementation.
+func (r *Resolver) TokenMediaProcessedNotification() generated.TokenMediaProcessedNotificationResolver {
+	return &tokenMediaProcessedNotificationResolver{r}
+}
+
+// TokenModeration returns generated.TokenModerationResolver implementation.
+func (r *Resolver) TokenModeration() generated.TokenModerationResolver {
+	return &tokenModerationResolver{r}
+}
+
+// TokensAcquiredFeedEventData returns generated.TokensAcquiredFeedEventDataResolver implementation.
+func (r *Resolver) TokensAcquiredFeedEventData() generated.TokensAcquiredFeedEventDataResolver {
+	return &tokensAcquiredFeedEventDataResolver{r}
+}
+
 // TokensAddedToCollectionFeedEventData returns generated.TokensAddedToCollectionFeedEventDataResolver implementation.
 func (r *Resolver) TokensAddedToCollectionFeedEventData() generated.TokensAddedToCollectionFeedEventDataResolver {
 	return &tokensAddedToCollectionFeedEventDataResolver{r}
@@ -2302,6 +2849,9 @@ func (r *Resolver) Viewer() generated.ViewerResolver { return &viewerResolver{r}
 // Wallet returns generated.WalletResolver implementation.
 func (r *Resolver) Wallet() generated.WalletResolver { return &walletResolver{r} }
 
+// Webhook returns generated.WebhookResolver implementation.
+func (r *Resolver) Webhook() generated.WebhookResolver { return &webhookResolver{r} }
+
 // ChainAddressInput returns generated.ChainAddressInputResolver implementation.
 func (r *Resolver) ChainAddressInput() generated.ChainAddressInputResolver {
 	return &chainAddressInputResolver{r}
@@ -2323,6 +2873,7 @@ type collectorsNoteAddedToTokenFeedEventDataResolver struct{ *Resolver }
 type commentResolver struct{ *Resolver }
 type commentOnFeedEventPayloadResolver struct{ *Resolver }
 type communityResolver struct{ *Resolver }
+type communityMemberJoinedNotificationResolver struct{ *Resolver }
 type createCollectionPayloadResolver struct{ *Resolver }
 type feedEventResolver struct{ *Resolver }
 type followInfoResolver struct{ *Resolver }
@@ -2337,17 +2888,26 @@ type previewURLSetResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
 type removeAdmirePayloadResolver struct{ *Resolver }
 type removeCommentPayloadResolver struct{ *Resolver }
+type repostedFeedEventDataResolver struct{ *Resolver }
 type setSpamPreferencePayloadResolver struct{ *Resolver }
 type socialConnectionResolver struct{ *Resolver }
 type socialQueriesResolver struct{ *Resolver }
+type someoneAddedYourMintedTokenNotificationResolver struct{ *Resolver }
 type someoneAdmiredYourFeedEventNotificationResolver struct{ *Resolver }
 type someoneCommentedOnYourFeedEventNotificationResolver struct{ *Resolver }
 type someoneFollowedYouBackNotificationResolver struct{ *Resolver }
 type someoneFollowedYouNotificationResolver struct{ *Resolver }
+type someoneMentionedYouNotificationResolver struct{ *Resolver }
+type someoneRepostedYourFeedEventNotificationResolver struct{ *Resolver }
 type someoneViewedYourGalleryNotificationResolver struct{ *Resolver }
+type someoneWroteANoteOnYourTokenNotificationResolver struct{ *Resolver }
 type subscriptionResolver struct{ *Resolver }
+type textEntityResolver struct{ *Resolver }
 type tokenResolver struct{ *Resolver }
 type tokenHolderResolver struct{ *Resolver }
+type tokenMediaProcessedNotificationResolver struct{ *Resolver }
+type tokenModerationResolver struct{ *Resolver }
+type tokensAcquiredFeedEventDataResolver struct{ *Resolver }
 type tokensAddedToCollectionFeedEventDataResolver struct{ *Resolver }
 type unfollowUserPayloadResolver struct{ *Resolver }
 type updateCollectionTokensPayloadResolver struct{ *Resolver }
@@ -2355,5 +2915,6 @@ type userCreatedFeedEventDataResolver struct{ *Resolver }
 type userFollowedUsersFeedEventDataResolver struct{ *Resolver }
 type viewerResolver struct{ *Resolver }
 type walletResolver struct{ *Resolver }
+type webhookResolver struct{ *Resolver }
 type chainAddressInputResolver struct{ *Resolver }
 type chainPubKeyInputResolver struct{ *Resolver }
@@ -6,20 +6,25 @@ package graphql
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/gammazero/workerpool"
 	"github.com/magiclabs/magic-admin-go/token"
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/graphql/model"
 	"github.com/mikeydub/go-gallery/service/emails"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/mediamapper"
+	"github.com/mikeydub/go-gallery/service/moderation"
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/notifications"
+	"github.com/mikeydub/go-gallery/service/push"
 	"github.com/mikeydub/go-gallery/service/socialauth"
 	"github.com/mikeydub/go-gallery/service/twitter"
 	"github.com/mikeydub/go-gallery/validate"
@@ -62,6 +67,26 @@ var nodeFetcher = model.NodeFetcher{
 			return nil, err
 		}
 	},
+	OnCommunityMemberJoinedNotification: func(ctx context.Context, dbid persist.DBID) (*model.CommunityMemberJoinedNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.CommunityMemberJoinedNotification)
+
+		return &notifConverted, nil
+	},
+	OnSomeoneAddedYourMintedTokenNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneAddedYourMintedTokenNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.SomeoneAddedYourMintedTokenNotification)
+
+		return &notifConverted, nil
+	},
 	OnSomeoneAdmiredYourFeedEventNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneAdmiredYourFeedEventNotification, error) {
 		notif, err := resolveNotificationByID(ctx, dbid)
 		if err != nil {
@@ -102,6 +127,26 @@ var nodeFetcher = model.NodeFetcher{
 
 		return &notifConverted, nil
 	},
+	OnSomeoneMentionedYouNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneMentionedYouNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.SomeoneMentionedYouNotification)
+
+		return &notifConverted, nil
+	},
+	OnSomeoneRepostedYourFeedEventNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneRepostedYourFeedEventNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.SomeoneRepostedYourFeedEventNotification)
+
+		return &notifConverted, nil
+	},
 	OnSomeoneViewedYourGalleryNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneViewedYourGalleryNotification, error) {
 		notif, err := resolveNotificationByID(ctx, dbid)
 		if err != nil {
@@ -110,6 +155,26 @@ var nodeFetcher = model.NodeFetcher{
 
 		notifConverted := notif.(model.SomeoneViewedYourGalleryNotification)
 
+		return &notifConverted, nil
+	},
+	OnSomeoneWroteANoteOnYourTokenNotification: func(ctx context.Context, dbid persist.DBID) (*model.SomeoneWroteANoteOnYourTokenNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.SomeoneWroteANoteOnYourTokenNotification)
+
+		return &notifConverted, nil
+	},
+	OnTokenMediaProcessedNotification: func(ctx context.Context, dbid persist.DBID) (*model.TokenMediaProcessedNotification, error) {
+		notif, err := resolveNotificationByID(ctx, dbid)
+		if err != nil {
+			return nil, err
+		}
+
+		notifConverted := notif.(model.TokenMediaProcessedNotification)
+
 		return &notifConverted, nil
 	},
 }
@@ -153,6 +218,10 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 		mappedErr = model.ErrAdmireAlreadyExists{Message: message}
 	case persist.ErrCommentNotFound:
 		mappedErr = model.ErrCommentNotFound{Message: message}
+	case persist.ErrNotificationNotFound:
+		mappedErr = model.ErrNotificationNotFound{Message: message}
+	case persist.ErrFeedEventAlreadyReposted:
+		mappedErr = model.ErrFeedEventAlreadyReposted{Message: message}
 	case publicapi.ErrTokenRefreshFailed:
 		mappedErr = model.ErrSyncFailed{Message: message}
 	case validate.ErrInvalidInput:
@@ -168,6 +237,10 @@ func errorToGraphqlType(ctx context.Context, err error, gqlTypeName string) (gql
 		mappedErr = model.ErrNeedsToReconnectSocial{SocialAccountType: persist.SocialProviderTwitter, Message: message}
 	}
 
+	if errors.Is(err, publicapi.ErrOnlyEditOwnFeedEvent) || errors.Is(err, publicapi.ErrFeedEventEditWindowClosed) {
+		mappedErr = model.ErrNotAuthorized{Message: message, Cause: model.ErrActionNotAllowed{Message: message}}
+	}
+
 	if mappedErr != nil {
 		if converted, ok := model.ConvertToModelType(mappedErr, gqlTypeName); ok {
 			return converted, true
@@ -222,6 +295,27 @@ func (r *Resolver) socialAuthMechanismToAuthenticator(ctx context.Context, m mod
 		return publicapi.For(ctx).Social.NewTwitterAuthenticator(authedUserID, m.Twitter.Code), nil
 	}
 
+	if m.Telegram != nil {
+		id, err := strconv.ParseInt(m.Telegram.ID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid telegram id %s: %w", m.Telegram.ID, err)
+		}
+		return publicapi.For(ctx).Social.NewTelegramAuthenticator(socialauth.TelegramAuthData{
+			ID:        id,
+			FirstName: util.FromPointer(m.Telegram.FirstName),
+			LastName:  util.FromPointer(m.Telegram.LastName),
+			Username:  util.FromPointer(m.Telegram.Username),
+			PhotoURL:  util.FromPointer(m.Telegram.PhotoURL),
+			AuthDate:  int64(m.Telegram.AuthDate),
+			Hash:      m.Telegram.Hash,
+		})
+	}
+
+	if m.Discord != nil {
+		authedUserID := publicapi.For(ctx).User.GetLoggedInUserId(ctx)
+		return publicapi.For(ctx).Social.NewDiscordAuthenticator(authedUserID, m.Discord.Code), nil
+	}
+
 	return nil, errNoAuthMechanismFound
 }
 
@@ -400,6 +494,8 @@ func resolveGalleryByGalleryID(ctx context.Context, galleryID persist.DBID) (*mo
 		Description:   &dbGal.Description,
 		Position:      &dbGal.Position,
 		Hidden:        &dbGal.Hidden,
+		Draft:         &dbGal.Draft,
+		PublishAt:     galleryPublishAt(*dbGal),
 		TokenPreviews: nil, // handled by dedicated resolver
 		Owner:         nil, // handled by dedicated resolver
 		Collections:   nil, // handled by dedicated resolver
@@ -517,6 +613,20 @@ func resolveTokenOwnerByTokenID(ctx context.Context, tokenID persist.DBID) (*mod
 	return resolveGalleryUserByUserID(ctx, token.OwnerUserID)
 }
 
+func resolveTokenProcessingStatusByTokenID(ctx context.Context, tokenID persist.DBID) (*model.TokenProcessingStatus, error) {
+	job, err := publicapi.For(ctx).Token.GetProcessingJobByTokenID(ctx, tokenID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if job == nil {
+		return nil, nil
+	}
+
+	return tokenProcessingJobToModel(*job), nil
+}
+
 func resolveContractByTokenID(ctx context.Context, tokenID persist.DBID) (*model.Contract, error) {
 	token, err := publicapi.For(ctx).Token.GetTokenById(ctx, tokenID)
 
@@ -580,10 +690,15 @@ func resolveViewerEmail(ctx context.Context) *model.UserEmail {
 		return nil
 	}
 
-	return userWithPIIToEmailModel(userWithPII)
+	digestTimezone, err := publicapi.For(ctx).User.GetEmailDigestTimezone(ctx)
+	if err != nil {
+		return nil
+	}
+
+	return userWithPIIToEmailModel(userWithPII, digestTimezone)
 }
 
-func userWithPIIToEmailModel(user *db.PiiUserView) *model.UserEmail {
+func userWithPIIToEmailModel(user *db.PiiUserView, digestTimezone string) *model.UserEmail {
 
 	return &model.UserEmail{
 		Email:              &user.PiiEmailAddress,
@@ -591,6 +706,9 @@ func userWithPIIToEmailModel(user *db.PiiUserView) *model.UserEmail {
 		EmailNotificationSettings: &model.EmailNotificationSettings{
 			UnsubscribedFromAll:           user.EmailUnsubscriptions.All.Bool(),
 			UnsubscribedFromNotifications: user.EmailUnsubscriptions.Notifications.Bool(),
+			UnsubscribedFromDigest:        user.EmailUnsubscriptions.Digest.Bool(),
+			DigestTimezone:                &digestTimezone,
+			Locale:                        &user.Locale,
 		},
 	}
 
@@ -616,6 +734,16 @@ func resolveCommunityByContractAddress(ctx context.Context, contractAddress pers
 	return communityToModel(ctx, *community, forceRefresh), nil
 }
 
+func resolveCommunityByContractID(ctx context.Context, contractID persist.DBID) (*model.Community, error) {
+	community, err := publicapi.For(ctx).Contract.GetContractByID(ctx, contractID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return communityToModel(ctx, *community, nil), nil
+}
+
 func resolveCommunityOwnersByContractID(ctx context.Context, contractID persist.DBID, before, after *string, first, last *int, onlyGalleryUsers *bool) (*model.TokenHoldersConnection, error) {
 	contract, err := publicapi.For(ctx).Contract.GetContractByID(ctx, contractID)
 	if err != nil {
@@ -736,6 +864,37 @@ func notificationsToEdges(notifs []db.Notification) ([]*model.NotificationEdge,
 	return edges, nil
 }
 
+// updateNotificationPayload returns a changed notification along with the viewer's new unseen count, so that clients
+// can update their badge without refetching their notifications
+func updateNotificationPayload(ctx context.Context, notif db.Notification) (*model.UpdateNotificationPayload, error) {
+	m, err := notificationToModel(notif)
+	if err != nil {
+		return nil, err
+	}
+
+	unseenCount, err := publicapi.For(ctx).Notifications.GetViewerUnseenNotificationCount(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.UpdateNotificationPayload{
+		Notification: m,
+		UnseenCount:  &unseenCount,
+	}, nil
+}
+
+func notificationsToModels(notifs []db.Notification) ([]model.Notification, error) {
+	models := make([]model.Notification, len(notifs))
+	for i, n := range notifs {
+		model, err := notificationToModel(n)
+		if err != nil {
+			return nil, err
+		}
+		models[i] = model
+	}
+	return models, nil
+}
+
 func notificationToModel(notif db.Notification) (model.Notification, error) {
 	amount := int(notif.Amount)
 	switch notif.Action {
@@ -748,12 +907,29 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			},
 			Dbid:         notif.ID,
 			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
 			CreationTime: &notif.CreatedAt,
 			UpdatedTime:  &notif.LastUpdated,
 			Count:        &amount,
 			FeedEvent:    nil, // handled by dedicated resolver
 			Admirers:     nil, // handled by dedicated resolver
 		}, nil
+	case persist.ActionRepostedFeedEvent:
+		return model.SomeoneRepostedYourFeedEventNotification{
+			HelperSomeoneRepostedYourFeedEventNotificationData: model.HelperSomeoneRepostedYourFeedEventNotificationData{
+				OwnerID:          notif.OwnerID,
+				FeedEventID:      notif.FeedEventID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Count:        &amount,
+			FeedEvent:    nil, // handled by dedicated resolver
+			Reposters:    nil, // handled by dedicated resolver
+		}, nil
 	case persist.ActionCommentedOnFeedEvent:
 		return model.SomeoneCommentedOnYourFeedEventNotification{
 			HelperSomeoneCommentedOnYourFeedEventNotificationData: model.HelperSomeoneCommentedOnYourFeedEventNotificationData{
@@ -764,10 +940,31 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			},
 			Dbid:         notif.ID,
 			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			FeedEvent:    nil, // handled by dedicated resolver
+			Comment:      nil, // handled by dedicated resolver
+		}, nil
+	case persist.ActionMentionedUser:
+		return model.SomeoneMentionedYouNotification{
+			HelperSomeoneMentionedYouNotificationData: model.HelperSomeoneMentionedYouNotificationData{
+				OwnerID:          notif.OwnerID,
+				FeedEventID:      notif.FeedEventID,
+				CommentID:        notif.CommentID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
 			CreationTime: &notif.CreatedAt,
 			UpdatedTime:  &notif.LastUpdated,
+			Source:       mentionSourceToModel(notif.Data.MentionSource),
+			Mentioner:    nil, // handled by dedicated resolver
 			FeedEvent:    nil, // handled by dedicated resolver
 			Comment:      nil, // handled by dedicated resolver
+			Collection:   nil, // handled by dedicated resolver
+			Token:        nil, // handled by dedicated resolver
 		}, nil
 	case persist.ActionUserFollowedUsers:
 		if !notif.Data.FollowedBack {
@@ -778,6 +975,7 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 				},
 				Dbid:         notif.ID,
 				Seen:         &notif.Seen,
+				Archived:     &notif.Archived,
 				CreationTime: &notif.CreatedAt,
 				UpdatedTime:  &notif.LastUpdated,
 				Count:        &amount,
@@ -791,6 +989,7 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			},
 			Dbid:         notif.ID,
 			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
 			CreationTime: &notif.CreatedAt,
 			UpdatedTime:  &notif.LastUpdated,
 			Count:        &amount,
@@ -806,6 +1005,7 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			},
 			Dbid:               notif.ID,
 			Seen:               &notif.Seen,
+			Archived:           &notif.Archived,
 			CreationTime:       &notif.CreatedAt,
 			UpdatedTime:        &notif.LastUpdated,
 			Count:              &amount,
@@ -813,6 +1013,65 @@ func notificationToModel(notif db.Notification) (model.Notification, error) {
 			Gallery:            nil, // handled by dedicated resolver
 			NonUserViewerCount: &nonCount,
 		}, nil
+	case persist.ActionMintedTokenAddedToGallery:
+		return model.SomeoneAddedYourMintedTokenNotification{
+			HelperSomeoneAddedYourMintedTokenNotificationData: model.HelperSomeoneAddedYourMintedTokenNotificationData{
+				OwnerID:          notif.OwnerID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Count:        &amount,
+			Collectors:   nil, // handled by dedicated resolver
+			Tokens:       nil, // handled by dedicated resolver
+		}, nil
+	case persist.ActionCollectorsNoteAddedToMintedToken:
+		return model.SomeoneWroteANoteOnYourTokenNotification{
+			HelperSomeoneWroteANoteOnYourTokenNotificationData: model.HelperSomeoneWroteANoteOnYourTokenNotificationData{
+				OwnerID:          notif.OwnerID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Collector:    nil, // handled by dedicated resolver
+			Token:        nil, // handled by dedicated resolver
+		}, nil
+	case persist.ActionCommunityMemberJoined:
+		return model.CommunityMemberJoinedNotification{
+			HelperCommunityMemberJoinedNotificationData: model.HelperCommunityMemberJoinedNotificationData{
+				OwnerID:          notif.OwnerID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Count:        &amount,
+			Community:    nil, // handled by dedicated resolver
+			NewMembers:   nil, // handled by dedicated resolver
+		}, nil
+	case persist.ActionTokenMediaProcessed:
+		succeeded := !bool(notif.Data.MediaFailed)
+		return model.TokenMediaProcessedNotification{
+			HelperTokenMediaProcessedNotificationData: model.HelperTokenMediaProcessedNotificationData{
+				OwnerID:          notif.OwnerID,
+				NotificationData: notif.Data,
+			},
+			Dbid:         notif.ID,
+			Seen:         &notif.Seen,
+			Archived:     &notif.Archived,
+			CreationTime: &notif.CreatedAt,
+			UpdatedTime:  &notif.LastUpdated,
+			Succeeded:    &succeeded,
+			Token:        nil, // handled by dedicated resolver
+		}, nil
 	default:
 		return nil, fmt.Errorf("unknown notification action: %s", notif.Action)
 	}
@@ -839,6 +1098,127 @@ func notificationSettingsToModel(ctx context.Context, user *db.User) *model.Noti
 		SomeoneAdmiredYourUpdate:     settings.SomeoneAdmiredYourUpdate,
 		SomeoneCommentedOnYourUpdate: settings.SomeoneCommentedOnYourUpdate,
 		SomeoneViewedYourGallery:     settings.SomeoneViewedYourGallery,
+		SomeoneMentionedYou:          settings.SomeoneMentionedYou,
+		Preferences:                  notificationPreferencesToModel(settings),
+		QuietHours:                   quietHoursToModel(settings.QuietHours),
+	}
+}
+
+var notificationTypes = map[model.NotificationType]persist.NotificationType{
+	model.NotificationTypeSomeoneFollowedYou:           persist.NotificationTypeSomeoneFollowedYou,
+	model.NotificationTypeSomeoneAdmiredYourUpdate:     persist.NotificationTypeSomeoneAdmiredYourUpdate,
+	model.NotificationTypeSomeoneRepostedYourUpdate:    persist.NotificationTypeSomeoneRepostedYourUpdate,
+	model.NotificationTypeSomeoneCommentedOnYourUpdate: persist.NotificationTypeSomeoneCommentedOnYourUpdate,
+	model.NotificationTypeSomeoneViewedYourGallery:     persist.NotificationTypeSomeoneViewedYourGallery,
+	model.NotificationTypeSomeoneMentionedYou:          persist.NotificationTypeSomeoneMentionedYou,
+	model.NotificationTypeMintedTokenAddedToGallery:    persist.NotificationTypeMintedTokenAddedToGallery,
+	model.NotificationTypeCollectorsNoteOnYourToken:    persist.NotificationTypeCollectorsNoteOnYourToken,
+	model.NotificationTypeCommunityMemberJoined:        persist.NotificationTypeCommunityMemberJoined,
+	model.NotificationTypeTokenMediaProcessed:          persist.NotificationTypeTokenMediaProcessed,
+}
+
+var notificationDeliveries = map[model.NotificationDelivery]persist.NotificationDelivery{
+	model.NotificationDeliveryInstant: persist.NotificationDeliveryInstant,
+	model.NotificationDeliveryDigest:  persist.NotificationDeliveryDigest,
+}
+
+// notificationPreferencesToModel returns a preference for every notification type, including the ones the user
+// hasn't changed from the defaults
+func notificationPreferencesToModel(settings persist.UserNotificationSettings) []*model.NotificationPreference {
+	prefs := make([]*model.NotificationPreference, 0, len(model.AllNotificationType))
+	for _, t := range model.AllNotificationType {
+		pref := settings.Preference(notificationTypes[t])
+		delivery := model.NotificationDeliveryInstant
+		if pref.Delivery == persist.NotificationDeliveryDigest {
+			delivery = model.NotificationDeliveryDigest
+		}
+		prefs = append(prefs, &model.NotificationPreference{
+			Type:     t,
+			InApp:    *pref.InApp,
+			Email:    *pref.Email,
+			Push:     *pref.Push,
+			Webhook:  *pref.Webhook,
+			Telegram: *pref.Telegram,
+			Discord:  *pref.Discord,
+			Delivery: delivery,
+		})
+	}
+	return prefs
+}
+
+func notificationPreferencesFromInput(input []*model.NotificationPreferenceInput) map[persist.NotificationType]persist.NotificationPreference {
+	if len(input) == 0 {
+		return nil
+	}
+
+	prefs := make(map[persist.NotificationType]persist.NotificationPreference, len(input))
+	for _, p := range input {
+		pref := persist.NotificationPreference{
+			InApp:    p.InApp,
+			Email:    p.Email,
+			Push:     p.Push,
+			Webhook:  p.Webhook,
+			Telegram: p.Telegram,
+			Discord:  p.Discord,
+		}
+		if p.Delivery != nil {
+			pref.Delivery = notificationDeliveries[*p.Delivery]
+		}
+		prefs[notificationTypes[p.Type]] = pref
+	}
+	return prefs
+}
+
+func quietHoursToModel(quietHours *persist.QuietHours) *model.QuietHours {
+	if quietHours == nil {
+		return nil
+	}
+	return &model.QuietHours{
+		Start:    quietHours.Start,
+		End:      quietHours.End,
+		Timezone: quietHours.Timezone,
+	}
+}
+
+func quietHoursFromInput(input *model.QuietHoursInput) *persist.QuietHours {
+	if input == nil {
+		return nil
+	}
+	return &persist.QuietHours{
+		Start:    input.Start,
+		End:      input.End,
+		Timezone: input.Timezone,
+	}
+}
+
+var pushPlatforms = map[model.PushPlatform]push.Platform{
+	model.PushPlatformWeb:     push.PlatformWeb,
+	model.PushPlatformIos:     push.PlatformIOS,
+	model.PushPlatformAndroid: push.PlatformAndroid,
+}
+
+func pushPlatformToModel(platform string) *model.PushPlatform {
+	for m, p := range pushPlatforms {
+		if string(p) == platform {
+			return &m
+		}
+	}
+	return nil
+}
+
+func resolveWebPushPublicKey() *string {
+	key := env.GetString("VAPID_PUBLIC_KEY")
+	if key == "" {
+		return nil
+	}
+	return &key
+}
+
+func pushDeviceToModel(device db.PushDevice) *model.PushDevice {
+	return &model.PushDevice{
+		Dbid:         device.ID,
+		Platform:     pushPlatformToModel(device.Platform),
+		CreationTime: &device.CreatedAt,
 	}
 }
 
@@ -1011,6 +1391,58 @@ func resolveCommentByCommentID(ctx context.Context, commentID persist.DBID) (*mo
 	return commentToModel(ctx, *comment), nil
 }
 
+func resolveTextEntitiesBySource(ctx context.Context, source entity.Source, sourceID persist.DBID) ([]*model.TextEntity, error) {
+	entities, err := publicapi.For(ctx).Entity.GetEntities(ctx, source, sourceID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*model.TextEntity, len(entities))
+	for i, e := range entities {
+		result[i] = textEntityToModel(e)
+	}
+
+	return result, nil
+}
+
+func textEntityToModel(e db.TextEntity) *model.TextEntity {
+	start := int(e.StartIndex)
+	length := int(e.Length)
+
+	result := &model.TextEntity{
+		HelperTextEntityData: model.HelperTextEntityData{
+			UserID: e.UserID,
+		},
+		Start:  &start,
+		Length: &length,
+		User:   nil, // handled by dedicated resolver
+	}
+
+	switch entity.Kind(e.Kind) {
+	case entity.KindMention:
+		result.Kind = util.ToPointer(model.TextEntityKindMention)
+	case entity.KindHashtag:
+		result.Kind = util.ToPointer(model.TextEntityKindHashtag)
+		result.Hashtag = util.ToPointer(e.Value)
+	}
+
+	return result
+}
+
+func mentionSourceToModel(source string) *model.MentionSource {
+	switch entity.Source(source) {
+	case entity.SourceComment:
+		return util.ToPointer(model.MentionSourceComment)
+	case entity.SourceCaption:
+		return util.ToPointer(model.MentionSourceCaption)
+	case entity.SourceCollectionCollectorsNote:
+		return util.ToPointer(model.MentionSourceCollectionCollectorsNote)
+	case entity.SourceTokenCollectorsNote:
+		return util.ToPointer(model.MentionSourceTokenCollectorsNote)
+	}
+	return nil
+}
+
 func resolveMerchTokenByTokenID(ctx context.Context, tokenID string) (*model.MerchToken, error) {
 	token, err := publicapi.For(ctx).Merch.GetMerchTokenByTokenID(ctx, persist.TokenID(tokenID))
 
@@ -1091,10 +1523,33 @@ func resendEmailVerification(ctx context.Context) (*model.ResendVerificationEmai
 }
 
 func updateUserEmailNotificationSettings(ctx context.Context, input model.UpdateEmailNotificationSettingsInput) (*model.UpdateEmailNotificationSettingsPayload, error) {
-	err := publicapi.For(ctx).User.UpdateUserEmailNotificationSettings(ctx, persist.EmailUnsubscriptions{
+	settings := persist.EmailUnsubscriptions{
 		All:           persist.NullBool(input.UnsubscribedFromAll),
 		Notifications: persist.NullBool(input.UnsubscribedFromNotifications),
-	})
+	}
+
+	// Keep the current digest setting if the client doesn't know about it yet
+	if input.UnsubscribedFromDigest != nil {
+		settings.Digest = persist.NullBool(*input.UnsubscribedFromDigest)
+	} else if userWithPII, err := publicapi.For(ctx).User.GetUserWithPII(ctx); err == nil {
+		settings.Digest = userWithPII.EmailUnsubscriptions.Digest
+	}
+
+	if input.DigestTimezone != nil {
+		err := publicapi.For(ctx).User.UpdateEmailDigestTimezone(ctx, *input.DigestTimezone)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Locale != nil {
+		err := publicapi.For(ctx).User.UpdateEmailLocale(ctx, *input.Locale)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := publicapi.For(ctx).User.UpdateUserEmailNotificationSettings(ctx, settings)
 	if err != nil {
 		return nil, err
 	}
@@ -1135,6 +1590,10 @@ func feedEventToDataModel(event *db.FeedEvent) (model.FeedEventData, error) {
 		return feedEventToCollectionUpdatedFeedEventData(event), nil
 	case persist.ActionGalleryUpdated:
 		return feedEventToGalleryUpdatedFeedEventData(event), nil
+	case persist.ActionTokensAcquired:
+		return feedEventToTokensAcquiredFeedEventData(event), nil
+	case persist.ActionRepostedFeedEvent:
+		return feedEventToRepostedFeedEventData(event), nil
 	default:
 		return nil, persist.ErrUnknownAction{Action: event.Action}
 	}
@@ -1174,6 +1633,7 @@ func feedEventToModel(event *db.FeedEvent) (*model.FeedEvent, error) {
 	return &model.FeedEvent{
 		Dbid:      event.ID,
 		Caption:   captionVal,
+		Hidden:    &event.Hidden,
 		EventData: data,
 	}, nil
 }
@@ -1343,6 +1803,41 @@ func feedEventToGalleryUpdatedFeedEventData(event *db.FeedEvent) model.FeedEvent
 	}
 }
 
+func feedEventToTokensAcquiredFeedEventData(event *db.FeedEvent) model.FeedEventData {
+	return model.TokensAcquiredFeedEventData{
+		EventTime:      &event.EventTime,
+		Owner:          &model.GalleryUser{Dbid: event.OwnerID}, // remaining fields handled by dedicated resolver
+		Action:         &event.Action,
+		AcquiredTokens: nil, // handled by dedicated resolver
+		MintedTokens:   nil, // handled by dedicated resolver
+		HelperTokensAcquiredFeedEventDataData: model.HelperTokensAcquiredFeedEventDataData{
+			AcquiredTokenIDs: event.Data.AcquiredTokenIDs,
+			MintedTokenIDs:   event.Data.MintedTokenIDs,
+		},
+	}
+}
+
+func feedEventToRepostedFeedEventData(event *db.FeedEvent) model.FeedEventData {
+	return model.RepostedFeedEventData{
+		EventTime:     &event.EventTime,
+		Owner:         &model.GalleryUser{Dbid: event.OwnerID}, // remaining fields handled by dedicated resolver
+		Action:        &event.Action,
+		OriginalEvent: nil, // handled by dedicated resolver
+		HelperRepostedFeedEventDataData: model.HelperRepostedFeedEventDataData{
+			FeedEventID: event.Data.RepostedFeedEventID,
+		},
+	}
+}
+
+func resolveTokensByTokenIDs(ctx context.Context, tokenIDs persist.DBIDList) ([]*model.Token, error) {
+	tokens, err := publicapi.For(ctx).Token.GetTokensByIDs(ctx, tokenIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return tokensToModel(ctx, tokens), nil
+}
+
 func resolveSubEventDatasByFeedEventID(ctx context.Context, feedEventID persist.DBID) ([]model.FeedEventData, error) {
 	feedEvent, err := publicapi.For(ctx).Feed.GetFeedEventById(ctx, feedEventID)
 	if err != nil {
@@ -1474,11 +1969,20 @@ func galleryToModel(ctx context.Context, gallery db.Gallery) *model.Gallery {
 		Description: &gallery.Description,
 		Position:    &gallery.Position,
 		Hidden:      &gallery.Hidden,
+		Draft:       &gallery.Draft,
+		PublishAt:   galleryPublishAt(gallery),
 		Owner:       nil, // handled by dedicated resolver
 		Collections: nil, // handled by dedicated resolver
 	}
 }
 
+func galleryPublishAt(gallery db.Gallery) *time.Time {
+	if gallery.PublishAt.Valid {
+		return &gallery.PublishAt.Time
+	}
+	return nil
+}
+
 func galleriesToModels(ctx context.Context, galleries []db.Gallery) []*model.Gallery {
 	models := make([]*model.Gallery, len(galleries))
 	for i, gallery := range galleries {
@@ -1677,6 +2181,7 @@ func collectionToModel(ctx context.Context, collection db.Collection) *model.Col
 		Gallery:        nil, // handled by dedicated resolver
 		Layout:         layoutToModel(ctx, collection.Layout, version),
 		Hidden:         &collection.Hidden,
+		Draft:          &collection.Draft,
 		Tokens:         nil, // handled by dedicated resolver
 	}
 }
@@ -1788,6 +2293,39 @@ func tokenToModel(ctx context.Context, token db.Token) *model.Token {
 	}
 }
 
+func tokenMetadataSnapshotToModel(ctx context.Context, snapshot db.TokenMetadataHistory) *model.TokenMetadataSnapshot {
+	metadata, _ := snapshot.TokenMetadata.MarshallJSON()
+	metadataString := string(metadata)
+
+	return &model.TokenMetadataSnapshot{
+		Dbid:          snapshot.ID,
+		TokenMetadata: &metadataString,
+		Media:         mediaToModel(ctx, snapshot.Media),
+		CreationTime:  &snapshot.CreatedAt,
+	}
+}
+
+func tokenProcessingJobToModel(job db.TokenProcessingJob) *model.TokenProcessingStatus {
+	var lastError *string
+	if job.LastError.Valid {
+		lastError = &job.LastError.String
+	}
+
+	// Only queued jobs are waiting on another attempt
+	var nextAttemptAt *time.Time
+	if job.Status == persist.TokenProcessingJobStatusQueued {
+		nextAttemptAt = &job.NextAttemptAt
+	}
+
+	return &model.TokenProcessingStatus{
+		Status:        &job.Status,
+		Attempts:      util.ToPointer(int(job.Attempts)),
+		LastError:     lastError,
+		NextAttemptAt: nextAttemptAt,
+		LastUpdated:   &job.LastUpdated,
+	}
+}
+
 func tokensToModel(ctx context.Context, token []db.Token) []*model.Token {
 	res := make([]*model.Token, len(token))
 	for i, token := range token {
@@ -1857,9 +2395,18 @@ func getUrlExtension(url string) string {
 }
 
 func getMediaForToken(ctx context.Context, token db.Token) model.MediaSubtype {
-	med := token.Media
+	return mediaToModel(ctx, token.Media)
+}
+
+func mediaToModel(ctx context.Context, med persist.Media) model.MediaSubtype {
 	switch med.MediaType {
-	case persist.MediaTypeImage, persist.MediaTypeSVG:
+	case persist.MediaTypeImage:
+		return getImageMedia(ctx, med)
+	case persist.MediaTypeSVG:
+		// SVGs that weren't cached couldn't be sanitized, so none of their URLs are served
+		if !moderation.CanServeSVG(med.MediaURL.String()) {
+			return getInvalidMedia(ctx, persist.Media{MediaType: med.MediaType, Dimensions: med.Dimensions})
+		}
 		return getImageMedia(ctx, med)
 	case persist.MediaTypeGIF:
 		return getGIFMedia(ctx, med)
@@ -2065,3 +2612,126 @@ func mediaToDimensions(media persist.Media) *model.MediaDimensions {
 		AspectRatio: &aspect,
 	}
 }
+
+func tokenModerationToModel(moderation db.TokenModeration) *model.TokenModeration {
+	var reviewedAt *time.Time
+	if moderation.ReviewedAt.Valid {
+		reviewedAt = &moderation.ReviewedAt.Time
+	}
+
+	return &model.TokenModeration{
+		HelperTokenModerationData: model.HelperTokenModerationData{
+			TokenIdentifiers: persist.NewTokenIdentifiers(moderation.ContractAddress, moderation.TokenID, moderation.Chain),
+		},
+		Dbid:         moderation.ID,
+		Token:        nil, // handled by dedicated resolver
+		Flags:        moderation.Flags,
+		Status:       &moderation.Status,
+		ReviewedAt:   reviewedAt,
+		CreationTime: &moderation.CreatedAt,
+		LastUpdated:  &moderation.LastUpdated,
+	}
+}
+
+// resolveWebhookDeliveries returns the delivery log of a webhook. Admin webhooks don't have an owner.
+func resolveWebhookDeliveries(ctx context.Context, webhookID persist.DBID, ownerID persist.DBID, limit *int) ([]*model.WebhookDelivery, error) {
+	var deliveries []db.WebhookDelivery
+	var err error
+
+	if ownerID == "" {
+		deliveries, err = publicapi.For(ctx).Admin.GetWebhookDeliveries(ctx, webhookID, limit)
+	} else {
+		deliveries, err = publicapi.For(ctx).Webhook.GetWebhookDeliveries(ctx, webhookID, limit)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	models := make([]*model.WebhookDelivery, len(deliveries))
+	for i, delivery := range deliveries {
+		models[i] = webhookDeliveryToModel(delivery)
+	}
+
+	return models, nil
+}
+
+func webhookActionsFromModel(actions []string) []persist.Action {
+	result := make([]persist.Action, len(actions))
+	for i, action := range actions {
+		result[i] = persist.Action(action)
+	}
+	return result
+}
+
+func webhooksToModels(hooks []db.Webhook) []*model.Webhook {
+	models := make([]*model.Webhook, len(hooks))
+	for i, hook := range hooks {
+		models[i] = webhookToModel(hook)
+	}
+	return models
+}
+
+func webhookToModel(hook db.Webhook) *model.Webhook {
+	actions := make([]string, len(hook.Actions))
+	for i, action := range hook.Actions {
+		actions[i] = string(action)
+	}
+
+	return &model.Webhook{
+		HelperWebhookData: model.HelperWebhookData{
+			OwnerID: hook.OwnerID,
+		},
+		Dbid:         hook.ID,
+		URL:          &hook.Url,
+		Actions:      actions,
+		Active:       &hook.Active,
+		CreationTime: &hook.CreatedAt,
+		Deliveries:   nil, // handled by dedicated resolver
+	}
+}
+
+func webhookDeliveryToModel(delivery db.WebhookDelivery) *model.WebhookDelivery {
+	action := string(delivery.Action)
+	attempts := int(delivery.Attempts)
+	payload := string(delivery.Payload)
+
+	var responseStatus *int
+	if delivery.ResponseStatus.Valid {
+		status := int(delivery.ResponseStatus.Int32)
+		responseStatus = &status
+	}
+
+	var lastError *string
+	if delivery.LastError.Valid {
+		lastError = &delivery.LastError.String
+	}
+
+	var replayOf *persist.DBID
+	if delivery.ReplayOfID != "" {
+		replayOf = &delivery.ReplayOfID
+	}
+
+	var nextAttemptAt *time.Time
+	if delivery.Status == persist.WebhookDeliveryStatusPending {
+		nextAttemptAt = &delivery.NextAttemptAt
+	}
+
+	var deliveredAt *time.Time
+	if delivery.DeliveredAt.Valid {
+		deliveredAt = &delivery.DeliveredAt.Time
+	}
+
+	return &model.WebhookDelivery{
+		Dbid:           delivery.ID,
+		Action:         &action,
+		Status:         &delivery.Status,
+		Attempts:       &attempts,
+		ResponseStatus: responseStatus,
+		LastError:      lastError,
+		Payload:        &payload,
+		ReplayOf:       replayOf,
+		CreationTime:   &delivery.CreatedAt,
+		NextAttemptAt:  nextAttemptAt,
+		DeliveredAt:    deliveredAt,
+	}
+}
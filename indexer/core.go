@@ -120,6 +120,9 @@ func SetDefaults() {
 	viper.SetDefault("RPC_URL", "")
 	viper.SetDefault("IPFS_URL", "https://gallery.infura-ipfs.io")
 	viper.SetDefault("IPFS_API_URL", "https://ipfs.infura.io:5001")
+	viper.SetDefault("IPFS_GATEWAYS", "")
+	viper.SetDefault("IPFS_LOCAL_API_URL", "")
+	viper.SetDefault("IPFS_HEDGE_DELAY", "2s")
 	viper.SetDefault("IPFS_PROJECT_ID", "")
 	viper.SetDefault("IPFS_PROJECT_SECRET", "")
 	viper.SetDefault("CHAIN", 0)
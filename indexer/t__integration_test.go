@@ -79,7 +79,7 @@ func TestIndexLogs_Success(t *testing.T) {
 		mediaTypeHasExpectedType(t, a, nil, persist.MediaTypeImage, predicted)
 
 		image, animation := media.KeywordsForChain(persist.ChainETH, imageKeywords, animationKeywords)
-		med, err := media.MakePreviewsForMetadata(ctx, metadata, persist.Address(token.ContractAddress), token.TokenID, uri, persist.ChainETH, ipfsShell, arweaveClient, stg, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), image, animation)
+		med, err := media.MakePreviewsForMetadata(ctx, metadata, persist.Address(token.ContractAddress), token.TokenID, uri, persist.ChainETH, ipfsShell, arweaveClient, stg, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), image, animation, nil)
 		mediaTypeHasExpectedType(t, a, err, persist.MediaTypeImage, med.MediaType)
 		a.Empty(med.ThumbnailURL)
 		a.NotEmpty(med.MediaURL)
@@ -98,7 +98,7 @@ func TestIndexLogs_Success(t *testing.T) {
 		mediaHasContent(t, a, err, metadata)
 
 		image, animation := media.KeywordsForChain(persist.ChainETH, imageKeywords, animationKeywords)
-		med, err := media.MakePreviewsForMetadata(ctx, metadata, persist.Address(token.ContractAddress), token.TokenID, uri, persist.ChainETH, ipfsShell, arweaveClient, stg, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), image, animation)
+		med, err := media.MakePreviewsForMetadata(ctx, metadata, persist.Address(token.ContractAddress), token.TokenID, uri, persist.ChainETH, ipfsShell, arweaveClient, stg, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), image, animation, nil)
 		mediaTypeHasExpectedType(t, a, err, persist.MediaTypeSVG, med.MediaType)
 		a.Empty(med.ThumbnailURL)
 		a.Contains(med.MediaURL.String(), "https://")
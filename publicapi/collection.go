@@ -12,6 +12,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
 )
 
@@ -42,6 +43,15 @@ func (api CollectionAPI) GetCollectionById(ctx context.Context, collectionID per
 		return nil, err
 	}
 
+	hidden, err := api.isHiddenDraft(ctx, collection)
+	if err != nil {
+		return nil, err
+	}
+
+	if hidden {
+		return nil, persist.ErrCollectionNotFoundByID{ID: collectionID}
+	}
+
 	return &collection, nil
 }
 
@@ -54,7 +64,24 @@ func (api CollectionAPI) GetCollectionsByIds(ctx context.Context, collectionIDs
 			return func() (db.Collection, error) { return db.Collection{}, err }
 		}
 
-		return api.loaders.CollectionByCollectionID.LoadThunk(collectionID)
+		thunk := api.loaders.CollectionByCollectionID.LoadThunk(collectionID)
+		return func() (db.Collection, error) {
+			collection, err := thunk()
+			if err != nil {
+				return db.Collection{}, err
+			}
+
+			hidden, err := api.isHiddenDraft(ctx, collection)
+			if err != nil {
+				return db.Collection{}, err
+			}
+
+			if hidden {
+				return db.Collection{}, persist.ErrCollectionNotFoundByID{ID: collectionID}
+			}
+
+			return collection, nil
+		}
 	}
 
 	// A "thunk" will add this request to a batch, and then return a function that will block to fetch
@@ -96,7 +123,40 @@ func (api CollectionAPI) GetCollectionsByGalleryId(ctx context.Context, galleryI
 		return nil, err
 	}
 
-	return collections, nil
+	visible := make([]db.Collection, 0, len(collections))
+	for _, collection := range collections {
+		hidden, err := api.isHiddenDraft(ctx, collection)
+		if err != nil {
+			return nil, err
+		}
+		if !hidden {
+			visible = append(visible, collection)
+		}
+	}
+
+	return visible, nil
+}
+
+// isHiddenDraft returns whether the viewer can't see a collection because it, or the gallery that it's in, is a draft
+// that hasn't been published yet
+func (api CollectionAPI) isHiddenDraft(ctx context.Context, collection db.Collection) (bool, error) {
+	if isHiddenDraft(ctx, collection.Draft, collection.OwnerUserID) {
+		return true, nil
+	}
+
+	if getViewerID(ctx) == collection.OwnerUserID {
+		return false, nil
+	}
+
+	gallery, err := api.loaders.GalleryByGalleryID.Load(collection.GalleryID)
+	if _, ok := err.(persist.ErrGalleryNotFound); ok {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return isHiddenDraft(ctx, gallery.Draft, gallery.OwnerUserID), nil
 }
 
 func (api CollectionAPI) CreateCollection(ctx context.Context, galleryID persist.DBID, name string, collectorsNote string, tokens []persist.DBID, layout persist.TokenLayout, tokenSettings map[persist.DBID]persist.CollectionTokenSettings, caption *string) (*db.Collection, *db.FeedEvent, error) {
@@ -174,6 +234,11 @@ func (api CollectionAPI) CreateCollection(ctx context.Context, galleryID persist
 		return nil, nil, err
 	}
 
+	updateTextEntities(ctx, api.queries, api.validator, entity.SourceCollectionCollectorsNote, collectionID, collectorsNote, db.Event{
+		ActorID: persist.DBIDToNullStr(userID),
+		Data:    persist.EventData{MentionCollectionID: collectionID},
+	})
+
 	// Send event
 	feedEvent, err := dispatchEvent(ctx, db.Event{
 		ActorID:        persist.DBIDToNullStr(userID),
@@ -188,6 +253,8 @@ func (api CollectionAPI) CreateCollection(ctx context.Context, galleryID persist
 		return nil, nil, err
 	}
 
+	updateCaptionEntities(ctx, api.queries, api.validator, feedEvent)
+
 	return &createdCollection, feedEvent, nil
 }
 
@@ -246,6 +313,11 @@ func (api CollectionAPI) UpdateCollectionInfo(ctx context.Context, collectionID
 		return err
 	}
 
+	updateTextEntities(ctx, api.queries, api.validator, entity.SourceCollectionCollectorsNote, collectionID, collectorsNote, db.Event{
+		ActorID: persist.DBIDToNullStr(userID),
+		Data:    persist.EventData{MentionCollectionID: collectionID},
+	})
+
 	// Send event
 	_, err = dispatchEvent(ctx, db.Event{
 		ActorID:        persist.DBIDToNullStr(userID),
@@ -321,7 +393,7 @@ func (api CollectionAPI) UpdateCollectionTokens(ctx context.Context, collectionI
 	}
 
 	// Send event
-	return dispatchEvent(ctx, db.Event{
+	feedEvent, err := dispatchEvent(ctx, db.Event{
 		ActorID:        persist.DBIDToNullStr(userID),
 		Action:         persist.ActionTokensAddedToCollection,
 		ResourceTypeID: persist.ResourceTypeCollection,
@@ -331,6 +403,13 @@ func (api CollectionAPI) UpdateCollectionTokens(ctx context.Context, collectionI
 		Data:           persist.EventData{CollectionTokenIDs: tokens},
 		Caption:        persist.StrToNullStr(caption),
 	}, api.validator, caption)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCaptionEntities(ctx, api.queries, api.validator, feedEvent)
+
+	return feedEvent, nil
 }
 
 func (api CollectionAPI) UpdateCollectionHidden(ctx context.Context, collectionID persist.DBID, hidden bool) error {
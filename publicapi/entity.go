@@ -0,0 +1,71 @@
+package publicapi
+
+import (
+	"context"
+
+	"github.com/go-playground/validator/v10"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/entity"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+type EntityAPI struct {
+	queries   *db.Queries
+	validator *validator.Validate
+}
+
+// GetEntities returns the mentions and hashtags in a piece of text, in the order they appear.
+func (api EntityAPI) GetEntities(ctx context.Context, source entity.Source, sourceID persist.DBID) ([]db.TextEntity, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"sourceID": {sourceID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetTextEntitiesBySource(ctx, db.GetTextEntitiesBySourceParams{
+		Source:   string(source),
+		SourceID: sourceID,
+	})
+}
+
+// updateTextEntities replaces the mentions and hashtags of a piece of text and notifies the users who are newly
+// mentioned in it. mention is the event that's sent to each of them, which says what they were mentioned in.
+// The text has already been saved at this point, so errors are reported rather than returned.
+func updateTextEntities(ctx context.Context, queries *db.Queries, v *validator.Validate, source entity.Source, sourceID persist.DBID, text string, mention db.Event) {
+	mentioned, err := entity.Save(ctx, queries, source, sourceID, text)
+	if err != nil {
+		logger.For(ctx).Errorf("failed to save entities of %s %s: %s", source, sourceID, err)
+		sentryutil.ReportError(ctx, err)
+		return
+	}
+
+	for _, userID := range mentioned {
+		evt := mention
+		evt.ResourceTypeID = persist.ResourceTypeUser
+		evt.SubjectID = userID
+		evt.Action = persist.ActionMentionedUser
+		evt.Data.MentionSource = string(source)
+
+		if _, err := dispatchEvent(ctx, evt, v, nil); err != nil {
+			logger.For(ctx).Errorf("failed to dispatch mention of %s in %s %s: %s", userID, source, sourceID, err)
+			sentryutil.ReportError(ctx, err)
+		}
+	}
+}
+
+// updateCaptionEntities updates the entities of a feed event's caption. It does nothing if a feed event wasn't
+// published, e.g. because an event without a caption is still waiting to be grouped with others.
+func updateCaptionEntities(ctx context.Context, queries *db.Queries, v *validator.Validate, feedEvent *db.FeedEvent) {
+	if feedEvent == nil {
+		return
+	}
+
+	updateTextEntities(ctx, queries, v, entity.SourceCaption, feedEvent.ID, feedEvent.Caption.String, db.Event{
+		ActorID: persist.DBIDToNullStr(feedEvent.OwnerID),
+		Data:    persist.EventData{MentionFeedEventID: feedEvent.ID},
+	})
+}
@@ -2,11 +2,13 @@ package publicapi
 
 import (
 	"context"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"sort"
+	"strings"
 	"time"
 
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
@@ -18,9 +20,41 @@ import (
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/recommend"
 )
 
+const (
+	// personalizedFeedWindow is how far back events are considered for the personalized feed
+	personalizedFeedWindow = 7 * 24 * time.Hour
+	// personalizedFeedMaxCandidates is the most events that are ranked for a user at a time
+	personalizedFeedMaxCandidates = 500
+	// personalizedFeedVelocityWindow is how far back interactions are counted to determine how quickly an event is taking off
+	personalizedFeedVelocityWindow = 6 * time.Hour
+	// personalizedFeedHalfLife is how long it takes for an event's freshness to halve
+	personalizedFeedHalfLife = 24 * time.Hour
+	// personalizedFeedTTL is how long a ranking is kept around for paging through
+	personalizedFeedTTL = time.Hour
+)
+
+// feedEventCaptionEditWindow is how long after a feed event is created that its owner can change its caption
+const feedEventCaptionEditWindow = time.Hour
+
+var ErrOnlyEditOwnFeedEvent = errors.New("only the owner of a feed event can change it")
+var ErrFeedEventEditWindowClosed = fmt.Errorf("a feed event's caption can only be changed within %s of it being posted", feedEventCaptionEditWindow)
+
+// personalizedFeedWeights determine how much each signal adds to an event's score
+var personalizedFeedWeights = struct {
+	Affinity  float64
+	Community float64
+	Velocity  float64
+}{
+	Affinity:  0.5,
+	Community: 0.2,
+	Velocity:  0.3,
+}
+
 type FeedAPI struct {
 	repos     *postgres.Repositories
 	queries   *db.Queries
@@ -76,6 +110,11 @@ func (api FeedAPI) GetFeedEventById(ctx context.Context, feedEventID persist.DBI
 		return nil, err
 	}
 
+	// Hidden feed events are only shown to their owner
+	if event.Hidden && getViewerID(ctx) != event.OwnerID {
+		return nil, persist.ErrFeedEventNotFoundByID{ID: feedEventID}
+	}
+
 	return &event, nil
 }
 
@@ -163,9 +202,117 @@ func (api FeedAPI) PaginateUserFeed(ctx context.Context, userID persist.DBID, be
 		return nil, PageInfo{}, err
 	}
 
+	// Owners can see their hidden feed events, so that they can unhide them, and their moderated ones. Everyone else,
+	// including the unauthenticated syndication feeds, sees neither, like in the global feed.
+	includeHidden := getViewerID(ctx) == userID
+
 	queryFunc := func(params timeIDPagingParams) ([]interface{}, error) {
 		keys, err := api.loaders.UserFeedByUserID.Load(db.PaginateUserFeedByUserIDParams{
 			OwnerID:       userID,
+			IncludeHidden: includeHidden,
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]interface{}, len(keys))
+		for i, key := range keys {
+			results[i] = key
+		}
+
+		return results, nil
+	}
+
+	paginator := timeIDPaginator{
+		QueryFunc:  queryFunc,
+		CursorFunc: feedCursor,
+	}
+
+	results, pageInfo, err := paginator.paginate(before, after, first, last)
+
+	feedEvents := make([]db.FeedEvent, len(results))
+	for i, result := range results {
+		feedEvents[i] = result.(db.FeedEvent)
+	}
+
+	return feedEvents, pageInfo, err
+}
+
+func (api FeedAPI) PaginateCollectionFeed(ctx context.Context, collectionID persist.DBID, before *string, after *string,
+	first *int, last *int) ([]db.FeedEvent, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"collectionID": {collectionID, "required"},
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]interface{}, error) {
+		keys, err := api.queries.PaginateCollectionFeedByCollectionID(ctx, db.PaginateCollectionFeedByCollectionIDParams{
+			CollectionID:  collectionID.String(),
+			Limit:         params.Limit,
+			CurBeforeTime: params.CursorBeforeTime,
+			CurBeforeID:   params.CursorBeforeID,
+			CurAfterTime:  params.CursorAfterTime,
+			CurAfterID:    params.CursorAfterID,
+			PagingForward: params.PagingForward,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]interface{}, len(keys))
+		for i, key := range keys {
+			results[i] = key
+		}
+
+		return results, nil
+	}
+
+	paginator := timeIDPaginator{
+		QueryFunc:  queryFunc,
+		CursorFunc: feedCursor,
+	}
+
+	results, pageInfo, err := paginator.paginate(before, after, first, last)
+
+	feedEvents := make([]db.FeedEvent, len(results))
+	for i, result := range results {
+		feedEvents[i] = result.(db.FeedEvent)
+	}
+
+	return feedEvents, pageInfo, err
+}
+
+// PaginateHashtagFeed returns the feed events whose caption or collectors note has the hashtag.
+func (api FeedAPI) PaginateHashtagFeed(ctx context.Context, hashtag string, before *string, after *string,
+	first *int, last *int) ([]db.FeedEvent, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"hashtag": {hashtag, "required,hashtag"},
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]interface{}, error) {
+		keys, err := api.queries.PaginateHashtagFeed(ctx, db.PaginateHashtagFeedParams{
+			Hashtag:       entity.NormalizeHashtag(hashtag),
 			Limit:         params.Limit,
 			CurBeforeTime: params.CursorBeforeTime,
 			CurBeforeID:   params.CursorBeforeID,
@@ -353,6 +500,322 @@ func (api FeedAPI) PaginateTrendingFeed(ctx context.Context, before *string, aft
 	return feedEvents, pageInfo, err
 }
 
+// PaginatePersonalizedFeed returns the viewer's feed ranked by how relevant each event is likely to be to them,
+// rather than by when it happened. Events are ranked by how close the viewer is to the event's owner in the follow
+// graph, how many communities they share, how quickly the event is picking up interactions and how recent it is.
+func (api FeedAPI) PaginatePersonalizedFeed(ctx context.Context, before *string, after *string, first *int, last *int) ([]db.FeedEvent, PageInfo, error) {
+	// Validate
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	paginator := scoreIDPaginator{MaxAge: personalizedFeedTTL}
+
+	// Later pages are ranked at the same point in time as the first page
+	rankedAt, err := paginator.rankedAt(before, after)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	firstPage := rankedAt.IsZero()
+	if firstPage {
+		rankedAt = time.Now()
+	}
+
+	ranked, err := api.loadPersonalizedFeedRanking(ctx, userID, rankedAt, firstPage)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	scores := make(map[persist.DBID]float64, len(ranked))
+	for _, r := range ranked {
+		scores[r.ID] = r.Score
+	}
+
+	paginator.QueryFunc = func(params scoreIDPagingParams) ([]any, error) {
+		inRange := rankedBetweenCursors(ranked, params)
+
+		// Events may have been deleted or hidden since they were ranked, so load them in chunks until the page is filled
+		results := make([]any, 0, params.Limit)
+		for start := 0; start < len(inRange) && len(results) < int(params.Limit); start += int(params.Limit) {
+			end := start + int(params.Limit)
+			if end > len(inRange) {
+				end = len(inRange)
+			}
+
+			events, errs := api.loaders.FeedEventByFeedEventID.LoadAll(inRange[start:end])
+			for i, event := range events {
+				if errs[i] != nil {
+					if _, ok := errs[i].(persist.ErrFeedEventNotFoundByID); ok {
+						continue
+					}
+					return nil, errs[i]
+				}
+				if event.Hidden {
+					continue
+				}
+				if len(results) < int(params.Limit) {
+					results = append(results, event)
+				}
+			}
+		}
+
+		return results, nil
+	}
+
+	paginator.CursorFunc = func(node any) (time.Time, float64, persist.DBID, error) {
+		if event, ok := node.(db.FeedEvent); ok {
+			return rankedAt, scores[event.ID], event.ID, nil
+		}
+		return time.Time{}, 0, "", fmt.Errorf("node is not a feed event")
+	}
+
+	paginator.CountFunc = func() (int, error) {
+		return len(ranked), nil
+	}
+
+	results, pageInfo, err := paginator.paginate(before, after, first, last)
+
+	feedEvents := make([]db.FeedEvent, len(results))
+	for i, result := range results {
+		feedEvents[i] = result.(db.FeedEvent)
+	}
+
+	return feedEvents, pageInfo, err
+}
+
+// rankedBetweenCursors returns the IDs of the ranked events between the cursors, in the order they're paged through.
+// Events are ranked in ascending order, so that the most relevant events are at the end of the feed like they are in
+// the chronological feeds.
+func rankedBetweenCursors(ranked []rankedFeedEvent, params scoreIDPagingParams) []persist.DBID {
+	inRange := make([]persist.DBID, 0, len(ranked))
+	for _, r := range ranked {
+		if !rankedBefore(rankedFeedEvent{ID: params.CursorAfterID, Score: params.CursorAfterScore}, r) ||
+			!rankedBefore(r, rankedFeedEvent{ID: params.CursorBeforeID, Score: params.CursorBeforeScore}) {
+			continue
+		}
+		inRange = append(inRange, r.ID)
+	}
+
+	if !params.PagingForward {
+		for i, j := 0, len(inRange)-1; i < j; i, j = i+1, j-1 {
+			inRange[i], inRange[j] = inRange[j], inRange[i]
+		}
+	}
+
+	return inRange
+}
+
+type rankedFeedEvent struct {
+	ID    persist.DBID `json:"id"`
+	Score float64      `json:"score"`
+}
+
+// rankedBefore returns true if a is ranked lower than b
+func rankedBefore(a, b rankedFeedEvent) bool {
+	if a.Score != b.Score {
+		return a.Score < b.Score
+	}
+	return a.ID < b.ID
+}
+
+// loadPersonalizedFeedRanking returns the ranking of a user's feed at the given time in ascending order. Rankings are
+// cached so that paging through them is consistent, since recommendations can vary from one computation to the next.
+// A ranking is only computed for the first page; ErrRankingExpired is returned for later pages of a ranking that is
+// no longer cached.
+func (api FeedAPI) loadPersonalizedFeedRanking(ctx context.Context, userID persist.DBID, rankedAt time.Time, firstPage bool) ([]rankedFeedEvent, error) {
+	key := fmt.Sprintf("personalized.feedEvents.%s.%d", userID, rankedAt.UnixNano())
+
+	byt, err := api.cache.Get(ctx, key)
+	var notFoundErr redis.ErrKeyNotFound
+
+	if err != nil && !errors.As(err, &notFoundErr) {
+		return nil, err
+	}
+
+	if errors.As(err, &notFoundErr) {
+		if !firstPage {
+			return nil, ErrRankingExpired
+		}
+
+		ranked, err := api.rankPersonalizedFeed(ctx, userID, rankedAt)
+		if err != nil {
+			return nil, err
+		}
+
+		byt, err = json.Marshal(ranked)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := api.cache.Set(ctx, key, byt, personalizedFeedTTL); err != nil {
+			return nil, err
+		}
+	}
+
+	var ranked []rankedFeedEvent
+	return ranked, json.Unmarshal(byt, &ranked)
+}
+
+func (api FeedAPI) rankPersonalizedFeed(ctx context.Context, userID persist.DBID, rankedAt time.Time) ([]rankedFeedEvent, error) {
+	follows, err := api.queries.GetFollowEdgesByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Users the viewer follows have the strongest affinity, followed by users that are recommended from their follows
+	affinities := make(map[persist.DBID]float64)
+	for _, follow := range follows {
+		affinities[follow.Followee] = 1
+	}
+
+	recommendedIDs, err := recommend.For(ctx).RecommendFromFollowing(ctx, userID, follows)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, id := range recommendedIDs {
+		if _, ok := affinities[id]; !ok {
+			// Recommendations are sorted by how strongly they're connected to the viewer's follows
+			affinities[id] = 0.5 * (1 - float64(i)/float64(len(recommendedIDs)))
+		}
+	}
+
+	delete(affinities, userID)
+
+	ownerIDs := make([]string, 0, len(affinities))
+	for id := range affinities {
+		ownerIDs = append(ownerIDs, id.String())
+	}
+
+	candidates, err := api.queries.GetFeedEventCandidatesByOwnerIDs(ctx, db.GetFeedEventCandidatesByOwnerIDsParams{
+		OwnerIds:    ownerIDs,
+		WindowStart: rankedAt.Add(-personalizedFeedWindow),
+		WindowEnd:   rankedAt,
+		Limit:       personalizedFeedMaxCandidates,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(candidates) == 0 {
+		return []rankedFeedEvent{}, nil
+	}
+
+	// Reposts are also credited with the interactions on the event they repost
+	eventIDs := make([]string, 0, len(candidates))
+	for _, candidate := range candidates {
+		eventIDs = append(eventIDs, candidate.ID.String())
+		if candidate.RootID != candidate.ID.String() {
+			eventIDs = append(eventIDs, candidate.RootID)
+		}
+	}
+
+	interactionCounts, err := api.queries.CountFeedEventInteractionsInWindow(ctx, db.CountFeedEventInteractionsInWindowParams{
+		FeedEventIds: eventIDs,
+		WindowStart:  rankedAt.Add(-personalizedFeedVelocityWindow),
+		WindowEnd:    rankedAt,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	interactions := make(map[persist.DBID]int64, len(interactionCounts))
+	for _, count := range interactionCounts {
+		interactions[count.FeedEventID] = count.Count
+	}
+
+	sharedCounts, err := api.queries.CountSharedContractsByUserIDs(ctx, db.CountSharedContractsByUserIDsParams{
+		UserID:  userID,
+		UserIds: ownerIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sharedCommunities := make(map[persist.DBID]int64, len(sharedCounts))
+	for _, count := range sharedCounts {
+		sharedCommunities[count.UserID] = count.Count
+	}
+
+	scored := make([]rankedFeedEvent, len(candidates))
+	roots := make(map[persist.DBID]persist.DBID, len(candidates))
+	for i, candidate := range candidates {
+		eventInteractions := interactions[candidate.ID]
+		if candidate.RootID != candidate.ID.String() {
+			eventInteractions += interactions[persist.DBID(candidate.RootID)]
+		}
+		roots[candidate.ID] = persist.DBID(candidate.RootID)
+		scored[i] = rankedFeedEvent{
+			ID: candidate.ID,
+			Score: scoreFeedEvent(feedEventSignals{
+				EventTime:         candidate.EventTime,
+				Affinity:          affinities[candidate.OwnerID],
+				SharedCommunities: sharedCommunities[candidate.OwnerID],
+				Interactions:      eventInteractions,
+			}, rankedAt),
+		}
+	}
+
+	sort.Slice(scored, func(i, j int) bool {
+		return rankedBefore(scored[i], scored[j])
+	})
+
+	return dedupeReposts(scored, roots), nil
+}
+
+// dedupeReposts only keeps the highest ranked of an original event and its reposts. ranked must be sorted in
+// ascending order, and roots maps each event to the event it reposts, or to itself if it isn't a repost.
+func dedupeReposts(ranked []rankedFeedEvent, roots map[persist.DBID]persist.DBID) []rankedFeedEvent {
+	seen := make(map[persist.DBID]bool, len(ranked))
+	deduped := make([]rankedFeedEvent, len(ranked))
+	start := len(ranked)
+	for i := len(ranked) - 1; i >= 0; i-- {
+		if root := roots[ranked[i].ID]; !seen[root] {
+			seen[root] = true
+			start--
+			deduped[start] = ranked[i]
+		}
+	}
+	return deduped[start:]
+}
+
+type feedEventSignals struct {
+	EventTime         time.Time
+	Affinity          float64 // How close the viewer is to the event's owner, from 0 to 1
+	SharedCommunities int64   // The number of communities the viewer and the event's owner are both in
+	Interactions      int64   // The number of interactions within the velocity window
+}
+
+// scoreFeedEvent combines an event's signals into a score. Freshness scales the score as a whole so that older
+// events eventually give way to newer ones, no matter how relevant they were.
+func scoreFeedEvent(signals feedEventSignals, rankedAt time.Time) float64 {
+	age := rankedAt.Sub(signals.EventTime)
+	if age < 0 {
+		age = 0
+	}
+
+	freshness := math.Pow(0.5, age.Hours()/personalizedFeedHalfLife.Hours())
+
+	// Having more than a handful of communities in common doesn't make an event much more relevant
+	community := math.Min(float64(signals.SharedCommunities), 10) / 10
+
+	// Interactions per hour, which is measured over the event's lifetime if it's younger than the window
+	hours := math.Max(math.Min(age.Hours(), personalizedFeedVelocityWindow.Hours()), 1)
+	perHour := float64(signals.Interactions) / hours
+	velocity := perHour / (perHour + 1)
+
+	return freshness * (1 +
+		personalizedFeedWeights.Affinity*signals.Affinity +
+		personalizedFeedWeights.Community*community +
+		personalizedFeedWeights.Velocity*velocity)
+}
+
 func (api FeedAPI) TrendingUsers(ctx context.Context, report model.Window) ([]db.User, error) {
 	calcFunc := func(ctx context.Context) ([]persist.DBID, error) {
 		if report.Name == "ALL_TIME" {
@@ -393,6 +856,131 @@ func (api FeedAPI) TrendingUsers(ctx context.Context, report model.Window) ([]db
 	return api.queries.GetTrendingUsersByIDs(ctx, asStr)
 }
 
+// UpdateFeedEventCaption changes the caption of one of the viewer's own feed events. An empty caption removes it.
+func (api FeedAPI) UpdateFeedEventCaption(ctx context.Context, feedEventID persist.DBID, caption string) (*db.FeedEvent, error) {
+	// Trim and optimistically sanitize the input while we're at it.
+	caption = strings.TrimSpace(caption)
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"feedEventID": {feedEventID, "required"},
+		"caption":     {caption, "caption"},
+	}); err != nil {
+		return nil, err
+	}
+
+	caption = validate.SanitizationPolicy.Sanitize(caption)
+
+	event, err := api.getOwnFeedEvent(ctx, feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if time.Since(event.CreatedAt) > feedEventCaptionEditWindow {
+		return nil, ErrFeedEventEditWindowClosed
+	}
+
+	updated, err := api.queries.UpdateFeedEventCaption(ctx, db.UpdateFeedEventCaptionParams{
+		Caption: sql.NullString{String: caption, Valid: caption != ""},
+		ID:      feedEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	updateCaptionEntities(ctx, api.queries, api.validator, &updated)
+
+	return &updated, nil
+}
+
+// SetFeedEventHidden hides or unhides one of the viewer's own feed events. Hidden feed events keep their admires and
+// comments, but are left out of every feed except their owner's own.
+func (api FeedAPI) SetFeedEventHidden(ctx context.Context, feedEventID persist.DBID, hidden bool) (*db.FeedEvent, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"feedEventID": {feedEventID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	if _, err := api.getOwnFeedEvent(ctx, feedEventID); err != nil {
+		return nil, err
+	}
+
+	updated, err := api.queries.SetFeedEventHidden(ctx, db.SetFeedEventHiddenParams{
+		Hidden: hidden,
+		ID:     feedEventID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &updated, nil
+}
+
+// DeleteFeedEvent removes one of the viewer's own feed events from every feed, along with its reposts, admires, comments and notifications
+func (api FeedAPI) DeleteFeedEvent(ctx context.Context, feedEventID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"feedEventID": {feedEventID, "required"},
+	}); err != nil {
+		return err
+	}
+
+	if _, err := api.getOwnFeedEvent(ctx, feedEventID); err != nil {
+		return err
+	}
+
+	tx, err := api.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	q := api.queries.WithTx(tx)
+
+	if err := q.DeleteFeedEvent(ctx, feedEventID); err != nil {
+		return err
+	}
+
+	if err := q.DeleteRepostsOfFeedEvent(ctx, feedEventID.String()); err != nil {
+		return err
+	}
+
+	if err := q.DeleteAdmiresByFeedEventID(ctx, feedEventID); err != nil {
+		return err
+	}
+
+	if err := q.DeleteCommentsByFeedEventID(ctx, feedEventID); err != nil {
+		return err
+	}
+
+	if err := q.DeleteNotificationsByFeedEventID(ctx, feedEventID); err != nil {
+		return err
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (api FeedAPI) getOwnFeedEvent(ctx context.Context, feedEventID persist.DBID) (*db.FeedEvent, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	event, err := api.loaders.FeedEventByFeedEventID.Load(feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if event.OwnerID != userID {
+		return nil, ErrOnlyEditOwnFeedEvent
+	}
+
+	return &event, nil
+}
+
 func feedCursor(i interface{}) (time.Time, persist.DBID, error) {
 	if row, ok := i.(db.FeedEvent); ok {
 		return row.EventTime, row.ID, nil
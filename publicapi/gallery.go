@@ -3,11 +3,14 @@ package publicapi
 import (
 	"context"
 	"crypto/sha256"
+	"database/sql"
 	"encoding"
 	"encoding/base64"
 	"fmt"
 	"net"
+	"time"
 
+	gcptasks "cloud.google.com/go/cloudtasks/apiv2"
 	"github.com/jackc/pgtype"
 	"github.com/jackc/pgx/v4"
 	"github.com/mikeydub/go-gallery/env"
@@ -22,17 +25,25 @@ import (
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/task"
 )
 
 const maxCollectionsPerGallery = 1000
 
+// maxPublishSchedule is how far ahead a gallery can be scheduled to publish, which is as long as a cloud task can be scheduled for
+const maxPublishSchedule = 30 * 24 * time.Hour
+
+var ErrPublishScheduleTooFar = fmt.Errorf("a gallery can't be scheduled to publish more than %s from now", maxPublishSchedule)
+
 type GalleryAPI struct {
-	repos     *postgres.Repositories
-	queries   *db.Queries
-	loaders   *dataloader.Loaders
-	validator *validator.Validate
-	ethClient *ethclient.Client
+	repos      *postgres.Repositories
+	queries    *db.Queries
+	loaders    *dataloader.Loaders
+	validator  *validator.Validate
+	ethClient  *ethclient.Client
+	taskClient *gcptasks.Client
 }
 
 func (api GalleryAPI) CreateGallery(ctx context.Context, name, description *string, position string) (db.Gallery, error) {
@@ -121,6 +132,7 @@ func (api GalleryAPI) UpdateGallery(ctx context.Context, update model.UpdateGall
 			Hidden:         c.Hidden,
 			Nfts:           c.Tokens,
 			TokenSettings:  modelToTokenSettings(c.TokenSettings),
+			Draft:          util.FromPointer(c.Draft),
 		})
 		if err != nil {
 			return db.Gallery{}, err
@@ -167,6 +179,7 @@ func (api GalleryAPI) UpdateGallery(ctx context.Context, update model.UpdateGall
 
 	util.SetConditionalValue(update.Name, &params.Name, &params.NameSet)
 	util.SetConditionalValue(update.Description, &params.Description, &params.DescriptionSet)
+	util.SetConditionalValue(update.Draft, &params.Draft, &params.DraftSet)
 
 	err = q.UpdateGalleryInfo(ctx, params)
 	if err != nil {
@@ -223,6 +236,15 @@ func (api GalleryAPI) UpdateGallery(ctx context.Context, update model.UpdateGall
 		return db.Gallery{}, err
 	}
 
+	for _, e := range events {
+		if e.Data.CollectionCollectorsNote != "" {
+			updateTextEntities(ctx, api.queries, api.validator, entity.SourceCollectionCollectorsNote, e.CollectionID, e.Data.CollectionCollectorsNote, db.Event{
+				ActorID: e.ActorID,
+				Data:    persist.EventData{MentionCollectionID: e.CollectionID},
+			})
+		}
+	}
+
 	if update.Caption != nil && *update.Caption == "" {
 		update.Caption = nil
 	}
@@ -243,13 +265,69 @@ func (api GalleryAPI) PublishGallery(ctx context.Context, update model.PublishGa
 		return err
 	}
 
-	_, err := publishEventGroup(ctx, update.EditID, persist.ActionGalleryUpdated, update.Caption)
+	gallery, err := api.loaders.GalleryByGalleryID.Load(update.GalleryID)
 	if err != nil {
 		return err
 	}
 
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	if gallery.OwnerUserID != userID {
+		return fmt.Errorf("user %s is not the owner of gallery %s", userID, update.GalleryID)
+	}
+
+	if update.PublishAt != nil && update.PublishAt.After(time.Now()) {
+		return api.schedulePublish(ctx, gallery, update)
+	}
+
+	// Publishing now supersedes any publish that was scheduled for later
+	err = api.queries.PublishGalleryDrafts(ctx, update.GalleryID)
+	if err != nil {
+		return err
+	}
+
+	feedEvent, err := publishEventGroup(ctx, update.EditID, persist.ActionGalleryUpdated, update.Caption)
+	if err != nil {
+		return err
+	}
+
+	updateCaptionEntities(ctx, api.queries, api.validator, feedEvent)
+
 	return nil
 }
+
+// schedulePublish publishes the gallery's drafts and the feed event of the edit at a later time. Only the latest
+// schedule of a gallery is published, so scheduling again moves the publish to the new time and edit.
+func (api GalleryAPI) schedulePublish(ctx context.Context, gallery db.Gallery, update model.PublishGalleryInput) error {
+	if update.PublishAt.After(time.Now().Add(maxPublishSchedule)) {
+		return ErrPublishScheduleTooFar
+	}
+
+	// The caption is saved with the edit's events now so that it's published along with them
+	if update.Caption != nil && *update.Caption != "" {
+		err := api.queries.UpdateEventCaptionByGroup(ctx, db.UpdateEventCaptionByGroupParams{
+			Caption: persist.StrToNullStr(update.Caption),
+			GroupID: persist.StrToNullStr(&update.EditID),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := api.queries.ScheduleGalleryPublish(ctx, db.ScheduleGalleryPublishParams{
+		PublishAt:     sql.NullTime{Time: *update.PublishAt, Valid: true},
+		PublishEditID: persist.StrToNullStr(&update.EditID),
+		ID:            gallery.ID,
+	})
+	if err != nil {
+		return err
+	}
+
+	return task.CreateTaskForFeed(ctx, *update.PublishAt, task.FeedMessage{ID: gallery.ID, EditID: update.EditID}, api.taskClient)
+}
 func updateCollectionsInfoAndTokens(ctx context.Context, q *db.Queries, actor, gallery persist.DBID, update []*model.UpdateCollectionInput) ([]db.Event, error) {
 
 	events := make([]db.Event, 0)
@@ -401,6 +479,10 @@ func (api GalleryAPI) GetGalleryById(ctx context.Context, galleryID persist.DBID
 		return nil, err
 	}
 
+	if isHiddenDraft(ctx, gallery.Draft, gallery.OwnerUserID) {
+		return nil, persist.ErrGalleryNotFound{ID: galleryID}
+	}
+
 	return &gallery, nil
 }
 
@@ -443,6 +525,10 @@ func (api GalleryAPI) GetGalleryByCollectionId(ctx context.Context, collectionID
 		return nil, err
 	}
 
+	if isHiddenDraft(ctx, gallery.Draft, gallery.OwnerUserID) {
+		return nil, persist.ErrGalleryNotFound{CollectionID: collectionID}
+	}
+
 	return &gallery, nil
 }
 
@@ -459,7 +545,20 @@ func (api GalleryAPI) GetGalleriesByUserId(ctx context.Context, userID persist.D
 		return nil, err
 	}
 
-	return galleries, nil
+	visible := make([]db.Gallery, 0, len(galleries))
+	for _, gallery := range galleries {
+		if !isHiddenDraft(ctx, gallery.Draft, gallery.OwnerUserID) {
+			visible = append(visible, gallery)
+		}
+	}
+
+	return visible, nil
+}
+
+// isHiddenDraft returns whether something is a draft that the viewer can't see. Only the owner of a draft can see it
+// before it's published.
+func isHiddenDraft(ctx context.Context, draft bool, ownerID persist.DBID) bool {
+	return draft && getViewerID(ctx) != ownerID
 }
 
 func (api GalleryAPI) GetTokenPreviewsByGalleryID(ctx context.Context, galleryID persist.DBID) ([]persist.Media, error) {
@@ -471,8 +570,9 @@ func (api GalleryAPI) GetTokenPreviewsByGalleryID(ctx context.Context, galleryID
 	}
 
 	medias, err := api.queries.GetGalleryTokenMediasByGalleryID(ctx, db.GetGalleryTokenMediasByGalleryIDParams{
-		ID:    galleryID,
-		Limit: 4,
+		ID:       galleryID,
+		ViewerID: getViewerID(ctx),
+		Limit:    4,
 	})
 	if err != nil {
 		if err == pgx.ErrNoRows {
@@ -617,6 +717,10 @@ func (api GalleryAPI) ViewGallery(ctx context.Context, galleryID persist.DBID) (
 		return db.Gallery{}, err
 	}
 
+	if isHiddenDraft(ctx, gallery.Draft, gallery.OwnerUserID) {
+		return db.Gallery{}, persist.ErrGalleryNotFound{ID: galleryID}
+	}
+
 	gc := util.GinContextFromContext(ctx)
 
 	if auth.GetUserAuthedFromCtx(gc) {
@@ -14,8 +14,11 @@ import (
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/event"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 )
 
 var ErrOnlyRemoveOwnAdmire = errors.New("only the actor who created the admire can remove it")
@@ -361,6 +364,11 @@ func (api InteractionAPI) AdmireFeedEvent(ctx context.Context, feedEventID persi
 		return "", err
 	}
 
+	// Deleted feed events can't be interacted with
+	if _, err := api.loaders.FeedEventByFeedEventID.Load(feedEventID); err != nil {
+		return "", err
+	}
+
 	admire, err := api.GetAdmireByActorIDAndFeedEventID(ctx, userID, feedEventID)
 	if err == nil {
 		return "", persist.ErrAdmireAlreadyExists{AdmireID: admire.ID, ActorID: userID, FeedEventID: feedEventID}
@@ -388,6 +396,87 @@ func (api InteractionAPI) AdmireFeedEvent(ctx context.Context, feedEventID persi
 	return admireID, err
 }
 
+// RepostFeedEvent shares a feed event to the viewer's followers with an optional quote. Reposting a repost
+// shares the original feed event instead.
+func (api InteractionAPI) RepostFeedEvent(ctx context.Context, feedEventID persist.DBID, quote *string) (*db.FeedEvent, error) {
+	if quote != nil {
+		trimmed := strings.TrimSpace(*quote)
+		quote = &trimmed
+	}
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"feedEventID": {feedEventID, "required"},
+		"quote":       {quote, "omitempty,caption"},
+	}); err != nil {
+		return nil, err
+	}
+
+	if quote != nil {
+		if *quote == "" {
+			quote = nil
+		} else {
+			sanitized := validate.SanitizationPolicy.Sanitize(*quote)
+			quote = &sanitized
+		}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	original, err := api.loaders.FeedEventByFeedEventID.Load(feedEventID)
+	if err != nil {
+		return nil, err
+	}
+
+	if original.Action == persist.ActionRepostedFeedEvent && !original.Hidden {
+		original, err = api.loaders.FeedEventByFeedEventID.Load(original.Data.RepostedFeedEventID)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Hidden feed events are left out of feeds, so they can't be shared to followers either
+	if original.Hidden {
+		return nil, persist.ErrFeedEventNotFoundByID{ID: original.ID}
+	}
+
+	reposted, err := api.queries.IsFeedEventRepostedByUser(ctx, db.IsFeedEventRepostedByUserParams{
+		OwnerID:     userID,
+		FeedEventID: original.ID.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if reposted {
+		return nil, persist.ErrFeedEventAlreadyReposted{ActorID: userID, FeedEventID: original.ID}
+	}
+
+	evt := db.Event{
+		ActorID:        persist.DBIDToNullStr(userID),
+		ResourceTypeID: persist.ResourceTypeFeedEvent,
+		SubjectID:      original.ID,
+		FeedEventID:    original.ID,
+		Action:         persist.ActionRepostedFeedEvent,
+		Caption:        persist.StrToNullStr(quote),
+	}
+	if err := api.validator.Struct(evt); err != nil {
+		return nil, err
+	}
+
+	// Reposts are always published right away, with or without a quote
+	feedEvent, err := event.DispatchImmediate(sentryutil.NewSentryHubGinContext(ctx), []db.Event{evt})
+	if err != nil {
+		return nil, err
+	}
+
+	updateCaptionEntities(ctx, api.queries, api.validator, feedEvent)
+
+	return feedEvent, nil
+}
+
 func (api InteractionAPI) RemoveAdmire(ctx context.Context, admireID persist.DBID) (persist.DBID, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
@@ -456,7 +545,7 @@ func (api InteractionAPI) CommentOnFeedEvent(ctx context.Context, feedEventID pe
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"feedEventID": {feedEventID, "required"},
-		"comment":     {comment, "required"},
+		"comment":     {comment, "required,comment"},
 	}); err != nil {
 		return "", err
 	}
@@ -466,6 +555,11 @@ func (api InteractionAPI) CommentOnFeedEvent(ctx context.Context, feedEventID pe
 		return "", err
 	}
 
+	// Deleted feed events can't be interacted with
+	if _, err := api.loaders.FeedEventByFeedEventID.Load(feedEventID); err != nil {
+		return "", err
+	}
+
 	// Sanitize
 	comment = validate.SanitizationPolicy.Sanitize(comment)
 
@@ -486,6 +580,11 @@ func (api InteractionAPI) CommentOnFeedEvent(ctx context.Context, feedEventID pe
 		return "", err
 	}
 
+	updateTextEntities(ctx, api.queries, api.validator, entity.SourceComment, commentID, comment, db.Event{
+		ActorID: persist.DBIDToNullStr(actor),
+		Data:    persist.EventData{MentionFeedEventID: feedEventID, MentionCommentID: commentID},
+	})
+
 	return commentID, nil
 }
 
@@ -6,9 +6,14 @@ import (
 	"time"
 
 	"github.com/go-playground/validator/v10"
+	"github.com/jackc/pgx/v4"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/notifications"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/push"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/validate"
 )
 
@@ -91,7 +96,7 @@ func (api NotificationsAPI) GetViewerNotifications(ctx context.Context, before,
 		}
 	}
 
-	count, err := api.queries.CountUserUnseenNotifications(ctx, userID)
+	count, err := api.queries.GetUnseenNotificationCount(ctx, userID)
 	if err != nil {
 		return nil, PageInfo{}, 0, err
 	}
@@ -99,6 +104,17 @@ func (api NotificationsAPI) GetViewerNotifications(ctx context.Context, before,
 	return notifications, pageInfo, int(count), err
 }
 
+// GetViewerUnseenNotificationCount returns the number of the viewer's notifications that haven't been read or archived
+func (api NotificationsAPI) GetViewerUnseenNotificationCount(ctx context.Context) (int, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := api.queries.GetUnseenNotificationCount(ctx, userID)
+	return int(count), err
+}
+
 func (api NotificationsAPI) GetByID(ctx context.Context, id persist.DBID) (db.Notification, error) {
 	return api.loaders.NotificationByID.Load(id)
 }
@@ -108,5 +124,175 @@ func (api NotificationsAPI) ClearUserNotifications(ctx context.Context) ([]db.No
 	if err != nil {
 		return nil, err
 	}
-	return api.queries.ClearNotificationsForUser(ctx, userID)
+
+	notifs, err := api.queries.ClearNotificationsForUser(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	publishNotificationUpdates(ctx, notifs)
+	return notifs, nil
+}
+
+// SetNotificationSeen marks one of the viewer's notifications as read or unread
+func (api NotificationsAPI) SetNotificationSeen(ctx context.Context, notificationID persist.DBID, seen bool) (*db.Notification, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"notificationID": {notificationID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notif, err := api.queries.SetNotificationSeen(ctx, db.SetNotificationSeenParams{
+		Seen:    seen,
+		ID:      notificationID,
+		OwnerID: userID,
+	})
+	if err != nil {
+		return nil, notificationNotFoundErr(notificationID, err)
+	}
+
+	publishNotificationUpdates(ctx, []db.Notification{notif})
+	return &notif, nil
+}
+
+// ArchiveNotification hides one of the viewer's notifications from their notifications. Archived notifications
+// no longer count towards the viewer's unseen notifications.
+func (api NotificationsAPI) ArchiveNotification(ctx context.Context, notificationID persist.DBID) (*db.Notification, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"notificationID": {notificationID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notif, err := api.queries.ArchiveNotification(ctx, db.ArchiveNotificationParams{
+		ID:      notificationID,
+		OwnerID: userID,
+	})
+	if err != nil {
+		return nil, notificationNotFoundErr(notificationID, err)
+	}
+
+	publishNotificationUpdates(ctx, []db.Notification{notif})
+	return &notif, nil
+}
+
+// MarkNotificationsSeenBefore marks the viewer's notifications that were last updated at or before the given time as
+// read. Clients pass the time of the newest notification they've shown, so notifications that arrive in the
+// meantime stay unread.
+func (api NotificationsAPI) MarkNotificationsSeenBefore(ctx context.Context, before time.Time) ([]db.Notification, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"before": {before, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	notifs, err := api.queries.MarkNotificationsSeenBefore(ctx, db.MarkNotificationsSeenBeforeParams{
+		OwnerID: userID,
+		Before:  before,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	publishNotificationUpdates(ctx, notifs)
+	return notifs, nil
+}
+
+// publishNotificationUpdates sends notifications whose read state changed to the owner's notificationUpdated
+// subscriptions so that every open client stays in sync. The change is already saved, so failing to publish it
+// doesn't fail the request.
+func publishNotificationUpdates(ctx context.Context, notifs []db.Notification) {
+	if err := notifications.For(ctx).PublishUpdated(ctx, notifs...); err != nil {
+		logger.For(ctx).Errorf("failed to publish updated notifications: %s", err)
+		sentryutil.ReportError(ctx, err)
+	}
+}
+
+func notificationNotFoundErr(notificationID persist.DBID, err error) error {
+	if err == pgx.ErrNoRows {
+		return persist.ErrNotificationNotFound{ID: notificationID}
+	}
+	return err
+}
+
+// RegisterPushDevice registers a device or browser that the viewer's notifications are pushed to. token is the
+// device's APNs or FCM token, or the endpoint of a browser's push subscription, in which case p256dh and auth are
+// the subscription's keys.
+func (api NotificationsAPI) RegisterPushDevice(ctx context.Context, platform push.Platform, token string, p256dh string, auth string) (*db.PushDevice, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"platform": {platform, fmt.Sprintf("required,oneof=%s %s %s", push.PlatformWeb, push.PlatformIOS, push.PlatformAndroid)},
+		"token":    {token, "required,max=2048"},
+	}); err != nil {
+		return nil, err
+	}
+
+	if platform == push.PlatformWeb {
+		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+			"token":  {token, "url,startswith=https://"},
+			"p256dh": {p256dh, "required"},
+			"auth":   {auth, "required"},
+		}); err != nil {
+			return nil, err
+		}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	device, err := api.queries.UpsertPushDevice(ctx, db.UpsertPushDeviceParams{
+		ID:       persist.GenerateID(),
+		OwnerID:  userID,
+		Platform: string(platform),
+		Token:    token,
+		P256dh:   p256dh,
+		Auth:     auth,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &device, nil
+}
+
+// UnregisterPushDevice stops pushing the viewer's notifications to a device, e.g. when they log out of the app
+func (api NotificationsAPI) UnregisterPushDevice(ctx context.Context, platform push.Platform, token string) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"platform": {platform, fmt.Sprintf("required,oneof=%s %s %s", push.PlatformWeb, push.PlatformIOS, push.PlatformAndroid)},
+		"token":    {token, "required"},
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return api.queries.DeletePushDeviceByToken(ctx, db.DeletePushDeviceByTokenParams{
+		OwnerID:  userID,
+		Platform: string(platform),
+		Token:    token,
+	})
 }
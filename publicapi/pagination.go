@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/base64"
 	"encoding/binary"
+	"errors"
 	"fmt"
 	"math"
 	"strings"
@@ -32,6 +33,11 @@ var (
 	defaultCursorBeforePositon = -1
 	// Some position that comes before any other position
 	defaultCursorAfterPosition = math.MaxInt32
+
+	// Some score that comes after any other score
+	defaultCursorBeforeScore = math.Inf(1)
+	// Some score that comes before any other score
+	defaultCursorAfterScore = math.Inf(-1)
 )
 
 type PageInfo struct {
@@ -758,6 +764,155 @@ func (p *positionPaginator) paginate(before *string, after *string, first *int,
 	return paginator.paginate(before, after, first, last)
 }
 
+// ErrRankingExpired is returned when paging with a cursor of a ranking that is no longer kept around. Paging has to
+// start over, since a new ranking would order the results differently.
+var ErrRankingExpired = errors.New("ranking expired, paging has to start over")
+
+// scoreIDPaginator paginates results that are ranked by a score computed at a point in time. The time that
+// the ranking was computed is kept in the cursor alongside the score and DBID of a node, so that each page is
+// ranked against the same point in time and results don't move between pages as new activity comes in.
+type scoreIDPaginator struct {
+	// QueryFunc returns paginated results for the given paging parameters
+	QueryFunc func(params scoreIDPagingParams) ([]any, error)
+
+	// MaxAge is how long a ranking is kept around for paging through. Cursors of older rankings are rejected with
+	// ErrRankingExpired. May be zero, in which case rankings don't expire.
+	MaxAge time.Duration
+
+	// CursorFunc returns the time the ranking was computed, and a score and DBID that will be encoded into a cursor string
+	CursorFunc func(node any) (time.Time, float64, persist.DBID, error)
+
+	// CountFunc returns the total number of items that can be paginated. May be nil, in which
+	// case the resulting PageInfo will omit the total field.
+	CountFunc func() (count int, err error)
+}
+
+type scoreIDPagingParams struct {
+	Limit             int32
+	RankedAt          time.Time
+	CursorBeforeScore float64
+	CursorBeforeID    persist.DBID
+	CursorAfterScore  float64
+	CursorAfterID     persist.DBID
+	PagingForward     bool
+}
+
+func (p *scoreIDPaginator) encodeCursor(rankedAt time.Time, score float64, id persist.DBID) (string, error) {
+	encoder := newCursorEncoder()
+	if err := encoder.appendTime(rankedAt); err != nil {
+		return "", err
+	}
+	encoder.appendFloat64(score)
+	encoder.appendDBID(id)
+	return encoder.AsBase64(), nil
+}
+
+func (p *scoreIDPaginator) decodeCursor(cursor string) (time.Time, float64, persist.DBID, error) {
+	decoder, err := newCursorDecoder(cursor)
+	if err != nil {
+		return time.Time{}, 0, "", err
+	}
+
+	rankedAt, err := decoder.readTime()
+	if err != nil {
+		return time.Time{}, 0, "", err
+	}
+
+	score, err := decoder.readFloat64()
+	if err != nil {
+		return time.Time{}, 0, "", err
+	}
+
+	id, err := decoder.readDBID()
+	if err != nil {
+		return time.Time{}, 0, "", err
+	}
+
+	return rankedAt, score, id, nil
+}
+
+// rankedAt returns the time that the ranking of the given cursors was computed, or the zero time if there
+// aren't any cursors
+func (p *scoreIDPaginator) rankedAt(before *string, after *string) (time.Time, error) {
+	for _, cursor := range []*string{before, after} {
+		if cursor != nil {
+			rankedAt, _, _, err := p.decodeCursor(*cursor)
+			if err != nil {
+				return time.Time{}, err
+			}
+			return rankedAt, p.checkExpiry(rankedAt)
+		}
+	}
+	return time.Time{}, nil
+}
+
+func (p *scoreIDPaginator) checkExpiry(rankedAt time.Time) error {
+	if p.MaxAge > 0 && time.Since(rankedAt) > p.MaxAge {
+		return ErrRankingExpired
+	}
+	return nil
+}
+
+func (p *scoreIDPaginator) paginate(before *string, after *string, first *int, last *int) ([]interface{}, PageInfo, error) {
+	queryFunc := func(limit int32, pagingForward bool) ([]interface{}, error) {
+		curBeforeScore := defaultCursorBeforeScore
+		curBeforeID := defaultCursorBeforeID
+		curAfterScore := defaultCursorAfterScore
+		curAfterID := defaultCursorAfterID
+
+		var err error
+		var rankedAt time.Time
+
+		if before != nil {
+			rankedAt, curBeforeScore, curBeforeID, err = p.decodeCursor(*before)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if after != nil {
+			rankedAt, curAfterScore, curAfterID, err = p.decodeCursor(*after)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if before != nil || after != nil {
+			if err := p.checkExpiry(rankedAt); err != nil {
+				return nil, err
+			}
+		}
+
+		queryParams := scoreIDPagingParams{
+			Limit:             limit,
+			RankedAt:          rankedAt,
+			CursorBeforeScore: curBeforeScore,
+			CursorBeforeID:    curBeforeID,
+			CursorAfterScore:  curAfterScore,
+			CursorAfterID:     curAfterID,
+			PagingForward:     pagingForward,
+		}
+
+		return p.QueryFunc(queryParams)
+	}
+
+	cursorFunc := func(node any) (string, error) {
+		rankedAt, score, id, err := p.CursorFunc(node)
+		if err != nil {
+			return "", err
+		}
+		return p.encodeCursor(rankedAt, score, id)
+	}
+
+	paginator := keysetPaginator{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  p.CountFunc,
+	}
+
+	return paginator.paginate(before, after, first, last)
+}
+
 type cursorEncoder struct {
 	buffer []byte
 }
@@ -828,6 +983,11 @@ func (e *cursorEncoder) appendInt64(i int64) {
 	e.buffer = append(e.buffer, buf[:bytesWritten]...)
 }
 
+// appendFloat64 appends a float64 to the underlying buffer, using its IEEE 754 binary representation
+func (e *cursorEncoder) appendFloat64(f float64) {
+	e.appendUInt64(math.Float64bits(f))
+}
+
 type cursorDecoder struct {
 	reader *bytes.Reader
 }
@@ -922,3 +1082,12 @@ func (d *cursorDecoder) readUInt64() (uint64, error) {
 func (d *cursorDecoder) readInt64() (int64, error) {
 	return binary.ReadVarint(d.reader)
 }
+
+// readFloat64 reads a float64 from the underlying reader and advances the stream
+func (d *cursorDecoder) readFloat64() (float64, error) {
+	i, err := d.readUInt64()
+	if err != nil {
+		return 0, err
+	}
+	return math.Float64frombits(i), nil
+}
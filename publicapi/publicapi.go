@@ -60,6 +60,8 @@ type PublicAPI struct {
 	Social        *SocialAPI
 	Card          *CardAPI
 	Search        *SearchAPI
+	Entity        *EntityAPI
+	Webhook       *WebhookAPI
 }
 
 func New(ctx context.Context, disableDataloaderCaching bool, repos *postgres.Repositories, queries *db.Queries, ethClient *ethclient.Client, ipfsClient *shell.Shell,
@@ -76,7 +78,7 @@ func New(ctx context.Context, disableDataloaderCaching bool, repos *postgres.Rep
 
 		Auth:          &AuthAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multiChainProvider: multichainProvider, magicLinkClient: magicClient},
 		Collection:    &CollectionAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient},
-		Gallery:       &GalleryAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient},
+		Gallery:       &GalleryAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, taskClient: taskClient},
 		User:          &UserAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, ipfsClient: ipfsClient, arweaveClient: arweaveClient, storageClient: storageClient, multichainProvider: multichainProvider},
 		Contract:      &ContractAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multichainProvider: multichainProvider, taskClient: taskClient},
 		Token:         &TokenAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, ethClient: ethClient, multichainProvider: multichainProvider, throttler: throttler},
@@ -90,6 +92,8 @@ func New(ctx context.Context, disableDataloaderCaching bool, repos *postgres.Rep
 		Social:        &SocialAPI{repos: repos, queries: queries, loaders: loaders, validator: validator, redis: socialCache},
 		Card:          &CardAPI{validator: validator, ethClient: ethClient, multichainProvider: multichainProvider, secrets: secrets},
 		Search:        &SearchAPI{queries: queries, loaders: loaders, validator: validator},
+		Entity:        &EntityAPI{queries: queries, validator: validator},
+		Webhook:       &WebhookAPI{queries: queries, validator: validator},
 	}
 }
 
@@ -126,6 +130,16 @@ func getAuthenticatedUserID(ctx context.Context) (persist.DBID, error) {
 	return userID, nil
 }
 
+// getViewerID returns the ID of the authenticated user, or an empty ID if the request isn't authenticated. Unlike
+// getAuthenticatedUserID, it can be called for requests that didn't go through the auth middleware.
+func getViewerID(ctx context.Context) persist.DBID {
+	gc := util.GinContextFromContext(ctx)
+	if !auth.GetUserAuthedFromCtx(gc) {
+		return ""
+	}
+	return auth.GetUserIDFromCtx(gc)
+}
+
 func publishEventGroup(ctx context.Context, groupID string, action persist.Action, caption *string) (*db.FeedEvent, error) {
 	return event.DispatchGroup(sentryutil.NewSentryHubGinContext(ctx), groupID, action, caption)
 }
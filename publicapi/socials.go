@@ -8,6 +8,7 @@ import (
 	"github.com/go-playground/validator/v10"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
 	"github.com/mikeydub/go-gallery/graphql/model"
 	"github.com/mikeydub/go-gallery/service/persist"
@@ -36,6 +37,25 @@ func (s SocialAPI) NewTwitterAuthenticator(userID persist.DBID, authCode string)
 	}
 }
 
+func (s SocialAPI) NewTelegramAuthenticator(authData socialauth.TelegramAuthData) (*socialauth.TelegramAuthenticator, error) {
+	botToken := env.GetString("TELEGRAM_BOT_TOKEN")
+	if botToken == "" {
+		return nil, socialauth.ErrTelegramNotConfigured
+	}
+	return &socialauth.TelegramAuthenticator{
+		BotToken: botToken,
+		AuthData: authData,
+	}, nil
+}
+
+func (s SocialAPI) NewDiscordAuthenticator(userID persist.DBID, authCode string) *socialauth.DiscordAuthenticator {
+	return &socialauth.DiscordAuthenticator{
+		AuthCode: authCode,
+		UserID:   userID,
+		Queries:  s.queries,
+	}
+}
+
 func (api SocialAPI) GetConnectionsPaginate(ctx context.Context, socialProvider persist.SocialProvider, before, after *string, first, last *int, onlyUnfollowing *bool) ([]model.SocialConnection, PageInfo, error) {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
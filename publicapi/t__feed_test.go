@@ -0,0 +1,206 @@
+package publicapi
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestScoreFeedEvent_Success(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	score := func(signals feedEventSignals) float64 {
+		return scoreFeedEvent(signals, now)
+	}
+
+	t.Run("an event without signals scores its freshness", func(t *testing.T) {
+		assert.Equal(t, 1.0, score(feedEventSignals{EventTime: now}))
+		assert.InDelta(t, 0.5, score(feedEventSignals{EventTime: now.Add(-personalizedFeedHalfLife)}), 1e-9)
+		assert.InDelta(t, 0.25, score(feedEventSignals{EventTime: now.Add(-2 * personalizedFeedHalfLife)}), 1e-9)
+	})
+
+	t.Run("events from the future aren't boosted", func(t *testing.T) {
+		assert.Equal(t, 1.0, score(feedEventSignals{EventTime: now.Add(time.Hour)}))
+	})
+
+	t.Run("closer owners score higher", func(t *testing.T) {
+		assert.Greater(t, score(feedEventSignals{EventTime: now, Affinity: 1}), score(feedEventSignals{EventTime: now, Affinity: 0.5}))
+	})
+
+	t.Run("shared communities are capped", func(t *testing.T) {
+		assert.Greater(t, score(feedEventSignals{EventTime: now, SharedCommunities: 10}), score(feedEventSignals{EventTime: now, SharedCommunities: 5}))
+		assert.Equal(t, score(feedEventSignals{EventTime: now, SharedCommunities: 10}), score(feedEventSignals{EventTime: now, SharedCommunities: 100}))
+	})
+
+	t.Run("interactions are measured per hour", func(t *testing.T) {
+		recent := score(feedEventSignals{EventTime: now.Add(-time.Hour), Interactions: 10})
+		older := score(feedEventSignals{EventTime: now.Add(-5 * time.Hour), Interactions: 10})
+		assert.Greater(t, recent, score(feedEventSignals{EventTime: now.Add(-time.Hour)}))
+		assert.Greater(t, recent, older)
+	})
+
+	t.Run("relevant events give way to newer ones", func(t *testing.T) {
+		relevant := feedEventSignals{EventTime: now.Add(-7 * personalizedFeedHalfLife), Affinity: 1, SharedCommunities: 10, Interactions: 100}
+		assert.Greater(t, score(feedEventSignals{EventTime: now}), score(relevant))
+	})
+}
+
+func TestScoreIDPaginator_Success(t *testing.T) {
+	rankedAt := time.Now().Add(-time.Minute)
+	ranked := []rankedFeedEvent{
+		{ID: "a", Score: 0.1},
+		{ID: "b", Score: 0.2},
+		{ID: "c", Score: 0.2},
+		{ID: "d", Score: 0.5},
+		{ID: "e", Score: 0.9},
+	}
+
+	newPaginator := func() scoreIDPaginator {
+		scores := make(map[persist.DBID]float64, len(ranked))
+		for _, r := range ranked {
+			scores[r.ID] = r.Score
+		}
+		return scoreIDPaginator{
+			MaxAge: personalizedFeedTTL,
+			QueryFunc: func(params scoreIDPagingParams) ([]any, error) {
+				results := make([]any, 0, params.Limit)
+				for _, id := range rankedBetweenCursors(ranked, params) {
+					if len(results) < int(params.Limit) {
+						results = append(results, id)
+					}
+				}
+				return results, nil
+			},
+			CursorFunc: func(node any) (time.Time, float64, persist.DBID, error) {
+				id := node.(persist.DBID)
+				return rankedAt, scores[id], id, nil
+			},
+		}
+	}
+
+	ids := func(results []any) []persist.DBID {
+		ids := make([]persist.DBID, len(results))
+		for i, r := range results {
+			ids[i] = r.(persist.DBID)
+		}
+		return ids
+	}
+
+	t.Run("paging backward walks the whole ranking once", func(t *testing.T) {
+		p := newPaginator()
+		var seen []persist.DBID
+		var before *string
+
+		for {
+			results, pageInfo, err := p.paginate(before, nil, nil, util.ToPointer(2))
+			require.NoError(t, err)
+			seen = append(ids(results), seen...)
+			if !pageInfo.HasPreviousPage {
+				break
+			}
+			before = &pageInfo.StartCursor
+		}
+
+		assert.Equal(t, []persist.DBID{"a", "b", "c", "d", "e"}, seen)
+	})
+
+	t.Run("paging forward walks the whole ranking once", func(t *testing.T) {
+		p := newPaginator()
+		var seen []persist.DBID
+		var after *string
+
+		for {
+			results, pageInfo, err := p.paginate(nil, after, util.ToPointer(2), nil)
+			require.NoError(t, err)
+			seen = append(seen, ids(results)...)
+			if !pageInfo.HasNextPage {
+				break
+			}
+			after = &pageInfo.EndCursor
+		}
+
+		assert.Equal(t, []persist.DBID{"a", "b", "c", "d", "e"}, seen)
+	})
+
+	t.Run("cursors keep the time of the ranking", func(t *testing.T) {
+		p := newPaginator()
+		_, pageInfo, err := p.paginate(nil, nil, nil, util.ToPointer(2))
+		require.NoError(t, err)
+
+		actual, err := p.rankedAt(&pageInfo.StartCursor, nil)
+
+		require.NoError(t, err)
+		assert.True(t, rankedAt.Equal(actual))
+	})
+
+	t.Run("the first page doesn't have a ranking time", func(t *testing.T) {
+		p := newPaginator()
+		actual, err := p.rankedAt(nil, nil)
+		require.NoError(t, err)
+		assert.True(t, actual.IsZero())
+	})
+}
+
+func TestScoreIDPaginator_Failure(t *testing.T) {
+	p := scoreIDPaginator{
+		MaxAge: personalizedFeedTTL,
+		QueryFunc: func(params scoreIDPagingParams) ([]any, error) {
+			return nil, nil
+		},
+	}
+
+	expired, err := p.encodeCursor(time.Now().Add(-personalizedFeedTTL-time.Minute), 0.5, "a")
+	require.NoError(t, err)
+
+	t.Run("cursors of expired rankings are rejected", func(t *testing.T) {
+		_, err := p.rankedAt(nil, &expired)
+		assert.ErrorIs(t, err, ErrRankingExpired)
+
+		_, _, err = p.paginate(&expired, nil, nil, util.ToPointer(2))
+		assert.ErrorIs(t, err, ErrRankingExpired)
+	})
+
+	t.Run("rankings don't expire without a max age", func(t *testing.T) {
+		p := p
+		p.MaxAge = 0
+		_, err := p.rankedAt(nil, &expired)
+		assert.NoError(t, err)
+	})
+}
+
+func TestDedupeReposts_Success(t *testing.T) {
+	roots := map[persist.DBID]persist.DBID{
+		"original":       "original",
+		"repost":         "original",
+		"another repost": "original",
+		"other":          "other",
+	}
+
+	t.Run("only the highest ranked of an event and its reposts is kept", func(t *testing.T) {
+		deduped := dedupeReposts([]rankedFeedEvent{
+			{ID: "original", Score: 0.1},
+			{ID: "other", Score: 0.2},
+			{ID: "another repost", Score: 0.3},
+			{ID: "repost", Score: 0.4},
+		}, roots)
+
+		assert.Equal(t, []rankedFeedEvent{{ID: "other", Score: 0.2}, {ID: "repost", Score: 0.4}}, deduped)
+	})
+
+	t.Run("the original is kept if it ranks higher than its reposts", func(t *testing.T) {
+		deduped := dedupeReposts([]rankedFeedEvent{
+			{ID: "repost", Score: 0.1},
+			{ID: "original", Score: 0.5},
+		}, roots)
+
+		assert.Equal(t, []rankedFeedEvent{{ID: "original", Score: 0.5}}, deduped)
+	})
+
+	t.Run("events without reposts are kept", func(t *testing.T) {
+		ranked := []rankedFeedEvent{{ID: "original", Score: 0.1}, {ID: "other", Score: 0.2}}
+		assert.Equal(t, ranked, dedupeReposts(ranked, roots))
+	})
+}
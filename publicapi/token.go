@@ -2,6 +2,7 @@ package publicapi
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
@@ -9,18 +10,28 @@ import (
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 
 	"github.com/gammazero/workerpool"
+	"github.com/jackc/pgx/v4"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/multichain"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/throttle"
 	"github.com/mikeydub/go-gallery/validate"
 
 	"github.com/ethereum/go-ethereum/ethclient"
 	"github.com/go-playground/validator/v10"
 	"github.com/mikeydub/go-gallery/graphql/dataloader"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
 )
 
+const (
+	// newMemberWindow is how long after signing up that a user's communities are told that they joined
+	newMemberWindow = 24 * time.Hour
+	// maxNewMemberNotifications is the most community members that are told about a new user
+	maxNewMemberNotifications = 100
+)
+
 type TokenAPI struct {
 	repos              *postgres.Repositories
 	queries            *db.Queries
@@ -332,9 +343,51 @@ func (api TokenAPI) SyncTokens(ctx context.Context, chains []persist.Chain) erro
 		return ErrTokenRefreshFailed{Message: err.Error()}
 	}
 
+	go notifyCommunitiesOfNewUser(sentryutil.NewSentryHubGinContext(ctx), api.queries, api.validator, userID)
+
 	return nil
 }
 
+// notifyCommunitiesOfNewUser tells the holders of a new user's tokens that the user joined. A user's tokens are
+// synced after they sign up, so this is done when they sync shortly after signing up, and holders who were already
+// told aren't told again. It runs in the background once the tokens have been synced, so errors are reported rather
+// than returned.
+func notifyCommunitiesOfNewUser(ctx context.Context, queries *db.Queries, v *validator.Validate, userID persist.DBID) {
+	user, err := queries.GetUserById(ctx, userID)
+	if err != nil {
+		logger.For(ctx).Errorf("failed to get user %s: %s", userID, err)
+		return
+	}
+
+	if time.Since(user.CreatedAt) > newMemberWindow {
+		return
+	}
+
+	members, err := queries.GetCommunityMembersToNotifyOfJoin(ctx, db.GetCommunityMembersToNotifyOfJoinParams{
+		NewUserID: userID,
+		Lim:       maxNewMemberNotifications,
+	})
+	if err != nil {
+		logger.For(ctx).Errorf("failed to get community members of new user %s: %s", userID, err)
+		sentryutil.ReportError(ctx, err)
+		return
+	}
+
+	for _, member := range members {
+		_, err := dispatchEvent(ctx, db.Event{
+			ActorID:        persist.DBIDToNullStr(userID),
+			Action:         persist.ActionCommunityMemberJoined,
+			ResourceTypeID: persist.ResourceTypeUser,
+			SubjectID:      member.UserID,
+			Data:           persist.EventData{CommunityContractID: member.ContractID},
+		}, v, nil)
+		if err != nil {
+			logger.For(ctx).Errorf("failed to dispatch join of %s to %s: %s", userID, member.UserID, err)
+			sentryutil.ReportError(ctx, err)
+		}
+	}
+}
+
 func (api TokenAPI) RefreshToken(ctx context.Context, tokenDBID persist.DBID) error {
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"tokenID": {tokenDBID, "required"},
@@ -371,6 +424,128 @@ func (api TokenAPI) RefreshToken(ctx context.Context, tokenDBID persist.DBID) er
 	return nil
 }
 
+// GetProcessingJobByTokenID returns the media processing job of a token. Only the token's owner can see its job.
+func (api TokenAPI) GetProcessingJobByTokenID(ctx context.Context, tokenID persist.DBID) (*db.TokenProcessingJob, error) {
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"tokenID": {tokenID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, nil
+	}
+
+	token, err := api.loaders.TokenByTokenID.Load(tokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if token.OwnerUserID != userID {
+		return nil, nil
+	}
+
+	contract, err := api.loaders.ContractByContractID.Load(token.Contract)
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := api.queries.GetTokenProcessingJobByTokenIdentifiers(ctx, db.GetTokenProcessingJobByTokenIdentifiersParams{
+		Chain:           contract.Chain,
+		ContractAddress: contract.Address,
+		TokenID:         token.TokenID,
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &job, nil
+}
+
+// PaginateTokenMetadataHistory returns the snapshots of a token's metadata and media, oldest first
+func (api TokenAPI) PaginateTokenMetadataHistory(ctx context.Context, tokenID persist.DBID, before *string, after *string, first *int, last *int) ([]db.TokenMetadataHistory, PageInfo, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"tokenID": {tokenID, "required"},
+	}); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	if err := validatePaginationParams(api.validator, first, last); err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	token, err := api.loaders.TokenByTokenID.Load(tokenID)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	contract, err := api.loaders.ContractByContractID.Load(token.Contract)
+	if err != nil {
+		return nil, PageInfo{}, err
+	}
+
+	queryFunc := func(params timeIDPagingParams) ([]interface{}, error) {
+		snapshots, err := api.queries.PaginateTokenMetadataHistory(ctx, db.PaginateTokenMetadataHistoryParams{
+			Chain:           contract.Chain,
+			ContractAddress: contract.Address,
+			TokenID:         token.TokenID,
+			CurBeforeTime:   params.CursorBeforeTime,
+			CurBeforeID:     params.CursorBeforeID,
+			CurAfterTime:    params.CursorAfterTime,
+			CurAfterID:      params.CursorAfterID,
+			PagingForward:   params.PagingForward,
+			Limit:           params.Limit,
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		results := make([]interface{}, len(snapshots))
+		for i, snapshot := range snapshots {
+			results[i] = snapshot
+		}
+
+		return results, nil
+	}
+
+	countFunc := func() (int, error) {
+		total, err := api.queries.CountTokenMetadataHistory(ctx, db.CountTokenMetadataHistoryParams{
+			Chain:           contract.Chain,
+			ContractAddress: contract.Address,
+			TokenID:         token.TokenID,
+		})
+		return int(total), err
+	}
+
+	cursorFunc := func(i interface{}) (time.Time, persist.DBID, error) {
+		if snapshot, ok := i.(db.TokenMetadataHistory); ok {
+			return snapshot.CreatedAt, snapshot.ID, nil
+		}
+		return time.Time{}, "", fmt.Errorf("interface{} is not a token metadata snapshot")
+	}
+
+	paginator := timeIDPaginator{
+		QueryFunc:  queryFunc,
+		CursorFunc: cursorFunc,
+		CountFunc:  countFunc,
+	}
+
+	results, pageInfo, err := paginator.paginate(before, after, first, last)
+
+	snapshots := make([]db.TokenMetadataHistory, len(results))
+	for i, result := range results {
+		snapshots[i] = result.(db.TokenMetadataHistory)
+	}
+
+	return snapshots, pageInfo, err
+}
+
 func (api TokenAPI) RefreshTokensInCollection(ctx context.Context, ci persist.ContractIdentifiers) error {
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
 		"contractIdentifiers": {ci, "required"},
@@ -469,6 +644,11 @@ func (api TokenAPI) UpdateTokenInfo(ctx context.Context, tokenID persist.DBID, c
 		return err
 	}
 
+	updateTextEntities(ctx, api.queries, api.validator, entity.SourceTokenCollectorsNote, tokenID, collectorsNote, db.Event{
+		ActorID: persist.DBIDToNullStr(userID),
+		Data:    persist.EventData{MentionTokenID: tokenID, MentionCollectionID: collectionID},
+	})
+
 	// Send event
 	_, err = dispatchEvent(ctx, db.Event{
 		ActorID:        persist.DBIDToNullStr(userID),
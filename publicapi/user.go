@@ -8,7 +8,9 @@ import (
 	"time"
 
 	"github.com/jackc/pgtype"
+	"github.com/jackc/pgx/v4"
 	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/feed"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
@@ -536,6 +538,62 @@ func (api UserAPI) UpdateUserEmailNotificationSettings(ctx context.Context, sett
 
 }
 
+// GetEmailDigestTimezone returns the timezone that the viewer's weekly digest is sent in
+func (api UserAPI) GetEmailDigestTimezone(ctx context.Context) (string, error) {
+	// Nothing to validate
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	timezone, err := api.queries.GetDigestTimezoneByUserID(ctx, userID)
+	if err == pgx.ErrNoRows {
+		return "UTC", nil
+	}
+
+	return timezone, err
+}
+
+func (api UserAPI) UpdateEmailDigestTimezone(ctx context.Context, timezone string) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"timezone": {timezone, "required,timezone"},
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return api.queries.UpsertDigestTimezone(ctx, db.UpsertDigestTimezoneParams{
+		UserID:   userID,
+		Timezone: timezone,
+	})
+}
+
+// UpdateEmailLocale sets the locale that the user's emails are written in
+func (api UserAPI) UpdateEmailLocale(ctx context.Context, locale string) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"locale": {locale, "required,bcp47_language_tag"},
+	}); err != nil {
+		return err
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return err
+	}
+
+	return api.queries.UpdateUserLocale(ctx, db.UpdateUserLocaleParams{
+		Locale: locale,
+		UserID: userID,
+	})
+}
+
 func (api UserAPI) ResendEmailVerification(ctx context.Context) error {
 
 	userID, err := getAuthenticatedUserID(ctx)
@@ -559,6 +617,16 @@ func (api UserAPI) UpdateUserNotificationSettings(ctx context.Context, notificat
 		return err
 	}
 
+	if quietHours := notificationSettings.QuietHours; quietHours != nil {
+		if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+			"quietHours.start":    {quietHours.Start, "required,datetime=15:04"},
+			"quietHours.end":      {quietHours.End, "required,datetime=15:04"},
+			"quietHours.timezone": {quietHours.Timezone, "required,timezone"},
+		}); err != nil {
+			return err
+		}
+	}
+
 	userID, err := getAuthenticatedUserID(ctx)
 	if err != nil {
 		return err
@@ -806,6 +874,8 @@ func (api UserAPI) FollowUser(ctx context.Context, userID persist.DBID) error {
 		return err
 	}
 
+	go addFolloweesToTimeline(sentryutil.NewSentryHubGinContext(ctx), api.queries, curUserID, []persist.DBID{userID})
+
 	// Send event
 	go dispatchFollowEventToFeed(sentryutil.NewSentryHubGinContext(ctx), api, curUserID, userID, refollowed)
 
@@ -845,11 +915,22 @@ func (api UserAPI) FollowAllSocialConnections(ctx context.Context, socialType pe
 		return persist.GenerateID().String(), nil
 	})
 
-	return api.queries.AddManyFollows(ctx, db.AddManyFollowsParams{
+	err = api.queries.AddManyFollows(ctx, db.AddManyFollowsParams{
 		Ids:       newIDs,
 		Follower:  curUserID,
 		Followees: userIDs,
 	})
+	if err != nil {
+		return err
+	}
+
+	followeeIDs, _ := util.Map(userIDs, func(id string) (persist.DBID, error) {
+		return persist.DBID(id), nil
+	})
+
+	go addFolloweesToTimeline(sentryutil.NewSentryHubGinContext(ctx), api.queries, curUserID, followeeIDs)
+
+	return nil
 }
 
 func (api UserAPI) UnfollowUser(ctx context.Context, userID persist.DBID) error {
@@ -865,7 +946,30 @@ func (api UserAPI) UnfollowUser(ctx context.Context, userID persist.DBID) error
 		return err
 	}
 
-	return api.repos.UserRepository.RemoveFollower(ctx, curUserID, userID)
+	if err := api.repos.UserRepository.RemoveFollower(ctx, curUserID, userID); err != nil {
+		return err
+	}
+
+	go removeFolloweeFromTimeline(sentryutil.NewSentryHubGinContext(ctx), api.queries, curUserID, userID)
+
+	return nil
+}
+
+// addFolloweesToTimeline adds the events of newly followed users to the user's home feed. The follow has already
+// happened by the time the timeline is updated, so errors are reported rather than returned.
+func addFolloweesToTimeline(ctx context.Context, queries *db.Queries, userID persist.DBID, followeeIDs []persist.DBID) {
+	if err := feed.NewTimeline(queries).AddFollowees(ctx, userID, followeeIDs); err != nil {
+		logger.For(ctx).Errorf("failed to add followees to timeline of user %s: %s", userID, err)
+		sentryutil.ReportError(ctx, err)
+	}
+}
+
+// removeFolloweeFromTimeline removes the events of an unfollowed user from the user's home feed
+func removeFolloweeFromTimeline(ctx context.Context, queries *db.Queries, userID persist.DBID, followeeID persist.DBID) {
+	if err := feed.NewTimeline(queries).RemoveFollowee(ctx, userID, followeeID); err != nil {
+		logger.For(ctx).Errorf("failed to remove followee %s from timeline of user %s: %s", followeeID, userID, err)
+		sentryutil.ReportError(ctx, err)
+	}
 }
 
 func dispatchFollowEventToFeed(ctx context.Context, api UserAPI, curUserID persist.DBID, followedUserID persist.DBID, refollowed bool) {
@@ -951,6 +1055,24 @@ func (api UserAPI) GetSocials(ctx context.Context, userID persist.DBID) (*model.
 				t.ProfileImageURL = profile
 			}
 			result.Twitter = t
+		case persist.SocialProviderTelegram:
+			result.Telegram = &model.TelegramSocialAccount{
+				Type:            prov,
+				Display:         social.Display,
+				SocialID:        social.ID,
+				Name:            socialMetadataString(social, "name"),
+				Username:        socialMetadataString(social, "username"),
+				ProfileImageURL: socialMetadataString(social, "profile_image_url"),
+			}
+		case persist.SocialProviderDiscord:
+			result.Discord = &model.DiscordSocialAccount{
+				Type:            prov,
+				Display:         social.Display,
+				SocialID:        social.ID,
+				Name:            socialMetadataString(social, "name"),
+				Username:        socialMetadataString(social, "username"),
+				ProfileImageURL: socialMetadataString(social, "profile_image_url"),
+			}
 		default:
 			return nil, fmt.Errorf("unknown social provider %s", prov)
 		}
@@ -1002,6 +1124,24 @@ func (api UserAPI) GetDisplayedSocials(ctx context.Context, userID persist.DBID)
 				t.ProfileImageURL = profile
 			}
 			result.Twitter = t
+		case persist.SocialProviderTelegram:
+			result.Telegram = &model.TelegramSocialAccount{
+				Type:            prov,
+				Display:         social.Display,
+				SocialID:        social.ID,
+				Name:            socialMetadataString(social, "name"),
+				Username:        socialMetadataString(social, "username"),
+				ProfileImageURL: socialMetadataString(social, "profile_image_url"),
+			}
+		case persist.SocialProviderDiscord:
+			result.Discord = &model.DiscordSocialAccount{
+				Type:            prov,
+				Display:         social.Display,
+				SocialID:        social.ID,
+				Name:            socialMetadataString(social, "name"),
+				Username:        socialMetadataString(social, "username"),
+				ProfileImageURL: socialMetadataString(social, "profile_image_url"),
+			}
 		default:
 			return nil, fmt.Errorf("unknown social provider %s", prov)
 		}
@@ -1010,6 +1150,11 @@ func (api UserAPI) GetDisplayedSocials(ctx context.Context, userID persist.DBID)
 	return result, nil
 }
 
+func socialMetadataString(social persist.SocialUserIdentifiers, key string) string {
+	v, _ := social.Metadata[key].(string)
+	return v
+}
+
 func (api UserAPI) UpdateUserSocialDisplayed(ctx context.Context, socialType persist.SocialProvider, displayed bool) error {
 	// Validate
 	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
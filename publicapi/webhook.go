@@ -0,0 +1,173 @@
+package publicapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-playground/validator/v10"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/webhook"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/mikeydub/go-gallery/validate"
+)
+
+const (
+	maxWebhooksPerUser          = 10
+	defaultWebhookDeliveryLimit = 50
+	maxWebhookDeliveryLimit     = 200
+)
+
+var ErrOnlyManageOwnWebhook = errors.New("only the owner of a webhook can manage it")
+var ErrTooManyWebhooks = fmt.Errorf("a user can register at most %d webhooks", maxWebhooksPerUser)
+
+type WebhookAPI struct {
+	queries   *db.Queries
+	validator *validator.Validate
+}
+
+// CreateWebhook registers an endpoint that the viewer's activity is sent to. The returned webhook's secret is used to
+// verify the signature of each request, and is only shown to the user when the webhook is created.
+func (api WebhookAPI) CreateWebhook(ctx context.Context, url string, actions []persist.Action) (*db.Webhook, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"url":     {url, "required,url,startswith=https://,max=2048"},
+		"actions": {actions, "required,unique,dive,webhook_action"},
+	}); err != nil {
+		return nil, err
+	}
+
+	// Deliveries are sent from inside our network, so they can't be pointed at anything that isn't public
+	if err := util.ValidatePublicURL(ctx, url); err != nil {
+		return nil, validate.ErrInvalidInput{Parameters: []string{"url"}, Reasons: []string{err.Error()}}
+	}
+
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := api.queries.GetWebhooksByOwnerID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if len(existing) >= maxWebhooksPerUser {
+		return nil, ErrTooManyWebhooks
+	}
+
+	secret, err := webhook.NewSecret()
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := api.queries.CreateWebhook(ctx, db.CreateWebhookParams{
+		ID:      persist.GenerateID(),
+		OwnerID: userID.String(),
+		Url:     url,
+		Secret:  secret,
+		Actions: actions,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hook, nil
+}
+
+// DeleteWebhook stops sending activity to one of the viewer's webhooks. Its delivery log is kept.
+func (api WebhookAPI) DeleteWebhook(ctx context.Context, webhookID persist.DBID) error {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": {webhookID, "required"},
+	}); err != nil {
+		return err
+	}
+
+	hook, err := api.getViewerWebhook(ctx, webhookID)
+	if err != nil {
+		return err
+	}
+
+	return api.queries.DeleteWebhook(ctx, hook.ID)
+}
+
+// GetViewerWebhooks returns the webhooks that the viewer has registered
+func (api WebhookAPI) GetViewerWebhooks(ctx context.Context) ([]db.Webhook, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetWebhooksByOwnerID(ctx, userID)
+}
+
+// GetWebhookDeliveries returns the most recent deliveries of one of the viewer's webhooks, newest first
+func (api WebhookAPI) GetWebhookDeliveries(ctx context.Context, webhookID persist.DBID, limit *int) ([]db.WebhookDelivery, error) {
+	lim := defaultWebhookDeliveryLimit
+	if limit != nil {
+		lim = *limit
+	}
+
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"webhookID": {webhookID, "required"},
+		"limit":     {lim, fmt.Sprintf("min=1,max=%d", maxWebhookDeliveryLimit)},
+	}); err != nil {
+		return nil, err
+	}
+
+	hook, err := api.getViewerWebhook(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	return api.queries.GetWebhookDeliveriesByWebhookID(ctx, db.GetWebhookDeliveriesByWebhookIDParams{
+		WebhookID: hook.ID,
+		Limit:     int32(lim),
+	})
+}
+
+// ReplayWebhookDelivery sends a delivery of one of the viewer's webhooks again
+func (api WebhookAPI) ReplayWebhookDelivery(ctx context.Context, deliveryID persist.DBID) (*db.WebhookDelivery, error) {
+	// Validate
+	if err := validate.ValidateFields(api.validator, validate.ValidationMap{
+		"deliveryID": {deliveryID, "required"},
+	}); err != nil {
+		return nil, err
+	}
+
+	delivery, err := api.queries.GetWebhookDeliveryByID(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := api.getViewerWebhook(ctx, delivery.WebhookID); err != nil {
+		return nil, err
+	}
+
+	replay, err := webhook.Replay(ctx, api.queries, delivery)
+	if err != nil {
+		return nil, err
+	}
+
+	return &replay, nil
+}
+
+func (api WebhookAPI) getViewerWebhook(ctx context.Context, webhookID persist.DBID) (*db.Webhook, error) {
+	userID, err := getAuthenticatedUserID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	hook, err := api.queries.GetWebhookByID(ctx, webhookID)
+	if err != nil {
+		return nil, err
+	}
+
+	if hook.OwnerID != userID {
+		return nil, ErrOnlyManageOwnWebhook
+	}
+
+	return &hook, nil
+}
@@ -18,7 +18,6 @@ import (
 	"google.golang.org/api/option"
 
 	cloudtasks "cloud.google.com/go/cloudtasks/apiv2"
-	"cloud.google.com/go/pubsub"
 	secretmanager "cloud.google.com/go/secretmanager/apiv1"
 	"cloud.google.com/go/storage"
 	"github.com/ethereum/go-ethereum/ethclient"
@@ -38,13 +37,14 @@ import (
 	"github.com/mikeydub/go-gallery/service/multichain/tezos"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
-	"github.com/mikeydub/go-gallery/service/pubsub/gcp"
+	"github.com/mikeydub/go-gallery/service/pubsub"
 	"github.com/mikeydub/go-gallery/service/recommend"
 	"github.com/mikeydub/go-gallery/service/redis"
 	"github.com/mikeydub/go-gallery/service/rpc"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/task"
 	"github.com/mikeydub/go-gallery/service/throttle"
+	"github.com/mikeydub/go-gallery/service/webhook"
 	"github.com/spf13/viper"
 )
 
@@ -77,7 +77,7 @@ type Clients struct {
 	StorageClient   *storage.Client
 	TaskClient      *cloudtasks.Client
 	SecretClient    *secretmanager.Client
-	PubSubClient    *pubsub.Client
+	PubSub          pubsub.PubSub
 	MagicLinkClient *magicclient.API
 	closeFunc       func()
 }
@@ -99,7 +99,7 @@ func ClientInit(ctx context.Context) *Clients {
 		StorageClient:   media.NewStorageClient(ctx),
 		TaskClient:      task.NewClient(ctx),
 		SecretClient:    newSecretsClient(),
-		PubSubClient:    gcp.NewClient(ctx),
+		PubSub:          pubsub.NewFromEnv(ctx),
 		MagicLinkClient: auth.NewMagicLinkClient(),
 		closeFunc: func() {
 			pq.Close()
@@ -137,8 +137,9 @@ func CoreInit(c *Clients, provider *multichain.Provider, recommender *recommend.
 	socialCache := redis.NewCache(redis.SocialDB)
 
 	recommender.Run(context.Background(), time.NewTicker(time.Hour))
+	webhook.NewDeliverer(c.Queries).Start(context.Background())
 
-	return handlersInit(router, c.Repos, c.Queries, c.EthClient, c.IPFSClient, c.ArweaveClient, c.StorageClient, provider, newThrottler(), c.TaskClient, c.PubSubClient, lock, c.SecretClient, graphqlAPQCache, feedCache, socialCache, c.MagicLinkClient, recommender)
+	return handlersInit(router, c.Repos, c.Queries, c.EthClient, c.IPFSClient, c.ArweaveClient, c.StorageClient, provider, newThrottler(), c.TaskClient, c.PubSub, lock, c.SecretClient, graphqlAPQCache, feedCache, socialCache, c.MagicLinkClient, recommender)
 }
 
 func newSecretsClient() *secretmanager.Client {
@@ -174,6 +175,9 @@ func SetDefaults() {
 	viper.SetDefault("POSTGRES_DB", "postgres")
 	viper.SetDefault("IPFS_URL", "https://gallery.infura-ipfs.io")
 	viper.SetDefault("IPFS_API_URL", "https://ipfs.infura.io:5001")
+	viper.SetDefault("IPFS_GATEWAYS", "")
+	viper.SetDefault("IPFS_LOCAL_API_URL", "")
+	viper.SetDefault("IPFS_HEDGE_DELAY", "2s")
 	viper.SetDefault("IPFS_PROJECT_ID", "")
 	viper.SetDefault("IPFS_PROJECT_SECRET", "")
 	viper.SetDefault("GCLOUD_TOKEN_CONTENT_BUCKET", "dev-token-content")
@@ -194,6 +198,9 @@ func SetDefaults() {
 	viper.SetDefault("GCLOUD_FEED_QUEUE", "projects/gallery-local/locations/here/queues/feed-event")
 	viper.SetDefault("GCLOUD_WALLET_VALIDATE_QUEUE", "projects/gallery-dev-322005/locations/us-west2/queues/wallet-validate")
 	viper.SetDefault("GCLOUD_FEED_BUFFER_SECS", 20)
+	viper.SetDefault("FEED_MAX_ACQUIRED_TOKENS", 25)
+	viper.SetDefault("FEED_FANOUT_MAX_FOLLOWERS", 10000)
+	viper.SetDefault("FEED_TIMELINE_MAX_EVENTS", 1000)
 	viper.SetDefault("FEED_SECRET", "feed-secret")
 	viper.SetDefault("TOKEN_PROCESSING_URL", "http://localhost:6500")
 	viper.SetDefault("TEZOS_API_URL", "https://api.tzkt.io")
@@ -202,6 +209,7 @@ func SetDefaults() {
 	viper.SetDefault("GAE_VERSION", "")
 	viper.SetDefault("TOKEN_PROCESSING_QUEUE", "projects/gallery-local/locations/here/queues/token-processing")
 	viper.SetDefault("GOOGLE_CLOUD_PROJECT", "gallery-dev-322005")
+	viper.SetDefault("PUBSUB_BACKEND", pubsub.BackendGCP)
 	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
 	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
 	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
@@ -219,6 +227,35 @@ func SetDefaults() {
 	viper.SetDefault("TWITTER_AUTH_REDIRECT_URI", "http://localhost:3000/auth/twitter")
 	viper.SetDefault("FEEDBOT_URL", "")
 	viper.SetDefault("GCLOUD_FEEDBOT_TASK_QUEUE", "projects/gallery-local/locations/here/queues/feedbot")
+	viper.SetDefault("ACTIVITYPUB_URL", "")
+	viper.SetDefault("ACTIVITYPUB_SECRET", "activitypub-secret")
+	viper.SetDefault("GCLOUD_ACTIVITYPUB_TASK_QUEUE", "projects/gallery-local/locations/here/queues/activitypub")
+	viper.SetDefault("GALLERY_HOST", "http://localhost:3000")
+	viper.SetDefault("VAPID_PUBLIC_KEY", "")
+	viper.SetDefault("VAPID_PRIVATE_KEY", "")
+	viper.SetDefault("VAPID_SUBJECT", "mailto:support@gallery.so")
+	viper.SetDefault("APNS_HOST", "https://api.sandbox.push.apple.com")
+	viper.SetDefault("APNS_KEY", "")
+	viper.SetDefault("APNS_KEY_ID", "")
+	viper.SetDefault("APNS_TEAM_ID", "")
+	viper.SetDefault("APNS_TOPIC", "")
+	viper.SetDefault("FCM_HOST", "https://fcm.googleapis.com")
+	viper.SetDefault("FCM_PROJECT_ID", "")
+	viper.SetDefault("TELEGRAM_API_HOST", "https://api.telegram.org")
+	viper.SetDefault("TELEGRAM_BOT_TOKEN", "")
+	viper.SetDefault("DISCORD_API_HOST", "https://discord.com/api/v10")
+	viper.SetDefault("DISCORD_BOT_TOKEN", "")
+	viper.SetDefault("DISCORD_CLIENT_ID", "")
+	viper.SetDefault("DISCORD_CLIENT_SECRET", "")
+	viper.SetDefault("DISCORD_AUTH_REDIRECT_URI", "http://localhost:3000/auth/discord")
+	viper.SetDefault("WEBHOOK_TIMEOUT", "10s")
+	viper.SetDefault("WEBHOOK_POLL_INTERVAL", "5s")
+	viper.SetDefault("WEBHOOK_MAX_ATTEMPTS", 8)
+	viper.SetDefault("WEBHOOK_RETRY_BACKOFF", "30s")
+	viper.SetDefault("WEBHOOK_MAX_RETRY_BACKOFF", "6h")
+	viper.SetDefault("WEBHOOK_STALE_AFTER", "5m")
+	viper.SetDefault("HELD_EVENT_POLL_INTERVAL", "30s")
+	viper.SetDefault("DEFERRED_NOTIFICATION_POLL_INTERVAL", "1m")
 
 	viper.AutomaticEnv()
 
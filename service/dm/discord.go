@@ -0,0 +1,97 @@
+package dm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// discordCannotMessageUser is the error code Discord returns when a user doesn't accept messages from the bot, e.g.
+// because they don't share a server with it or turned off direct messages from server members
+const discordCannotMessageUser = 50007
+
+// DiscordSender messages users through a Discord bot. Discord only lets bots message users that share a server with
+// them, so users are asked to join Gallery's server when they link their account.
+type DiscordSender struct {
+	client *http.Client
+	host   string
+	token  string
+}
+
+// NewDiscordSender creates a sender that calls the API at host, e.g. "https://discord.com/api/v10", as the bot with
+// the given token
+func NewDiscordSender(host, token string) *DiscordSender {
+	return &DiscordSender{
+		client: &http.Client{Timeout: 10 * time.Second},
+		host:   strings.TrimSuffix(host, "/"),
+		token:  token,
+	}
+}
+
+type discordCreateDMRequest struct {
+	RecipientID string `json:"recipient_id"`
+}
+
+type discordChannel struct {
+	ID string `json:"id"`
+}
+
+type discordCreateMessageRequest struct {
+	Content string `json:"content"`
+}
+
+type discordError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// Send opens the bot's DM channel with the recipient, which Discord returns as is if it already exists, and posts the
+// message to it
+func (s *DiscordSender) Send(ctx context.Context, recipientID string, text string) error {
+	var channel discordChannel
+	if err := s.post(ctx, "/users/@me/channels", discordCreateDMRequest{RecipientID: recipientID}, &channel); err != nil {
+		return err
+	}
+
+	return s.post(ctx, fmt.Sprintf("/channels/%s/messages", channel.ID), discordCreateMessageRequest{Content: text}, nil)
+}
+
+func (s *DiscordSender) post(ctx context.Context, path string, body any, into any) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.host+path, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bot "+s.token)
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		if into == nil {
+			return nil
+		}
+		return json.NewDecoder(resp.Body).Decode(into)
+	}
+
+	var discordErr discordError
+	json.NewDecoder(resp.Body).Decode(&discordErr)
+
+	if discordErr.Code == discordCannotMessageUser {
+		return fmt.Errorf("%w: %s", ErrRecipientUnreachable, discordErr.Message)
+	}
+
+	return fmt.Errorf("discord request %s failed with status %d: %s", path, resp.StatusCode, discordErr.Message)
+}
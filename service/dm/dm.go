@@ -0,0 +1,168 @@
+// Package dm delivers notifications as direct messages in the chat apps that users linked to their accounts. Each
+// app has its own Sender, and a Messenger decides which notifications to send and to which apps.
+package dm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/push"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+)
+
+// ErrRecipientUnreachable is returned by a Sender when the app won't deliver messages to the recipient, e.g. because
+// they blocked the bot or never started a conversation with it. The account stays linked, since the user can
+// unblock the bot at any time.
+var ErrRecipientUnreachable = errors.New("recipient can't be messaged")
+
+// channels are the notification channels of each app that can be messaged
+var channels = map[persist.SocialProvider]persist.NotificationChannel{
+	persist.SocialProviderTelegram: persist.NotificationChannelTelegram,
+	persist.SocialProviderDiscord:  persist.NotificationChannelDiscord,
+}
+
+type Sender interface {
+	// Send messages the user with the app's ID recipientID
+	Send(ctx context.Context, recipientID string, text string) error
+}
+
+type Messenger struct {
+	queries *db.Queries
+	senders map[persist.SocialProvider]Sender
+}
+
+func NewMessenger(queries *db.Queries, senders map[persist.SocialProvider]Sender) *Messenger {
+	return &Messenger{queries: queries, senders: senders}
+}
+
+// NewSendersFromEnv returns a sender for each app that has a bot configured. Apps without a sender are skipped when
+// messaging.
+func NewSendersFromEnv() map[persist.SocialProvider]Sender {
+	senders := map[persist.SocialProvider]Sender{}
+
+	if env.GetString("TELEGRAM_BOT_TOKEN") != "" {
+		senders[persist.SocialProviderTelegram] = NewTelegramSender(env.GetString("TELEGRAM_API_HOST"), env.GetString("TELEGRAM_BOT_TOKEN"))
+	}
+
+	if env.GetString("DISCORD_BOT_TOKEN") != "" {
+		senders[persist.SocialProviderDiscord] = NewDiscordSender(env.GetString("DISCORD_API_HOST"), env.GetString("DISCORD_BOT_TOKEN"))
+	}
+
+	return senders
+}
+
+// Send messages a notification to each app its owner linked and wants notifications of its kind in. Notifications that
+// arrive during the owner's quiet hours are deferred until the quiet hours end. Like pushes, it should only be called
+// for new notifications, so that a notification that others are grouped into is only sent once.
+func (m *Messenger) Send(ctx context.Context, notif db.Notification) error {
+	if m == nil || len(m.senders) == 0 {
+		return nil
+	}
+
+	owner, err := m.queries.GetUserById(ctx, notif.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner %s of notification %s: %w", notif.OwnerID, notif.ID, err)
+	}
+
+	socials, err := m.queries.GetSocialsByUserID(ctx, notif.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to get socials of user %s: %w", notif.OwnerID, err)
+	}
+
+	now := time.Now()
+	var text string
+
+	for provider, sender := range m.senders {
+		social, ok := socials[provider]
+		if !ok || social.ID == "" {
+			continue
+		}
+
+		send, deferUntil := owner.NotificationSettings.InstantDelivery(notif.Action, channels[provider], now)
+		if !deferUntil.IsZero() {
+			err := m.queries.DeferNotification(ctx, db.DeferNotificationParams{
+				NotificationID: notif.ID,
+				Channel:        channels[provider],
+				DeliverAfter:   deferUntil,
+			})
+			if err != nil {
+				return err
+			}
+			continue
+		}
+
+		if !send {
+			continue
+		}
+
+		if text == "" {
+			msg, err := push.MessageForNotification(ctx, m.queries, notif)
+			if err != nil {
+				return err
+			}
+			text = messageText(msg)
+		}
+
+		m.send(ctx, notif, provider, sender, social.ID, text)
+	}
+
+	return nil
+}
+
+// Deliver messages a notification over channel without checking whether its owner wants it there. Nothing is sent if
+// the owner unlinked the app since.
+func (m *Messenger) Deliver(ctx context.Context, notif db.Notification, channel persist.NotificationChannel) error {
+	if m == nil {
+		return nil
+	}
+
+	for provider, sender := range m.senders {
+		if channels[provider] != channel {
+			continue
+		}
+
+		socials, err := m.queries.GetSocialsByUserID(ctx, notif.OwnerID)
+		if err != nil {
+			return fmt.Errorf("failed to get socials of user %s: %w", notif.OwnerID, err)
+		}
+
+		social, ok := socials[provider]
+		if !ok || social.ID == "" {
+			return nil
+		}
+
+		msg, err := push.MessageForNotification(ctx, m.queries, notif)
+		if err != nil {
+			return err
+		}
+
+		m.send(ctx, notif, provider, sender, social.ID, messageText(msg))
+	}
+
+	return nil
+}
+
+// send messages the text of a notification to recipientID. Errors are logged rather than returned, since an app that
+// can't be reached shouldn't stop the rest from getting the message.
+func (m *Messenger) send(ctx context.Context, notif db.Notification, provider persist.SocialProvider, sender Sender, recipientID string, text string) {
+	err := sender.Send(ctx, recipientID, text)
+	if errors.Is(err, ErrRecipientUnreachable) {
+		logger.For(ctx).Infof("can't message user %s on %s: %s", notif.OwnerID, provider, err)
+		return
+	}
+
+	if err != nil {
+		logger.For(ctx).Errorf("failed to send notification %s to %s: %s", notif.ID, provider, err)
+		sentryutil.ReportError(ctx, err)
+	}
+}
+
+func messageText(msg push.Message) string {
+	return fmt.Sprintf("%s\n\n%s", msg.Body, msg.URL)
+}
@@ -0,0 +1,97 @@
+package dm
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramSender_Success(t *testing.T) {
+	t.Run("messages the user's chat with the bot", func(t *testing.T) {
+		var received telegramSendMessageRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "/botbot-token/sendMessage", r.URL.Path)
+			json.NewDecoder(r.Body).Decode(&received)
+			json.NewEncoder(w).Encode(telegramResponse{OK: true})
+		}))
+		defer server.Close()
+
+		err := NewTelegramSender(server.URL, "bot-token").Send(context.Background(), "12345", "alice admired your update")
+
+		require.NoError(t, err)
+		assert.Equal(t, telegramSendMessageRequest{ChatID: "12345", Text: "alice admired your update"}, received)
+	})
+
+	t.Run("reports users that blocked the bot", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(telegramResponse{ErrorCode: 403, Description: "Forbidden: bot was blocked by the user"})
+		}))
+		defer server.Close()
+
+		err := NewTelegramSender(server.URL, "bot-token").Send(context.Background(), "12345", "hello")
+
+		assert.ErrorIs(t, err, ErrRecipientUnreachable)
+	})
+
+	t.Run("reports users that never started the bot", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(telegramResponse{ErrorCode: 400, Description: "Bad Request: chat not found"})
+		}))
+		defer server.Close()
+
+		err := NewTelegramSender(server.URL, "bot-token").Send(context.Background(), "12345", "hello")
+
+		assert.ErrorIs(t, err, ErrRecipientUnreachable)
+	})
+}
+
+func TestDiscordSender_Success(t *testing.T) {
+	t.Run("opens a DM channel and posts to it", func(t *testing.T) {
+		var recipient discordCreateDMRequest
+		var message discordCreateMessageRequest
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "Bot bot-token", r.Header.Get("Authorization"))
+			switch r.URL.Path {
+			case "/users/@me/channels":
+				json.NewDecoder(r.Body).Decode(&recipient)
+				json.NewEncoder(w).Encode(discordChannel{ID: "channel"})
+			case "/channels/channel/messages":
+				json.NewDecoder(r.Body).Decode(&message)
+				json.NewEncoder(w).Encode(map[string]string{"id": "message"})
+			default:
+				t.Errorf("unexpected request to %s", r.URL.Path)
+				w.WriteHeader(http.StatusNotFound)
+			}
+		}))
+		defer server.Close()
+
+		err := NewDiscordSender(server.URL, "bot-token").Send(context.Background(), "67890", "alice admired your update")
+
+		require.NoError(t, err)
+		assert.Equal(t, "67890", recipient.RecipientID)
+		assert.Equal(t, "alice admired your update", message.Content)
+	})
+
+	t.Run("reports users that don't accept messages from the bot", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if r.URL.Path == "/users/@me/channels" {
+				json.NewEncoder(w).Encode(discordChannel{ID: "channel"})
+				return
+			}
+			w.WriteHeader(http.StatusForbidden)
+			json.NewEncoder(w).Encode(discordError{Code: discordCannotMessageUser, Message: "Cannot send messages to this user"})
+		}))
+		defer server.Close()
+
+		err := NewDiscordSender(server.URL, "bot-token").Send(context.Background(), "67890", "hello")
+
+		assert.ErrorIs(t, err, ErrRecipientUnreachable)
+	})
+}
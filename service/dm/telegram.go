@@ -0,0 +1,73 @@
+package dm
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// TelegramSender messages users through a Telegram bot. A user's Telegram ID is also the ID of their private chat
+// with the bot, which exists once they've started the bot or allowed it to message them when logging in.
+type TelegramSender struct {
+	client *http.Client
+	host   string
+	token  string
+}
+
+// NewTelegramSender creates a sender that calls the Bot API at host, e.g. "https://api.telegram.org", as the bot
+// with the given token
+func NewTelegramSender(host, token string) *TelegramSender {
+	return &TelegramSender{
+		client: &http.Client{Timeout: 10 * time.Second},
+		host:   strings.TrimSuffix(host, "/"),
+		token:  token,
+	}
+}
+
+type telegramSendMessageRequest struct {
+	ChatID string `json:"chat_id"`
+	Text   string `json:"text"`
+}
+
+type telegramResponse struct {
+	OK          bool   `json:"ok"`
+	ErrorCode   int    `json:"error_code"`
+	Description string `json:"description"`
+}
+
+func (s *TelegramSender) Send(ctx context.Context, recipientID string, text string) error {
+	payload, err := json.Marshal(telegramSendMessageRequest{ChatID: recipientID, Text: text})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/bot%s/sendMessage", s.host, s.token), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var body telegramResponse
+	json.NewDecoder(resp.Body).Decode(&body)
+
+	if resp.StatusCode == http.StatusOK && body.OK {
+		return nil
+	}
+
+	// Telegram forbids messaging users that blocked the bot, and doesn't know the chat of users that never started it
+	if resp.StatusCode == http.StatusForbidden || (resp.StatusCode == http.StatusBadRequest && strings.Contains(body.Description, "chat not found")) {
+		return fmt.Errorf("%w: %s", ErrRecipientUnreachable, body.Description)
+	}
+
+	return fmt.Errorf("telegram message failed with status %d: %s", resp.StatusCode, body.Description)
+}
@@ -0,0 +1,144 @@
+// Package entity finds the @mentions and #hashtags in text that users write, and keeps track of them so that
+// mentioned users can be notified and hashtags can be browsed.
+package entity
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// Source is the kind of text that entities were parsed from.
+type Source string
+
+const (
+	SourceComment                  Source = "comment"
+	SourceCaption                  Source = "caption"
+	SourceCollectionCollectorsNote Source = "collection_collectors_note"
+	SourceTokenCollectorsNote      Source = "token_collectors_note"
+	// SourceFeedEventCollectorsNotes are the collectors notes that a feed event was published with. Notes can be edited
+	// or removed later, so their hashtags are kept with the feed event as they were when it was published.
+	SourceFeedEventCollectorsNotes Source = "feed_event_collectors_notes"
+)
+
+// Save replaces the entities of a source with the ones in its text. Mentions of users that don't exist aren't saved.
+// It returns the users who are mentioned in the text but weren't mentioned in it before, so that editing a piece of
+// text doesn't notify the same users again.
+func Save(ctx context.Context, queries *db.Queries, source Source, sourceID persist.DBID, text string) ([]persist.DBID, error) {
+	previous, err := queries.GetTextEntitiesBySource(ctx, db.GetTextEntitiesBySourceParams{
+		Source:   string(source),
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	alreadyMentioned := make(map[persist.DBID]bool)
+	for _, e := range previous {
+		if e.Kind == string(KindMention) {
+			alreadyMentioned[e.UserID] = true
+		}
+	}
+
+	err = queries.DeleteTextEntitiesBySource(ctx, db.DeleteTextEntitiesBySourceParams{
+		Source:   string(source),
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	users := make(map[string]*db.User)
+	mentioned := make([]persist.DBID, 0)
+
+	for _, e := range Parse(text) {
+		params := db.CreateTextEntityParams{
+			ID:         persist.GenerateID(),
+			Source:     string(source),
+			SourceID:   sourceID,
+			Kind:       string(e.Kind),
+			StartIndex: int32(e.Start),
+			Length:     int32(e.Length),
+			Value:      e.Value,
+		}
+
+		if e.Kind == KindMention {
+			user, err := userByUsername(ctx, queries, users, e.Value)
+			if err != nil {
+				return nil, err
+			}
+			if user == nil {
+				continue
+			}
+
+			params.UserID = user.ID
+
+			if !alreadyMentioned[user.ID] {
+				mentioned = append(mentioned, user.ID)
+				alreadyMentioned[user.ID] = true
+			}
+		}
+
+		if err := queries.CreateTextEntity(ctx, params); err != nil {
+			return nil, err
+		}
+	}
+
+	return mentioned, nil
+}
+
+// SaveHashtags replaces the hashtags of a source that is made up of several texts. Each hashtag's position is relative
+// to the text it was found in. Mentions aren't saved, since they're saved with the texts themselves.
+func SaveHashtags(ctx context.Context, queries *db.Queries, source Source, sourceID persist.DBID, texts []string) error {
+	err := queries.DeleteTextEntitiesBySource(ctx, db.DeleteTextEntitiesBySourceParams{
+		Source:   string(source),
+		SourceID: sourceID,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, text := range texts {
+		for _, e := range Parse(text) {
+			if e.Kind != KindHashtag {
+				continue
+			}
+			err := queries.CreateTextEntity(ctx, db.CreateTextEntityParams{
+				ID:         persist.GenerateID(),
+				Source:     string(source),
+				SourceID:   sourceID,
+				Kind:       string(e.Kind),
+				StartIndex: int32(e.Start),
+				Length:     int32(e.Length),
+				Value:      e.Value,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// userByUsername looks up a mentioned user, returning nil if there isn't a user with that username. Lookups are
+// cached since the same user is often mentioned more than once.
+func userByUsername(ctx context.Context, queries *db.Queries, cache map[string]*db.User, username string) (*db.User, error) {
+	if user, ok := cache[username]; ok {
+		return user, nil
+	}
+
+	user, err := queries.GetUserByUsername(ctx, username)
+	if err == pgx.ErrNoRows {
+		cache[username] = nil
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	cache[username] = &user
+	return &user, nil
+}
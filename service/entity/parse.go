@@ -0,0 +1,133 @@
+package entity
+
+import (
+	"strings"
+	"unicode"
+)
+
+// Kind is the kind of entity that was found in a piece of text.
+type Kind string
+
+const (
+	KindMention Kind = "mention"
+	KindHashtag Kind = "hashtag"
+)
+
+const (
+	// MaxHashtagLength is the longest that a hashtag can be, not including the #. Longer tags aren't treated as hashtags.
+	MaxHashtagLength  = 50
+	minUsernameLength = 2
+	maxUsernameLength = 50
+)
+
+// Entity is an @mention or #hashtag in a piece of text. Start and Length are counted in characters (not bytes)
+// and include the leading @ or #, so that the entity can be highlighted in the text as it's returned by the API.
+type Entity struct {
+	Kind   Kind
+	Start  int
+	Length int
+	// Value is the username that was mentioned as it was written, or the normalized hashtag without the #
+	Value string
+}
+
+// Parse returns the mentions and hashtags in the text in the order they appear.
+func Parse(text string) []Entity {
+	runes := []rune(text)
+	entities := make([]Entity, 0)
+
+	for i := 0; i < len(runes); i++ {
+		var prev rune
+		if i > 0 {
+			prev = runes[i-1]
+		}
+
+		switch runes[i] {
+		case '@':
+			// Skip email addresses and the like
+			if i > 0 && (isWordRune(prev) || prev == '.' || prev == '@') {
+				continue
+			}
+			end := i + 1
+			for end < len(runes) && isUsernameRune(runes[end]) {
+				end++
+			}
+			// A mention at the end of a sentence shouldn't include the period
+			for end > i+1 && (runes[end-1] == '.' || runes[end-1] == '_') {
+				end--
+			}
+			username := string(runes[i+1 : end])
+			if n := end - i - 1; n < minUsernameLength || n > maxUsernameLength {
+				continue
+			}
+			entities = append(entities, Entity{Kind: KindMention, Start: i, Length: end - i, Value: username})
+			i = end - 1
+		case '#':
+			// Sanitized text contains character references like &#39;, which aren't hashtags
+			if i > 0 && (isWordRune(prev) || prev == '&' || prev == '#') {
+				continue
+			}
+			end := i + 1
+			hasLetter := false
+			for end < len(runes) && isWordRune(runes[end]) {
+				hasLetter = hasLetter || unicode.IsLetter(runes[end])
+				end++
+			}
+			if !hasLetter || end-i-1 > MaxHashtagLength {
+				i = end - 1
+				continue
+			}
+			entities = append(entities, Entity{Kind: KindHashtag, Start: i, Length: end - i, Value: NormalizeHashtag(string(runes[i+1 : end]))})
+			i = end - 1
+		}
+	}
+
+	return entities
+}
+
+// Mentions returns the distinct usernames mentioned in the text.
+func Mentions(text string) []string {
+	return distinctValues(Parse(text), KindMention)
+}
+
+// Hashtags returns the distinct normalized hashtags in the text.
+func Hashtags(text string) []string {
+	return distinctValues(Parse(text), KindHashtag)
+}
+
+// NormalizeHashtag returns the form of a hashtag that's used to compare it with other hashtags, so that #Punks
+// and #punks are the same tag.
+func NormalizeHashtag(tag string) string {
+	return strings.ToLower(strings.TrimPrefix(strings.TrimSpace(tag), "#"))
+}
+
+// IsHashtag reports whether the tag, with or without its leading #, would be parsed as a hashtag.
+func IsHashtag(tag string) bool {
+	tag = strings.TrimSpace(tag)
+	if !strings.HasPrefix(tag, "#") {
+		tag = "#" + tag
+	}
+	entities := Parse(tag)
+	return len(entities) == 1 && entities[0].Kind == KindHashtag && entities[0].Length == len([]rune(tag))
+}
+
+func distinctValues(entities []Entity, kind Kind) []string {
+	values := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, e := range entities {
+		key := strings.ToLower(e.Value)
+		if e.Kind == kind && !seen[key] {
+			values = append(values, e.Value)
+			seen[key] = true
+		}
+	}
+	return values
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// isUsernameRune reports whether the rune can be part of a username, see validate.UsernameValidator
+func isUsernameRune(r rune) bool {
+	return r == '_' || r == '.' || (r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)))
+}
@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParse_Success(t *testing.T) {
+	t.Run("finds mentions and hashtags", func(t *testing.T) {
+		entities := Parse("gm @alice, check out #Punks with @bob.")
+
+		assert.Equal(t, []Entity{
+			{Kind: KindMention, Start: 3, Length: 6, Value: "alice"},
+			{Kind: KindHashtag, Start: 21, Length: 6, Value: "punks"},
+			{Kind: KindMention, Start: 33, Length: 4, Value: "bob"},
+		}, entities)
+	})
+
+	t.Run("counts characters instead of bytes", func(t *testing.T) {
+		entities := Parse("très bien #généralité")
+
+		assert.Equal(t, []Entity{{Kind: KindHashtag, Start: 10, Length: 11, Value: "généralité"}}, entities)
+	})
+
+	t.Run("ignores emails and character references", func(t *testing.T) {
+		entities := Parse("mail me@gallery.so, it&#39;s #1 in a#row")
+
+		assert.Empty(t, entities)
+	})
+
+	t.Run("returns distinct values", func(t *testing.T) {
+		assert.Equal(t, []string{"alice"}, Mentions("@alice @Alice"))
+		assert.Equal(t, []string{"punks", "apes"}, Hashtags("#punks #PUNKS #apes"))
+	})
+}
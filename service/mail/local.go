@@ -0,0 +1,75 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// FileProvider writes each email to a .eml file instead of sending it, so that emails can be opened in a mail client
+// while developing locally
+type FileProvider struct {
+	dir string
+}
+
+func NewFileProvider(dir string) *FileProvider {
+	return &FileProvider{dir: dir}
+}
+
+var unsafeFilenameChars = regexp.MustCompile(`[^a-zA-Z0-9._@-]+`)
+
+func (p *FileProvider) Send(ctx context.Context, msg Message) error {
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(p.dir, 0o755); err != nil {
+		return err
+	}
+
+	name := fmt.Sprintf("%d-%s.eml", time.Now().UnixNano(), unsafeFilenameChars.ReplaceAllString(msg.To.Email, "_"))
+	return os.WriteFile(filepath.Join(p.dir, name), body, 0o644)
+}
+
+// Mailbox keeps sent emails in memory so tests can check what would have been sent
+type Mailbox struct {
+	mu       sync.Mutex
+	messages []Message
+}
+
+func NewMailbox() *Mailbox {
+	return &Mailbox{}
+}
+
+func (m *Mailbox) Send(ctx context.Context, msg Message) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.messages = append(m.messages, msg)
+	return nil
+}
+
+// Messages returns the emails sent so far, oldest first
+func (m *Mailbox) Messages() []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return append([]Message{}, m.messages...)
+}
+
+// MessagesTo returns the emails sent to an address, oldest first
+func (m *Mailbox) MessagesTo(email string) []Message {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var sent []Message
+	for _, msg := range m.messages {
+		if msg.To.Email == email {
+			sent = append(sent, msg)
+		}
+	}
+	return sent
+}
@@ -0,0 +1,152 @@
+// Package mail sends email through a configurable provider. Emails are rendered before they get here, so providers
+// only need to deliver them.
+package mail
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"net/textproto"
+	"sort"
+	"time"
+)
+
+type Address struct {
+	Name  string
+	Email string
+}
+
+func (a Address) String() string {
+	return (&netmail.Address{Name: a.Name, Address: a.Email}).String()
+}
+
+type Message struct {
+	From    Address
+	To      Address
+	Subject string
+	HTML    string
+	Text    string
+	// Headers are added to the message as is, e.g. List-Unsubscribe
+	Headers map[string]string
+}
+
+type Provider interface {
+	Send(ctx context.Context, msg Message) error
+}
+
+// Verdict is how likely it is that an address can receive email
+type Verdict string
+
+const (
+	VerdictValid   Verdict = "valid"
+	VerdictRisky   Verdict = "risky"
+	VerdictInvalid Verdict = "invalid"
+)
+
+// Validator is implemented by providers that can check whether an address can receive email before anything is sent
+// to it. source is where the address was entered, e.g. "signup".
+type Validator interface {
+	ValidateEmail(ctx context.Context, email string, source string) (Verdict, error)
+}
+
+// ContactLister is implemented by providers that keep a list of the addresses that we send to
+type ContactLister interface {
+	AddContact(ctx context.Context, email string) error
+}
+
+// ValidateEmail checks an address with the provider if it's able to, and otherwise only checks that it's well-formed
+func ValidateEmail(ctx context.Context, p Provider, email string, source string) (Verdict, error) {
+	if v, ok := p.(Validator); ok {
+		return v.ValidateEmail(ctx, email, source)
+	}
+
+	if _, err := netmail.ParseAddress(email); err != nil {
+		return VerdictInvalid, nil
+	}
+
+	return VerdictValid, nil
+}
+
+// AddContact adds an address to the provider's contacts if it keeps any
+func AddContact(ctx context.Context, p Provider, email string) error {
+	if l, ok := p.(ContactLister); ok {
+		return l.AddContact(ctx, email)
+	}
+	return nil
+}
+
+// Bytes encodes the message as a multipart/alternative MIME message, which is what SMTP servers and mail clients
+// expect
+func (m Message) Bytes() ([]byte, error) {
+	if m.To.Email == "" {
+		return nil, errors.New("message has no recipient")
+	}
+
+	var buf bytes.Buffer
+	body := multipart.NewWriter(&buf)
+
+	headers := map[string]string{
+		"From":         m.From.String(),
+		"To":           m.To.String(),
+		"Subject":      mime.QEncoding.Encode("utf-8", m.Subject),
+		"Date":         time.Now().Format(time.RFC1123Z),
+		"MIME-Version": "1.0",
+		"Content-Type": fmt.Sprintf("multipart/alternative; boundary=%s", body.Boundary()),
+	}
+	for k, v := range m.Headers {
+		headers[k] = v
+	}
+
+	keys := make([]string, 0, len(headers))
+	for k := range headers {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var out bytes.Buffer
+	for _, k := range keys {
+		fmt.Fprintf(&out, "%s: %s\r\n", k, headers[k])
+	}
+	out.WriteString("\r\n")
+
+	// Clients show the last part they're able to, so the HTML part goes last
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{"text/plain; charset=utf-8", m.Text},
+		{"text/html; charset=utf-8", m.HTML},
+	} {
+		if part.content == "" {
+			continue
+		}
+
+		w, err := body.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		qp := quotedprintable.NewWriter(w)
+		if _, err := qp.Write([]byte(part.content)); err != nil {
+			return nil, err
+		}
+		if err := qp.Close(); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := body.Close(); err != nil {
+		return nil, err
+	}
+
+	out.Write(buf.Bytes())
+	return out.Bytes(), nil
+}
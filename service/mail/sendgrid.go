@@ -0,0 +1,194 @@
+package mail
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sendgrid/sendgrid-go"
+	sgmail "github.com/sendgrid/sendgrid-go/helpers/mail"
+)
+
+const sendgridHost = "https://api.sendgrid.com"
+
+// SendGridProvider sends email with SendGrid's v3 API. It also validates addresses and adds them to a marketing list.
+type SendGridProvider struct {
+	client        *sendgrid.Client
+	apiKey        string
+	validationKey string
+	listID        string
+}
+
+// NewSendGridProvider creates a provider that sends with apiKey. validationKey is a separate key that's allowed to
+// validate addresses, and listID is the marketing list that verified addresses are added to.
+func NewSendGridProvider(apiKey, validationKey, listID string) *SendGridProvider {
+	return &SendGridProvider{
+		client:        sendgrid.NewSendClient(apiKey),
+		apiKey:        apiKey,
+		validationKey: validationKey,
+		listID:        listID,
+	}
+}
+
+func (p *SendGridProvider) Send(ctx context.Context, msg Message) error {
+	m := sgmail.NewV3Mail()
+	m.SetFrom(sgmail.NewEmail(msg.From.Name, msg.From.Email))
+	m.Subject = msg.Subject
+
+	personalization := sgmail.NewPersonalization()
+	personalization.AddTos(sgmail.NewEmail(msg.To.Name, msg.To.Email))
+	m.AddPersonalizations(personalization)
+
+	if msg.Text != "" {
+		m.AddContent(sgmail.NewContent("text/plain", msg.Text))
+	}
+	if msg.HTML != "" {
+		m.AddContent(sgmail.NewContent("text/html", msg.HTML))
+	}
+
+	for k, v := range msg.Headers {
+		m.SetHeader(k, v)
+	}
+
+	response, err := p.client.SendWithContext(ctx, m)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return fmt.Errorf("sendgrid send failed with status %d: %s", response.StatusCode, response.Body)
+	}
+
+	return nil
+}
+
+type sendgridEmailValidation struct {
+	Email  string `json:"email"`
+	Source string `json:"source"`
+}
+
+/*
+{
+   "result":{
+      "email":"bc@gallery.so",
+      "verdict":"Risky",
+      "score":0.21029,
+      "local":"bc",
+      "host":"gallery.so",
+      "checks":{
+         "domain":{
+            "has_valid_address_syntax":true,
+            "has_mx_or_a_record":true,
+            "is_suspected_disposable_address":false
+         },
+         "local_part":{
+            "is_suspected_role_address":false
+         },
+         "additional":{
+            "has_known_bounces":false,
+            "has_suspected_bounces":true
+         }
+      },
+      "source":"SIGNUP",
+      "ip_address":"172.119.250.71"
+   }
+}
+*/
+
+type sendgridEmailValidationResult struct {
+	Result struct {
+		Email   string  `json:"email"`
+		Verdict string  `json:"verdict"`
+		Score   float64 `json:"score"`
+		Local   string  `json:"local"`
+		Host    string  `json:"host"`
+		Checks  struct {
+			Domain struct {
+				HasValidAddressSyntax        bool `json:"has_valid_address_syntax"`
+				HasMxOrARecord               bool `json:"has_mx_or_a_record"`
+				IsSuspectedDisposableAddress bool `json:"is_suspected_disposable_address"`
+			} `json:"domain"`
+			LocalPart struct {
+				IsSuspectedRoleAddress bool `json:"is_suspected_role_address"`
+			} `json:"local_part"`
+			Additional struct {
+				HasKnownBounces     bool `json:"has_known_bounces"`
+				HasSuspectedBounces bool `json:"has_suspected_bounces"`
+			} `json:"additional"`
+		} `json:"checks"`
+		Source    string `json:"source"`
+		IPAddress string `json:"ip_address"`
+	} `json:"result"`
+}
+
+func (p *SendGridProvider) ValidateEmail(ctx context.Context, email string, source string) (Verdict, error) {
+	request := sendgrid.GetRequest(p.validationKey, "/v3/validations/email", sendgridHost)
+	request.Method = "POST"
+
+	body, err := json.Marshal(sendgridEmailValidation{Email: email, Source: source})
+	if err != nil {
+		return VerdictInvalid, err
+	}
+
+	request.Body = body
+
+	response, err := sendgrid.MakeRequestWithContext(ctx, request)
+	if err != nil {
+		return VerdictInvalid, err
+	}
+
+	if response.StatusCode != 200 {
+		return VerdictInvalid, fmt.Errorf("verify email returned: %+v", response)
+	}
+
+	var result sendgridEmailValidationResult
+	if err := json.Unmarshal([]byte(response.Body), &result); err != nil {
+		return VerdictInvalid, err
+	}
+
+	switch strings.ToLower(result.Result.Verdict) {
+	case "valid":
+		return VerdictValid, nil
+	case "risky":
+		return VerdictRisky, nil
+	default:
+		return VerdictInvalid, nil
+	}
+}
+
+type sendgridContacts struct {
+	ListIDs  []string          `json:"list_ids"`
+	Contacts []sendgridContact `json:"contacts"`
+}
+
+type sendgridContact struct {
+	Email        string                 `json:"email"`
+	CustomFields map[string]interface{} `json:"custom_fields"`
+}
+
+func (p *SendGridProvider) AddContact(ctx context.Context, email string) error {
+	request := sendgrid.GetRequest(p.apiKey, "/v3/marketing/contacts", sendgridHost)
+	request.Method = "PUT"
+
+	body, err := json.Marshal(sendgridContacts{
+		ListIDs:  []string{p.listID},
+		Contacts: []sendgridContact{{Email: email}},
+	})
+	if err != nil {
+		return err
+	}
+
+	request.Body = body
+
+	response, err := sendgrid.MakeRequestWithContext(ctx, request)
+	if err != nil {
+		return err
+	}
+
+	if response.StatusCode != 202 {
+		return fmt.Errorf("email contact addition failed and returned: %+v", response)
+	}
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package mail
+
+import (
+	"context"
+	"fmt"
+	"net/smtp"
+)
+
+// SMTPProvider sends email through any SMTP server, upgrading to TLS when the server supports it
+type SMTPProvider struct {
+	addr string
+	auth smtp.Auth
+}
+
+// NewSMTPProvider creates a provider that sends through host:port. The server is connected to anonymously if username
+// is empty.
+func NewSMTPProvider(host string, port int, username, password string) *SMTPProvider {
+	p := &SMTPProvider{addr: fmt.Sprintf("%s:%d", host, port)}
+	if username != "" {
+		p.auth = smtp.PlainAuth("", username, password, host)
+	}
+	return p
+}
+
+func (p *SMTPProvider) Send(ctx context.Context, msg Message) error {
+	body, err := msg.Bytes()
+	if err != nil {
+		return err
+	}
+
+	// net/smtp doesn't take a context, so the best we can do is not start sending if it's already done
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return smtp.SendMail(p.addr, p.auth, msg.From.Email, []string{msg.To.Email}, body)
+}
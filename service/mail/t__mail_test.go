@@ -0,0 +1,93 @@
+package mail
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testMessage = Message{
+	From:    Address{Name: "Gallery", Email: "hello@gallery.so"},
+	To:      Address{Name: "bob", Email: "bob@example.com"},
+	Subject: "Your weekly notifications ✨",
+	HTML:    "<p>bob admired your gallery</p>",
+	Text:    "bob admired your gallery",
+	Headers: map[string]string{"List-Unsubscribe": "<https://gallery.so/unsubscribe>"},
+}
+
+func TestMessageBytes_Success(t *testing.T) {
+	body, err := testMessage.Bytes()
+	require.NoError(t, err)
+
+	parsed, err := netmail.ReadMessage(strings.NewReader(string(body)))
+	require.NoError(t, err)
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(parsed.Header.Get("Subject"))
+	require.NoError(t, err)
+	assert.Equal(t, testMessage.Subject, subject)
+	assert.Equal(t, testMessage.Headers["List-Unsubscribe"], parsed.Header.Get("List-Unsubscribe"))
+
+	to, err := parsed.Header.AddressList("To")
+	require.NoError(t, err)
+	assert.Equal(t, "bob@example.com", to[0].Address)
+
+	mediaType, params, err := mime.ParseMediaType(parsed.Header.Get("Content-Type"))
+	require.NoError(t, err)
+	assert.Equal(t, "multipart/alternative", mediaType)
+
+	parts := map[string]string{}
+	r := multipart.NewReader(parsed.Body, params["boundary"])
+	for {
+		part, err := r.NextRawPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		content, err := io.ReadAll(quotedprintable.NewReader(part))
+		require.NoError(t, err)
+		parts[part.Header.Get("Content-Type")] = string(content)
+	}
+
+	assert.Equal(t, testMessage.Text, parts["text/plain; charset=utf-8"])
+	assert.Equal(t, testMessage.HTML, parts["text/html; charset=utf-8"])
+}
+
+func TestLocalProviders_Success(t *testing.T) {
+	ctx := context.Background()
+
+	t.Run("mailbox keeps sent messages", func(t *testing.T) {
+		m := NewMailbox()
+		require.NoError(t, m.Send(ctx, testMessage))
+		assert.Len(t, m.MessagesTo("bob@example.com"), 1)
+		assert.Empty(t, m.MessagesTo("alice@example.com"))
+	})
+
+	t.Run("file provider writes a .eml file", func(t *testing.T) {
+		dir := t.TempDir()
+		require.NoError(t, NewFileProvider(dir).Send(ctx, testMessage))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		body, err := os.ReadFile(files[0])
+		require.NoError(t, err)
+		assert.Contains(t, string(body), "List-Unsubscribe")
+	})
+
+	t.Run("addresses are checked locally when the provider can't validate", func(t *testing.T) {
+		verdict, err := ValidateEmail(ctx, NewMailbox(), "not an email", "signup")
+		require.NoError(t, err)
+		assert.Equal(t, VerdictInvalid, verdict)
+	})
+}
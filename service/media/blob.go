@@ -0,0 +1,299 @@
+package media
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"cloud.google.com/go/storage"
+	"github.com/everFinance/goar"
+	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/jackc/pgx/v4"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/sirupsen/logrus"
+)
+
+var errBlobCollected = errors.New("blob was collected")
+
+// blobPrefixes are the prefixes of every object that may be derived from a blob
+var blobPrefixes = []string{"image", "video", "svg", "thumbnail", "liverender"}
+
+// mediaNames are the names that a token's media may be cached under. Media cached
+// by its content is preferred over media cached under the token's own name.
+type mediaNames struct {
+	token string
+	blobs []string
+}
+
+func (n mediaNames) String() string {
+	return n.token
+}
+
+// servingURL returns the serving URL of the first cached object with the given prefix
+func (n mediaNames) servingURL(ctx context.Context, bucket, prefix string, client *storage.Client) (string, error) {
+	candidates := append(append([]string{}, n.blobs...), n.token)
+
+	var err error
+	for _, name := range candidates {
+		var servingURL string
+		servingURL, err = getMediaServingURL(ctx, bucket, fmt.Sprintf("%s-%s", prefix, name), client)
+		if err == nil {
+			return servingURL, nil
+		}
+	}
+
+	return "", err
+}
+
+// blobIndex keeps track of the blobs that a token's media is cached under
+type blobIndex struct {
+	queries         *coredb.Queries
+	bucket          string
+	chain           persist.Chain
+	contractAddress persist.Address
+	tokenID         persist.TokenID
+}
+
+func newBlobIndex(queries *coredb.Queries, bucket string, chain persist.Chain, contractAddress persist.Address, tokenID persist.TokenID) *blobIndex {
+	return &blobIndex{
+		queries:         queries,
+		bucket:          bucket,
+		chain:           chain,
+		contractAddress: contractAddress,
+		tokenID:         tokenID,
+	}
+}
+
+func (b *blobIndex) enabled() bool {
+	return b != nil && b.queries != nil
+}
+
+// lookup returns the blob stored for the content key if it is still in storage
+func (b *blobIndex) lookup(ctx context.Context, contentKey string, client *storage.Client) (coredb.MediaBlob, bool) {
+	blob, err := b.queries.GetMediaBlobByContentKey(ctx, coredb.GetMediaBlobByContentKeyParams{
+		Bucket:     b.bucket,
+		ContentKey: contentKey,
+	})
+	if err != nil {
+		if !errors.Is(err, pgx.ErrNoRows) {
+			logger.For(ctx).Errorf("failed to get blob for %s: %s", contentKey, err)
+		}
+		return blob, false
+	}
+
+	exists, err := objectExists(ctx, client, b.bucket, blob.ObjectName)
+	if err != nil {
+		logger.For(ctx).Errorf("failed to check if blob %s exists: %s", blob.ObjectName, err)
+		return blob, false
+	}
+
+	return blob, exists
+}
+
+// save records the blob that was cached for the content key and links it to the token
+func (b *blobIndex) save(ctx context.Context, urlType, contentKey, objectName string, mediaType persist.MediaType) error {
+	blob, err := b.queries.UpsertMediaBlob(ctx, coredb.UpsertMediaBlobParams{
+		ID:         persist.GenerateID(),
+		Bucket:     b.bucket,
+		ContentKey: contentKey,
+		ObjectName: objectName,
+		MediaType:  string(mediaType),
+	})
+	if err != nil {
+		return err
+	}
+	return b.link(ctx, urlType, blob.ID)
+}
+
+// link links the token to the blob and touches the blob so that it isn't collected while it's being used.
+// errBlobCollected is returned if the blob was collected since it was looked up.
+func (b *blobIndex) link(ctx context.Context, urlType string, blobID persist.DBID) error {
+	linked, err := b.queries.LinkTokenToMediaBlob(ctx, coredb.LinkTokenToMediaBlobParams{
+		BlobID:          blobID,
+		ID:              persist.GenerateID(),
+		Chain:           b.chain,
+		ContractAddress: b.contractAddress,
+		TokenID:         b.tokenID,
+		UrlType:         urlType,
+	})
+	if err != nil {
+		return err
+	}
+	if linked == 0 {
+		return errBlobCollected
+	}
+	return nil
+}
+
+func (b *blobIndex) unlink(ctx context.Context, urlType string) {
+	if !b.enabled() {
+		return
+	}
+	err := b.queries.UnlinkTokenFromMediaBlob(ctx, coredb.UnlinkTokenFromMediaBlobParams{
+		Chain:           b.chain,
+		ContractAddress: b.contractAddress,
+		TokenID:         b.tokenID,
+		UrlType:         urlType,
+	})
+	if err != nil {
+		logger.For(ctx).Errorf("failed to unlink %s blob: %s", urlType, err)
+	}
+}
+
+// contentKeyForURI returns a key that identifies the content of a URI without having to download it.
+// An empty string is returned if the URI is not content-addressed.
+func contentKeyForURI(uri persist.TokenURI) string {
+	switch uri.Type() {
+	case persist.URITypeIPFS, persist.URITypeIPFSGateway:
+		return "ipfs://" + util.GetURIPath(uri.String(), true)
+	case persist.URITypeIPFSAPI:
+		parsedURL, err := url.Parse(uri.String())
+		if err != nil {
+			return ""
+		}
+		return "ipfs://" + parsedURL.Query().Get("arg")
+	case persist.URITypeArweave:
+		return "ar://" + util.GetURIPath(uri.String(), true)
+	case persist.URITypeBase64JSON, persist.URITypeBase64SVG, persist.URITypeBase64BMP, persist.URITypeJSON, persist.URITypeSVG:
+		// the content is embedded in the URI itself
+		sum := sha256.Sum256([]byte(uri))
+		return "sha256:" + hex.EncodeToString(sum[:])
+	default:
+		return ""
+	}
+}
+
+// blobNameForKey returns the name that objects of a blob are stored under
+func blobNameForKey(contentKey string) string {
+	sum := sha256.Sum256([]byte(contentKey))
+	return fmt.Sprintf("blob-%s", hex.EncodeToString(sum[:]))
+}
+
+// downloadAndCache caches the media at mediaURL. If the URL is content-addressed, the media is stored as a blob
+// named after its content, and the download is skipped altogether if the blob was already cached by another token.
+func downloadAndCache(pCtx context.Context, mediaURL, name, ipfsPrefix string, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, bucket string, blobs *blobIndex) (persist.MediaType, string, bool, error) {
+	key := contentKeyForURI(persist.TokenURI(mediaURL))
+	if key == "" || !blobs.enabled() {
+		mediaType, _, cached, err := cacheMediaFromURL(pCtx, mediaURL, name, ipfsPrefix, ipfsClient, arweaveClient, storageClient, bucket)
+		return mediaType, "", cached, err
+	}
+
+	// Blobs are scoped to the URL type because media that was found as an image is cached differently than a video
+	contentKey := fmt.Sprintf("%s:%s", ipfsPrefix, key)
+	blobName := blobNameForKey(contentKey)
+	pCtx = logger.NewContextWithFields(pCtx, logrus.Fields{"blobName": blobName})
+
+	if blob, ok := blobs.lookup(pCtx, contentKey, storageClient); ok {
+		err := blobs.link(pCtx, ipfsPrefix, blob.ID)
+		if err == nil {
+			logger.For(pCtx).Infof("content of '%s' is already cached as %s, skipping download", truncateString(mediaURL, 50), blob.ObjectName)
+			return persist.MediaType(blob.MediaType), blobName, true, nil
+		}
+
+		// The blob's objects may be deleted at any time if it was collected, so the media is downloaded again
+		if errors.Is(err, errBlobCollected) {
+			logger.For(pCtx).Infof("blob %s was collected before it could be linked, downloading again", blob.ID)
+		} else {
+			logger.For(pCtx).Errorf("failed to link blob %s: %s", blob.ID, err)
+		}
+	}
+
+	mediaType, objectName, cached, err := cacheMediaFromURL(pCtx, mediaURL, blobName, ipfsPrefix, ipfsClient, arweaveClient, storageClient, bucket)
+	if err != nil || objectName == "" {
+		return mediaType, "", cached, err
+	}
+
+	if err := blobs.save(pCtx, ipfsPrefix, contentKey, objectName, mediaType); err != nil {
+		logger.For(pCtx).Errorf("failed to save blob %s: %s", objectName, err)
+	}
+
+	return mediaType, blobName, cached, nil
+}
+
+// CollectUnreferencedBlobs deletes blobs that are no longer referenced by any token. Blobs are only eligible for
+// collection once they have gone unreferenced for the grace period so that in-flight processing can still link to them.
+func CollectUnreferencedBlobs(ctx context.Context, repos *postgres.Repositories, queries *coredb.Queries, storageClient *storage.Client, gracePeriod time.Duration, limit int) (int, error) {
+	unlinked, err := queries.UnlinkMediaBlobsOfDeletedTokens(ctx)
+	if err != nil {
+		return 0, err
+	}
+	logger.For(ctx).Infof("unlinked %d blobs of deleted tokens", unlinked)
+
+	cutoff := time.Now().Add(-gracePeriod)
+
+	blobs, err := queries.GetUnreferencedMediaBlobs(ctx, coredb.GetUnreferencedMediaBlobsParams{
+		LastUpdated: cutoff,
+		Limit:       int32(limit),
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	var collected int
+	for _, blob := range blobs {
+		// The blob is deleted from the database first so that it can't be linked to while its objects are being deleted
+		deleted, err := deleteUnreferencedBlob(ctx, repos, queries, blob.ID, cutoff)
+		if err != nil {
+			return collected, err
+		}
+		if !deleted {
+			continue
+		}
+
+		name := blobNameForKey(blob.ContentKey)
+		for _, prefix := range blobPrefixes {
+			fileName := fmt.Sprintf("%s-%s", prefix, name)
+			if err := deleteMedia(ctx, blob.Bucket, fileName, storageClient); err != nil && err != storage.ErrObjectNotExist {
+				logger.For(ctx).Errorf("failed to delete %s of blob %s: %s", fileName, blob.ID, err)
+			}
+		}
+
+		collected++
+	}
+
+	return collected, nil
+}
+
+// deleteUnreferencedBlob deletes the blob if it is still unreferenced. The blob is locked before its references are
+// counted, so a token that is being linked to it either commits first and is counted, or waits and then finds that
+// the blob was collected.
+func deleteUnreferencedBlob(ctx context.Context, repos *postgres.Repositories, queries *coredb.Queries, blobID persist.DBID, cutoff time.Time) (bool, error) {
+	tx, err := repos.BeginTx(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer tx.Rollback(ctx)
+
+	q := queries.WithTx(tx)
+
+	// The blob is skipped if it was linked, and therefore touched, since it was found
+	_, err = q.LockUnreferencedMediaBlob(ctx, coredb.LockUnreferencedMediaBlobParams{ID: blobID, LastUpdated: cutoff})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	references, err := q.CountMediaBlobReferences(ctx, blobID)
+	if err != nil {
+		return false, err
+	}
+	if references > 0 {
+		return false, nil
+	}
+
+	if err := q.DeleteMediaBlob(ctx, blobID); err != nil {
+		return false, err
+	}
+
+	return true, tx.Commit(ctx)
+}
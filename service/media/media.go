@@ -21,6 +21,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/mediamapper"
@@ -113,8 +114,10 @@ func NewStorageClient(ctx context.Context) *storage.Client {
 }
 
 // MakePreviewsForMetadata uses a metadata map to generate media content and cache resized versions of the media content.
-func MakePreviewsForMetadata(pCtx context.Context, metadata persist.TokenMetadata, contractAddress persist.Address, tokenID persist.TokenID, tokenURI persist.TokenURI, chain persist.Chain, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, tokenBucket string, imageKeywords, animationKeywords Keywords) (persist.Media, error) {
+// Media that has a content-addressed URI is cached once per unique piece of content and shared between all tokens that refer to it.
+func MakePreviewsForMetadata(pCtx context.Context, metadata persist.TokenMetadata, contractAddress persist.Address, tokenID persist.TokenID, tokenURI persist.TokenURI, chain persist.Chain, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, tokenBucket string, imageKeywords, animationKeywords Keywords, queries *coredb.Queries) (persist.Media, error) {
 	name := fmt.Sprintf("%s-%s", contractAddress, tokenID)
+	blobs := newBlobIndex(queries, tokenBucket, chain, contractAddress, tokenID)
 	imgURL, vURL := FindImageAndAnimationURLs(pCtx, tokenID, contractAddress, metadata, tokenURI, animationKeywords, imageKeywords, true)
 	logger.For(pCtx).Infof("got imgURL=%s;videoURL=%s", imgURL, vURL)
 
@@ -126,10 +129,10 @@ func MakePreviewsForMetadata(pCtx context.Context, metadata persist.TokenMetadat
 	)
 
 	if vURL != "" {
-		vidCh = downloadMediaFromURL(pCtx, storageClient, arweaveClient, ipfsClient, "video", vURL, name, tokenBucket)
+		vidCh = downloadMediaFromURL(pCtx, storageClient, arweaveClient, ipfsClient, "video", vURL, name, tokenBucket, blobs)
 	}
 	if imgURL != "" {
-		imgCh = downloadMediaFromURL(pCtx, storageClient, arweaveClient, ipfsClient, "image", imgURL, name, tokenBucket)
+		imgCh = downloadMediaFromURL(pCtx, storageClient, arweaveClient, ipfsClient, "image", imgURL, name, tokenBucket, blobs)
 	}
 
 	if vidCh != nil {
@@ -179,19 +182,34 @@ func MakePreviewsForMetadata(pCtx context.Context, metadata persist.TokenMetadat
 		go deleteMedia(context.Background(), tokenBucket, fmt.Sprintf("liverender-%s", name), storageClient)
 	}
 
+	// if a URL is no longer cached as a blob, the token shouldn't keep the stale blob from being collected
+	if vidResult.blobName == "" {
+		blobs.unlink(pCtx, "video")
+	}
+	if imgResult.blobName == "" {
+		blobs.unlink(pCtx, "image")
+	}
+
+	names := mediaNames{token: name}
+	for _, result := range []cacheResult{vidResult, imgResult} {
+		if result.blobName != "" {
+			names.blobs = append(names.blobs, result.blobName)
+		}
+	}
+
 	switch mediaType {
 	case persist.MediaTypeImage:
-		res = getImageMedia(pCtx, name, tokenBucket, storageClient, vURL, imgURL)
+		res = getImageMedia(pCtx, names, tokenBucket, storageClient, vURL, imgURL)
 	case persist.MediaTypeVideo, persist.MediaTypeAudio, persist.MediaTypeText, persist.MediaTypePDF, persist.MediaTypeAnimation:
-		res = getAuxilaryMedia(pCtx, name, tokenBucket, storageClient, vURL, imgURL, mediaType)
+		res = getAuxilaryMedia(pCtx, names, tokenBucket, storageClient, vURL, imgURL, mediaType)
 	case persist.MediaTypeHTML:
-		res = getHTMLMedia(pCtx, name, tokenBucket, storageClient, vURL, imgURL)
+		res = getHTMLMedia(pCtx, names, tokenBucket, storageClient, vURL, imgURL)
 	case persist.MediaTypeGIF:
-		res = getGIFMedia(pCtx, name, tokenBucket, storageClient, vURL, imgURL)
+		res = getGIFMedia(pCtx, names, tokenBucket, storageClient, vURL, imgURL)
 	case persist.MediaTypeSVG:
-		res = getSvgMedia(pCtx, name, tokenBucket, storageClient, vURL, imgURL)
+		res = getSvgMedia(pCtx, names, tokenBucket, storageClient, vURL, imgURL)
 	default:
-		res = getRawMedia(pCtx, mediaType, name, vURL, imgURL)
+		res = getRawMedia(pCtx, mediaType, names, vURL, imgURL)
 	}
 
 	logger.For(pCtx).Infof("media for %s of type %s: %+v", name, mediaType, res)
@@ -201,10 +219,11 @@ func MakePreviewsForMetadata(pCtx context.Context, metadata persist.TokenMetadat
 type cacheResult struct {
 	mediaType persist.MediaType
 	cached    bool
+	blobName  string
 	err       error
 }
 
-func downloadMediaFromURL(ctx context.Context, storageClient *storage.Client, arweaveClient *goar.Client, ipfsClient *shell.Shell, urlType, mediaURL, name, bucket string) chan cacheResult {
+func downloadMediaFromURL(ctx context.Context, storageClient *storage.Client, arweaveClient *goar.Client, ipfsClient *shell.Shell, urlType, mediaURL, name, bucket string, blobs *blobIndex) chan cacheResult {
 	resultCh := make(chan cacheResult)
 	ctx = logger.NewContextWithFields(ctx, logrus.Fields{
 		"tokenURIType": persist.TokenURI(mediaURL).Type(),
@@ -212,38 +231,38 @@ func downloadMediaFromURL(ctx context.Context, storageClient *storage.Client, ar
 	})
 
 	go func() {
-		mediaType, cached, err := downloadAndCache(ctx, mediaURL, name, urlType, ipfsClient, arweaveClient, storageClient, bucket)
+		mediaType, blobName, cached, err := downloadAndCache(ctx, mediaURL, name, urlType, ipfsClient, arweaveClient, storageClient, bucket, blobs)
 		if err == nil {
-			resultCh <- cacheResult{mediaType, cached, err}
+			resultCh <- cacheResult{mediaType, cached, blobName, err}
 			return
 		}
 
 		switch caught := err.(type) {
 		case rpc.ErrHTTP:
 			if err.(rpc.ErrHTTP).Status == http.StatusNotFound {
-				resultCh <- cacheResult{persist.MediaTypeInvalid, cached, err}
+				resultCh <- cacheResult{persist.MediaTypeInvalid, cached, blobName, err}
 			} else {
-				resultCh <- cacheResult{mediaType, cached, err}
+				resultCh <- cacheResult{mediaType, cached, blobName, err}
 			}
 		case *net.DNSError:
-			resultCh <- cacheResult{persist.MediaTypeInvalid, cached, err}
+			resultCh <- cacheResult{persist.MediaTypeInvalid, cached, blobName, err}
 		case *googleapi.Error:
 			panic(fmt.Errorf("googleAPI error %s: %s", caught, err))
 		default:
 			logger.For(ctx).Error(err)
 			sentryutil.ReportError(ctx, err)
-			resultCh <- cacheResult{mediaType, cached, err}
+			resultCh <- cacheResult{mediaType, cached, blobName, err}
 		}
 	}()
 
 	return resultCh
 }
 
-func getAuxilaryMedia(pCtx context.Context, name, tokenBucket string, storageClient *storage.Client, vURL string, imgURL string, mediaType persist.MediaType) persist.Media {
+func getAuxilaryMedia(pCtx context.Context, name mediaNames, tokenBucket string, storageClient *storage.Client, vURL string, imgURL string, mediaType persist.MediaType) persist.Media {
 	res := persist.Media{
 		MediaType: mediaType,
 	}
-	videoURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("video-%s", name), storageClient)
+	videoURL, err := name.servingURL(pCtx, tokenBucket, "video", storageClient)
 	if err == nil {
 		vURL = videoURL
 	}
@@ -265,7 +284,7 @@ func getAuxilaryMedia(pCtx context.Context, name, tokenBucket string, storageCli
 	}
 
 	if mediaType == persist.MediaTypeVideo {
-		liveRenderURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("liverender-%s", name), storageClient)
+		liveRenderURL, err := name.servingURL(pCtx, tokenBucket, "liverender", storageClient)
 		if err != nil {
 			logger.For(pCtx).Errorf("failed to get live render URL for %s: %v", name, err)
 		} else {
@@ -276,15 +295,15 @@ func getAuxilaryMedia(pCtx context.Context, name, tokenBucket string, storageCli
 	return res
 }
 
-func getGIFMedia(pCtx context.Context, name, tokenBucket string, storageClient *storage.Client, vURL string, imgURL string) persist.Media {
+func getGIFMedia(pCtx context.Context, name mediaNames, tokenBucket string, storageClient *storage.Client, vURL string, imgURL string) persist.Media {
 	res := persist.Media{
 		MediaType: persist.MediaTypeGIF,
 	}
-	videoURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("video-%s", name), storageClient)
+	videoURL, err := name.servingURL(pCtx, tokenBucket, "video", storageClient)
 	if err == nil {
 		vURL = videoURL
 	}
-	imageURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("image-%s", name), storageClient)
+	imageURL, err := name.servingURL(pCtx, tokenBucket, "image", storageClient)
 	if err == nil {
 		logger.For(pCtx).Infof("found imageURL for %s: %s", name, imageURL)
 		imgURL = imageURL
@@ -311,11 +330,11 @@ func getGIFMedia(pCtx context.Context, name, tokenBucket string, storageClient *
 	return res
 }
 
-func getSvgMedia(pCtx context.Context, name, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
+func getSvgMedia(pCtx context.Context, name mediaNames, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
 	res := persist.Media{
 		MediaType: persist.MediaTypeSVG,
 	}
-	imageURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("svg-%s", name), storageClient)
+	imageURL, err := name.servingURL(pCtx, tokenBucket, "svg", storageClient)
 	if err == nil {
 		logger.For(pCtx).Infof("found svgURL for svg %s: %s", name, imageURL)
 		res.MediaURL = persist.NullString(imageURL)
@@ -415,11 +434,11 @@ func getSvgDimensions(ctx context.Context, url string) (persist.Dimensions, erro
 	}, nil
 }
 
-func getImageMedia(pCtx context.Context, name, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
+func getImageMedia(pCtx context.Context, name mediaNames, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
 	res := persist.Media{
 		MediaType: persist.MediaTypeImage,
 	}
-	imageURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("image-%s", name), storageClient)
+	imageURL, err := name.servingURL(pCtx, tokenBucket, "image", storageClient)
 	if err == nil {
 		logger.For(pCtx).Infof("found imageURL for %s: %s", name, imageURL)
 		res.MediaURL = persist.NullString(imageURL)
@@ -446,7 +465,7 @@ func getImageMedia(pCtx context.Context, name, tokenBucket string, storageClient
 	return res
 }
 
-func getHTMLMedia(pCtx context.Context, name, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
+func getHTMLMedia(pCtx context.Context, name mediaNames, tokenBucket string, storageClient *storage.Client, vURL, imgURL string) persist.Media {
 	res := persist.Media{
 		MediaType: persist.MediaTypeHTML,
 	}
@@ -520,7 +539,7 @@ func getHTMLDimensions(ctx context.Context, url string) (persist.Dimensions, err
 
 }
 
-func getRawMedia(pCtx context.Context, mediaType persist.MediaType, name, vURL, imgURL string) persist.Media {
+func getRawMedia(pCtx context.Context, mediaType persist.MediaType, name mediaNames, vURL, imgURL string) persist.Media {
 	var res persist.Media
 	res.MediaType = mediaType
 	if vURL != "" {
@@ -653,17 +672,17 @@ func predictTrueURLs(ctx context.Context, curImg, curV string) (string, string)
 	return curImg, curV
 }
 
-func getThumbnailURL(pCtx context.Context, tokenBucket string, name string, imgURL string, storageClient *storage.Client) string {
-	if storageImageURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("image-%s", name), storageClient); err == nil {
+func getThumbnailURL(pCtx context.Context, tokenBucket string, name mediaNames, imgURL string, storageClient *storage.Client) string {
+	if storageImageURL, err := name.servingURL(pCtx, tokenBucket, "image", storageClient); err == nil {
 		logger.For(pCtx).Infof("found imageURL for thumbnail %s: %s", name, storageImageURL)
 		return storageImageURL
-	} else if storageImageURL, err = getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("svg-%s", name), storageClient); err == nil {
+	} else if storageImageURL, err = name.servingURL(pCtx, tokenBucket, "svg", storageClient); err == nil {
 		logger.For(pCtx).Infof("found svg for thumbnail %s: %s", name, storageImageURL)
 		return storageImageURL
 	} else if imgURL != "" && persist.TokenURI(imgURL).IsRenderable() {
 		logger.For(pCtx).Infof("using imgURL for thumbnail %s: %s", name, imgURL)
 		return imgURL
-	} else if storageImageURL, err := getMediaServingURL(pCtx, tokenBucket, fmt.Sprintf("thumbnail-%s", name), storageClient); err == nil {
+	} else if storageImageURL, err := name.servingURL(pCtx, tokenBucket, "thumbnail", storageClient); err == nil {
 		logger.For(pCtx).Infof("found thumbnailURL for %s: %s", name, storageImageURL)
 		return storageImageURL
 	}
@@ -802,7 +821,7 @@ func getMediaServingURL(pCtx context.Context, bucketID, objectID string, client
 	return fmt.Sprintf("https://storage.googleapis.com/%s/%s", bucketID, objectID), nil
 }
 
-func downloadAndCache(pCtx context.Context, mediaURL, name, ipfsPrefix string, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, bucket string) (persist.MediaType, bool, error) {
+func cacheMediaFromURL(pCtx context.Context, mediaURL, name, ipfsPrefix string, ipfsClient *shell.Shell, arweaveClient *goar.Client, storageClient *storage.Client, bucket string) (persist.MediaType, string, bool, error) {
 	asURI := persist.TokenURI(mediaURL)
 	timeBeforePredict := time.Now()
 	mediaType, contentType, contentLength, _ := PredictMediaType(pCtx, asURI.String())
@@ -823,14 +842,14 @@ outer:
 			break outer
 		default:
 			logger.For(pCtx).Infof("skipping caching of mediaType '%s' and uriType '%s'", mediaType, asURI.Type())
-			return mediaType, false, nil
+			return mediaType, "", false, nil
 		}
 	}
 
 	timeBeforeDataReader := time.Now()
 	reader, err := rpc.GetDataFromURIAsReader(pCtx, asURI, ipfsClient, arweaveClient)
 	if err != nil {
-		return mediaType, false, fmt.Errorf("could not get reader for %s: %s", mediaURL, err)
+		return mediaType, "", false, fmt.Errorf("could not get reader for %s: %s", mediaURL, err)
 	}
 	logger.For(pCtx).Infof("got reader for %s in %s", name, time.Since(timeBeforeDataReader))
 	defer reader.Close()
@@ -849,7 +868,7 @@ outer:
 		videoURL := fmt.Sprintf("https://storage.googleapis.com/%s/video-%s", bucket, name)
 		err := cacheRawVideoMedia(pCtx, reader, bucket, name, contentType, storageClient)
 		if err != nil {
-			return mediaType, false, err
+			return mediaType, "", false, err
 		}
 
 		if err := thumbnailAndCache(pCtx, videoURL, bucket, name, storageClient); err != nil {
@@ -861,29 +880,29 @@ outer:
 		}
 
 		logger.For(pCtx).Infof("cached video for %s in %s", name, time.Since(timeBeforeCache))
-		return persist.MediaTypeVideo, true, nil
+		return persist.MediaTypeVideo, fmt.Sprintf("video-%s", name), true, nil
 	case persist.MediaTypeSVG:
 		timeBeforeCache := time.Now()
 		err = cacheRawSvgMedia(pCtx, reader, bucket, name, storageClient)
 		if err != nil {
-			return mediaType, false, err
+			return mediaType, "", false, err
 		}
 		logger.For(pCtx).Infof("cached svg for %s in %s", name, time.Since(timeBeforeCache))
-		return persist.MediaTypeSVG, true, nil
+		return persist.MediaTypeSVG, fmt.Sprintf("svg-%s", name), true, nil
 	case persist.MediaTypeBase64BMP:
 		timeBeforeCache := time.Now()
 		err = cacheRawImageMedia(pCtx, reader, bucket, name, contentType, storageClient)
 		if err != nil {
-			return mediaType, false, err
+			return mediaType, "", false, err
 		}
 		logger.For(pCtx).Infof("cached image for %s in %s", name, time.Since(timeBeforeCache))
-		return persist.MediaTypeImage, true, nil
+		return persist.MediaTypeImage, fmt.Sprintf("image-%s", name), true, nil
 	}
 
 	switch asURI.Type() {
 	case persist.URITypeIPFS, persist.URITypeArweave:
 		if mediaType == persist.MediaTypeHTML && persist.TokenURI(mediaURL).IsPathPrefixed() {
-			return mediaType, true, nil
+			return mediaType, "", true, nil
 		}
 		logger.For(pCtx).Infof("caching %.2f mb of raw media with type '%s' for '%s' at '%s-%s'", float64(contentLength)/1024/1024, mediaType, mediaURL, ipfsPrefix, name)
 
@@ -891,21 +910,21 @@ outer:
 			timeBeforeCache := time.Now()
 			err = cacheRawAnimationMedia(pCtx, reader, bucket, fmt.Sprintf("%s-%s", ipfsPrefix, name), storageClient)
 			if err != nil {
-				return mediaType, false, err
+				return mediaType, "", false, err
 			}
 			logger.For(pCtx).Infof("cached animation for %s in %s", name, time.Since(timeBeforeCache))
-			return mediaType, true, nil
+			return mediaType, fmt.Sprintf("%s-%s", ipfsPrefix, name), true, nil
 		}
 		timeBeforeCache := time.Now()
 		err = cacheRawMedia(pCtx, reader, bucket, fmt.Sprintf("%s-%s", ipfsPrefix, name), contentType, storageClient)
 		if err != nil {
-			return mediaType, false, err
+			return mediaType, "", false, err
 		}
 		logger.For(pCtx).Infof("cached raw media for %s in %s", name, time.Since(timeBeforeCache))
-		return mediaType, true, nil
+		return mediaType, fmt.Sprintf("%s-%s", ipfsPrefix, name), true, nil
 	}
 
-	return mediaType, false, nil
+	return mediaType, "", false, nil
 }
 
 // PredictMediaType guesses the media type of the given URL.
@@ -0,0 +1,153 @@
+package media
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	migrate "github.com/mikeydub/go-gallery/db"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/docker"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContentKeyForURI_Success(t *testing.T) {
+	assert.Equal(t, "ipfs://QmHash/image.png", contentKeyForURI("ipfs://QmHash/image.png"))
+	assert.Equal(t, "ipfs://QmHash/image.png", contentKeyForURI("https://ipfs.io/ipfs/QmHash/image.png"), "gateways share the content of the CID")
+	assert.Equal(t, "ar://txid", contentKeyForURI("ar://txid"))
+	assert.True(t, strings.HasPrefix(contentKeyForURI("data:image/svg+xml;base64,PHN2Zz48L3N2Zz4="), "sha256:"))
+	assert.Empty(t, contentKeyForURI("https://example.com/image.png"), "plain URLs aren't content-addressed")
+	assert.NotEqual(t, blobNameForKey("image:ipfs://QmHash"), blobNameForKey("video:ipfs://QmHash"))
+}
+
+func TestBlobCollection_Success(t *testing.T) {
+	repos, queries := setupBlobTest(t)
+	ctx := context.Background()
+
+	newBlob := func(t *testing.T) coredb.MediaBlob {
+		blob, err := queries.UpsertMediaBlob(ctx, coredb.UpsertMediaBlobParams{
+			ID:         persist.GenerateID(),
+			Bucket:     "bucket",
+			ContentKey: "image:ipfs://" + persist.GenerateID().String(),
+			ObjectName: "image-blob",
+			MediaType:  string(persist.MediaTypeImage),
+		})
+		require.NoError(t, err)
+		return blob
+	}
+
+	newIndex := func() *blobIndex {
+		return newBlobIndex(queries, "bucket", persist.ChainETH, "0x123", persist.TokenID(persist.GenerateID().String()))
+	}
+
+	t.Run("referenced blobs are kept", func(t *testing.T) {
+		blob := newBlob(t)
+		require.NoError(t, newIndex().link(ctx, "image", blob.ID))
+
+		deleted, err := deleteUnreferencedBlob(ctx, repos, queries, blob.ID, time.Now().Add(time.Hour))
+
+		require.NoError(t, err)
+		assert.False(t, deleted)
+	})
+
+	t.Run("linking touches the blob", func(t *testing.T) {
+		blob := newBlob(t)
+		index := newIndex()
+		cutoff := time.Now()
+		require.NoError(t, index.link(ctx, "image", blob.ID))
+		index.unlink(ctx, "image")
+
+		deleted, err := deleteUnreferencedBlob(ctx, repos, queries, blob.ID, cutoff)
+		require.NoError(t, err)
+		assert.False(t, deleted, "blob is still in its grace period")
+
+		deleted, err = deleteUnreferencedBlob(ctx, repos, queries, blob.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		assert.True(t, deleted)
+	})
+
+	t.Run("collected blobs can't be linked", func(t *testing.T) {
+		blob := newBlob(t)
+		deleted, err := deleteUnreferencedBlob(ctx, repos, queries, blob.ID, time.Now().Add(time.Hour))
+		require.NoError(t, err)
+		require.True(t, deleted)
+
+		err = newIndex().link(ctx, "image", blob.ID)
+
+		assert.ErrorIs(t, err, errBlobCollected)
+	})
+
+	t.Run("a link that commits while the blob is being collected keeps it", func(t *testing.T) {
+		blob := newBlob(t)
+		index := newIndex()
+
+		tx, err := repos.BeginTx(ctx)
+		require.NoError(t, err)
+		linked, err := queries.WithTx(tx).LinkTokenToMediaBlob(ctx, coredb.LinkTokenToMediaBlobParams{
+			BlobID:          blob.ID,
+			ID:              persist.GenerateID(),
+			Chain:           index.chain,
+			ContractAddress: index.contractAddress,
+			TokenID:         index.tokenID,
+			UrlType:         "image",
+		})
+		require.NoError(t, err)
+		require.EqualValues(t, 1, linked)
+
+		// The collector waits on the link's lock, then counts the committed reference
+		result := make(chan bool)
+		go func() {
+			deleted, err := deleteUnreferencedBlob(ctx, repos, queries, blob.ID, time.Now().Add(time.Hour))
+			assert.NoError(t, err)
+			result <- deleted
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, tx.Commit(ctx))
+
+		assert.False(t, <-result)
+	})
+
+	t.Run("a link that waits on the collector finds the blob collected", func(t *testing.T) {
+		blob := newBlob(t)
+		cutoff := time.Now().Add(time.Hour)
+
+		tx, err := repos.BeginTx(ctx)
+		require.NoError(t, err)
+		q := queries.WithTx(tx)
+		_, err = q.LockUnreferencedMediaBlob(ctx, coredb.LockUnreferencedMediaBlobParams{ID: blob.ID, LastUpdated: cutoff})
+		require.NoError(t, err)
+		require.NoError(t, q.DeleteMediaBlob(ctx, blob.ID))
+
+		result := make(chan error)
+		go func() {
+			result <- newIndex().link(ctx, "image", blob.ID)
+		}()
+
+		time.Sleep(100 * time.Millisecond)
+		require.NoError(t, tx.Commit(ctx))
+
+		assert.ErrorIs(t, <-result, errBlobCollected)
+	})
+}
+
+func setupBlobTest(t *testing.T) (*postgres.Repositories, *coredb.Queries) {
+	t.Helper()
+	r, err := docker.StartPostgres()
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+
+	hostAndPort := strings.Split(r.GetHostPort("5432/tcp"), ":")
+	t.Setenv("POSTGRES_HOST", hostAndPort[0])
+	t.Setenv("POSTGRES_PORT", hostAndPort[1])
+
+	err = migrate.RunMigrations(postgres.MustCreateClient(postgres.WithUser("postgres")), "./db/migrations/core")
+	require.NoError(t, err)
+
+	pgx := postgres.NewPgxClient()
+	return postgres.NewRepositories(postgres.MustCreateClient(), pgx), coredb.New(pgx)
+}
@@ -0,0 +1,229 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strings"
+
+	"cloud.google.com/go/storage"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// sanitizedMetadataKey is set on SVG objects that were rewritten by the sanitizer, so that tokens that share the
+// object are flagged the same way as the token that was processed first
+const sanitizedMetadataKey = "moderation-sanitized"
+
+// defaultBlockedKeywords are used by the local classifier when MODERATION_BLOCKED_KEYWORDS isn't set
+var defaultBlockedKeywords = []string{"nsfw", "porn", "xxx", "nude", "nudes", "hentai"}
+
+// Subject is a token's media and the metadata it was found with
+type Subject struct {
+	Media       persist.Media
+	Metadata    persist.TokenMetadata
+	Name        string
+	Description string
+}
+
+// Classifier inspects a token's media and returns the reasons it shouldn't be shown, if any
+type Classifier interface {
+	Classify(ctx context.Context, subject Subject) ([]persist.ModerationFlag, error)
+}
+
+// KeywordClassifier flags media whose name or description contain a blocked keyword
+type KeywordClassifier struct {
+	pattern *regexp.Regexp
+}
+
+// NewKeywordClassifier returns a classifier that matches whole words case-insensitively
+func NewKeywordClassifier(keywords []string) *KeywordClassifier {
+	quoted := make([]string, 0, len(keywords))
+	for _, k := range keywords {
+		if k = strings.TrimSpace(k); k != "" {
+			quoted = append(quoted, regexp.QuoteMeta(k))
+		}
+	}
+	if len(quoted) == 0 {
+		return &KeywordClassifier{}
+	}
+	return &KeywordClassifier{pattern: regexp.MustCompile(`(?i)\b(` + strings.Join(quoted, "|") + `)\b`)}
+}
+
+// NewLocalClassifier returns the classifier that runs in-process, configured by MODERATION_BLOCKED_KEYWORDS
+func NewLocalClassifier() *KeywordClassifier {
+	keywords := env.GetStringSlice("MODERATION_BLOCKED_KEYWORDS")
+	if len(keywords) == 1 && strings.Contains(keywords[0], ",") {
+		keywords = strings.Split(keywords[0], ",")
+	}
+	if len(keywords) == 0 {
+		keywords = defaultBlockedKeywords
+	}
+	return NewKeywordClassifier(keywords)
+}
+
+// Classify implements Classifier
+func (k *KeywordClassifier) Classify(ctx context.Context, subject Subject) ([]persist.ModerationFlag, error) {
+	if k.pattern == nil {
+		return nil, nil
+	}
+
+	texts := []string{subject.Name, subject.Description}
+	for _, key := range []string{"name", "description"} {
+		if v, ok := util.GetValueFromMapUnsafe(subject.Metadata, key, util.DefaultSearchDepth).(string); ok {
+			texts = append(texts, v)
+		}
+	}
+
+	for _, text := range texts {
+		if k.pattern.MatchString(text) {
+			return []persist.ModerationFlag{persist.ModerationFlagNSFW}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// Moderator checks a token's media once it has been processed. Unsafe SVGs are sanitized in place, and whatever
+// is flagged is recorded on the token so that it can be kept out of public feeds until it has been reviewed.
+type Moderator struct {
+	queries       *coredb.Queries
+	storageClient *storage.Client
+	classifier    Classifier
+}
+
+func NewModerator(queries *coredb.Queries, storageClient *storage.Client, classifier Classifier) *Moderator {
+	return &Moderator{
+		queries:       queries,
+		storageClient: storageClient,
+		classifier:    classifier,
+	}
+}
+
+// ModerateMedia moderates a token's newly processed media and returns the flags that were recorded
+func (m *Moderator) ModerateMedia(ctx context.Context, ti persist.TokenIdentifiers, subject Subject) ([]persist.ModerationFlag, error) {
+	flagged := make(map[persist.ModerationFlag]bool)
+
+	if subject.Media.MediaType == persist.MediaTypeSVG {
+		unsafe, err := m.sanitizeCachedSVG(ctx, subject.Media.MediaURL.String())
+		if err != nil {
+			logger.For(ctx).Errorf("failed to sanitize svg of %s: %s", ti, err)
+		}
+		if unsafe {
+			flagged[persist.ModerationFlagUnsafeSVG] = true
+		}
+	}
+
+	if m.classifier != nil {
+		flags, err := m.classifier.Classify(ctx, subject)
+		if err != nil {
+			return nil, err
+		}
+		for _, flag := range flags {
+			flagged[flag] = true
+		}
+	}
+
+	if len(flagged) == 0 {
+		return nil, m.queries.ClearTokenModeration(ctx, coredb.ClearTokenModerationParams{
+			Chain:           ti.Chain,
+			ContractAddress: ti.ContractAddress,
+			TokenID:         ti.TokenID,
+		})
+	}
+
+	flags := make([]persist.ModerationFlag, 0, len(flagged))
+	names := make([]string, 0, len(flagged))
+	for flag := range flagged {
+		flags = append(flags, flag)
+		names = append(names, string(flag))
+	}
+	sort.Slice(flags, func(i, j int) bool { return flags[i] < flags[j] })
+	sort.Strings(names)
+
+	logger.For(ctx).Infof("media of %s was flagged by moderation: %v", ti, names)
+
+	_, err := m.queries.UpsertTokenModeration(ctx, coredb.UpsertTokenModerationParams{
+		ID:              persist.GenerateID(),
+		Chain:           ti.Chain,
+		ContractAddress: ti.ContractAddress,
+		TokenID:         ti.TokenID,
+		Flags:           names,
+	})
+
+	return flags, err
+}
+
+// sanitizeCachedSVG rewrites a cached SVG without anything unsafe in it. It returns true if the SVG
+// had to be changed or couldn't be sanitized.
+func (m *Moderator) sanitizeCachedSVG(ctx context.Context, mediaURL string) (bool, error) {
+	bucket, objectName, ok := parseStorageURL(mediaURL)
+	if !ok {
+		// Only media that we serve ourselves can be sanitized, and SVGs served from anywhere else aren't shown
+		return true, nil
+	}
+	if m.storageClient == nil {
+		return false, nil
+	}
+
+	object := m.storageClient.Bucket(bucket).Object(objectName)
+	reader, err := object.NewReader(ctx)
+	if err != nil {
+		return false, err
+	}
+	defer reader.Close()
+
+	attrs, err := object.Attrs(ctx)
+	if err != nil {
+		return false, err
+	}
+	if _, ok := attrs.Metadata[sanitizedMetadataKey]; ok {
+		return true, nil
+	}
+
+	var sanitized bytes.Buffer
+	removed, err := SanitizeSVG(reader, &sanitized)
+	if err != nil {
+		return true, err
+	}
+	if removed == 0 {
+		return false, nil
+	}
+
+	writer := object.NewWriter(ctx)
+	writer.ContentType = "image/svg+xml"
+	writer.Metadata = map[string]string{sanitizedMetadataKey: fmt.Sprint(removed)}
+	if _, err := writer.Write(sanitized.Bytes()); err != nil {
+		writer.Close()
+		return true, err
+	}
+
+	logger.For(ctx).Infof("removed %d unsafe parts from %s", removed, objectName)
+	return true, writer.Close()
+}
+
+// CanServeSVG returns whether the SVG at mediaURL can be shown. Only SVGs that were cached to storage are sanitized
+// when their token is processed, so an SVG served from anywhere else may still have scripts in it.
+func CanServeSVG(mediaURL string) bool {
+	_, _, ok := parseStorageURL(mediaURL)
+	return ok
+}
+
+// parseStorageURL returns the bucket and object of a URL that is served from cloud storage
+func parseStorageURL(mediaURL string) (string, string, bool) {
+	u, err := url.Parse(mediaURL)
+	if err != nil || u.Host != "storage.googleapis.com" {
+		return "", "", false
+	}
+	parts := strings.SplitN(strings.TrimPrefix(u.Path, "/"), "/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", false
+	}
+	return parts[0], parts[1], true
+}
@@ -0,0 +1,214 @@
+package moderation
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// svgBlockedElements are removed from SVGs along with everything inside them
+var svgBlockedElements = map[string]bool{
+	"script":        true,
+	"foreignobject": true,
+	"iframe":        true,
+	"embed":         true,
+	"object":        true,
+	"handler":       true,
+	"listener":      true,
+}
+
+// svgAnimationElements can change another attribute's value, so they're removed if they target a link or an event handler
+var svgAnimationElements = map[string]bool{
+	"set":     true,
+	"animate": true,
+}
+
+// svgLinkAttributes are attributes whose value is loaded as a reference
+var svgLinkAttributes = map[string]bool{
+	"href": true,
+	"src":  true,
+}
+
+var (
+	cssImportPattern = regexp.MustCompile(`(?i)@import[^;]*;?`)
+	cssURLPattern    = regexp.MustCompile(`(?i)url\(\s*(['"]?)\s*([^'")]*?)\s*(['"]?)\s*\)`)
+)
+
+// SanitizeSVG copies an SVG document from r to w without any scripts, event handlers or references to external
+// resources, and returns how many things were removed. An error is returned if the document can't be parsed,
+// in which case the output should be discarded.
+func SanitizeSVG(r io.Reader, w io.Writer) (int, error) {
+	s := &svgSanitizer{w: w}
+	// The decoder is strict by default, so entities that a DTD would have to define fail to parse
+	decoder := xml.NewDecoder(r)
+
+	for {
+		token, err := decoder.RawToken()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return s.removed, fmt.Errorf("failed to parse svg: %w", err)
+		}
+		if err := s.handle(token); err != nil {
+			return s.removed, err
+		}
+	}
+
+	return s.removed, nil
+}
+
+type svgSanitizer struct {
+	w       io.Writer
+	removed int
+	// skipDepth is how many elements deep we are inside of a removed element
+	skipDepth int
+	// inStyle is true when the current element is a stylesheet
+	inStyle bool
+}
+
+func (s *svgSanitizer) handle(token xml.Token) error {
+	switch t := token.(type) {
+	case xml.StartElement:
+		if s.skipDepth > 0 {
+			s.skipDepth++
+			return nil
+		}
+		if s.isBlocked(t) {
+			s.removed++
+			s.skipDepth = 1
+			return nil
+		}
+		s.inStyle = strings.EqualFold(t.Name.Local, "style")
+		return s.writeStart(t)
+	case xml.EndElement:
+		if s.skipDepth > 0 {
+			s.skipDepth--
+			return nil
+		}
+		s.inStyle = false
+		_, err := fmt.Fprintf(s.w, "</%s>", qualifiedName(t.Name))
+		return err
+	case xml.CharData:
+		if s.skipDepth > 0 {
+			return nil
+		}
+		text := string(t)
+		if s.inStyle {
+			text = s.sanitizeCSS(text)
+		}
+		return xml.EscapeText(s.w, []byte(text))
+	case xml.Comment:
+		if s.skipDepth > 0 {
+			return nil
+		}
+		_, err := fmt.Fprintf(s.w, "<!--%s-->", t)
+		return err
+	case xml.ProcInst:
+		// Only the XML declaration is kept; other instructions like xml-stylesheet can load external resources
+		if t.Target != "xml" {
+			s.removed++
+			return nil
+		}
+		_, err := fmt.Fprintf(s.w, "<?%s %s?>", t.Target, t.Inst)
+		return err
+	case xml.Directive:
+		// DOCTYPEs can declare entities that reference external resources
+		if strings.Contains(strings.ToUpper(string(t)), "ENTITY") {
+			s.removed++
+		}
+		return nil
+	}
+	return nil
+}
+
+func (s *svgSanitizer) isBlocked(t xml.StartElement) bool {
+	local := strings.ToLower(t.Name.Local)
+	if svgBlockedElements[local] {
+		return true
+	}
+	if svgAnimationElements[local] {
+		for _, attr := range t.Attr {
+			if !strings.EqualFold(attr.Name.Local, "attributeName") {
+				continue
+			}
+			target := strings.ToLower(strings.TrimSpace(attr.Value))
+			if i := strings.IndexByte(target, ':'); i != -1 {
+				target = target[i+1:]
+			}
+			if svgLinkAttributes[target] || strings.HasPrefix(target, "on") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s *svgSanitizer) writeStart(t xml.StartElement) error {
+	var b strings.Builder
+	b.WriteString("<")
+	b.WriteString(qualifiedName(t.Name))
+
+	for _, attr := range t.Attr {
+		local := strings.ToLower(attr.Name.Local)
+		isNamespace := attr.Name.Space == "xmlns" || (attr.Name.Space == "" && local == "xmlns")
+
+		if !isNamespace && strings.HasPrefix(local, "on") {
+			s.removed++
+			continue
+		}
+		if !isNamespace && svgLinkAttributes[local] && !isLocalReference(attr.Value) {
+			s.removed++
+			continue
+		}
+
+		value := attr.Value
+		if !isNamespace {
+			value = s.sanitizeCSS(value)
+		}
+
+		b.WriteString(" ")
+		b.WriteString(qualifiedName(attr.Name))
+		b.WriteString(`="`)
+		if err := xml.EscapeText(&b, []byte(value)); err != nil {
+			return err
+		}
+		b.WriteString(`"`)
+	}
+
+	b.WriteString(">")
+	_, err := io.WriteString(s.w, b.String())
+	return err
+}
+
+// sanitizeCSS removes imports and external urls from CSS, which may be a stylesheet or an attribute value
+func (s *svgSanitizer) sanitizeCSS(css string) string {
+	css = cssImportPattern.ReplaceAllStringFunc(css, func(string) string {
+		s.removed++
+		return ""
+	})
+	return cssURLPattern.ReplaceAllStringFunc(css, func(match string) string {
+		target := cssURLPattern.FindStringSubmatch(match)[2]
+		if isLocalReference(target) {
+			return match
+		}
+		s.removed++
+		return "none"
+	})
+}
+
+// isLocalReference returns true if a reference points within the document or embeds its content directly
+func isLocalReference(ref string) bool {
+	ref = strings.ToLower(strings.TrimSpace(ref))
+	return ref == "" || strings.HasPrefix(ref, "#") || strings.HasPrefix(ref, "data:image/")
+}
+
+func qualifiedName(name xml.Name) string {
+	if name.Space == "" {
+		return name.Local
+	}
+	return name.Space + ":" + name.Local
+}
@@ -0,0 +1,127 @@
+package moderation
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSanitizeSVG(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		removed  int
+		contains []string
+		excludes []string
+	}{
+		{
+			name:     "safe svg is unchanged",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg" viewBox="0 0 10 10"><rect width="10" height="10" fill="red"/></svg>`,
+			removed:  0,
+			contains: []string{`<rect width="10" height="10" fill="red">`, `viewBox="0 0 10 10"`},
+		},
+		{
+			name:     "scripts are removed",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><script>alert(1)</script><circle r="1"/></svg>`,
+			removed:  1,
+			contains: []string{`<circle r="1">`},
+			excludes: []string{"script", "alert"},
+		},
+		{
+			name:     "event handlers are removed",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg" onload="alert(1)"><rect onclick="alert(2)" width="1"/></svg>`,
+			removed:  2,
+			contains: []string{`<rect width="1">`},
+			excludes: []string{"onload", "onclick"},
+		},
+		{
+			name:     "external references are removed",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg" xmlns:xlink="http://www.w3.org/1999/xlink"><image xlink:href="https://example.com/a.png"/><use href="#shape"/></svg>`,
+			removed:  1,
+			contains: []string{`<use href="#shape">`},
+			excludes: []string{"example.com"},
+		},
+		{
+			name:     "embedded images are kept",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><image href="data:image/png;base64,AAAA"/></svg>`,
+			removed:  0,
+			contains: []string{`href="data:image/png;base64,AAAA"`},
+		},
+		{
+			name:     "external css is removed",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><style>@import url(https://example.com/a.css); rect { fill: url(#grad); background: url('https://example.com/b.png') }</style><rect style="fill: url(http://example.com/c)"/></svg>`,
+			removed:  3,
+			contains: []string{"url(#grad)", "background: none"},
+			excludes: []string{"example.com", "@import"},
+		},
+		{
+			name:     "foreign objects are removed with their content",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><foreignObject><div><iframe src="https://example.com"></iframe></div></foreignObject><g/></svg>`,
+			removed:  1,
+			contains: []string{"<g>"},
+			excludes: []string{"div", "iframe"},
+		},
+		{
+			name:     "animations that set links are removed",
+			input:    `<svg xmlns="http://www.w3.org/2000/svg"><a><set attributeName="href" to="javascript:alert(1)"/><animate attributeName="x" to="1"/></a></svg>`,
+			removed:  1,
+			contains: []string{`<animate attributeName="x" to="1">`},
+			excludes: []string{"javascript"},
+		},
+		{
+			name:     "stylesheet instructions are removed",
+			input:    `<?xml version="1.0"?><?xml-stylesheet href="https://example.com/a.css"?><svg xmlns="http://www.w3.org/2000/svg"/>`,
+			removed:  1,
+			contains: []string{`<?xml version="1.0"?>`},
+			excludes: []string{"example.com"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			removed, err := SanitizeSVG(strings.NewReader(tt.input), &out)
+			require.NoError(t, err)
+			assert.Equal(t, tt.removed, removed)
+			for _, s := range tt.contains {
+				assert.Contains(t, out.String(), s)
+			}
+			for _, s := range tt.excludes {
+				assert.NotContains(t, out.String(), s)
+			}
+		})
+	}
+}
+
+func TestSanitizeSVGRejectsExternalEntities(t *testing.T) {
+	input := `<?xml version="1.0"?><!DOCTYPE svg [<!ENTITY x SYSTEM "file:///etc/passwd">]><svg xmlns="http://www.w3.org/2000/svg"><text>&x;</text></svg>`
+	_, err := SanitizeSVG(strings.NewReader(input), &bytes.Buffer{})
+	assert.Error(t, err)
+}
+
+func TestUncachedSVGs(t *testing.T) {
+	assert.True(t, CanServeSVG("https://storage.googleapis.com/bucket/svg-token"))
+	assert.False(t, CanServeSVG("https://ipfs.io/ipfs/QmHash"))
+	assert.False(t, CanServeSVG("data:image/svg+xml;base64,PHN2Zz48L3N2Zz4="))
+
+	unsafe, err := (&Moderator{}).sanitizeCachedSVG(context.Background(), "https://ipfs.io/ipfs/QmHash")
+	require.NoError(t, err)
+	assert.True(t, unsafe, "SVGs that can't be sanitized are flagged")
+}
+
+func TestKeywordClassifier(t *testing.T) {
+	classifier := NewKeywordClassifier([]string{"nsfw"})
+
+	flags, err := classifier.Classify(context.Background(), Subject{Name: "Totally NSFW #1"})
+	require.NoError(t, err)
+	assert.Equal(t, []persist.ModerationFlag{persist.ModerationFlagNSFW}, flags)
+
+	flags, err = classifier.Classify(context.Background(), Subject{Description: "nsfwish", Metadata: persist.TokenMetadata{"name": "fine"}})
+	require.NoError(t, err)
+	assert.Empty(t, flags)
+}
@@ -0,0 +1,124 @@
+package multichain
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/task"
+)
+
+// newWalletPeriod is how long after a wallet is connected that its tokens aren't considered to be newly acquired
+const newWalletPeriod = 24 * time.Hour
+
+// dispatchTokenAcquisitions records an event for each token that a user received since they were last synced, and
+// schedules the feed to group them into a single feed event. Tokens are considered minted if the user's wallet
+// deployed the token's contract.
+func (p *Provider) dispatchTokenAcquisitions(ctx context.Context, user persist.User, tokens []persist.TokenGallery) error {
+	acquired := newlyAcquiredTokens(user, tokens, time.Now())
+	if len(acquired) == 0 {
+		return nil
+	}
+
+	// A large number of new tokens usually means that a wallet was just added, rather than that the user went on a spree
+	if max := env.GetInt("FEED_MAX_ACQUIRED_TOKENS"); max > 0 && len(acquired) > max {
+		logger.For(ctx).Infof("not creating acquisition events for %d new tokens of user %s", len(acquired), user.ID)
+		return nil
+	}
+
+	contractIDs := make(persist.DBIDList, 0, len(acquired))
+	for _, token := range acquired {
+		contractIDs = append(contractIDs, token.Contract)
+	}
+
+	contracts, err := p.Queries.GetContractsByIDs(ctx, contractIDs)
+	if err != nil {
+		return err
+	}
+
+	creators := make(map[persist.DBID]persist.Address, len(contracts))
+	for _, contract := range contracts {
+		creators[contract.ID] = contract.CreatorAddress
+	}
+
+	eventRepo := postgres.EventRepository{Queries: p.Queries}
+
+	var last *db.Event
+	for _, token := range acquired {
+		last, err = eventRepo.Add(ctx, db.Event{
+			ActorID:        persist.DBIDToNullStr(user.ID),
+			Action:         acquisitionAction(user, creators[token.Contract]),
+			ResourceTypeID: persist.ResourceTypeToken,
+			SubjectID:      token.ID,
+			TokenID:        token.ID,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// The feed groups the events in the window together when it handles the most recent one, so that is the only one it needs to be told about
+	scheduleOn := last.CreatedAt.Add(time.Duration(env.GetInt("GCLOUD_FEED_BUFFER_SECS")) * time.Second)
+	return task.CreateTaskForFeed(ctx, scheduleOn, task.FeedMessage{ID: last.ID}, p.TasksClient)
+}
+
+// newlyAcquiredTokens returns the tokens that should be posted to the feed as acquired at now
+func newlyAcquiredTokens(user persist.User, tokens []persist.TokenGallery, now time.Time) []persist.TokenGallery {
+	walletAddedAt := make(map[persist.DBID]time.Time, len(user.Wallets))
+	for _, wallet := range user.Wallets {
+		walletAddedAt[wallet.ID] = wallet.CreationTime.Time()
+	}
+
+	acquired := make([]persist.TokenGallery, 0, len(tokens))
+	for _, token := range tokens {
+		// Spam is kept out of the feed
+		if token.IsProviderMarkedSpam != nil && *token.IsProviderMarkedSpam {
+			continue
+		}
+		// Tokens of a wallet that was just connected were already owned by the user
+		if isOnlyInNewWallets(token, walletAddedAt, now) {
+			continue
+		}
+		acquired = append(acquired, token)
+	}
+
+	return acquired
+}
+
+// acquisitionAction returns whether the user minted or acquired a token of the contract deployed by creator
+func acquisitionAction(user persist.User, creator persist.Address) persist.Action {
+	if isUserWallet(user, creator) {
+		return persist.ActionTokenMinted
+	}
+	return persist.ActionTokenAcquired
+}
+
+func isOnlyInNewWallets(token persist.TokenGallery, walletAddedAt map[persist.DBID]time.Time, now time.Time) bool {
+	if len(token.OwnedByWallets) == 0 {
+		return false
+	}
+	for _, wallet := range token.OwnedByWallets {
+		addedAt, ok := walletAddedAt[wallet.ID]
+		if !ok || now.Sub(addedAt) > newWalletPeriod {
+			return false
+		}
+	}
+	return true
+}
+
+func isUserWallet(user persist.User, address persist.Address) bool {
+	if address == "" {
+		return false
+	}
+	for _, wallet := range user.Wallets {
+		if strings.EqualFold(string(wallet.Address), string(address)) {
+			return true
+		}
+	}
+	return false
+}
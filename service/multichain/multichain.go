@@ -475,10 +475,12 @@ func (p *Provider) processTokensForOwnersOfContract(ctx context.Context, contrac
 	for userID, offset := range userTokenOffsets {
 		start, end := offset[0], offset[1]
 		userTokenIDs := make([]persist.DBID, 0, end-start)
+		userNewTokens := make([]persist.TokenGallery, 0, end-start)
 
 		for _, token := range persistedTokens[start:end] {
 			if newUserTokens[userID][token.TokenIdentifiers()] {
 				userTokenIDs = append(userTokenIDs, token.ID)
+				userNewTokens = append(userNewTokens, token)
 			}
 		}
 
@@ -486,6 +488,10 @@ func (p *Provider) processTokensForOwnersOfContract(ctx context.Context, contrac
 		if err != nil {
 			errors = append(errors, err)
 		}
+
+		if err := p.dispatchTokenAcquisitions(ctx, users[userID], userNewTokens); err != nil {
+			logger.For(ctx).Errorf("failed to dispatch token acquisitions for user %s: %s", userID, err)
+		}
 	}
 
 	if len(errors) > 1 {
@@ -507,14 +513,27 @@ func (p *Provider) processTokensForUser(ctx context.Context, tokensFromProviders
 	}
 
 	tokenIDs := make([]persist.DBID, 0, len(newTokens))
+	acquiredTokens := make([]persist.TokenGallery, 0, len(newTokens))
 	for _, token := range persistedTokens {
 		if newTokens[token.TokenIdentifiers()] {
 			tokenIDs = append(tokenIDs, token.ID)
+			acquiredTokens = append(acquiredTokens, token)
 		}
 	}
 
 	err = p.sendTokensToTokenProcessing(ctx, user.ID, tokenIDs)
-	return persistedTokens, err
+	if err != nil {
+		return persistedTokens, err
+	}
+
+	// If every token is new, then this is the user's first sync and their tokens weren't just acquired
+	if len(acquiredTokens) < len(persistedTokens) {
+		if err := p.dispatchTokenAcquisitions(ctx, user, acquiredTokens); err != nil {
+			logger.For(ctx).Errorf("failed to dispatch token acquisitions for user %s: %s", user.ID, err)
+		}
+	}
+
+	return persistedTokens, nil
 }
 
 func (p *Provider) sendTokensToTokenProcessing(ctx context.Context, userID persist.DBID, tokens []persist.DBID) error {
@@ -0,0 +1,45 @@
+package multichain
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewlyAcquiredTokens_Success(t *testing.T) {
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+	oldWallet := persist.Wallet{ID: "old", Address: "0xold", CreationTime: persist.CreationTime(now.Add(-30 * 24 * time.Hour))}
+	newWallet := persist.Wallet{ID: "new", Address: "0xnew", CreationTime: persist.CreationTime(now.Add(-time.Hour))}
+	user := persist.User{ID: "user", Wallets: []persist.Wallet{oldWallet, newWallet}}
+
+	tokens := []persist.TokenGallery{
+		{ID: "received", OwnedByWallets: []persist.Wallet{oldWallet}},
+		{ID: "spam", OwnedByWallets: []persist.Wallet{oldWallet}, IsProviderMarkedSpam: util.ToPointer(true)},
+		{ID: "in new wallet", OwnedByWallets: []persist.Wallet{newWallet}},
+		{ID: "in both wallets", OwnedByWallets: []persist.Wallet{oldWallet, newWallet}},
+	}
+
+	acquired := newlyAcquiredTokens(user, tokens, now)
+
+	ids := make([]persist.DBID, len(acquired))
+	for i, token := range acquired {
+		ids[i] = token.ID
+	}
+	assert.Equal(t, []persist.DBID{"received", "in both wallets"}, ids)
+
+	t.Run("a wallet's tokens are acquired once it's no longer new", func(t *testing.T) {
+		acquired := newlyAcquiredTokens(user, tokens[2:3], now.Add(newWalletPeriod))
+		assert.Len(t, acquired, 1)
+	})
+}
+
+func TestAcquisitionAction_Success(t *testing.T) {
+	user := persist.User{ID: "user", Wallets: []persist.Wallet{{ID: "wallet", Address: "0xAbC"}}}
+
+	assert.Equal(t, persist.ActionTokenMinted, acquisitionAction(user, "0xabc"), "contracts deployed by the user's wallet are minted")
+	assert.Equal(t, persist.ActionTokenAcquired, acquisitionAction(user, "0xdef"))
+	assert.Equal(t, persist.ActionTokenAcquired, acquisitionAction(user, ""), "contracts without a known creator are acquired")
+}
@@ -7,17 +7,20 @@ import (
 	"fmt"
 	"time"
 
-	"cloud.google.com/go/pubsub"
 	"github.com/bsm/redislock"
 	"github.com/gin-gonic/gin"
-	"github.com/googleapis/gax-go/v2/apierror"
+	"github.com/jackc/pgx/v4"
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
 	db "github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/dm"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/pubsub"
+	"github.com/mikeydub/go-gallery/service/push"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/util"
-	"google.golang.org/grpc/codes"
 )
 
 type lockKey struct {
@@ -35,23 +38,42 @@ type NotificationHandlers struct {
 	Notifications            *notificationDispatcher
 	UserNewNotifications     map[persist.DBID]chan db.Notification
 	UserUpdatedNotifications map[persist.DBID]chan db.Notification
-	pubSub                   *pubsub.Client
+	pubSub                   pubsub.PubSub
 }
 
 // New registers specific notification handlers
-func New(queries *db.Queries, pub *pubsub.Client, lock *redislock.Client) *NotificationHandlers {
-	notifDispatcher := notificationDispatcher{handlers: map[persist.Action]notificationHandler{}, lock: lock}
+func New(queries *db.Queries, pub pubsub.PubSub, lock *redislock.Client, pusher *push.Pusher, messenger *dm.Messenger) *NotificationHandlers {
+	notificationHandlers := NewSender(queries, pub, lock, pusher, messenger)
+	if pub != nil {
+		go notificationHandlers.receiveNewNotificationsFromPubSub()
+		go notificationHandlers.receiveUpdatedNotificationsFromPubSub()
+	} else {
+		logger.For(nil).Warn("pubsub not configured, notifications will not be received")
+	}
+	return notificationHandlers
+}
 
-	def := defaultNotificationHandler{queries: queries, pubSub: pub}
-	group := groupedNotificationHandler{queries: queries, pubSub: pub}
-	view := viewedNotificationHandler{queries: queries, pubSub: pub}
+// NewSender registers the same handlers as New, but doesn't receive notifications from pubsub. It's meant for
+// services that create notifications without serving them to users.
+func NewSender(queries *db.Queries, pub pubsub.PubSub, lock *redislock.Client, pusher *push.Pusher, messenger *dm.Messenger) *NotificationHandlers {
+	notifDispatcher := notificationDispatcher{handlers: map[persist.Action]notificationHandler{}, lock: lock, queries: queries}
+
+	def := defaultNotificationHandler{queries: queries, pubSub: pub, pusher: pusher, messenger: messenger}
+	group := groupedNotificationHandler{queries: queries, pubSub: pub, pusher: pusher, messenger: messenger}
+	view := viewedNotificationHandler{queries: queries, pubSub: pub, pusher: pusher, messenger: messenger}
 
 	// grouped notification actions
 	notifDispatcher.AddHandler(persist.ActionUserFollowedUsers, group)
 	notifDispatcher.AddHandler(persist.ActionAdmiredFeedEvent, group)
+	notifDispatcher.AddHandler(persist.ActionRepostedFeedEvent, group)
+	notifDispatcher.AddHandler(persist.ActionMintedTokenAddedToGallery, group)
+	notifDispatcher.AddHandler(persist.ActionCommunityMemberJoined, group)
 
 	// single notification actions (default)
 	notifDispatcher.AddHandler(persist.ActionCommentedOnFeedEvent, def)
+	notifDispatcher.AddHandler(persist.ActionMentionedUser, def)
+	notifDispatcher.AddHandler(persist.ActionCollectorsNoteAddedToMintedToken, def)
+	notifDispatcher.AddHandler(persist.ActionTokenMediaProcessed, def)
 
 	// viewed notifications are handled separately
 	notifDispatcher.AddHandler(persist.ActionViewedGallery, view)
@@ -59,14 +81,7 @@ func New(queries *db.Queries, pub *pubsub.Client, lock *redislock.Client) *Notif
 	new := map[persist.DBID]chan db.Notification{}
 	updated := map[persist.DBID]chan db.Notification{}
 
-	notificationHandlers := &NotificationHandlers{Notifications: &notifDispatcher, UserNewNotifications: new, UserUpdatedNotifications: updated, pubSub: pub}
-	if pub != nil {
-		go notificationHandlers.receiveNewNotificationsFromPubSub()
-		go notificationHandlers.receiveUpdatedNotificationsFromPubSub()
-	} else {
-		logger.For(nil).Warn("pubsub not configured, notifications will not be received")
-	}
-	return notificationHandlers
+	return &NotificationHandlers{Notifications: &notifDispatcher, UserNewNotifications: new, UserUpdatedNotifications: updated, pubSub: pub}
 }
 
 // Register specific notification handlers
@@ -111,6 +126,15 @@ func (n *NotificationHandlers) UnsubscribeUpdatedNotificationsForUser(userID per
 	delete(n.UserUpdatedNotifications, userID)
 }
 
+// PublishUpdated sends notifications whose state changed outside of the notification handlers, e.g. because their
+// owner read or archived them, to every subscriber of the owner's updated notifications.
+func (n *NotificationHandlers) PublishUpdated(ctx context.Context, notifs ...db.Notification) error {
+	if n.pubSub == nil || len(notifs) == 0 {
+		return nil
+	}
+	return publishUpdatedNotifs(ctx, n.pubSub, notifs)
+}
+
 type notificationHandler interface {
 	Handle(context.Context, db.Notification) error
 }
@@ -118,6 +142,7 @@ type notificationHandler interface {
 type notificationDispatcher struct {
 	handlers map[persist.Action]notificationHandler
 	lock     *redislock.Client
+	queries  *db.Queries
 }
 
 func (d *notificationDispatcher) AddHandler(action persist.Action, handler notificationHandler) {
@@ -125,6 +150,12 @@ func (d *notificationDispatcher) AddHandler(action persist.Action, handler notif
 }
 
 func (d *notificationDispatcher) Dispatch(ctx context.Context, notif db.Notification) error {
+	// The other channels deliver notifications that exist in-app, so there's nothing to do if the owner turned them off
+	if owner, err := d.queries.GetUserById(ctx, notif.OwnerID); err == nil && !owner.NotificationSettings.Wants(notif.Action, persist.NotificationChannelInApp) {
+		logger.For(ctx).Infof("user %s turned off %s notifications", notif.OwnerID, notif.Action)
+		return nil
+	}
+
 	if handler, ok := d.handlers[notif.Action]; ok {
 		l, _ := d.lock.Obtain(ctx, lockKey{ownerID: notif.OwnerID, action: notif.Action}.String(), maxLockTimeout, &redislock.Options{RetryStrategy: redislock.LinearBackoff(5 * time.Second)})
 		if l != nil {
@@ -137,17 +168,21 @@ func (d *notificationDispatcher) Dispatch(ctx context.Context, notif db.Notifica
 }
 
 type defaultNotificationHandler struct {
-	queries *coredb.Queries
-	pubSub  *pubsub.Client
+	queries   *coredb.Queries
+	pubSub    pubsub.PubSub
+	pusher    *push.Pusher
+	messenger *dm.Messenger
 }
 
 func (h defaultNotificationHandler) Handle(ctx context.Context, notif db.Notification) error {
-	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub)
+	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.pusher, h.messenger)
 }
 
 type groupedNotificationHandler struct {
-	queries *coredb.Queries
-	pubSub  *pubsub.Client
+	queries   *coredb.Queries
+	pubSub    pubsub.PubSub
+	pusher    *push.Pusher
+	messenger *dm.Messenger
 }
 
 func (h groupedNotificationHandler) Handle(ctx context.Context, notif db.Notification) error {
@@ -169,18 +204,21 @@ func (h groupedNotificationHandler) Handle(ctx context.Context, notif db.Notific
 		})
 	}
 
-	if time.Since(curNotif.CreatedAt) < groupedWindow {
+	// Members who joined different communities aren't grouped together, since the notification is about one community
+	if time.Since(curNotif.CreatedAt) < groupedWindow && curNotif.Data.CommunityContractID == notif.Data.CommunityContractID {
 		logger.For(ctx).Infof("grouping notification %s: %s-%s", curNotif.ID, notif.Action, notif.OwnerID)
 		return updateAndPublishNotif(ctx, notif, curNotif, h.queries, h.pubSub)
 	}
 	logger.For(ctx).Infof("not grouping notification: %s-%s", notif.Action, notif.OwnerID)
-	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub)
+	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.pusher, h.messenger)
 
 }
 
 type viewedNotificationHandler struct {
-	queries *coredb.Queries
-	pubSub  *pubsub.Client
+	queries   *coredb.Queries
+	pubSub    pubsub.PubSub
+	pusher    *push.Pusher
+	messenger *dm.Messenger
 }
 
 // will return the beginning of the week (sunday) in PST
@@ -210,7 +248,7 @@ func (h viewedNotificationHandler) Handle(ctx context.Context, notif db.Notifica
 	if notifs == nil || len(notifs) == 0 {
 		// if there are no notifications this week, then we definitely are going to insert this one
 		logger.For(ctx).Debugf("no notifications this week, inserting: %s-%s", notif.Action, notif.OwnerID)
-		return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub)
+		return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.pusher, h.messenger)
 	}
 
 	mostRecentNotif := notifs[0]
@@ -262,44 +300,21 @@ func (h viewedNotificationHandler) Handle(ctx context.Context, notif db.Notifica
 		return updateAndPublishNotif(ctx, notif, mostRecentNotif, h.queries, h.pubSub)
 	}
 	logger.For(ctx).Debugf("not grouping notification: %s-%s", notif.Action, notif.OwnerID)
-	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub)
-}
-
-// subscribe returns a subscription to the given topic
-func (n *NotificationHandlers) subscribe(ctx context.Context, topic, name string) (*pubsub.Subscription, error) {
-	sub, err := createSubscription(ctx, n.pubSub, topic, name)
-	if err == nil {
-		return sub, nil
-	}
-
-	if errTopicMissing(err) {
-		if _, err := n.pubSub.CreateTopic(ctx, topic); err != nil {
-			return nil, err
-		}
-	}
-
-	return createSubscription(ctx, n.pubSub, topic, name)
+	return insertAndPublishNotif(ctx, notif, h.queries, h.pubSub, h.pusher, h.messenger)
 }
 
 func (n *NotificationHandlers) receiveNewNotificationsFromPubSub() {
-	sub, err := n.subscribe(context.Background(), env.GetString("PUBSUB_TOPIC_NEW_NOTIFICATIONS"), fmt.Sprintf("new-notifications-%s", persist.GenerateID()))
-	if err != nil {
-		logger.For(nil).Errorf("error creating updated notifications subscription: %s", err)
-		panic(err)
-	}
-
 	logger.For(nil).Info("subscribing to new notifications pubsub topic")
 
-	err = sub.Receive(context.Background(), func(ctx context.Context, msg *pubsub.Message) {
+	err := n.pubSub.Subscribe(context.Background(), env.GetString("PUBSUB_TOPIC_NEW_NOTIFICATIONS"), func(ctx context.Context, msg []byte) error {
 
-		logger.For(ctx).Debugf("received new notification from pubsub: %s", string(msg.Data))
+		logger.For(ctx).Debugf("received new notification from pubsub: %s", string(msg))
 
-		defer msg.Ack()
 		notif := db.Notification{}
-		err := json.Unmarshal(msg.Data, &notif)
+		err := json.Unmarshal(msg, &notif)
 		if err != nil {
 			logger.For(ctx).Warnf("failed to unmarshal pubsub message: %s", err)
-			return
+			return nil
 		}
 
 		logger.For(ctx).Infof("received new notification from pubsub: %s", notif.OwnerID)
@@ -315,6 +330,7 @@ func (n *NotificationHandlers) receiveNewNotificationsFromPubSub() {
 		} else {
 			logger.For(ctx).Debugf("no notification create channel open for user: %s", notif.OwnerID)
 		}
+		return nil
 	})
 	if err != nil {
 		logger.For(nil).Errorf("error receiving new notifications from pubsub: %s", err)
@@ -323,24 +339,17 @@ func (n *NotificationHandlers) receiveNewNotificationsFromPubSub() {
 }
 
 func (n *NotificationHandlers) receiveUpdatedNotificationsFromPubSub() {
-	sub, err := n.subscribe(context.Background(), env.GetString("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS"), fmt.Sprintf("updated-notifications-%s", persist.GenerateID()))
-	if err != nil {
-		logger.For(nil).Errorf("error creating updated notifications subscription: %s", err)
-		panic(err)
-	}
+	logger.For(nil).Infof("subscribing to updated notifications pubsub topic")
 
-	logger.For(nil).Infof("subscribed to updated notifications pubsub")
+	err := n.pubSub.Subscribe(context.Background(), env.GetString("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS"), func(ctx context.Context, msg []byte) error {
 
-	err = sub.Receive(context.Background(), func(ctx context.Context, msg *pubsub.Message) {
+		logger.For(ctx).Debugf("received updated notification from pubsub: %s", string(msg))
 
-		logger.For(ctx).Debugf("received updated notification from pubsub: %s", string(msg.Data))
-
-		defer msg.Ack()
 		notif := db.Notification{}
-		err := json.Unmarshal(msg.Data, &notif)
+		err := json.Unmarshal(msg, &notif)
 		if err != nil {
 			logger.For(ctx).Warnf("failed to unmarshal pubsub message: %s", err)
-			return
+			return nil
 		}
 
 		logger.For(ctx).Infof("received updated notification from pubsub: %s", notif.OwnerID)
@@ -356,14 +365,15 @@ func (n *NotificationHandlers) receiveUpdatedNotificationsFromPubSub() {
 		} else {
 			logger.For(ctx).Debugf("no notification update channel open for user: %s", notif.OwnerID)
 		}
+		return nil
 	})
 	if err != nil {
-		logger.For(nil).Errorf("error receiving new notifications from pubsub: %s", err)
+		logger.For(nil).Errorf("error receiving updated notifications from pubsub: %s", err)
 		panic(err)
 	}
 }
 
-func insertAndPublishNotif(ctx context.Context, notif db.Notification, queries *db.Queries, ps *pubsub.Client) error {
+func insertAndPublishNotif(ctx context.Context, notif db.Notification, queries *db.Queries, ps pubsub.PubSub, pusher *push.Pusher, messenger *dm.Messenger) error {
 	newNotif, err := addNotification(ctx, notif, queries)
 	if err != nil {
 		return fmt.Errorf("failed to create notification: %w", err)
@@ -373,30 +383,42 @@ func insertAndPublishNotif(ctx context.Context, notif db.Notification, queries *
 	if err != nil {
 		return err
 	}
-	t := ps.Topic(env.GetString("PUBSUB_TOPIC_NEW_NOTIFICATIONS"))
-	result := t.Publish(ctx, &pubsub.Message{
-		Data: marshalled,
-	})
-
-	_, err = result.Get(ctx)
+	err = publish(ctx, ps, env.GetString("PUBSUB_TOPIC_NEW_NOTIFICATIONS"), marshalled)
 	if err != nil {
 		return fmt.Errorf("failed to publish new notification: %w", err)
 	}
 
-	logger.For(ctx).Infof("pushed new notification to pubsub: %s", notif.OwnerID)
+	// Only new notifications are pushed to devices and messaged, so notifications that are grouped into this one don't
+	// send another
+	if err := pusher.Push(ctx, newNotif); err != nil {
+		logger.For(ctx).Errorf("failed to push notification %s to devices: %s", newNotif.ID, err)
+		sentryutil.ReportError(ctx, err)
+	}
+
+	if err := messenger.Send(ctx, newNotif); err != nil {
+		logger.For(ctx).Errorf("failed to message notification %s: %s", newNotif.ID, err)
+		sentryutil.ReportError(ctx, err)
+	}
+
 	return nil
 }
 
-func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecentNotif db.Notification, queries *db.Queries, ps *pubsub.Client) error {
+func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecentNotif db.Notification, queries *db.Queries, ps pubsub.PubSub) error {
 	amount := notif.Amount
 	resultData := mostRecentNotif.Data.Concat(notif.Data)
 	switch notif.Action {
 	case persist.ActionAdmiredFeedEvent:
 		amount = int32(len(resultData.AdmirerIDs))
+	case persist.ActionRepostedFeedEvent:
+		amount = int32(len(resultData.ReposterIDs))
 	case persist.ActionViewedGallery:
 		amount = int32(len(resultData.AuthedViewerIDs) + len(resultData.UnauthedViewerIDs))
 	case persist.ActionUserFollowedUsers:
 		amount = int32(len(resultData.FollowerIDs))
+	case persist.ActionMintedTokenAddedToGallery:
+		amount = int32(len(resultData.CollectorIDs))
+	case persist.ActionCommunityMemberJoined:
+		amount = int32(len(resultData.NewMemberIDs))
 	default:
 		amount = mostRecentNotif.Amount + notif.Amount
 	}
@@ -415,27 +437,45 @@ func updateAndPublishNotif(ctx context.Context, notif db.Notification, mostRecen
 	if err != nil {
 		return fmt.Errorf("error getting updated notification by %s: %w", mostRecentNotif.ID, err)
 	}
-	marshalled, err := json.Marshal(updatedNotif)
-	if err != nil {
-		return err
+	return publishUpdatedNotifs(ctx, ps, []db.Notification{updatedNotif})
+}
+
+func publishUpdatedNotifs(ctx context.Context, ps pubsub.PubSub, notifs []db.Notification) error {
+	for _, notif := range notifs {
+		marshalled, err := json.Marshal(notif)
+		if err != nil {
+			return err
+		}
+
+		err = publish(ctx, ps, env.GetString("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS"), marshalled)
+		if err != nil {
+			return fmt.Errorf("error publishing updated notification: %w", err)
+		}
 	}
-	t := ps.Topic(env.GetString("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS"))
-	result := t.Publish(ctx, &pubsub.Message{
-		Data: marshalled,
-	})
-	_, err = result.Get(ctx)
-	if err != nil {
-		return fmt.Errorf("error publishing updated notification: %w", err)
+
+	return nil
+}
+
+// publish sends a notification to the users subscribed to it. Notifications are already saved by the time they're
+// published, so they're only missing from live updates if pubsub isn't configured.
+func publish(ctx context.Context, ps pubsub.PubSub, topic string, message []byte) error {
+	if ps == nil {
+		logger.For(ctx).Warnf("pubsub not configured, not publishing to %s", topic)
+		return nil
+	}
+
+	if err := ps.Publish(ctx, topic, message, true); err != nil {
+		return err
 	}
 
-	logger.For(ctx).Infof("pushed updated notification to pubsub: %s", updatedNotif.OwnerID)
+	logger.For(ctx).Infof("pushed notification to pubsub topic %s", topic)
 	return nil
 }
 
 func addNotification(ctx context.Context, notif db.Notification, queries *db.Queries) (db.Notification, error) {
 	id := persist.GenerateID()
 	switch notif.Action {
-	case persist.ActionAdmiredFeedEvent:
+	case persist.ActionAdmiredFeedEvent, persist.ActionRepostedFeedEvent:
 		return queries.CreateAdmireNotification(ctx, db.CreateAdmireNotificationParams{
 			ID:          id,
 			OwnerID:     notif.OwnerID,
@@ -455,6 +495,16 @@ func addNotification(ctx context.Context, notif db.Notification, queries *db.Que
 			CommentID:   notif.CommentID,
 		})
 
+	case persist.ActionMentionedUser:
+		return queries.CreateMentionNotification(ctx, db.CreateMentionNotificationParams{
+			ID:          id,
+			OwnerID:     notif.OwnerID,
+			Action:      notif.Action,
+			Data:        notif.Data,
+			EventIds:    notif.EventIds,
+			FeedEventID: notif.FeedEventID.String(),
+			CommentID:   notif.CommentID.String(),
+		})
 	case persist.ActionUserFollowedUsers:
 		return queries.CreateFollowNotification(ctx, db.CreateFollowNotificationParams{
 			ID:       id,
@@ -472,6 +522,14 @@ func addNotification(ctx context.Context, notif db.Notification, queries *db.Que
 			EventIds:  notif.EventIds,
 			GalleryID: notif.GalleryID,
 		})
+	case persist.ActionMintedTokenAddedToGallery, persist.ActionCollectorsNoteAddedToMintedToken, persist.ActionCommunityMemberJoined, persist.ActionTokenMediaProcessed:
+		return queries.CreateSimpleNotification(ctx, db.CreateSimpleNotificationParams{
+			ID:       id,
+			OwnerID:  notif.OwnerID,
+			Action:   notif.Action,
+			Data:     notif.Data,
+			EventIds: notif.EventIds,
+		})
 
 	default:
 		return db.Notification{}, fmt.Errorf("unknown notification action: %s", notif.Action)
@@ -482,17 +540,122 @@ func (l lockKey) String() string {
 	return fmt.Sprintf("%s:%s", l.ownerID, l.action)
 }
 
-func errTopicMissing(err error) bool {
-	var aErr *apierror.APIError
-	if ok := errors.As(err, &aErr); ok && aErr.GRPCStatus().Code() == codes.NotFound {
-		return true
+// deferredAtATime is how many deferred notifications are sent in a single poll
+const deferredAtATime = 100
+
+// DeferredNotificationReleaser sends the notifications that were deferred over instant channels, like push and direct
+// messages, because they arrived during their owner's quiet hours, once the quiet hours have ended.
+type DeferredNotificationReleaser struct {
+	repos        *postgres.Repositories
+	queries      *db.Queries
+	pusher       *push.Pusher
+	messenger    *dm.Messenger
+	pollInterval time.Duration
+}
+
+func NewDeferredNotificationReleaser(repos *postgres.Repositories, queries *db.Queries, pusher *push.Pusher, messenger *dm.Messenger) *DeferredNotificationReleaser {
+	return &DeferredNotificationReleaser{
+		repos:        repos,
+		queries:      queries,
+		pusher:       pusher,
+		messenger:    messenger,
+		pollInterval: env.GetDuration("DEFERRED_NOTIFICATION_POLL_INTERVAL"),
 	}
-	return false
 }
 
-func createSubscription(ctx context.Context, client *pubsub.Client, topic, name string) (*pubsub.Subscription, error) {
-	return client.CreateSubscription(ctx, name, pubsub.SubscriptionConfig{
-		Topic:            client.Topic(topic),
-		ExpirationPolicy: time.Hour * 24 * 3,
-	})
+// Start sends deferred notifications in the background until ctx is done.
+func (r *DeferredNotificationReleaser) Start(ctx context.Context) {
+	go r.work(ctx)
+}
+
+func (r *DeferredNotificationReleaser) work(ctx context.Context) {
+	ticker := time.NewTicker(r.pollInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := r.release(ctx); err != nil {
+				logger.For(ctx).Errorf("failed to send deferred notifications: %s", err)
+				sentryutil.ReportError(ctx, err)
+			}
+		}
+	}
+}
+
+// release sends the deferred notifications that are due. They stay locked until they've been sent, and the ones that
+// couldn't be sent are kept for the next poll. Owners' settings are checked again, since they may have turned the
+// channel off or moved their quiet hours in the meantime.
+func (r *DeferredNotificationReleaser) release(ctx context.Context) error {
+	tx, err := r.repos.BeginTx(ctx)
+	if err != nil {
+		return err
+	}
+
+	defer tx.Rollback(ctx)
+
+	q := r.queries.WithTx(tx)
+
+	due, err := q.GetDueDeferredNotifications(ctx, deferredAtATime)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, d := range due {
+		notif, err := r.queries.GetNotificationByID(ctx, d.NotificationID)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			logger.For(ctx).Errorf("failed to get deferred notification %s: %s", d.NotificationID, err)
+			continue
+		}
+
+		// Notifications that were deleted, or seen in-app while they were deferred, aren't sent
+		if err == nil && !notif.Seen {
+			owner, err := r.queries.GetUserById(ctx, notif.OwnerID)
+			if err != nil {
+				logger.For(ctx).Errorf("failed to get owner %s of notification %s: %s", notif.OwnerID, notif.ID, err)
+				continue
+			}
+
+			send, deferUntil := owner.NotificationSettings.InstantDelivery(notif.Action, d.Channel, now)
+			if !deferUntil.IsZero() {
+				err := q.DeferNotification(ctx, db.DeferNotificationParams{
+					NotificationID: d.NotificationID,
+					Channel:        d.Channel,
+					DeliverAfter:   deferUntil,
+				})
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			if send {
+				if err := r.deliver(ctx, notif, d.Channel); err != nil {
+					logger.For(ctx).Errorf("failed to send deferred notification %s over %s: %s", notif.ID, d.Channel, err)
+					sentryutil.ReportError(ctx, err)
+					continue
+				}
+			}
+		}
+
+		err = q.DeleteDeferredNotification(ctx, db.DeleteDeferredNotificationParams{
+			NotificationID: d.NotificationID,
+			Channel:        d.Channel,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit(ctx)
+}
+
+func (r *DeferredNotificationReleaser) deliver(ctx context.Context, notif db.Notification, channel persist.NotificationChannel) error {
+	if channel == persist.NotificationChannelPush {
+		return r.pusher.Deliver(ctx, notif)
+	}
+	return r.messenger.Deliver(ctx, notif, channel)
 }
@@ -0,0 +1,323 @@
+package notifications
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	migrate "github.com/mikeydub/go-gallery/db"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/docker"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/persist/postgres"
+	"github.com/mikeydub/go-gallery/service/pubsub/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRepostNotifications_Success(t *testing.T) {
+	repos, queries := setupNotificationsTest(t)
+	ctx := context.Background()
+	h := groupedNotificationHandler{queries: queries, pubSub: memory.NewPubSub()}
+
+	owner := newTestUser(t, ctx, repos)
+	feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+	alice := newTestUser(t, ctx, repos)
+	bob := newTestUser(t, ctx, repos)
+
+	repost := func(t *testing.T, reposterID, feedEventID persist.DBID) {
+		t.Helper()
+		err := h.Handle(ctx, coredb.Notification{
+			OwnerID:     owner,
+			Action:      persist.ActionRepostedFeedEvent,
+			FeedEventID: feedEventID,
+			Data:        persist.NotificationData{ReposterIDs: []persist.DBID{reposterID}},
+			EventIds:    persist.DBIDList{persist.GenerateID()},
+			Amount:      1,
+		})
+		require.NoError(t, err)
+	}
+
+	repostNotifications := func(t *testing.T) []coredb.Notification {
+		t.Helper()
+		notifs, err := queries.GetNotificationsByOwnerIDForActionAfter(ctx, coredb.GetNotificationsByOwnerIDForActionAfterParams{
+			OwnerID:      owner,
+			Action:       persist.ActionRepostedFeedEvent,
+			CreatedAfter: time.Now().Add(-time.Hour),
+		})
+		require.NoError(t, err)
+		return notifs
+	}
+
+	t.Run("reposts of the same feed event are grouped", func(t *testing.T) {
+		repost(t, alice, feedEventID)
+		repost(t, bob, feedEventID)
+
+		notifs := repostNotifications(t)
+
+		require.Len(t, notifs, 1)
+		assert.Equal(t, []persist.DBID{bob, alice}, notifs[0].Data.ReposterIDs)
+		assert.EqualValues(t, 2, notifs[0].Amount)
+	})
+
+	t.Run("reposters are only counted once", func(t *testing.T) {
+		repost(t, alice, feedEventID)
+
+		notifs := repostNotifications(t)
+
+		require.Len(t, notifs, 1)
+		assert.ElementsMatch(t, []persist.DBID{alice, bob}, notifs[0].Data.ReposterIDs)
+		assert.EqualValues(t, 2, notifs[0].Amount)
+	})
+
+	t.Run("reposts of different feed events aren't grouped", func(t *testing.T) {
+		otherFeedEventID := newTestFeedEvent(t, ctx, queries, owner)
+
+		repost(t, alice, otherFeedEventID)
+
+		assert.Len(t, repostNotifications(t), 2)
+	})
+}
+
+func TestUnseenNotificationCount_Success(t *testing.T) {
+	repos, queries := setupNotificationsTest(t)
+	ctx := context.Background()
+
+	// The count kept by the trigger should always agree with counting the user's notifications
+	assertUnseen := func(t *testing.T, userID persist.DBID, expected int) {
+		t.Helper()
+		counted, err := queries.CountUserUnseenNotifications(ctx, userID)
+		require.NoError(t, err)
+		kept, err := queries.GetUnseenNotificationCount(ctx, userID)
+		require.NoError(t, err)
+		assert.EqualValues(t, expected, counted)
+		assert.EqualValues(t, expected, kept)
+	}
+
+	newNotification := func(t *testing.T, ownerID persist.DBID, feedEventID persist.DBID) coredb.Notification {
+		t.Helper()
+		notif, err := queries.CreateAdmireNotification(ctx, coredb.CreateAdmireNotificationParams{
+			ID:          persist.GenerateID(),
+			OwnerID:     ownerID,
+			Action:      persist.ActionAdmiredFeedEvent,
+			Data:        persist.NotificationData{AdmirerIDs: []persist.DBID{persist.GenerateID()}},
+			EventIds:    persist.DBIDList{persist.GenerateID()},
+			FeedEventID: feedEventID,
+		})
+		require.NoError(t, err)
+		return notif
+	}
+
+	t.Run("new notifications are counted", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+
+		newNotification(t, owner, feedEventID)
+		newNotification(t, owner, feedEventID)
+
+		assertUnseen(t, owner, 2)
+	})
+
+	t.Run("regrouping a seen notification counts it again", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		notif := newNotification(t, owner, newTestFeedEvent(t, ctx, queries, owner))
+		_, err := queries.ClearNotificationsForUser(ctx, owner)
+		require.NoError(t, err)
+		assertUnseen(t, owner, 0)
+
+		err = queries.UpdateNotification(ctx, coredb.UpdateNotificationParams{
+			ID:       notif.ID,
+			Data:     notif.Data,
+			EventIds: persist.DBIDList{persist.GenerateID()},
+			Amount:   notif.Amount + 1,
+		})
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+	})
+
+	t.Run("regrouping an unseen notification doesn't count it twice", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		notif := newNotification(t, owner, newTestFeedEvent(t, ctx, queries, owner))
+
+		err := queries.UpdateNotification(ctx, coredb.UpdateNotificationParams{
+			ID:       notif.ID,
+			Data:     notif.Data,
+			EventIds: persist.DBIDList{persist.GenerateID()},
+			Amount:   notif.Amount + 1,
+		})
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+	})
+
+	t.Run("archived notifications aren't counted", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+		notif := newNotification(t, owner, feedEventID)
+		newNotification(t, owner, feedEventID)
+
+		_, err := queries.ArchiveNotification(ctx, coredb.ArchiveNotificationParams{ID: notif.ID, OwnerID: owner})
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+	})
+
+	t.Run("deleted notifications aren't counted", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+		newNotification(t, owner, feedEventID)
+		newNotification(t, owner, newTestFeedEvent(t, ctx, queries, owner))
+
+		err := queries.DeleteNotificationsByFeedEventID(ctx, feedEventID)
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+	})
+
+	t.Run("notifications seen before a time aren't counted", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+		seen := newNotification(t, owner, feedEventID)
+		time.Sleep(10 * time.Millisecond)
+		newNotification(t, owner, feedEventID)
+
+		_, err := queries.MarkNotificationsSeenBefore(ctx, coredb.MarkNotificationsSeenBeforeParams{OwnerID: owner, Before: seen.LastUpdated})
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+	})
+
+	t.Run("clearing notifications resets the count", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		feedEventID := newTestFeedEvent(t, ctx, queries, owner)
+		newNotification(t, owner, feedEventID)
+		newNotification(t, owner, feedEventID)
+
+		_, err := queries.ClearNotificationsForUser(ctx, owner)
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 0)
+	})
+
+	t.Run("users are counted apart", func(t *testing.T) {
+		owner := newTestUser(t, ctx, repos)
+		other := newTestUser(t, ctx, repos)
+		newNotification(t, owner, newTestFeedEvent(t, ctx, queries, owner))
+
+		_, err := queries.ClearNotificationsForUser(ctx, other)
+
+		require.NoError(t, err)
+		assertUnseen(t, owner, 1)
+		assertUnseen(t, other, 0)
+	})
+}
+
+func TestGetCommunityMembersToNotifyOfJoin_Success(t *testing.T) {
+	repos, queries := setupNotificationsTest(t)
+	ctx := context.Background()
+	pgx := postgres.NewPgxClient()
+
+	hold := func(t *testing.T, userID persist.DBID, contractID persist.DBID, spam bool) {
+		t.Helper()
+		_, err := pgx.Exec(ctx, `insert into tokens (id, owner_user_id, contract, token_id, chain, owned_by_wallets, is_provider_marked_spam)
+			values ($1, $2, $3, $4, $5, '{}', $6)`, persist.GenerateID(), userID, contractID, persist.GenerateID().String(), persist.ChainETH, spam)
+		require.NoError(t, err)
+	}
+
+	membersToNotify := func(t *testing.T, newUserID persist.DBID, limit int32) []coredb.GetCommunityMembersToNotifyOfJoinRow {
+		t.Helper()
+		members, err := queries.GetCommunityMembersToNotifyOfJoin(ctx, coredb.GetCommunityMembersToNotifyOfJoinParams{
+			NewUserID: newUserID,
+			Lim:       limit,
+		})
+		require.NoError(t, err)
+		return members
+	}
+
+	newUser := newTestUser(t, ctx, repos)
+	alice := newTestUser(t, ctx, repos)
+	bob := newTestUser(t, ctx, repos)
+	universal := newTestUser(t, ctx, repos)
+	spammer := newTestUser(t, ctx, repos)
+	small, big, spam := persist.GenerateID(), persist.GenerateID(), persist.GenerateID()
+
+	hold(t, newUser, small, false)
+	hold(t, newUser, big, false)
+	hold(t, newUser, spam, true)
+	hold(t, alice, small, false)
+	hold(t, alice, big, false)
+	hold(t, bob, big, false)
+	hold(t, universal, big, false)
+	hold(t, spammer, spam, false)
+	_, err := pgx.Exec(ctx, "update users set universal = true where id = $1", universal)
+	require.NoError(t, err)
+
+	t.Run("members sharing more communities are told first about the smallest one", func(t *testing.T) {
+		members := membersToNotify(t, newUser, 10)
+
+		require.Len(t, members, 2)
+		assert.Equal(t, coredb.GetCommunityMembersToNotifyOfJoinRow{UserID: alice, ContractID: small}, members[0])
+		assert.Equal(t, coredb.GetCommunityMembersToNotifyOfJoinRow{UserID: bob, ContractID: big}, members[1])
+	})
+
+	t.Run("the limit keeps the most relevant members", func(t *testing.T) {
+		members := membersToNotify(t, newUser, 1)
+
+		require.Len(t, members, 1)
+		assert.Equal(t, alice, members[0].UserID)
+	})
+
+	t.Run("members that were already told are left out", func(t *testing.T) {
+		_, err := pgx.Exec(ctx, "insert into events (id, actor_id, action, resource_type_id, subject_id) values ($1, $2, $3, $4, $5)",
+			persist.GenerateID(), newUser, persist.ActionCommunityMemberJoined, persist.ResourceTypeUser, alice)
+		require.NoError(t, err)
+
+		members := membersToNotify(t, newUser, 10)
+
+		require.Len(t, members, 1)
+		assert.Equal(t, bob, members[0].UserID)
+	})
+}
+
+func setupNotificationsTest(t *testing.T) (*postgres.Repositories, *coredb.Queries) {
+	t.Helper()
+	r, err := docker.StartPostgres()
+	require.NoError(t, err)
+	t.Cleanup(func() { r.Close() })
+
+	hostAndPort := strings.Split(r.GetHostPort("5432/tcp"), ":")
+	t.Setenv("POSTGRES_HOST", hostAndPort[0])
+	t.Setenv("POSTGRES_PORT", hostAndPort[1])
+
+	err = migrate.RunMigrations(postgres.MustCreateClient(postgres.WithUser("postgres")), "./db/migrations/core")
+	require.NoError(t, err)
+
+	pgx := postgres.NewPgxClient()
+	return postgres.NewRepositories(postgres.MustCreateClient(), pgx), coredb.New(pgx)
+}
+
+func newTestUser(t *testing.T, ctx context.Context, repos *postgres.Repositories) persist.DBID {
+	t.Helper()
+	id := persist.GenerateID().String()
+	userID, err := repos.UserRepository.Create(ctx, persist.CreateUserInput{
+		Username:     "user" + id,
+		ChainAddress: persist.NewChainAddress(persist.Address("0x"+strings.ToLower(id)), persist.ChainETH),
+	})
+	require.NoError(t, err)
+	return userID
+}
+
+func newTestFeedEvent(t *testing.T, ctx context.Context, queries *coredb.Queries, ownerID persist.DBID) persist.DBID {
+	t.Helper()
+	feedEvent, err := queries.CreateFeedEvent(ctx, coredb.CreateFeedEventParams{
+		ID:        persist.GenerateID(),
+		OwnerID:   ownerID,
+		Action:    persist.ActionUserCreated,
+		EventTime: time.Now(),
+		EventIds:  persist.DBIDList{persist.GenerateID()},
+	})
+	require.NoError(t, err)
+	return feedEvent.ID
+}
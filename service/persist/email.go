@@ -18,6 +18,7 @@ var emailVerificationStatuses = []string{"Unverified", "Verified", "Failed", "Ad
 
 const (
 	EmailTypeNotifications EmailType = "notifications"
+	EmailTypeDigest        EmailType = "digest"
 	EmailTypeAdmin                   = "admin"
 )
 
@@ -31,6 +32,7 @@ const (
 type EmailUnsubscriptions struct {
 	All           NullBool `json:"all"`
 	Notifications NullBool `json:"notifications"`
+	Digest        NullBool `json:"digest"`
 }
 
 func (e EmailUnsubscriptions) Value() (driver.Value, error) {
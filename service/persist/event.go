@@ -16,18 +16,28 @@ const (
 	ResourceTypeGallery
 	ResourceTypeAdmire
 	ResourceTypeComment
-	ActionUserCreated                     Action = "UserCreated"
-	ActionUserFollowedUsers               Action = "UserFollowedUsers"
-	ActionCollectorsNoteAddedToToken      Action = "CollectorsNoteAddedToToken"
-	ActionCollectionCreated               Action = "CollectionCreated"
-	ActionCollectorsNoteAddedToCollection Action = "CollectorsNoteAddedToCollection"
-	ActionTokensAddedToCollection         Action = "TokensAddedToCollection"
-	ActionAdmiredFeedEvent                Action = "AdmiredFeedEvent"
-	ActionCommentedOnFeedEvent            Action = "CommentedOnFeedEvent"
-	ActionViewedGallery                   Action = "ViewedGallery"
-	ActionCollectionUpdated               Action = "CollectionUpdated"
-	ActionGalleryUpdated                  Action = "GalleryUpdated"
-	ActionGalleryInfoUpdated              Action = "GalleryInfoUpdated"
+	ResourceTypeFeedEvent
+	ActionUserCreated                      Action = "UserCreated"
+	ActionUserFollowedUsers                Action = "UserFollowedUsers"
+	ActionCollectorsNoteAddedToToken       Action = "CollectorsNoteAddedToToken"
+	ActionCollectionCreated                Action = "CollectionCreated"
+	ActionCollectorsNoteAddedToCollection  Action = "CollectorsNoteAddedToCollection"
+	ActionTokensAddedToCollection          Action = "TokensAddedToCollection"
+	ActionAdmiredFeedEvent                 Action = "AdmiredFeedEvent"
+	ActionCommentedOnFeedEvent             Action = "CommentedOnFeedEvent"
+	ActionViewedGallery                    Action = "ViewedGallery"
+	ActionCollectionUpdated                Action = "CollectionUpdated"
+	ActionGalleryUpdated                   Action = "GalleryUpdated"
+	ActionGalleryInfoUpdated               Action = "GalleryInfoUpdated"
+	ActionTokenAcquired                    Action = "TokenAcquired"
+	ActionTokenMinted                      Action = "TokenMinted"
+	ActionTokensAcquired                   Action = "TokensAcquired"
+	ActionRepostedFeedEvent                Action = "RepostedFeedEvent"
+	ActionMentionedUser                    Action = "MentionedUser"
+	ActionMintedTokenAddedToGallery        Action = "MintedTokenAddedToGallery"
+	ActionCollectorsNoteAddedToMintedToken Action = "CollectorsNoteAddedToMintedToken"
+	ActionCommunityMemberJoined            Action = "CommunityMemberJoined"
+	ActionTokenMediaProcessed              Action = "TokenMediaProcessed"
 )
 
 type EventData struct {
@@ -44,6 +54,14 @@ type EventData struct {
 	GalleryNewTokenIDs                  map[DBID]DBIDList `json:"gallery_new_token_ids"`
 	GalleryNewCollections               DBIDList          `json:"gallery_new_collections"`
 	GalleryNewTokenCollectorsNotes      map[DBID]string   `json:"gallery_new_token_collectors_notes"`
+	// Mentions are user events, so what the user was mentioned in is kept here
+	MentionSource       string `json:"mention_source,omitempty"`
+	MentionFeedEventID  DBID   `json:"mention_feed_event_id,omitempty"`
+	MentionCommentID    DBID   `json:"mention_comment_id,omitempty"`
+	MentionCollectionID DBID   `json:"mention_collection_id,omitempty"`
+	MentionTokenID      DBID   `json:"mention_token_id,omitempty"`
+	// The community that a new user shares with the user who's notified that they joined
+	CommunityContractID DBID `json:"community_contract_id,omitempty"`
 }
 
 type FeedEventData struct {
@@ -69,6 +87,9 @@ type FeedEventData struct {
 	// this field should never be used again and should be replaced with its collection equivalent
 	GalleryNewTokenCollectorsNotes           map[DBID]string          `json:"gallery_new_token_collectors_notes"`
 	GalleryNewCollectionTokenCollectorsNotes map[DBID]map[DBID]string `json:"gallery_new_collection_token_collectors_notes"`
+	AcquiredTokenIDs                         DBIDList                 `json:"acquired_token_ids"`
+	MintedTokenIDs                           DBIDList                 `json:"minted_token_ids"`
+	RepostedFeedEventID                      DBID                     `json:"reposted_feed_event_id"`
 }
 
 type ErrFeedEventNotFoundByID struct {
@@ -79,6 +100,15 @@ func (e ErrFeedEventNotFoundByID) Error() string {
 	return fmt.Sprintf("event not found by id: %s", e.ID)
 }
 
+type ErrFeedEventAlreadyReposted struct {
+	ActorID     DBID
+	FeedEventID DBID
+}
+
+func (e ErrFeedEventAlreadyReposted) Error() string {
+	return fmt.Sprintf("feed event already reposted | ActorID: %s, FeedEventID: %s", e.ActorID, e.FeedEventID)
+}
+
 type ErrUnknownAction struct {
 	Action Action
 }
@@ -0,0 +1,57 @@
+package persist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// ModerationFlag is a reason that a token's media was flagged by moderation
+type ModerationFlag string
+
+const (
+	// ModerationFlagUnsafeSVG means the token's SVG contained scripts or external references, or couldn't be sanitized
+	ModerationFlagUnsafeSVG ModerationFlag = "unsafe_svg"
+	// ModerationFlagNSFW means a classifier found the token's media to be not safe for work
+	ModerationFlagNSFW ModerationFlag = "nsfw"
+)
+
+// TokenModerationStatus represents where a flagged token is in the review process
+type TokenModerationStatus string
+
+const (
+	// TokenModerationStatusFlagged represents a token that is waiting to be reviewed
+	TokenModerationStatusFlagged TokenModerationStatus = "flagged"
+	// TokenModerationStatusApproved represents a token that a reviewer decided is fine to show
+	TokenModerationStatusApproved TokenModerationStatus = "approved"
+	// TokenModerationStatusRejected represents a token that a reviewer decided should stay hidden
+	TokenModerationStatusRejected TokenModerationStatus = "rejected"
+)
+
+var tokenModerationStatuses = map[TokenModerationStatus]string{
+	TokenModerationStatusFlagged:  "Flagged",
+	TokenModerationStatusApproved: "Approved",
+	TokenModerationStatusRejected: "Rejected",
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (s TokenModerationStatus) MarshalGQL(w io.Writer) {
+	w.Write([]byte(fmt.Sprintf(`"%s"`, tokenModerationStatuses[s])))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (s *TokenModerationStatus) UnmarshalGQL(v interface{}) error {
+	n, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TokenModerationStatus must be a string")
+	}
+
+	for status, name := range tokenModerationStatuses {
+		if strings.EqualFold(name, n) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid TokenModerationStatus: %s", n)
+}
@@ -1,20 +1,36 @@
 package persist
 
+import "fmt"
+
 type NotificationData struct {
-	AuthedViewerIDs   []DBID   `json:"viewer_ids,omitempty"`
-	UnauthedViewerIDs []string `json:"unauthed_viewer_ids,omitempty"`
-	FollowerIDs       []DBID   `json:"follower_ids,omitempty"`
-	AdmirerIDs        []DBID   `json:"admirer_ids,omitempty"`
-	FollowedBack      NullBool `json:"followed_back,omitempty"`
-	Refollowed        NullBool `json:"refollowed,omitempty"`
+	AuthedViewerIDs     []DBID   `json:"viewer_ids,omitempty"`
+	UnauthedViewerIDs   []string `json:"unauthed_viewer_ids,omitempty"`
+	FollowerIDs         []DBID   `json:"follower_ids,omitempty"`
+	AdmirerIDs          []DBID   `json:"admirer_ids,omitempty"`
+	ReposterIDs         []DBID   `json:"reposter_ids,omitempty"`
+	FollowedBack        NullBool `json:"followed_back,omitempty"`
+	Refollowed          NullBool `json:"refollowed,omitempty"`
+	MentionerID         DBID     `json:"mentioner_id,omitempty"`
+	MentionSource       string   `json:"mention_source,omitempty"`
+	MentionCollectionID DBID     `json:"mention_collection_id,omitempty"`
+	MentionTokenID      DBID     `json:"mention_token_id,omitempty"`
+	CollectorIDs        []DBID   `json:"collector_ids,omitempty"`
+	TokenIDs            []DBID   `json:"token_ids,omitempty"`
+	NewMemberIDs        []DBID   `json:"new_member_ids,omitempty"`
+	CommunityContractID DBID     `json:"community_contract_id,omitempty"`
+	MediaFailed         NullBool `json:"media_failed,omitempty"`
 }
 
 func (n NotificationData) Validate() NotificationData {
-	result := NotificationData{}
+	result := n
 	result.AdmirerIDs = uniqueDBIDs(n.AdmirerIDs)
+	result.ReposterIDs = uniqueDBIDs(n.ReposterIDs)
 	result.FollowerIDs = uniqueDBIDs(n.FollowerIDs)
 	result.AuthedViewerIDs = uniqueDBIDs(n.AuthedViewerIDs)
 	result.UnauthedViewerIDs = uniqueStrings(n.UnauthedViewerIDs)
+	result.CollectorIDs = uniqueDBIDs(n.CollectorIDs)
+	result.TokenIDs = uniqueDBIDs(n.TokenIDs)
+	result.NewMemberIDs = uniqueDBIDs(n.NewMemberIDs)
 
 	return result
 }
@@ -22,11 +38,16 @@ func (n NotificationData) Validate() NotificationData {
 func (n NotificationData) Concat(other NotificationData) NotificationData {
 	result := NotificationData{}
 	result.AdmirerIDs = append(other.AdmirerIDs, n.AdmirerIDs...)
+	result.ReposterIDs = append(other.ReposterIDs, n.ReposterIDs...)
 	result.FollowerIDs = append(other.FollowerIDs, n.FollowerIDs...)
 	result.AuthedViewerIDs = append(other.AuthedViewerIDs, n.AuthedViewerIDs...)
 	result.UnauthedViewerIDs = append(other.UnauthedViewerIDs, n.UnauthedViewerIDs...)
 	result.FollowedBack = other.FollowedBack || n.FollowedBack
 	result.Refollowed = other.Refollowed || n.Refollowed
+	result.CollectorIDs = append(other.CollectorIDs, n.CollectorIDs...)
+	result.TokenIDs = append(other.TokenIDs, n.TokenIDs...)
+	result.NewMemberIDs = append(other.NewMemberIDs, n.NewMemberIDs...)
+	result.CommunityContractID = n.CommunityContractID
 
 	return result.Validate()
 }
@@ -58,3 +79,11 @@ func uniqueStrings(strs []string) []string {
 
 	return result
 }
+
+type ErrNotificationNotFound struct {
+	ID DBID
+}
+
+func (e ErrNotificationNotFound) Error() string {
+	return fmt.Sprintf("notification not found by id: %s", e.ID)
+}
@@ -30,6 +30,8 @@ func (r *EventRepository) Add(ctx context.Context, event db.Event) (*db.Event, e
 		return r.AddCommentEvent(ctx, event)
 	case persist.ResourceTypeGallery:
 		return r.AddGalleryEvent(ctx, event)
+	case persist.ResourceTypeFeedEvent:
+		return r.AddFeedEventEvent(ctx, event)
 	default:
 		return nil, persist.ErrUnknownResourceType{ResourceType: event.ResourceTypeID}
 	}
@@ -125,6 +127,20 @@ func (r *EventRepository) AddGalleryEvent(ctx context.Context, event db.Event) (
 	return &event, err
 }
 
+func (r *EventRepository) AddFeedEventEvent(ctx context.Context, event db.Event) (*db.Event, error) {
+	event, err := r.Queries.CreateFeedEventEvent(ctx, db.CreateFeedEventEventParams{
+		ID:             persist.GenerateID(),
+		ActorID:        event.ActorID,
+		Action:         event.Action,
+		ResourceTypeID: event.ResourceTypeID,
+		FeedEventID:    event.FeedEventID,
+		Data:           event.Data,
+		GroupID:        event.GroupID,
+		Caption:        event.Caption,
+	})
+	return &event, err
+}
+
 func (r *EventRepository) IsActorActionActive(ctx context.Context, event db.Event, actions persist.ActionList, windowSize time.Duration) (bool, error) {
 	return r.Queries.IsActorActionActive(ctx, db.IsActorActionActiveParams{
 		ActorID:     event.ActorID,
@@ -0,0 +1,61 @@
+package persist
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstantDelivery_Success(t *testing.T) {
+	settings := UserNotificationSettings{
+		SomeoneCommentedOnYourUpdate: util.ToPointer(false),
+		Preferences: map[NotificationType]NotificationPreference{
+			NotificationTypeSomeoneAdmiredYourUpdate:     {Push: util.ToPointer(true), Telegram: util.ToPointer(true)},
+			NotificationTypeSomeoneFollowedYou:           {Push: util.ToPointer(false), Discord: util.ToPointer(true)},
+			NotificationTypeSomeoneMentionedYou:          {Push: util.ToPointer(true), Telegram: util.ToPointer(true), Delivery: NotificationDeliveryDigest},
+			NotificationTypeSomeoneCommentedOnYourUpdate: {Push: util.ToPointer(true)},
+		},
+	}
+	now := time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+	sends := func(s UserNotificationSettings, action Action, channel NotificationChannel, at time.Time) bool {
+		send, deferUntil := s.InstantDelivery(action, channel, at)
+		assert.True(t, deferUntil.IsZero())
+		return send
+	}
+
+	assert.True(t, sends(settings, ActionAdmiredFeedEvent, NotificationChannelPush, now))
+	assert.True(t, sends(settings, ActionAdmiredFeedEvent, NotificationChannelTelegram, now))
+	assert.False(t, sends(settings, ActionAdmiredFeedEvent, NotificationChannelDiscord, now), "channels are set separately")
+	assert.False(t, sends(settings, ActionUserFollowedUsers, NotificationChannelPush, now))
+	assert.True(t, sends(settings, ActionUserFollowedUsers, NotificationChannelDiscord, now))
+	assert.False(t, sends(settings, ActionViewedGallery, NotificationChannelPush, now), "push is opt-in")
+	assert.False(t, sends(settings, ActionViewedGallery, NotificationChannelTelegram, now), "direct messages are opt-in")
+	assert.False(t, sends(settings, ActionMentionedUser, NotificationChannelPush, now), "digest notifications aren't sent instantly")
+	assert.False(t, sends(settings, ActionCommentedOnFeedEvent, NotificationChannelPush, now), "off in-app is off everywhere")
+	assert.False(t, sends(UserNotificationSettings{}, ActionAdmiredFeedEvent, NotificationChannelPush, now))
+
+	t.Run("wanted notifications are deferred until quiet hours end", func(t *testing.T) {
+		settings.QuietHours = &QuietHours{Start: "22:00", End: "14:00", Timezone: "America/New_York"}
+		ny, err := time.LoadLocation("America/New_York")
+		assert.NoError(t, err)
+
+		for _, channel := range []NotificationChannel{NotificationChannelPush, NotificationChannelTelegram} {
+			send, deferUntil := settings.InstantDelivery(ActionAdmiredFeedEvent, channel, now)
+			assert.False(t, send)
+			assert.Equal(t, time.Date(2023, 3, 1, 14, 0, 0, 0, ny), deferUntil)
+		}
+
+		assert.True(t, sends(settings, ActionAdmiredFeedEvent, NotificationChannelPush, now.Add(10*time.Hour)))
+		assert.False(t, sends(settings, ActionUserFollowedUsers, NotificationChannelPush, now), "unwanted notifications aren't deferred")
+		assert.False(t, sends(settings, ActionMentionedUser, NotificationChannelPush, now), "digest notifications aren't deferred")
+	})
+
+	t.Run("quiet hours that wrap past midnight end the next day", func(t *testing.T) {
+		settings.QuietHours = &QuietHours{Start: "22:00", End: "07:00", Timezone: "UTC"}
+		assert.Equal(t, time.Date(2023, 3, 2, 7, 0, 0, 0, time.UTC), settings.QuietHours.EndAfter(time.Date(2023, 3, 1, 23, 0, 0, 0, time.UTC)))
+		assert.Equal(t, time.Date(2023, 3, 2, 7, 0, 0, 0, time.UTC), settings.QuietHours.EndAfter(time.Date(2023, 3, 2, 6, 0, 0, 0, time.UTC)))
+	})
+}
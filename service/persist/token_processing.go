@@ -0,0 +1,50 @@
+package persist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// TokenProcessingJobStatus represents the state of a token's media processing job
+type TokenProcessingJobStatus string
+
+const (
+	// TokenProcessingJobStatusQueued represents a job that is waiting to be processed
+	TokenProcessingJobStatusQueued TokenProcessingJobStatus = "queued"
+	// TokenProcessingJobStatusRunning represents a job that is currently being processed
+	TokenProcessingJobStatusRunning TokenProcessingJobStatus = "running"
+	// TokenProcessingJobStatusSucceeded represents a job that finished processing
+	TokenProcessingJobStatusSucceeded TokenProcessingJobStatus = "succeeded"
+	// TokenProcessingJobStatusFailed represents a job that ran out of retries
+	TokenProcessingJobStatusFailed TokenProcessingJobStatus = "failed"
+)
+
+var tokenProcessingJobStatuses = map[TokenProcessingJobStatus]string{
+	TokenProcessingJobStatusQueued:    "Queued",
+	TokenProcessingJobStatusRunning:   "Running",
+	TokenProcessingJobStatusSucceeded: "Succeeded",
+	TokenProcessingJobStatusFailed:    "Failed",
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (s TokenProcessingJobStatus) MarshalGQL(w io.Writer) {
+	w.Write([]byte(fmt.Sprintf(`"%s"`, tokenProcessingJobStatuses[s])))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (s *TokenProcessingJobStatus) UnmarshalGQL(v interface{}) error {
+	n, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("TokenProcessingJobStatus must be a string")
+	}
+
+	for status, name := range tokenProcessingJobStatuses {
+		if strings.EqualFold(name, n) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid TokenProcessingJobStatus: %s", n)
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io"
 	"strings"
+	"time"
 
 	"github.com/lib/pq"
 )
@@ -25,11 +26,15 @@ type SocialUserIdentifiers struct {
 type SocialProvider string
 
 const (
-	SocialProviderTwitter SocialProvider = "Twitter"
+	SocialProviderTwitter  SocialProvider = "Twitter"
+	SocialProviderTelegram SocialProvider = "Telegram"
+	SocialProviderDiscord  SocialProvider = "Discord"
 )
 
 var AllSocialProviders = []SocialProvider{
 	SocialProviderTwitter,
+	SocialProviderTelegram,
+	SocialProviderDiscord,
 }
 
 // User represents a user with all of their addresses
@@ -73,6 +78,268 @@ type UserNotificationSettings struct {
 	SomeoneAdmiredYourUpdate     *bool `json:"someone_admired_your_update,omitempty"`
 	SomeoneCommentedOnYourUpdate *bool `json:"someone_commented_on_your_update,omitempty"`
 	SomeoneViewedYourGallery     *bool `json:"someone_viewed_your_gallery,omitempty"`
+	SomeoneMentionedYou          *bool `json:"someone_mentioned_you,omitempty"`
+
+	// Preferences say how each type of notification is delivered. The booleans above predate them and are still
+	// honored as the in-app setting of a type without a preference.
+	Preferences map[NotificationType]NotificationPreference `json:"preferences,omitempty"`
+	QuietHours  *QuietHours                                 `json:"quiet_hours,omitempty"`
+}
+
+// NotificationType is a kind of notification that users can set preferences for
+type NotificationType string
+
+const (
+	NotificationTypeSomeoneFollowedYou           NotificationType = "someone_followed_you"
+	NotificationTypeSomeoneAdmiredYourUpdate     NotificationType = "someone_admired_your_update"
+	NotificationTypeSomeoneRepostedYourUpdate    NotificationType = "someone_reposted_your_update"
+	NotificationTypeSomeoneCommentedOnYourUpdate NotificationType = "someone_commented_on_your_update"
+	NotificationTypeSomeoneViewedYourGallery     NotificationType = "someone_viewed_your_gallery"
+	NotificationTypeSomeoneMentionedYou          NotificationType = "someone_mentioned_you"
+	NotificationTypeMintedTokenAddedToGallery    NotificationType = "minted_token_added_to_gallery"
+	NotificationTypeCollectorsNoteOnYourToken    NotificationType = "collectors_note_on_your_token"
+	NotificationTypeCommunityMemberJoined        NotificationType = "community_member_joined"
+	NotificationTypeTokenMediaProcessed          NotificationType = "token_media_processed"
+)
+
+// NotificationTypes are all of the types that users can set preferences for
+var NotificationTypes = []NotificationType{
+	NotificationTypeSomeoneFollowedYou,
+	NotificationTypeSomeoneAdmiredYourUpdate,
+	NotificationTypeSomeoneRepostedYourUpdate,
+	NotificationTypeSomeoneCommentedOnYourUpdate,
+	NotificationTypeSomeoneViewedYourGallery,
+	NotificationTypeSomeoneMentionedYou,
+	NotificationTypeMintedTokenAddedToGallery,
+	NotificationTypeCollectorsNoteOnYourToken,
+	NotificationTypeCommunityMemberJoined,
+	NotificationTypeTokenMediaProcessed,
+}
+
+// NotificationTypeForAction returns the type of the notifications created for action
+func NotificationTypeForAction(action Action) (NotificationType, bool) {
+	switch action {
+	case ActionUserFollowedUsers:
+		return NotificationTypeSomeoneFollowedYou, true
+	case ActionAdmiredFeedEvent:
+		return NotificationTypeSomeoneAdmiredYourUpdate, true
+	case ActionRepostedFeedEvent:
+		return NotificationTypeSomeoneRepostedYourUpdate, true
+	case ActionCommentedOnFeedEvent:
+		return NotificationTypeSomeoneCommentedOnYourUpdate, true
+	case ActionViewedGallery:
+		return NotificationTypeSomeoneViewedYourGallery, true
+	case ActionMentionedUser:
+		return NotificationTypeSomeoneMentionedYou, true
+	case ActionMintedTokenAddedToGallery:
+		return NotificationTypeMintedTokenAddedToGallery, true
+	case ActionCollectorsNoteAddedToMintedToken:
+		return NotificationTypeCollectorsNoteOnYourToken, true
+	case ActionCommunityMemberJoined:
+		return NotificationTypeCommunityMemberJoined, true
+	case ActionTokenMediaProcessed:
+		return NotificationTypeTokenMediaProcessed, true
+	default:
+		return "", false
+	}
+}
+
+// NotificationChannel is a way that notifications reach a user
+type NotificationChannel string
+
+const (
+	NotificationChannelInApp    NotificationChannel = "in_app"
+	NotificationChannelEmail    NotificationChannel = "email"
+	NotificationChannelPush     NotificationChannel = "push"
+	NotificationChannelWebhook  NotificationChannel = "webhook"
+	NotificationChannelTelegram NotificationChannel = "telegram"
+	NotificationChannelDiscord  NotificationChannel = "discord"
+)
+
+// NotificationDelivery is whether a notification is sent as soon as it happens or saved for the weekly digest
+type NotificationDelivery string
+
+const (
+	NotificationDeliveryInstant NotificationDelivery = "instant"
+	NotificationDeliveryDigest  NotificationDelivery = "digest"
+)
+
+// NotificationPreference is how a user wants one type of notification delivered. A channel that isn't set uses its
+// default: in-app and email are on, push, webhooks and direct messages are off.
+type NotificationPreference struct {
+	InApp    *bool                `json:"in_app,omitempty"`
+	Email    *bool                `json:"email,omitempty"`
+	Push     *bool                `json:"push,omitempty"`
+	Webhook  *bool                `json:"webhook,omitempty"`
+	Telegram *bool                `json:"telegram,omitempty"`
+	Discord  *bool                `json:"discord,omitempty"`
+	Delivery NotificationDelivery `json:"delivery,omitempty"`
+}
+
+// QuietHours is a daily window in which nothing is pushed, messaged or emailed to a user. Notifications are deferred
+// until the window ends rather than dropped. Start and End are "15:04" times in Timezone, and the window wraps past
+// midnight if End is before Start.
+type QuietHours struct {
+	Start    string `json:"start"`
+	End      string `json:"end"`
+	Timezone string `json:"timezone"`
+}
+
+// Preference returns how notifications of type t are delivered, with defaults filled in for any channel the user
+// hasn't set
+func (s UserNotificationSettings) Preference(t NotificationType) NotificationPreference {
+	pref := s.Preferences[t]
+	inApp, email, off := s.legacyInApp(t), true, false
+
+	if pref.InApp == nil {
+		pref.InApp = &inApp
+	}
+	if pref.Email == nil {
+		pref.Email = &email
+	}
+	if pref.Push == nil {
+		pref.Push = &off
+	}
+	if pref.Webhook == nil {
+		pref.Webhook = &off
+	}
+	if pref.Telegram == nil {
+		pref.Telegram = &off
+	}
+	if pref.Discord == nil {
+		pref.Discord = &off
+	}
+	if pref.Delivery != NotificationDeliveryDigest {
+		pref.Delivery = NotificationDeliveryInstant
+	}
+
+	return pref
+}
+
+// Wants returns whether notifications created for action should be delivered over channel. The other channels
+// deliver notifications that exist in-app, so a type that is off in-app is off everywhere.
+func (s UserNotificationSettings) Wants(action Action, channel NotificationChannel) bool {
+	t, ok := NotificationTypeForAction(action)
+	if !ok {
+		return channel == NotificationChannelInApp || channel == NotificationChannelEmail
+	}
+
+	pref := s.Preference(t)
+	if !*pref.InApp {
+		return false
+	}
+
+	switch channel {
+	case NotificationChannelInApp:
+		return true
+	case NotificationChannelEmail:
+		return *pref.Email
+	case NotificationChannelPush:
+		return *pref.Push
+	case NotificationChannelWebhook:
+		return *pref.Webhook
+	case NotificationChannelTelegram:
+		return *pref.Telegram
+	case NotificationChannelDiscord:
+		return *pref.Discord
+	default:
+		return false
+	}
+}
+
+// DeliveryFor returns whether notifications created for action are delivered instantly or in the weekly digest
+func (s UserNotificationSettings) DeliveryFor(action Action) NotificationDelivery {
+	if t, ok := NotificationTypeForAction(action); ok {
+		return s.Preference(t).Delivery
+	}
+	return NotificationDeliveryInstant
+}
+
+// InQuietHours returns whether t falls in the user's quiet hours
+func (s UserNotificationSettings) InQuietHours(t time.Time) bool {
+	if s.QuietHours == nil {
+		return false
+	}
+	return s.QuietHours.Contains(t)
+}
+
+// InstantDelivery returns whether a notification created for action should be sent over channel at now, for the
+// channels that send each notification as it's created. Notifications that the user doesn't want over channel, or
+// only wants in the digest, aren't sent. Wanted notifications that arrive during quiet hours are deferred instead,
+// and deferUntil is when the quiet hours end.
+func (s UserNotificationSettings) InstantDelivery(action Action, channel NotificationChannel, now time.Time) (send bool, deferUntil time.Time) {
+	if !s.Wants(action, channel) || s.DeliveryFor(action) != NotificationDeliveryInstant {
+		return false, time.Time{}
+	}
+	if s.InQuietHours(now) {
+		return false, s.QuietHours.EndAfter(now)
+	}
+	return true, time.Time{}
+}
+
+// Contains returns whether t falls in the window. A window that can't be parsed never contains anything.
+func (q QuietHours) Contains(t time.Time) bool {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return false
+	}
+
+	start, err := time.Parse("15:04", q.Start)
+	if err != nil {
+		return false
+	}
+
+	end, err := time.Parse("15:04", q.End)
+	if err != nil {
+		return false
+	}
+
+	local := t.In(loc)
+	now := local.Hour()*60 + local.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from <= to {
+		return now >= from && now < to
+	}
+	return now >= from || now < to
+}
+
+// EndAfter returns the first time after t that the window ends. A window that can't be parsed ends at t.
+func (q QuietHours) EndAfter(t time.Time) time.Time {
+	loc, err := time.LoadLocation(q.Timezone)
+	if err != nil {
+		return t
+	}
+
+	end, err := time.Parse("15:04", q.End)
+	if err != nil {
+		return t
+	}
+
+	local := t.In(loc)
+	next := time.Date(local.Year(), local.Month(), local.Day(), end.Hour(), end.Minute(), 0, 0, loc)
+	if !next.After(local) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+func (s UserNotificationSettings) legacyInApp(t NotificationType) bool {
+	var enabled *bool
+	switch t {
+	case NotificationTypeSomeoneFollowedYou:
+		enabled = s.SomeoneFollowedYou
+	case NotificationTypeSomeoneAdmiredYourUpdate:
+		enabled = s.SomeoneAdmiredYourUpdate
+	case NotificationTypeSomeoneCommentedOnYourUpdate:
+		enabled = s.SomeoneCommentedOnYourUpdate
+	case NotificationTypeSomeoneViewedYourGallery:
+		enabled = s.SomeoneViewedYourGallery
+	case NotificationTypeSomeoneMentionedYou:
+		enabled = s.SomeoneMentionedYou
+	}
+	return enabled == nil || *enabled
 }
 
 type CreateUserInput struct {
@@ -173,7 +440,7 @@ func (s *SocialProvider) Scan(src interface{}) error {
 
 func (s SocialProvider) IsValid() bool {
 	switch s {
-	case SocialProviderTwitter:
+	case SocialProviderTwitter, SocialProviderTelegram, SocialProviderDiscord:
 		return true
 	default:
 		return false
@@ -0,0 +1,50 @@
+package persist
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// WebhookDeliveryStatus represents the state of a webhook delivery
+type WebhookDeliveryStatus string
+
+const (
+	// WebhookDeliveryStatusPending represents a delivery that is waiting to be sent
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "pending"
+	// WebhookDeliveryStatusDelivering represents a delivery that is currently being sent
+	WebhookDeliveryStatusDelivering WebhookDeliveryStatus = "delivering"
+	// WebhookDeliveryStatusSucceeded represents a delivery that the endpoint accepted
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "succeeded"
+	// WebhookDeliveryStatusFailed represents a delivery that ran out of retries
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "failed"
+)
+
+var webhookDeliveryStatuses = map[WebhookDeliveryStatus]string{
+	WebhookDeliveryStatusPending:    "Pending",
+	WebhookDeliveryStatusDelivering: "Delivering",
+	WebhookDeliveryStatusSucceeded:  "Succeeded",
+	WebhookDeliveryStatusFailed:     "Failed",
+}
+
+// MarshalGQL implements the graphql.Marshaler interface
+func (s WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	w.Write([]byte(fmt.Sprintf(`"%s"`, webhookDeliveryStatuses[s])))
+}
+
+// UnmarshalGQL implements the graphql.Unmarshaler interface
+func (s *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	n, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("WebhookDeliveryStatus must be a string")
+	}
+
+	for status, name := range webhookDeliveryStatuses {
+		if strings.EqualFold(name, n) {
+			*s = status
+			return nil
+		}
+	}
+
+	return fmt.Errorf("invalid WebhookDeliveryStatus: %s", n)
+}
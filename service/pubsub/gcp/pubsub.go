@@ -2,15 +2,20 @@ package gcp
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/util"
 
 	"cloud.google.com/go/pubsub"
+	"github.com/googleapis/gax-go/v2/apierror"
 	"google.golang.org/api/option"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials/insecure"
 )
 
@@ -21,7 +26,11 @@ type PubSub struct {
 
 // NewPubSub creates a new GCPPubSub instance.
 func NewPubSub(pCtx context.Context, opts ...option.ClientOption) (*PubSub, error) {
-	return &PubSub{pubsub: NewClient(pCtx)}, nil
+	client := NewClient(pCtx)
+	if client == nil {
+		return nil, errors.New("no pubsub client available")
+	}
+	return &PubSub{pubsub: client}, nil
 }
 
 func NewClient(ctx context.Context) *pubsub.Client {
@@ -68,18 +77,24 @@ func (g *PubSub) Publish(pCtx context.Context, topicName string, message []byte,
 	return nil
 }
 
-// Subscribe subscribes to a topic
+// Subscribe subscribes to a topic. Each subscriber gets its own subscription so that every subscriber receives every
+// message. The topic is created if it doesn't exist yet, and the subscription expires after it goes unused for a few
+// days, e.g. because the instance that created it has shut down.
 func (g *PubSub) Subscribe(pCtx context.Context, topicName string, handler func(context.Context, []byte) error) error {
+	name := fmt.Sprintf("%s-%s", topicName, persist.GenerateID())
 
-	sub, err := g.pubsub.CreateSubscription(pCtx, topicName, pubsub.SubscriptionConfig{
-		Topic:            g.pubsub.Topic(topicName),
-		AckDeadline:      time.Second * 10,
-		ExpirationPolicy: time.Hour * 24 * 3,
-	})
+	sub, err := g.createSubscription(pCtx, topicName, name)
+	if errTopicMissing(err) {
+		if err := g.CreateTopic(pCtx, topicName); err != nil {
+			return err
+		}
+		sub, err = g.createSubscription(pCtx, topicName, name)
+	}
 	if err != nil {
 		return err
 	}
-	err = sub.Receive(context.Background(), func(ctx context.Context, msg *pubsub.Message) {
+
+	err = sub.Receive(pCtx, func(ctx context.Context, msg *pubsub.Message) {
 		err := handler(ctx, msg.Data)
 		if err != nil {
 			logger.For(ctx).WithError(err).Error("error handling sub message")
@@ -96,3 +111,19 @@ func (g *PubSub) CreateTopic(pCtx context.Context, topic string) error {
 	_, err := g.pubsub.CreateTopic(pCtx, topic)
 	return err
 }
+
+func (g *PubSub) createSubscription(ctx context.Context, topicName, name string) (*pubsub.Subscription, error) {
+	return g.pubsub.CreateSubscription(ctx, name, pubsub.SubscriptionConfig{
+		Topic:            g.pubsub.Topic(topicName),
+		AckDeadline:      time.Second * 10,
+		ExpirationPolicy: time.Hour * 24 * 3,
+	})
+}
+
+func errTopicMissing(err error) bool {
+	var aErr *apierror.APIError
+	if ok := errors.As(err, &aErr); ok && aErr.GRPCStatus().Code() == codes.NotFound {
+		return true
+	}
+	return false
+}
@@ -0,0 +1,91 @@
+package memory
+
+import (
+	"context"
+	"sync"
+
+	"github.com/mikeydub/go-gallery/service/logger"
+)
+
+// subscriberBuffer is how many messages a subscriber can fall behind by before publishing to it blocks
+const subscriberBuffer = 100
+
+// PubSub is a PubSub that only delivers messages to subscribers in the same process. It's meant for local development
+// and tests, where running the pub/sub emulator or Redis isn't worth it.
+type PubSub struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[string]map[int]subscriber
+}
+
+type subscriber struct {
+	messages chan []byte
+	// done is closed when the subscriber stops receiving, so that publishers don't wait on it
+	done chan struct{}
+}
+
+// NewPubSub creates a new in-memory PubSub
+func NewPubSub() *PubSub {
+	return &PubSub{subs: map[string]map[int]subscriber{}}
+}
+
+// Publish sends a message to every current subscriber of the topic. Messages published to a topic without
+// subscribers are dropped.
+func (p *PubSub) Publish(ctx context.Context, topic string, message []byte, block bool) error {
+	p.mu.RLock()
+	subs := make([]subscriber, 0, len(p.subs[topic]))
+	for _, sub := range p.subs[topic] {
+		subs = append(subs, sub)
+	}
+	p.mu.RUnlock()
+
+	for _, sub := range subs {
+		select {
+		case sub.messages <- message:
+		case <-sub.done:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	return nil
+}
+
+// Subscribe processes every message published to the topic until ctx is done
+func (p *PubSub) Subscribe(ctx context.Context, topic string, handler func(ctx context.Context, message []byte) error) error {
+	id, sub := p.addSubscriber(topic)
+	defer p.removeSubscriber(topic, id)
+
+	for {
+		select {
+		case msg := <-sub.messages:
+			if err := handler(ctx, msg); err != nil {
+				logger.For(ctx).WithError(err).Error("error handling sub message")
+			}
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+func (p *PubSub) addSubscriber(topic string) (int, subscriber) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.subs[topic] == nil {
+		p.subs[topic] = map[int]subscriber{}
+	}
+
+	p.nextID++
+	sub := subscriber{messages: make(chan []byte, subscriberBuffer), done: make(chan struct{})}
+	p.subs[topic][p.nextID] = sub
+
+	return p.nextID, sub
+}
+
+func (p *PubSub) removeSubscriber(topic string, id int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	close(p.subs[topic][id].done)
+	delete(p.subs[topic], id)
+}
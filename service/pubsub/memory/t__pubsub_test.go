@@ -0,0 +1,84 @@
+package memory
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPubSub_Success(t *testing.T) {
+	t.Run("every subscriber receives every message", func(t *testing.T) {
+		ps := NewPubSub()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		first := subscribe(ctx, ps, "topic")
+		second := subscribe(ctx, ps, "topic")
+		waitForSubscribers(t, ps, "topic", 2)
+
+		require.NoError(t, ps.Publish(ctx, "topic", []byte("hello"), true))
+
+		assert.Equal(t, "hello", receive(t, first))
+		assert.Equal(t, "hello", receive(t, second))
+	})
+
+	t.Run("subscribers only receive messages from their topic", func(t *testing.T) {
+		ps := NewPubSub()
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		sub := subscribe(ctx, ps, "topic")
+		waitForSubscribers(t, ps, "topic", 1)
+
+		require.NoError(t, ps.Publish(ctx, "other", []byte("ignored"), true))
+		require.NoError(t, ps.Publish(ctx, "topic", []byte("hello"), true))
+
+		assert.Equal(t, "hello", receive(t, sub))
+	})
+
+	t.Run("publishing doesn't wait on subscribers that stopped", func(t *testing.T) {
+		ps := NewPubSub()
+		ctx, cancel := context.WithCancel(context.Background())
+
+		subscribe(ctx, ps, "topic")
+		waitForSubscribers(t, ps, "topic", 1)
+		cancel()
+		waitForSubscribers(t, ps, "topic", 0)
+
+		for i := 0; i < subscriberBuffer+1; i++ {
+			require.NoError(t, ps.Publish(context.Background(), "topic", []byte("hello"), true))
+		}
+	})
+}
+
+func subscribe(ctx context.Context, ps *PubSub, topic string) chan string {
+	received := make(chan string, 10)
+	go ps.Subscribe(ctx, topic, func(ctx context.Context, message []byte) error {
+		received <- string(message)
+		return nil
+	})
+	return received
+}
+
+func waitForSubscribers(t *testing.T, ps *PubSub, topic string, n int) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		ps.mu.RLock()
+		defer ps.mu.RUnlock()
+		return len(ps.subs[topic]) == n
+	}, time.Second, 10*time.Millisecond)
+}
+
+func receive(t *testing.T, received chan string) string {
+	t.Helper()
+	select {
+	case msg := <-received:
+		return msg
+	case <-time.After(time.Second):
+		t.Fatal("timed out waiting for message")
+		return ""
+	}
+}
@@ -1,11 +1,52 @@
 package pubsub
 
-import "context"
+import (
+	"context"
+
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/pubsub/gcp"
+	"github.com/mikeydub/go-gallery/service/pubsub/memory"
+	redispubsub "github.com/mikeydub/go-gallery/service/pubsub/redis"
+	"github.com/mikeydub/go-gallery/service/redis"
+)
+
+const (
+	BackendGCP    = "gcp"
+	BackendRedis  = "redis"
+	BackendMemory = "memory"
+)
+
+// inMemory is shared by every client in the process so that messages published by one reach subscribers of another
+var inMemory = memory.NewPubSub()
 
 // PubSub is a wrapper around the Pub/Sub client
 type PubSub interface {
 	// Publish publishes a message to a topic and optionally blocks for that message to be acknowledged by the server
 	Publish(ctx context.Context, topic string, message []byte, block bool) error
-	// Subscribe subscribes to a topic and processes messages using the given handler func
+	// Subscribe subscribes to a topic and processes messages using the given handler func. Every subscriber receives
+	// every message published after it subscribes. Subscribe blocks until ctx is done or receiving fails.
 	Subscribe(ctx context.Context, topic string, handler func(ctx context.Context, message []byte) error) error
 }
+
+// NewFromEnv returns the PubSub configured by PUBSUB_BACKEND. The in-memory backend only reaches subscribers in the
+// same process, so it's only suitable for local development and tests. Returns nil if the backend is unknown or GCP is
+// configured but no credentials are available, so that services that don't need PubSub can still start.
+func NewFromEnv(ctx context.Context) PubSub {
+	switch backend := env.GetString("PUBSUB_BACKEND"); backend {
+	case BackendMemory:
+		return inMemory
+	case BackendRedis:
+		return redispubsub.NewPubSub(redis.NewClient(redis.PubSubDB))
+	case BackendGCP:
+		ps, err := gcp.NewPubSub(ctx)
+		if err != nil {
+			logger.For(ctx).WithError(err).Error("failed to create gcp pubsub")
+			return nil
+		}
+		return ps
+	default:
+		logger.For(ctx).WithField("backend", backend).Error("unknown pubsub backend")
+		return nil
+	}
+}
@@ -0,0 +1,99 @@
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-redis/redis/v8"
+	"github.com/mikeydub/go-gallery/service/logger"
+)
+
+const (
+	// maxStreamLength is roughly how many messages are kept in each topic's stream. Subscribers only read messages
+	// published after they subscribe, so older messages are only kept to let a slow subscriber catch up.
+	maxStreamLength = 10000
+	// readTimeout is how long a read blocks waiting for new messages before checking whether the subscriber is done
+	readTimeout  = 5 * time.Second
+	readCount    = 100
+	messageField = "data"
+)
+
+// PubSub is a PubSub backed by Redis Streams. Each topic is a stream, and each subscriber reads the stream from
+// where it was when it subscribed, so every subscriber receives every message.
+type PubSub struct {
+	client *redis.Client
+}
+
+// NewPubSub creates a new Redis Streams PubSub
+func NewPubSub(client *redis.Client) *PubSub {
+	return &PubSub{client: client}
+}
+
+// Publish appends a message to the topic's stream. Redis acknowledges the message before returning either way, so
+// block has no effect.
+func (p *PubSub) Publish(ctx context.Context, topic string, message []byte, block bool) error {
+	return p.client.XAdd(ctx, &redis.XAddArgs{
+		Stream: streamKey(topic),
+		MaxLen: maxStreamLength,
+		Approx: true,
+		Values: map[string]interface{}{messageField: message},
+	}).Err()
+}
+
+// Subscribe processes every message published to the topic after the call until ctx is done
+func (p *PubSub) Subscribe(ctx context.Context, topic string, handler func(ctx context.Context, message []byte) error) error {
+	stream := streamKey(topic)
+
+	// Start after the newest message so that only messages published from now on are read
+	lastID := "0-0"
+	newest, err := p.client.XRevRangeN(ctx, stream, "+", "-", 1).Result()
+	if err != nil {
+		return fmt.Errorf("failed to read from stream %s: %w", stream, err)
+	}
+	if len(newest) > 0 {
+		lastID = newest[0].ID
+	}
+
+	for {
+		if ctx.Err() != nil {
+			return nil
+		}
+
+		streams, err := p.client.XRead(ctx, &redis.XReadArgs{
+			Streams: []string{stream, lastID},
+			Count:   readCount,
+			Block:   readTimeout,
+		}).Result()
+		if errors.Is(err, redis.Nil) {
+			continue
+		}
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return fmt.Errorf("failed to read from stream %s: %w", stream, err)
+		}
+
+		for _, s := range streams {
+			for _, msg := range s.Messages {
+				lastID = msg.ID
+
+				data, ok := msg.Values[messageField].(string)
+				if !ok {
+					logger.For(ctx).Warnf("skipping message %s in stream %s without data", msg.ID, stream)
+					continue
+				}
+
+				if err := handler(ctx, []byte(data)); err != nil {
+					logger.For(ctx).WithError(err).Error("error handling sub message")
+				}
+			}
+		}
+	}
+}
+
+func streamKey(topic string) string {
+	return fmt.Sprintf("pubsub:%s", topic)
+}
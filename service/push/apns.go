@@ -0,0 +1,172 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+)
+
+// APNs rejects provider tokens that are more than an hour old, and throttles providers that refresh them more often
+// than every 20 minutes
+const apnsTokenLifetime = 50 * time.Minute
+
+// APNsSender sends pushes to iOS devices through the Apple Push Notification service, authenticating with a
+// token-based (.p8) signing key
+type APNsSender struct {
+	client *http.Client
+	host   string
+	key    *ecdsa.PrivateKey
+	keyID  string
+	teamID string
+	topic  string
+
+	mu       sync.Mutex
+	token    string
+	issuedAt time.Time
+}
+
+// NewAPNsSender creates a sender that pushes to host, which is either Apple's production or sandbox server. key is
+// the PEM encoded signing key, and topic is the app's bundle ID.
+func NewAPNsSender(host, key, keyID, teamID, topic string) (*APNsSender, error) {
+	signingKey, err := parseAPNsKey(key)
+	if err != nil {
+		return nil, fmt.Errorf("invalid APNs signing key: %w", err)
+	}
+
+	return &APNsSender{
+		client: &http.Client{Timeout: 10 * time.Second},
+		host:   host,
+		key:    signingKey,
+		keyID:  keyID,
+		teamID: teamID,
+		topic:  topic,
+	}, nil
+}
+
+// parseAPNsKey parses a signing key downloaded from Apple, which is a PKCS #8 key unlike the SEC 1 keys that jwt-go
+// can parse
+func parseAPNsKey(key string) (*ecdsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(key))
+	if block == nil {
+		return nil, errors.New("key must be PEM encoded")
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+
+	ecKey, ok := parsed.(*ecdsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("key must be an ECDSA key")
+	}
+
+	return ecKey, nil
+}
+
+type apnsAlert struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type apnsAps struct {
+	Alert    apnsAlert `json:"alert"`
+	Sound    string    `json:"sound,omitempty"`
+	ThreadID string    `json:"thread-id,omitempty"`
+}
+
+type apnsPayload struct {
+	Aps            apnsAps `json:"aps"`
+	URL            string  `json:"url,omitempty"`
+	NotificationID string  `json:"notificationId,omitempty"`
+}
+
+type apnsError struct {
+	Reason string `json:"reason"`
+}
+
+func (s *APNsSender) Send(ctx context.Context, device db.PushDevice, msg Message) error {
+	payload, err := json.Marshal(apnsPayload{
+		Aps: apnsAps{
+			Alert:    apnsAlert{Title: msg.Title, Body: msg.Body},
+			Sound:    "default",
+			ThreadID: msg.Thread,
+		},
+		URL:            msg.URL,
+		NotificationID: msg.NotificationID.String(),
+	})
+	if err != nil {
+		return err
+	}
+
+	token, err := s.providerToken()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/3/device/%s", s.host, device.Token), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "bearer "+token)
+	req.Header.Set("apns-topic", s.topic)
+	req.Header.Set("apns-push-type", "alert")
+	req.Header.Set("apns-priority", "10")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var apnsErr apnsError
+	json.NewDecoder(resp.Body).Decode(&apnsErr)
+
+	if resp.StatusCode == http.StatusGone || apnsErr.Reason == "BadDeviceToken" || apnsErr.Reason == "Unregistered" {
+		return ErrDeviceUnregistered
+	}
+
+	return fmt.Errorf("apns push failed with status %d: %s", resp.StatusCode, apnsErr.Reason)
+}
+
+// providerToken returns the token that authenticates requests to APNs, signing a new one when the current one is
+// about to expire
+func (s *APNsSender) providerToken() (string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token != "" && time.Since(s.issuedAt) < apnsTokenLifetime {
+		return s.token, nil
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.StandardClaims{
+		Issuer:   s.teamID,
+		IssuedAt: now.Unix(),
+	})
+	token.Header["kid"] = s.keyID
+
+	signed, err := token.SignedString(s.key)
+	if err != nil {
+		return "", err
+	}
+
+	s.token = signed
+	s.issuedAt = now
+	return signed, nil
+}
@@ -0,0 +1,114 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"golang.org/x/oauth2/google"
+)
+
+const fcmScope = "https://www.googleapis.com/auth/firebase.messaging"
+
+// FCMSender sends pushes to Android devices through the Firebase Cloud Messaging HTTP v1 API. It authenticates with
+// the service's default Google credentials.
+type FCMSender struct {
+	client    *http.Client
+	host      string
+	projectID string
+}
+
+func NewFCMSender(ctx context.Context, host, projectID string) (*FCMSender, error) {
+	client, err := google.DefaultClient(ctx, fcmScope)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get FCM credentials: %w", err)
+	}
+	client.Timeout = 10 * time.Second
+
+	return &FCMSender{client: client, host: host, projectID: projectID}, nil
+}
+
+type fcmNotification struct {
+	Title string `json:"title"`
+	Body  string `json:"body"`
+}
+
+type fcmAndroidNotification struct {
+	Tag string `json:"tag,omitempty"`
+}
+
+type fcmAndroidConfig struct {
+	Notification fcmAndroidNotification `json:"notification"`
+}
+
+type fcmMessage struct {
+	Token        string            `json:"token"`
+	Notification fcmNotification   `json:"notification"`
+	Android      fcmAndroidConfig  `json:"android"`
+	Data         map[string]string `json:"data,omitempty"`
+}
+
+type fcmRequest struct {
+	Message fcmMessage `json:"message"`
+}
+
+type fcmError struct {
+	Error struct {
+		Status  string `json:"status"`
+		Message string `json:"message"`
+		Details []struct {
+			ErrorCode string `json:"errorCode"`
+		} `json:"details"`
+	} `json:"error"`
+}
+
+func (s *FCMSender) Send(ctx context.Context, device db.PushDevice, msg Message) error {
+	payload, err := json.Marshal(fcmRequest{
+		Message: fcmMessage{
+			Token:        device.Token,
+			Notification: fcmNotification{Title: msg.Title, Body: msg.Body},
+			Android:      fcmAndroidConfig{Notification: fcmAndroidNotification{Tag: msg.Thread}},
+			Data: map[string]string{
+				"url":            msg.URL,
+				"notificationId": msg.NotificationID.String(),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/v1/projects/%s/messages:send", s.host, s.projectID), bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+
+	var fcmErr fcmError
+	json.NewDecoder(resp.Body).Decode(&fcmErr)
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrDeviceUnregistered
+	}
+	for _, detail := range fcmErr.Error.Details {
+		if detail.ErrorCode == "UNREGISTERED" {
+			return ErrDeviceUnregistered
+		}
+	}
+
+	return fmt.Errorf("fcm push failed with status %d: %s", resp.StatusCode, fcmErr.Error.Message)
+}
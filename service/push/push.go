@@ -0,0 +1,236 @@
+// Package push delivers notifications to users' phones and browsers. Each platform has its own Sender, and a
+// Pusher decides which notifications to push and to which devices.
+package push
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+type Platform string
+
+const (
+	PlatformWeb     Platform = "web"
+	PlatformIOS     Platform = "ios"
+	PlatformAndroid Platform = "android"
+)
+
+// ErrDeviceUnregistered is returned by a Sender when the push service no longer knows about a device, e.g. because
+// the app was uninstalled or the browser's subscription expired. The device is removed when this happens.
+var ErrDeviceUnregistered = errors.New("push device is no longer registered")
+
+// Message is what's shown to the user when a notification is pushed
+type Message struct {
+	Title          string
+	Body           string
+	URL            string
+	NotificationID persist.DBID
+	// Notifications with the same thread are collapsed together by platforms that support it
+	Thread string
+}
+
+type Sender interface {
+	Send(ctx context.Context, device db.PushDevice, msg Message) error
+}
+
+type Pusher struct {
+	queries *db.Queries
+	senders map[Platform]Sender
+}
+
+func NewPusher(queries *db.Queries, senders map[Platform]Sender) *Pusher {
+	return &Pusher{queries: queries, senders: senders}
+}
+
+// NewSendersFromEnv returns a sender for each platform that's configured. Platforms without a sender are skipped
+// when pushing.
+func NewSendersFromEnv(ctx context.Context) map[Platform]Sender {
+	senders := map[Platform]Sender{}
+
+	if env.GetString("VAPID_PRIVATE_KEY") != "" {
+		s, err := NewWebPushSender(env.GetString("VAPID_PUBLIC_KEY"), env.GetString("VAPID_PRIVATE_KEY"), env.GetString("VAPID_SUBJECT"))
+		if err != nil {
+			panic(err)
+		}
+		senders[PlatformWeb] = s
+	}
+
+	if env.GetString("APNS_KEY") != "" {
+		s, err := NewAPNsSender(env.GetString("APNS_HOST"), env.GetString("APNS_KEY"), env.GetString("APNS_KEY_ID"), env.GetString("APNS_TEAM_ID"), env.GetString("APNS_TOPIC"))
+		if err != nil {
+			panic(err)
+		}
+		senders[PlatformIOS] = s
+	}
+
+	if env.GetString("FCM_PROJECT_ID") != "" {
+		s, err := NewFCMSender(ctx, env.GetString("FCM_HOST"), env.GetString("FCM_PROJECT_ID"))
+		if err != nil {
+			panic(err)
+		}
+		senders[PlatformAndroid] = s
+	}
+
+	return senders
+}
+
+// Push sends a notification to each of its owner's devices if the owner wants pushes of its kind. Notifications that
+// arrive during the owner's quiet hours are deferred until the quiet hours end. It should only be called for new
+// notifications, so that a notification that others are grouped into is only pushed once.
+func (p *Pusher) Push(ctx context.Context, notif db.Notification) error {
+	if p == nil || len(p.senders) == 0 {
+		return nil
+	}
+
+	owner, err := p.queries.GetUserById(ctx, notif.OwnerID)
+	if err != nil {
+		return fmt.Errorf("failed to get owner %s of notification %s: %w", notif.OwnerID, notif.ID, err)
+	}
+
+	send, deferUntil := owner.NotificationSettings.InstantDelivery(notif.Action, persist.NotificationChannelPush, time.Now())
+	if !deferUntil.IsZero() {
+		return p.queries.DeferNotification(ctx, db.DeferNotificationParams{
+			NotificationID: notif.ID,
+			Channel:        persist.NotificationChannelPush,
+			DeliverAfter:   deferUntil,
+		})
+	}
+
+	if !send {
+		return nil
+	}
+
+	return p.Deliver(ctx, notif)
+}
+
+// Deliver sends a notification to each of its owner's devices without checking whether the owner wants it pushed
+func (p *Pusher) Deliver(ctx context.Context, notif db.Notification) error {
+	if p == nil || len(p.senders) == 0 {
+		return nil
+	}
+
+	devices, err := p.queries.GetPushDevicesByOwnerID(ctx, notif.OwnerID)
+	if err != nil {
+		return err
+	}
+
+	if len(devices) == 0 {
+		return nil
+	}
+
+	msg, err := MessageForNotification(ctx, p.queries, notif)
+	if err != nil {
+		return err
+	}
+
+	for _, device := range devices {
+		sender, ok := p.senders[Platform(device.Platform)]
+		if !ok {
+			continue
+		}
+
+		err := sender.Send(ctx, device, msg)
+		if err == nil {
+			continue
+		}
+
+		if errors.Is(err, ErrDeviceUnregistered) {
+			logger.For(ctx).Infof("removing unregistered %s device %s of user %s", device.Platform, device.ID, device.OwnerID)
+			err = p.queries.DeletePushDeviceByID(ctx, device.ID)
+		}
+
+		// A device that can't be reached shouldn't stop the rest from getting the push
+		if err != nil {
+			logger.For(ctx).Errorf("failed to push notification %s to device %s: %s", notif.ID, device.ID, err)
+			sentryutil.ReportError(ctx, err)
+		}
+	}
+
+	return nil
+}
+
+// MessageForNotification describes a notification in a short message. It's shared by the other channels that deliver
+// notifications as plain text, like direct messages.
+func MessageForNotification(ctx context.Context, queries *db.Queries, notif db.Notification) (Message, error) {
+	msg := Message{
+		Title:          "Gallery",
+		URL:            env.GetString("GALLERY_HOST"),
+		NotificationID: notif.ID,
+		Thread:         string(notif.Action),
+	}
+
+	var actorID persist.DBID
+	var action string
+
+	switch notif.Action {
+	case persist.ActionUserFollowedUsers:
+		actorID, action = firstID(notif.Data.FollowerIDs), "followed you"
+		if notif.Data.FollowedBack {
+			action = "followed you back"
+		}
+	case persist.ActionAdmiredFeedEvent:
+		actorID, action = firstID(notif.Data.AdmirerIDs), "admired your update"
+	case persist.ActionRepostedFeedEvent:
+		actorID, action = firstID(notif.Data.ReposterIDs), "reposted your update"
+	case persist.ActionViewedGallery:
+		actorID, action = firstID(notif.Data.AuthedViewerIDs), "viewed your gallery"
+	case persist.ActionMentionedUser:
+		actorID, action = notif.Data.MentionerID, "mentioned you"
+	case persist.ActionCommentedOnFeedEvent:
+		comment, err := queries.GetCommentByCommentID(ctx, notif.CommentID)
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to get comment %s: %w", notif.CommentID, err)
+		}
+		actorID, action = comment.ActorID, fmt.Sprintf("commented on your update: %s", util.TruncateWithEllipsis(comment.Comment, 100))
+	case persist.ActionMintedTokenAddedToGallery:
+		actorID, action = firstID(notif.Data.CollectorIDs), "displayed your token in their gallery"
+	case persist.ActionCollectorsNoteAddedToMintedToken:
+		actorID, action = firstID(notif.Data.CollectorIDs), "wrote a note on your token"
+	case persist.ActionCommunityMemberJoined:
+		actorID, action = firstID(notif.Data.NewMemberIDs), "joined Gallery from your community"
+	case persist.ActionTokenMediaProcessed:
+		token, err := queries.GetTokenById(ctx, firstID(notif.Data.TokenIDs))
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to get token %s: %w", firstID(notif.Data.TokenIDs), err)
+		}
+		name := token.Name.String
+		if name == "" {
+			name = "Your token"
+		}
+		msg.Body = fmt.Sprintf("%s finished processing", name)
+		if notif.Data.MediaFailed {
+			msg.Body = fmt.Sprintf("%s couldn't be processed", name)
+		}
+		return msg, nil
+	default:
+		return Message{}, fmt.Errorf("unknown notification action: %s", notif.Action)
+	}
+
+	actor := "Someone"
+	if actorID != "" {
+		user, err := queries.GetUserById(ctx, actorID)
+		if err != nil {
+			return Message{}, fmt.Errorf("failed to get user %s: %w", actorID, err)
+		}
+		actor = user.Username.String
+	}
+
+	msg.Body = fmt.Sprintf("%s %s", actor, action)
+	return msg, nil
+}
+
+func firstID(ids []persist.DBID) persist.DBID {
+	if len(ids) == 0 {
+		return ""
+	}
+	return ids[0]
+}
@@ -0,0 +1,129 @@
+package push
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/dgrijalva/jwt-go"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/hkdf"
+)
+
+func TestWebPushSender_Success(t *testing.T) {
+	sender, vapidKey := newTestWebPushSender(t)
+	uaKey, p256dh, auth := newTestSubscriptionKeys(t)
+
+	t.Run("sends an encrypted, signed push", func(t *testing.T) {
+		var received webPushPayload
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			assert.Equal(t, "aes128gcm", r.Header.Get("Content-Encoding"))
+			assertValidVAPID(t, r.Header.Get("Authorization"), vapidKey)
+
+			body, _ := io.ReadAll(r.Body)
+			json.Unmarshal(decryptWebPush(t, body, uaKey, auth), &received)
+			w.WriteHeader(http.StatusCreated)
+		}))
+		defer server.Close()
+
+		err := sender.Send(context.Background(), db.PushDevice{Token: server.URL + "/push/abc", P256dh: p256dh, Auth: auth}, Message{
+			Title:          "Gallery",
+			Body:           "alice admired your update",
+			NotificationID: "notif",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, webPushPayload{Title: "Gallery", Body: "alice admired your update", NotificationID: "notif"}, received)
+	})
+
+	t.Run("reports expired subscriptions", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusGone)
+		}))
+		defer server.Close()
+
+		err := sender.Send(context.Background(), db.PushDevice{Token: server.URL, P256dh: p256dh, Auth: auth}, Message{})
+
+		assert.ErrorIs(t, err, ErrDeviceUnregistered)
+	})
+}
+
+func newTestWebPushSender(t *testing.T) (*WebPushSender, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	publicKey := base64.RawURLEncoding.EncodeToString(elliptic.Marshal(key.Curve, key.X, key.Y))
+	privateKey := base64.RawURLEncoding.EncodeToString(key.D.FillBytes(make([]byte, 32)))
+
+	sender, err := NewWebPushSender(publicKey, privateKey, "mailto:test@gallery.so")
+	require.NoError(t, err)
+	return sender, key
+}
+
+func newTestSubscriptionKeys(t *testing.T) (*ecdsa.PrivateKey, string, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	auth := make([]byte, 16)
+	rand.Read(auth)
+
+	p256dh := base64.RawURLEncoding.EncodeToString(elliptic.Marshal(key.Curve, key.X, key.Y))
+	return key, p256dh, base64.RawURLEncoding.EncodeToString(auth)
+}
+
+func assertValidVAPID(t *testing.T, header string, key *ecdsa.PrivateKey) {
+	t.Helper()
+	fields := strings.Split(strings.TrimPrefix(header, "vapid "), ", ")
+	require.Len(t, fields, 2)
+
+	token, err := jwt.Parse(strings.TrimPrefix(fields[0], "t="), func(*jwt.Token) (interface{}, error) {
+		return &key.PublicKey, nil
+	})
+	require.NoError(t, err)
+	assert.True(t, token.Valid)
+}
+
+// decryptWebPush decrypts a push like a browser would
+func decryptWebPush(t *testing.T, body []byte, uaKey *ecdsa.PrivateKey, auth string) []byte {
+	t.Helper()
+	curve := elliptic.P256()
+
+	salt := body[:16]
+	keyLength := int(body[20])
+	asPublic := body[21 : 21+keyLength]
+	ciphertext := body[21+keyLength:]
+
+	asX, asY := elliptic.Unmarshal(curve, asPublic)
+	sharedX, _ := curve.ScalarMult(asX, asY, uaKey.D.Bytes())
+	authSecret, _ := base64.RawURLEncoding.DecodeString(auth)
+
+	keyInfo := append([]byte("WebPush: info\x00"), elliptic.Marshal(curve, uaKey.X, uaKey.Y)...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm, err := hkdfExpand(hkdf.Extract(sha256.New, sharedX.FillBytes(make([]byte, 32)), authSecret), keyInfo, 32)
+	require.NoError(t, err)
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek, _ := hkdfExpand(prk, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	nonce, _ := hkdfExpand(prk, []byte("Content-Encoding: nonce\x00"), 12)
+
+	block, _ := aes.NewCipher(cek)
+	gcm, _ := cipher.NewGCM(block)
+	plaintext, err := gcm.Open(nil, nonce, ciphertext, nil)
+	require.NoError(t, err)
+	require.Equal(t, byte(0x02), plaintext[len(plaintext)-1])
+
+	return plaintext[:len(plaintext)-1]
+}
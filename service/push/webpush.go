@@ -0,0 +1,224 @@
+package push
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/dgrijalva/jwt-go"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"golang.org/x/crypto/hkdf"
+)
+
+// How long a push service holds on to a push for a browser that's offline
+const webPushTTL = 24 * time.Hour
+
+// The record size of an encrypted push. Pushes are small enough to fit in a single record.
+const webPushRecordSize = 4096
+
+// WebPushSender sends pushes to browsers with the Web Push protocol. Payloads are encrypted as described in RFC 8291
+// and requests are authenticated with VAPID (RFC 8292), so no push service needs to be registered with up front.
+type WebPushSender struct {
+	client     *http.Client
+	publicKey  string
+	privateKey *ecdsa.PrivateKey
+	subject    string
+}
+
+// NewWebPushSender creates a sender from a VAPID key pair, encoded as unpadded base64url like browsers expect the
+// public key to be. subject is a mailto: or https: URL that push services can use to contact us.
+func NewWebPushSender(publicKey, privateKey, subject string) (*WebPushSender, error) {
+	d, err := base64.RawURLEncoding.DecodeString(privateKey)
+	if err != nil {
+		return nil, fmt.Errorf("invalid VAPID private key: %w", err)
+	}
+
+	curve := elliptic.P256()
+	key := &ecdsa.PrivateKey{D: new(big.Int).SetBytes(d)}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(d)
+
+	if publicKey != base64.RawURLEncoding.EncodeToString(elliptic.Marshal(curve, key.PublicKey.X, key.PublicKey.Y)) {
+		return nil, errors.New("VAPID public key doesn't match the private key")
+	}
+
+	return &WebPushSender{
+		client:     &http.Client{Timeout: 10 * time.Second},
+		publicKey:  publicKey,
+		privateKey: key,
+		subject:    subject,
+	}, nil
+}
+
+type webPushPayload struct {
+	Title          string `json:"title"`
+	Body           string `json:"body"`
+	URL            string `json:"url,omitempty"`
+	NotificationID string `json:"notificationId,omitempty"`
+	Tag            string `json:"tag,omitempty"`
+}
+
+func (s *WebPushSender) Send(ctx context.Context, device db.PushDevice, msg Message) error {
+	payload, err := json.Marshal(webPushPayload{
+		Title:          msg.Title,
+		Body:           msg.Body,
+		URL:            msg.URL,
+		NotificationID: msg.NotificationID.String(),
+		Tag:            msg.Thread,
+	})
+	if err != nil {
+		return err
+	}
+
+	body, err := encryptWebPush(payload, device.P256dh, device.Auth)
+	if err != nil {
+		return err
+	}
+
+	authorization, err := s.authorization(device.Token)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, device.Token, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", authorization)
+	req.Header.Set("Content-Encoding", "aes128gcm")
+	req.Header.Set("Content-Type", "application/octet-stream")
+	req.Header.Set("TTL", fmt.Sprint(int(webPushTTL.Seconds())))
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	switch {
+	case resp.StatusCode == http.StatusNotFound || resp.StatusCode == http.StatusGone:
+		return ErrDeviceUnregistered
+	case resp.StatusCode >= 300:
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("web push to %s failed with status %d: %s", req.URL.Host, resp.StatusCode, respBody)
+	}
+
+	return nil
+}
+
+// authorization returns a VAPID header for the push service that hosts the endpoint
+func (s *WebPushSender) authorization(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+
+	token, err := jwt.NewWithClaims(jwt.SigningMethodES256, jwt.StandardClaims{
+		Audience:  fmt.Sprintf("%s://%s", u.Scheme, u.Host),
+		ExpiresAt: time.Now().Add(12 * time.Hour).Unix(),
+		Subject:   s.subject,
+	}).SignedString(s.privateKey)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("vapid t=%s, k=%s", token, s.publicKey), nil
+}
+
+// encryptWebPush encrypts a payload for a subscription with the aes128gcm content encoding
+func encryptWebPush(payload []byte, p256dh, auth string) ([]byte, error) {
+	curve := elliptic.P256()
+
+	uaPublic, err := base64.RawURLEncoding.DecodeString(p256dh)
+	if err != nil {
+		return nil, fmt.Errorf("invalid p256dh key: %w", err)
+	}
+
+	uaX, uaY := elliptic.Unmarshal(curve, uaPublic)
+	if uaX == nil {
+		return nil, errors.New("invalid p256dh key: not a point on P-256")
+	}
+
+	authSecret, err := base64.RawURLEncoding.DecodeString(auth)
+	if err != nil {
+		return nil, fmt.Errorf("invalid auth secret: %w", err)
+	}
+
+	// Each push is encrypted with a new key pair
+	asPrivate, err := ecdsa.GenerateKey(curve, rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	asPublic := elliptic.Marshal(curve, asPrivate.X, asPrivate.Y)
+
+	sharedX, _ := curve.ScalarMult(uaX, uaY, asPrivate.D.Bytes())
+	ecdhSecret := sharedX.FillBytes(make([]byte, 32))
+
+	keyInfo := append([]byte("WebPush: info\x00"), uaPublic...)
+	keyInfo = append(keyInfo, asPublic...)
+	ikm, err := hkdfExpand(hkdf.Extract(sha256.New, ecdhSecret, authSecret), keyInfo, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, 16)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+
+	prk := hkdf.Extract(sha256.New, ikm, salt)
+	cek, err := hkdfExpand(prk, []byte("Content-Encoding: aes128gcm\x00"), 16)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := hkdfExpand(prk, []byte("Content-Encoding: nonce\x00"), 12)
+	if err != nil {
+		return nil, err
+	}
+
+	block, err := aes.NewCipher(cek)
+	if err != nil {
+		return nil, err
+	}
+	gcm, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+
+	// 0x02 marks the last (and only) record
+	plaintext := append(append([]byte{}, payload...), 0x02)
+	if len(plaintext)+gcm.Overhead() > webPushRecordSize {
+		return nil, fmt.Errorf("web push payload is too large: %d bytes", len(payload))
+	}
+
+	header := make([]byte, 0, 16+4+1+len(asPublic))
+	header = append(header, salt...)
+	header = binary.BigEndian.AppendUint32(header, webPushRecordSize)
+	header = append(header, byte(len(asPublic)))
+	header = append(header, asPublic...)
+
+	return gcm.Seal(header, nonce, plaintext, nil), nil
+}
+
+func hkdfExpand(prk, info []byte, length int) ([]byte, error) {
+	out := make([]byte, length)
+	if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, info), out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
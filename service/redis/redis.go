@@ -32,6 +32,7 @@ const (
 	GraphQLAPQ                = 12
 	FeedDB                    = 13
 	SocialDB                  = 14
+	PubSubDB                  = 15
 )
 
 // GetNameForDatabase returns a name for the given database ID, if available.
@@ -50,6 +51,8 @@ func GetNameForDatabase(databaseId int) string {
 		return "TestSuiteDB"
 	case FeedDB:
 		return "FeedDB"
+	case PubSubDB:
+		return "PubSubDB"
 	}
 
 	return fmt.Sprintf("db %d", databaseId)
@@ -0,0 +1,321 @@
+package rpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	shell "github.com/ipfs/go-ipfs-api"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+)
+
+const (
+	// defaultIPFSHedgeDelay is how long to wait on a source before also trying the next one
+	defaultIPFSHedgeDelay = 2 * time.Second
+	// initialIPFSLatency is the latency assumed for a source that hasn't been used yet
+	initialIPFSLatency = time.Second
+	// ipfsScoreDecay is the weight given to the latest request when updating a source's score
+	ipfsScoreDecay = 0.2
+)
+
+var (
+	defaultIPFSPool     *ipfsPool
+	defaultIPFSPoolOnce sync.Once
+)
+
+// ipfsSource is somewhere IPFS content can be fetched from, such as a gateway or an IPFS node's API.
+// Sources are scored by how often their requests succeed and how quickly they respond.
+type ipfsSource struct {
+	name  string
+	fetch func(ctx context.Context, path string) (io.ReadCloser, error)
+
+	mu          sync.Mutex
+	successRate float64
+	latency     time.Duration
+}
+
+func newIPFSSource(name string, fetch func(ctx context.Context, path string) (io.ReadCloser, error)) *ipfsSource {
+	return &ipfsSource{
+		name:        name,
+		fetch:       fetch,
+		successRate: 1,
+		latency:     initialIPFSLatency,
+	}
+}
+
+func newIPFSGatewaySource(gatewayURL string, client *http.Client) *ipfsSource {
+	gatewayURL = strings.TrimSuffix(gatewayURL, "/")
+	return newIPFSSource(gatewayURL, func(ctx context.Context, path string) (io.ReadCloser, error) {
+		url := fmt.Sprintf("%s/ipfs/%s", gatewayURL, path)
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		if resp.StatusCode > 399 || resp.StatusCode < 200 {
+			resp.Body.Close()
+			return nil, ErrHTTP{Status: resp.StatusCode, URL: url}
+		}
+		return resp.Body, nil
+	})
+}
+
+func newIPFSShellSource(name string, sh *shell.Shell) *ipfsSource {
+	return newIPFSSource(name, func(ctx context.Context, path string) (io.ReadCloser, error) {
+		resp, err := sh.Request("cat", path).Send(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if resp.Error != nil {
+			resp.Close()
+			return nil, resp.Error
+		}
+		return resp.Output, nil
+	})
+}
+
+// score returns how desirable the source is, where a higher score is better
+func (s *ipfsSource) score() float64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.successRate / s.latency.Seconds()
+}
+
+// record updates the source's score with the outcome of a request
+func (s *ipfsSource) record(err error, took time.Duration) {
+	// Requests that lost the race are cancelled, which says nothing about the source
+	if errors.Is(err, context.Canceled) {
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	outcome := 1.0
+	if err != nil {
+		outcome = 0
+		// Failures are often fast, so count them as taking at least as long as the source usually does
+		if took < s.latency {
+			took = s.latency
+		}
+	}
+
+	s.successRate = (1-ipfsScoreDecay)*s.successRate + ipfsScoreDecay*outcome
+	s.latency = time.Duration((1-ipfsScoreDecay)*float64(s.latency) + ipfsScoreDecay*float64(took))
+}
+
+// ipfsPool fetches IPFS content by racing its sources against each other. The best scoring source is tried first,
+// and the next best is hedged in whenever a source fails or hasn't responded within the hedge delay.
+type ipfsPool struct {
+	gateways   []*ipfsSource
+	nodes      []*ipfsSource
+	hedgeDelay time.Duration
+
+	mu     sync.Mutex
+	shells map[*shell.Shell]*ipfsSource
+}
+
+func newIPFSPool(gateways []*ipfsSource, nodes []*ipfsSource, hedgeDelay time.Duration) *ipfsPool {
+	if hedgeDelay <= 0 {
+		hedgeDelay = defaultIPFSHedgeDelay
+	}
+	return &ipfsPool{
+		gateways:   gateways,
+		nodes:      nodes,
+		hedgeDelay: hedgeDelay,
+		shells:     make(map[*shell.Shell]*ipfsSource),
+	}
+}
+
+// getIPFSPool returns the pool configured by the environment. IPFS_GATEWAYS is a comma-separated list of gateways
+// to use, falling back to IPFS_URL, and IPFS_LOCAL_API_URL is the API of a local Kubo node to use alongside them.
+func getIPFSPool() *ipfsPool {
+	defaultIPFSPoolOnce.Do(func() {
+		gatewayURLs := env.GetStringSlice("IPFS_GATEWAYS")
+		if len(gatewayURLs) == 1 && strings.Contains(gatewayURLs[0], ",") {
+			gatewayURLs = strings.Split(gatewayURLs[0], ",")
+		}
+		if len(gatewayURLs) == 0 {
+			gatewayURLs = []string{env.GetString("IPFS_URL")}
+		}
+
+		gateways := make([]*ipfsSource, 0, len(gatewayURLs))
+		for _, gatewayURL := range gatewayURLs {
+			if gatewayURL = strings.TrimSpace(gatewayURL); gatewayURL != "" {
+				gateways = append(gateways, newIPFSGatewaySource(gatewayURL, defaultHTTPClient))
+			}
+		}
+
+		nodes := make([]*ipfsSource, 0, 1)
+		if localURL := env.GetString("IPFS_LOCAL_API_URL"); localURL != "" {
+			sh := shell.NewShell(localURL)
+			sh.SetTimeout(time.Minute * 2)
+			nodes = append(nodes, newIPFSShellSource(localURL, sh))
+		}
+
+		defaultIPFSPool = newIPFSPool(gateways, nodes, env.GetDuration("IPFS_HEDGE_DELAY"))
+	})
+	return defaultIPFSPool
+}
+
+// shellSource returns the source for an IPFS API client so that its score is kept between requests
+func (p *ipfsPool) shellSource(sh *shell.Shell) *ipfsSource {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if s, ok := p.shells[sh]; ok {
+		return s
+	}
+	s := newIPFSShellSource(fmt.Sprintf("api-%d", len(p.shells)), sh)
+	p.shells[sh] = s
+	return s
+}
+
+// ranked returns the pool's sources from best to worst
+func (p *ipfsPool) ranked(ipfsClient *shell.Shell) []*ipfsSource {
+	sources := make([]*ipfsSource, 0, len(p.gateways)+len(p.nodes)+1)
+	sources = append(sources, p.nodes...)
+	sources = append(sources, p.gateways...)
+	if ipfsClient != nil {
+		sources = append(sources, p.shellSource(ipfsClient))
+	}
+
+	scores := make(map[*ipfsSource]float64, len(sources))
+	for _, s := range sources {
+		scores[s] = s.score()
+	}
+
+	sort.SliceStable(sources, func(i, j int) bool {
+		return scores[sources[i]] > scores[sources[j]]
+	})
+
+	return sources
+}
+
+// bestGateway returns the URL of the best scoring gateway
+func (p *ipfsPool) bestGateway() string {
+	var best *ipfsSource
+	for _, g := range p.gateways {
+		if best == nil || g.score() > best.score() {
+			best = g
+		}
+	}
+	if best == nil {
+		return env.GetString("IPFS_URL")
+	}
+	return best.name
+}
+
+type ipfsAttempt struct {
+	index  int
+	source *ipfsSource
+	body   io.ReadCloser
+	err    error
+}
+
+// fetch races the pool's sources for the content at path
+func (p *ipfsPool) fetch(ctx context.Context, ipfsClient *shell.Shell, path string) (io.ReadCloser, error) {
+	sources := p.ranked(ipfsClient)
+	if len(sources) == 0 {
+		return nil, fmt.Errorf("no IPFS sources configured")
+	}
+
+	attempts := make(chan ipfsAttempt, len(sources))
+	cancels := make([]context.CancelFunc, 0, len(sources))
+	pending := 0
+
+	launch := func() {
+		index := len(cancels)
+		source := sources[index]
+		attemptCtx, cancel := context.WithCancel(ctx)
+		cancels = append(cancels, cancel)
+		pending++
+
+		go func() {
+			start := time.Now()
+			body, err := source.fetch(attemptCtx, path)
+			source.record(err, time.Since(start))
+			attempts <- ipfsAttempt{index: index, source: source, body: body, err: err}
+		}()
+	}
+
+	// abandon stops the requests that are still in flight, except for the winner's, and closes
+	// any responses that arrive after we're done
+	abandon := func(winner int) {
+		for i, cancel := range cancels {
+			if i != winner {
+				cancel()
+			}
+		}
+		go func(remaining int) {
+			for i := 0; i < remaining; i++ {
+				if a := <-attempts; a.body != nil {
+					a.body.Close()
+				}
+			}
+		}(pending)
+	}
+
+	launch()
+	hedge := time.NewTimer(p.hedgeDelay)
+	defer hedge.Stop()
+
+	errs := make([]string, 0, len(sources))
+
+	for pending > 0 {
+		select {
+		case <-ctx.Done():
+			abandon(-1)
+			return nil, ctx.Err()
+		case <-hedge.C:
+			if len(cancels) < len(sources) {
+				logger.For(ctx).Debugf("no response for %s after %s, also trying %s", path, p.hedgeDelay, sources[len(cancels)].name)
+				launch()
+				hedge.Reset(p.hedgeDelay)
+			}
+		case a := <-attempts:
+			pending--
+			if a.err == nil {
+				abandon(a.index)
+				return cancelOnClose{ReadCloser: a.body, cancel: cancels[a.index]}, nil
+			}
+
+			cancels[a.index]()
+			errs = append(errs, fmt.Sprintf("%s: %s", a.source.name, a.err))
+
+			// Don't wait out the hedge delay if a source failed outright
+			if len(cancels) < len(sources) {
+				launch()
+				if !hedge.Stop() {
+					select {
+					case <-hedge.C:
+					default:
+					}
+				}
+				hedge.Reset(p.hedgeDelay)
+			}
+		}
+	}
+
+	return nil, fmt.Errorf("failed to get %s from IPFS: %s", path, strings.Join(errs, "; "))
+}
+
+// cancelOnClose releases a request's context once its body is closed
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
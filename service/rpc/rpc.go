@@ -622,7 +622,7 @@ func DecodeMetadataFromURI(ctx context.Context, turi persist.TokenURI, into *per
 			return err
 		}
 		query := parsedURL.Query().Get("arg")
-		it, err := ipfsClient.Cat(query)
+		it, err := GetIPFSResponse(ctx, ipfsClient, query)
 		if err != nil {
 			return err
 		}
@@ -641,59 +641,10 @@ func DecodeMetadataFromURI(ctx context.Context, turi persist.TokenURI, into *per
 
 }
 
+// GetIPFSResponse returns the content at an IPFS path. The request is raced across the configured gateways,
+// any local IPFS node, and the ipfsClient's API if one is provided.
 func GetIPFSResponse(pCtx context.Context, ipfsClient *shell.Shell, path string) (io.ReadCloser, error) {
-	// Either an io.ReadCloser or an error
-	responseCh := make(chan interface{})
-
-	// Via HTTP gateway
-	go func() {
-		url := fmt.Sprintf("%s/ipfs/%s", env.GetString("IPFS_URL"), path)
-		req, err := http.NewRequestWithContext(pCtx, "GET", url, nil)
-		if err != nil {
-			responseCh <- err
-			return
-		}
-		resp, err := defaultHTTPClient.Do(req)
-		if err != nil {
-			responseCh <- err
-			return
-		}
-		if resp.StatusCode > 399 || resp.StatusCode < 200 {
-			responseCh <- ErrHTTP{Status: resp.StatusCode, URL: url}
-		}
-		responseCh <- resp.Body
-	}()
-
-	// Via IPFS cat
-	go func() {
-		if reader, err := ipfsClient.Cat(path); err != nil {
-			responseCh <- err
-		} else {
-			responseCh <- reader
-		}
-	}()
-
-	// Check if we can return the first reply
-	reply := <-responseCh
-	if result, ok := reply.(io.ReadCloser); ok {
-
-		// Close the second reply if we get one
-		go func() {
-			reply = <-responseCh
-			if result, ok := reply.(io.ReadCloser); ok {
-				result.Close()
-			}
-		}()
-
-		return result, nil
-	}
-
-	// Otherwise wait for the second reply
-	reply = <-responseCh
-	if result, ok := reply.(io.ReadCloser); ok {
-		return result, nil
-	}
-	return nil, reply.(error)
+	return getIPFSPool().fetch(pCtx, ipfsClient, path)
 }
 
 func GetIPFSData(pCtx context.Context, ipfsClient *shell.Shell, path string) ([]byte, error) {
@@ -769,7 +720,7 @@ func getContentHeaders(ctx context.Context, url string) (contentType string, con
 
 // GetIPFSHeaders returns the headers for the given IPFS hash
 func GetIPFSHeaders(ctx context.Context, path string) (contentType string, contentLength int64, err error) {
-	url := fmt.Sprintf("%s/ipfs/%s", env.GetString("IPFS_URL"), path)
+	url := fmt.Sprintf("%s/ipfs/%s", getIPFSPool().bestGateway(), path)
 	return getContentHeaders(ctx, url)
 }
 
@@ -0,0 +1,73 @@
+package rpc
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestGateway(t *testing.T, delay time.Duration, status int, body string) *httptest.Server {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(delay):
+		case <-r.Context().Done():
+			return
+		}
+		w.WriteHeader(status)
+		w.Write([]byte(body))
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+func readIPFS(t *testing.T, pool *ipfsPool) (string, error) {
+	resp, err := pool.fetch(context.Background(), nil, "QmTest")
+	if err != nil {
+		return "", err
+	}
+	defer resp.Close()
+	bs, err := io.ReadAll(resp)
+	require.NoError(t, err)
+	return string(bs), nil
+}
+
+func TestIPFSPool_HedgesSlowGateway(t *testing.T) {
+	slow := newIPFSGatewaySource(newTestGateway(t, 2*time.Second, http.StatusOK, "slow").URL, http.DefaultClient)
+	fast := newIPFSGatewaySource(newTestGateway(t, 0, http.StatusOK, "fast").URL, http.DefaultClient)
+	pool := newIPFSPool([]*ipfsSource{slow, fast}, nil, 50*time.Millisecond)
+
+	start := time.Now()
+	body, err := readIPFS(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "fast", body)
+	assert.Less(t, time.Since(start), time.Second)
+
+	// The slow gateway was cancelled rather than failing, so only the fast gateway's score changed
+	assert.Greater(t, fast.score(), slow.score())
+	assert.Equal(t, fast, pool.ranked(nil)[0])
+}
+
+func TestIPFSPool_FallsBackOnFailure(t *testing.T) {
+	broken := newIPFSGatewaySource(newTestGateway(t, 0, http.StatusBadGateway, "").URL, http.DefaultClient)
+	working := newIPFSGatewaySource(newTestGateway(t, 0, http.StatusOK, "ok").URL, http.DefaultClient)
+	pool := newIPFSPool([]*ipfsSource{broken, working}, nil, time.Minute)
+
+	body, err := readIPFS(t, pool)
+	require.NoError(t, err)
+	assert.Equal(t, "ok", body)
+	assert.Less(t, broken.score(), working.score())
+}
+
+func TestIPFSPool_AllSourcesFail(t *testing.T) {
+	broken := newIPFSGatewaySource(newTestGateway(t, 0, http.StatusNotFound, "").URL, http.DefaultClient)
+	pool := newIPFSPool([]*ipfsSource{broken}, nil, time.Minute)
+
+	_, err := readIPFS(t, pool)
+	assert.Error(t, err)
+}
@@ -2,8 +2,21 @@ package socialauth
 
 import (
 	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
 
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/redis"
 	"github.com/mikeydub/go-gallery/service/twitter"
@@ -57,3 +70,208 @@ func (a TwitterAuthenticator) Authenticate(ctx context.Context) (*SocialAuthResu
 		},
 	}, nil
 }
+
+// telegramAuthMaxAge is how long the data from a Telegram login is accepted for, so that it can't be replayed later
+const telegramAuthMaxAge = 24 * time.Hour
+
+var errInvalidTelegramAuth = errors.New("telegram login data isn't signed by the bot")
+var errExpiredTelegramAuth = errors.New("telegram login data has expired")
+
+// ErrTelegramNotConfigured is returned when there's no bot token to check Telegram logins with. Without one any
+// login data could be signed with an empty key, so logins are refused instead.
+var ErrTelegramNotConfigured = errors.New("telegram bot token isn't configured")
+
+// TelegramAuthData is what the Telegram Login Widget returns once a user logs in. Hash signs the other fields with
+// the bot's token.
+type TelegramAuthData struct {
+	ID        int64
+	FirstName string
+	LastName  string
+	Username  string
+	PhotoURL  string
+	AuthDate  int64
+	Hash      string
+}
+
+// TelegramAuthenticator links the Telegram account that logged in through the Login Widget. Users are asked to let
+// the bot message them when logging in, so notifications can be sent to their chat with the bot.
+type TelegramAuthenticator struct {
+	BotToken string
+	AuthData TelegramAuthData
+}
+
+func (a TelegramAuthenticator) Authenticate(ctx context.Context) (*SocialAuthResult, error) {
+	if a.BotToken == "" {
+		return nil, ErrTelegramNotConfigured
+	}
+
+	if err := a.AuthData.verify(a.BotToken, time.Now()); err != nil {
+		return nil, err
+	}
+
+	name := strings.TrimSpace(a.AuthData.FirstName + " " + a.AuthData.LastName)
+
+	return &SocialAuthResult{
+		Provider: persist.SocialProviderTelegram,
+		ID:       strconv.FormatInt(a.AuthData.ID, 10),
+		Metadata: map[string]interface{}{
+			"username":          a.AuthData.Username,
+			"name":              name,
+			"profile_image_url": a.AuthData.PhotoURL,
+		},
+	}, nil
+}
+
+// verify checks that the data was signed with the bot's token as described in
+// https://core.telegram.org/widgets/login#checking-authorization
+func (d TelegramAuthData) verify(botToken string, now time.Time) error {
+	fields := map[string]string{
+		"id":         strconv.FormatInt(d.ID, 10),
+		"first_name": d.FirstName,
+		"last_name":  d.LastName,
+		"username":   d.Username,
+		"photo_url":  d.PhotoURL,
+		"auth_date":  strconv.FormatInt(d.AuthDate, 10),
+	}
+
+	// Fields that Telegram didn't send aren't signed
+	lines := make([]string, 0, len(fields))
+	for k, v := range fields {
+		if v != "" {
+			lines = append(lines, k+"="+v)
+		}
+	}
+	sort.Strings(lines)
+
+	secret := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(strings.Join(lines, "\n")))
+
+	expected, err := hex.DecodeString(d.Hash)
+	if err != nil || !hmac.Equal(mac.Sum(nil), expected) {
+		return errInvalidTelegramAuth
+	}
+
+	if now.Sub(time.Unix(d.AuthDate, 0)) > telegramAuthMaxAge {
+		return errExpiredTelegramAuth
+	}
+
+	return nil
+}
+
+// DiscordAuthenticator links the Discord account that authorized Gallery with an OAuth code
+type DiscordAuthenticator struct {
+	Queries *coredb.Queries
+
+	UserID   persist.DBID
+	AuthCode string
+}
+
+type discordAccessTokenResponse struct {
+	AccessToken  string `json:"access_token"`
+	RefreshToken string `json:"refresh_token"`
+}
+
+type discordUser struct {
+	ID         string `json:"id"`
+	Username   string `json:"username"`
+	GlobalName string `json:"global_name"`
+	Avatar     string `json:"avatar"`
+}
+
+func (a DiscordAuthenticator) Authenticate(ctx context.Context) (*SocialAuthResult, error) {
+	host := strings.TrimSuffix(env.GetString("DISCORD_API_HOST"), "/")
+
+	access, err := exchangeDiscordCode(ctx, host, a.AuthCode)
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := getDiscordUser(ctx, host, access.AccessToken)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.Queries.UpsertSocialOAuth(ctx, coredb.UpsertSocialOAuthParams{
+		ID:           persist.GenerateID(),
+		UserID:       a.UserID,
+		Provider:     persist.SocialProviderDiscord,
+		AccessToken:  util.ToNullString(access.AccessToken),
+		RefreshToken: util.ToNullString(access.RefreshToken),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var profileImageURL string
+	if user.Avatar != "" {
+		profileImageURL = fmt.Sprintf("https://cdn.discordapp.com/avatars/%s/%s.png", user.ID, user.Avatar)
+	}
+
+	return &SocialAuthResult{
+		Provider: persist.SocialProviderDiscord,
+		ID:       user.ID,
+		Metadata: map[string]interface{}{
+			"username":          user.Username,
+			"name":              user.GlobalName,
+			"profile_image_url": profileImageURL,
+		},
+	}, nil
+}
+
+func exchangeDiscordCode(ctx context.Context, host, code string) (discordAccessTokenResponse, error) {
+	q := url.Values{}
+	q.Set("client_id", env.GetString("DISCORD_CLIENT_ID"))
+	q.Set("client_secret", env.GetString("DISCORD_CLIENT_SECRET"))
+	q.Set("grant_type", "authorization_code")
+	q.Set("code", code)
+	q.Set("redirect_uri", env.GetString("DISCORD_AUTH_REDIRECT_URI"))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, host+"/oauth2/token", strings.NewReader(q.Encode()))
+	if err != nil {
+		return discordAccessTokenResponse{}, fmt.Errorf("failed to create access token request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return discordAccessTokenResponse{}, fmt.Errorf("failed to get access token: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return discordAccessTokenResponse{}, fmt.Errorf("failed to get access token, returned status: %s", util.GetErrFromResp(resp))
+	}
+
+	var access discordAccessTokenResponse
+	if err := json.NewDecoder(resp.Body).Decode(&access); err != nil {
+		return discordAccessTokenResponse{}, err
+	}
+
+	return access, nil
+}
+
+func getDiscordUser(ctx context.Context, host, accessToken string) (discordUser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, host+"/users/@me", nil)
+	if err != nil {
+		return discordUser{}, err
+	}
+	req.Header.Set("Authorization", "Bearer "+accessToken)
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return discordUser{}, fmt.Errorf("failed to get discord user: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return discordUser{}, fmt.Errorf("failed to get discord user, returned status: %s", util.GetErrFromResp(resp))
+	}
+
+	var user discordUser
+	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
+		return discordUser{}, err
+	}
+
+	return user, nil
+}
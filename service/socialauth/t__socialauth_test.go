@@ -0,0 +1,92 @@
+package socialauth
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTelegramAuthenticator_Success(t *testing.T) {
+	now := time.Now()
+	data := TelegramAuthData{ID: 12345, FirstName: "Alice", Username: "alice", AuthDate: now.Unix()}
+	data.Hash = signTelegramAuth("auth_date="+strconv.FormatInt(now.Unix(), 10)+"\nfirst_name=Alice\nid=12345\nusername=alice", "bot-token")
+
+	t.Run("accepts data signed by the bot", func(t *testing.T) {
+		res, err := TelegramAuthenticator{BotToken: "bot-token", AuthData: data}.Authenticate(context.Background())
+		require.NoError(t, err)
+		assert.Equal(t, "12345", res.ID)
+		assert.Equal(t, "alice", res.Metadata["username"])
+		assert.Equal(t, "Alice", res.Metadata["name"])
+	})
+
+	t.Run("rejects data signed by another bot", func(t *testing.T) {
+		_, err := TelegramAuthenticator{BotToken: "other-token", AuthData: data}.Authenticate(context.Background())
+		assert.ErrorIs(t, err, errInvalidTelegramAuth)
+	})
+
+	t.Run("rejects data that was changed", func(t *testing.T) {
+		changed := data
+		changed.ID = 67890
+		_, err := TelegramAuthenticator{BotToken: "bot-token", AuthData: changed}.Authenticate(context.Background())
+		assert.ErrorIs(t, err, errInvalidTelegramAuth)
+	})
+
+	t.Run("rejects old logins", func(t *testing.T) {
+		err := data.verify("bot-token", now.Add(telegramAuthMaxAge+time.Minute))
+		assert.ErrorIs(t, err, errExpiredTelegramAuth)
+	})
+
+	t.Run("rejects logins without a bot token", func(t *testing.T) {
+		unsigned := data
+		unsigned.Hash = signTelegramAuth("auth_date="+strconv.FormatInt(now.Unix(), 10)+"\nfirst_name=Alice\nid=12345\nusername=alice", "")
+		_, err := TelegramAuthenticator{AuthData: unsigned}.Authenticate(context.Background())
+		assert.ErrorIs(t, err, ErrTelegramNotConfigured)
+	})
+}
+
+func TestDiscordAuth_Success(t *testing.T) {
+	viper.Set("DISCORD_CLIENT_ID", "client-id")
+	viper.Set("DISCORD_CLIENT_SECRET", "client-secret")
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/oauth2/token":
+			r.ParseForm()
+			assert.Equal(t, "client-id", r.Form.Get("client_id"))
+			assert.Equal(t, "code", r.Form.Get("code"))
+			json.NewEncoder(w).Encode(discordAccessTokenResponse{AccessToken: "access", RefreshToken: "refresh"})
+		case "/users/@me":
+			assert.Equal(t, "Bearer access", r.Header.Get("Authorization"))
+			json.NewEncoder(w).Encode(discordUser{ID: "67890", Username: "alice"})
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer server.Close()
+
+	access, err := exchangeDiscordCode(context.Background(), server.URL, "code")
+	require.NoError(t, err)
+	assert.Equal(t, "refresh", access.RefreshToken)
+
+	user, err := getDiscordUser(context.Background(), server.URL, access.AccessToken)
+	require.NoError(t, err)
+	assert.Equal(t, discordUser{ID: "67890", Username: "alice"}, user)
+}
+
+func signTelegramAuth(dataCheckString, botToken string) string {
+	secret := sha256.Sum256([]byte(botToken))
+	mac := hmac.New(sha256.New, secret[:])
+	mac.Write([]byte(dataCheckString))
+	return hex.EncodeToString(mac.Sum(nil))
+}
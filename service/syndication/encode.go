@@ -0,0 +1,261 @@
+package syndication
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// Encode writes the feed in the given format.
+func Encode(w io.Writer, f Format, feed Feed) error {
+	switch f {
+	case FormatRSS:
+		return encodeXML(w, toRSS(feed))
+	case FormatAtom:
+		return encodeXML(w, toAtom(feed))
+	case FormatJSON:
+		enc := json.NewEncoder(w)
+		enc.SetEscapeHTML(false)
+		return enc.Encode(toJSONFeed(feed))
+	}
+	return ErrUnknownFormat{Format: string(f)}
+}
+
+func encodeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	return enc.Encode(v)
+}
+
+// RSS 2.0, see https://www.rssboard.org/rss-specification
+
+type rss struct {
+	XMLName xml.Name   `xml:"rss"`
+	Version string     `xml:"version,attr"`
+	Atom    string     `xml:"xmlns:atom,attr"`
+	Content string     `xml:"xmlns:content,attr"`
+	DC      string     `xml:"xmlns:dc,attr"`
+	Channel rssChannel `xml:"channel"`
+}
+
+type rssChannel struct {
+	Title         string    `xml:"title"`
+	Link          string    `xml:"link"`
+	Description   string    `xml:"description"`
+	SelfLink      atomLink  `xml:"atom:link"`
+	LastBuildDate string    `xml:"lastBuildDate,omitempty"`
+	Items         []rssItem `xml:"item"`
+}
+
+type rssItem struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	Content     *cdata        `xml:"content:encoded,omitempty"`
+	Author      string        `xml:"dc:creator,omitempty"`
+	GUID        rssGUID       `xml:"guid"`
+	PubDate     string        `xml:"pubDate"`
+	Enclosure   *rssEnclosure `xml:"enclosure,omitempty"`
+}
+
+type rssGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type rssEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int    `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+func toRSS(feed Feed) rss {
+	channel := rssChannel{
+		Title:       feed.Title,
+		Link:        feed.Link,
+		Description: feed.Description,
+		SelfLink:    atomLink{Href: feed.URL, Rel: "self", Type: FormatRSS.mediaType()},
+		Items:       make([]rssItem, 0, len(feed.Items)),
+	}
+	if !feed.Updated.IsZero() {
+		channel.LastBuildDate = feed.Updated.UTC().Format(time.RFC1123Z)
+	}
+
+	for _, item := range feed.Items {
+		i := rssItem{
+			Title:       item.Title,
+			Link:        item.Link,
+			Description: item.Summary,
+			Author:      item.Author,
+			GUID:        rssGUID{Value: item.guid()},
+			PubDate:     item.Published.UTC().Format(time.RFC1123Z),
+		}
+		if item.Content != "" {
+			i.Content = &cdata{item.Content}
+		}
+		if item.Image != "" {
+			// The length of the image isn't known without fetching it, which the spec allows to be 0
+			i.Enclosure = &rssEnclosure{URL: item.Image, Type: "image/jpeg"}
+		}
+		channel.Items = append(channel.Items, i)
+	}
+
+	return rss{
+		Version: "2.0",
+		Atom:    "http://www.w3.org/2005/Atom",
+		Content: "http://purl.org/rss/1.0/modules/content/",
+		DC:      "http://purl.org/dc/elements/1.1/",
+		Channel: channel,
+	}
+}
+
+// Atom, see https://datatracker.ietf.org/doc/html/rfc4287
+
+type atomFeed struct {
+	XMLName  xml.Name    `xml:"http://www.w3.org/2005/Atom feed"`
+	ID       string      `xml:"id"`
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle,omitempty"`
+	Updated  string      `xml:"updated"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntry struct {
+	ID        string      `xml:"id"`
+	Title     string      `xml:"title"`
+	Links     []atomLink  `xml:"link"`
+	Author    *atomAuthor `xml:"author,omitempty"`
+	Published string      `xml:"published"`
+	Updated   string      `xml:"updated"`
+	Summary   string      `xml:"summary,omitempty"`
+	Content   *atomText   `xml:"content,omitempty"`
+}
+
+type atomAuthor struct {
+	Name string `xml:"name"`
+}
+
+type atomText struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+func toAtom(feed Feed) atomFeed {
+	updated := feed.Updated
+	if updated.IsZero() {
+		// Atom requires that a feed has an updated time, so an empty feed is as new as the epoch
+		updated = time.Unix(0, 0)
+	}
+
+	f := atomFeed{
+		ID:       feed.URL,
+		Title:    feed.Title,
+		Subtitle: feed.Description,
+		Updated:  updated.UTC().Format(time.RFC3339),
+		Links: []atomLink{
+			{Href: feed.Link, Rel: "alternate", Type: "text/html"},
+			{Href: feed.URL, Rel: "self", Type: FormatAtom.mediaType()},
+		},
+		Entries: make([]atomEntry, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		entry := atomEntry{
+			ID:        item.guid(),
+			Title:     item.Title,
+			Links:     []atomLink{{Href: item.Link, Rel: "alternate", Type: "text/html"}},
+			Published: item.Published.UTC().Format(time.RFC3339),
+			Updated:   item.Updated.UTC().Format(time.RFC3339),
+			Summary:   item.Summary,
+		}
+		if item.Author != "" {
+			entry.Author = &atomAuthor{Name: item.Author}
+		}
+		if item.Content != "" {
+			entry.Content = &atomText{Type: "html", Value: item.Content}
+		}
+		if item.Image != "" {
+			entry.Links = append(entry.Links, atomLink{Href: item.Image, Rel: "enclosure"})
+		}
+		f.Entries = append(f.Entries, entry)
+	}
+
+	return f
+}
+
+// JSON Feed, see https://www.jsonfeed.org/version/1.1/
+
+type jsonFeed struct {
+	Version     string         `json:"version"`
+	Title       string         `json:"title"`
+	HomePageURL string         `json:"home_page_url"`
+	FeedURL     string         `json:"feed_url"`
+	Description string         `json:"description,omitempty"`
+	Items       []jsonFeedItem `json:"items"`
+}
+
+type jsonFeedItem struct {
+	ID            string           `json:"id"`
+	URL           string           `json:"url"`
+	Title         string           `json:"title"`
+	ContentHTML   string           `json:"content_html,omitempty"`
+	ContentText   string           `json:"content_text,omitempty"`
+	Summary       string           `json:"summary,omitempty"`
+	Image         string           `json:"image,omitempty"`
+	DatePublished string           `json:"date_published"`
+	DateModified  string           `json:"date_modified"`
+	Authors       []jsonFeedAuthor `json:"authors,omitempty"`
+}
+
+type jsonFeedAuthor struct {
+	Name string `json:"name"`
+}
+
+func toJSONFeed(feed Feed) jsonFeed {
+	f := jsonFeed{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       feed.Title,
+		HomePageURL: feed.Link,
+		FeedURL:     feed.URL,
+		Description: feed.Description,
+		Items:       make([]jsonFeedItem, 0, len(feed.Items)),
+	}
+
+	for _, item := range feed.Items {
+		i := jsonFeedItem{
+			ID:            item.guid(),
+			URL:           item.Link,
+			Title:         item.Title,
+			ContentHTML:   item.Content,
+			Summary:       item.Summary,
+			Image:         item.Image,
+			DatePublished: item.Published.UTC().Format(time.RFC3339),
+			DateModified:  item.Updated.UTC().Format(time.RFC3339),
+		}
+		// An item must have some content, so fall back to the title if there isn't anything else to show
+		if i.ContentHTML == "" {
+			i.ContentText = item.Title
+		}
+		if item.Author != "" {
+			i.Authors = []jsonFeedAuthor{{Name: item.Author}}
+		}
+		f.Items = append(f.Items, i)
+	}
+
+	return f
+}
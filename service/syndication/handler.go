@@ -0,0 +1,339 @@
+package syndication
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/publicapi"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/mediamapper"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+)
+
+// maxAge is how long clients and caches can reuse a feed before checking if it changed
+const maxAge = 5 * time.Minute
+
+// UserFeed serves the feed events of a single user.
+func UserFeed(galleryHost string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, ok := formatParam(c)
+		if !ok {
+			return
+		}
+
+		api := publicapi.For(c)
+
+		user, err := api.User.GetUserByUsername(c, c.Param("username"))
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		events, _, err := api.Feed.PaginateUserFeed(c, user.ID, nil, nil, util.ToPointer(feedSize), nil)
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		r := &renderer{galleryHost: galleryHost}
+		feed := Feed{
+			Title:       fmt.Sprintf("%s on Gallery", user.Username.String),
+			Description: user.Bio.String,
+			Link:        r.profileURL(user.Username.String),
+		}
+
+		serve(c, format, feed, events, r)
+	}
+}
+
+// CollectionFeed serves the feed events that changed a collection, including the parts of gallery updates that
+// were about the collection.
+func CollectionFeed(galleryHost string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, ok := formatParam(c)
+		if !ok {
+			return
+		}
+
+		api := publicapi.For(c)
+
+		collection, err := api.Collection.GetCollectionById(c, persist.DBID(c.Param("collectionID")))
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		owner, err := api.User.GetUserById(c, collection.OwnerUserID)
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		events, _, err := api.Feed.PaginateCollectionFeed(c, collection.ID, nil, nil, util.ToPointer(feedSize), nil)
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		name := collection.Name.String
+		if name == "" {
+			name = "Untitled collection"
+		}
+
+		r := &renderer{galleryHost: galleryHost, collectionID: collection.ID}
+		feed := Feed{
+			Title:       fmt.Sprintf("%s by %s on Gallery", name, owner.Username.String),
+			Description: collection.CollectorsNote.String,
+			Link:        r.collectionURL(owner.Username.String, collection.ID),
+		}
+
+		serve(c, format, feed, events, r)
+	}
+}
+
+// GlobalFeed serves the latest feed events from everyone on Gallery.
+func GlobalFeed(galleryHost string) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		format, ok := formatParam(c)
+		if !ok {
+			return
+		}
+
+		events, _, err := publicapi.For(c).Feed.PaginateGlobalFeed(c, nil, nil, util.ToPointer(feedSize), nil)
+		if err != nil {
+			errResponse(c, err)
+			return
+		}
+
+		feed := Feed{
+			Title:       "Gallery",
+			Description: "The latest from collectors on Gallery",
+			Link:        galleryHost,
+		}
+
+		serve(c, format, feed, events, &renderer{galleryHost: galleryHost})
+	}
+}
+
+func formatParam(c *gin.Context) (Format, bool) {
+	format := Format(c.Param("format"))
+	if !format.IsValid() {
+		util.ErrResponse(c, http.StatusNotFound, ErrUnknownFormat{Format: string(format)})
+		return "", false
+	}
+	return format, true
+}
+
+func errResponse(c *gin.Context, err error) {
+	switch err.(type) {
+	case persist.ErrUserNotFound, persist.ErrCollectionNotFoundByID:
+		util.ErrResponse(c, http.StatusNotFound, err)
+	default:
+		util.ErrResponse(c, http.StatusInternalServerError, err)
+	}
+}
+
+// serve writes the feed, or tells the client that the copy it already has is still current. The events are compared
+// before anything they refer to is loaded, so a client that is polling a feed that hasn't changed is cheap to answer.
+func serve(c *gin.Context, format Format, feed Feed, events []db.FeedEvent, r *renderer) {
+	etag, lastModified := validators(format, events)
+
+	c.Header("ETag", etag)
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(maxAge.Seconds())))
+	if !lastModified.IsZero() {
+		c.Header("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+	}
+
+	if notModified(c.Request, etag, lastModified) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	r.mm = mediamapper.For(c)
+	if err := load(c, publicapi.For(c), r, events); err != nil {
+		errResponse(c, err)
+		return
+	}
+
+	feed.URL = requestURL(c.Request)
+	feed.Updated = lastModified
+	feed.Items = r.items(events)
+
+	c.Header("Content-Type", format.ContentType())
+	c.Status(http.StatusOK)
+	if err := Encode(c.Writer, format, feed); err != nil {
+		logger.For(c).Errorf("failed to encode %s feed: %s", format, err)
+	}
+}
+
+// validators returns the ETag and Last-Modified time of a feed made from the given events. An event is changed
+// whenever it's updated, e.g. when its caption is edited, and the ETag also changes when an event is removed.
+func validators(format Format, events []db.FeedEvent) (string, time.Time) {
+	var lastModified time.Time
+
+	h := sha256.New()
+	h.Write([]byte(format))
+	for _, event := range events {
+		h.Write([]byte(event.ID))
+		binary.Write(h, binary.BigEndian, event.LastUpdated.UnixNano())
+
+		if event.EventTime.After(lastModified) {
+			lastModified = event.EventTime
+		}
+		if event.LastUpdated.After(lastModified) {
+			lastModified = event.LastUpdated
+		}
+	}
+
+	// The feed is rendered from more than the events themselves, e.g. usernames and collection names, so it's only
+	// weakly equivalent to another feed with the same events
+	return fmt.Sprintf(`W/"%s"`, hex.EncodeToString(h.Sum(nil))[:32]), lastModified
+}
+
+// notModified reports whether the client's copy of the feed is current. If-Modified-Since is only considered when the
+// client didn't send an ETag to compare, as per RFC 7232.
+func notModified(req *http.Request, etag string, lastModified time.Time) bool {
+	if match := req.Header.Get("If-None-Match"); match != "" {
+		for _, candidate := range strings.Split(match, ",") {
+			candidate = strings.TrimSpace(candidate)
+			if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+				return true
+			}
+		}
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+
+	since, err := http.ParseTime(req.Header.Get("If-Modified-Since"))
+	if err != nil {
+		return false
+	}
+
+	// HTTP dates only have second precision
+	return !lastModified.Truncate(time.Second).After(since)
+}
+
+func requestURL(req *http.Request) string {
+	scheme := "https"
+	if proto := req.Header.Get("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	} else if req.TLS == nil {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s%s", scheme, req.Host, req.URL.Path)
+}
+
+// load fetches everything that the events refer to. Things that have since been deleted are left out, and the
+// parts of events that refer to them aren't rendered.
+func load(ctx context.Context, api *publicapi.PublicAPI, r *renderer, events []db.FeedEvent) error {
+	r.users = make(map[persist.DBID]db.User)
+	r.collections = make(map[persist.DBID]db.Collection)
+	r.tokens = make(map[persist.DBID]db.Token)
+	r.reposted = make(map[persist.DBID]db.FeedEvent)
+
+	all := make([]db.FeedEvent, 0, len(events))
+	for _, event := range events {
+		all = append(all, event)
+
+		repostedID := event.Data.RepostedFeedEventID
+		if repostedID == "" {
+			continue
+		}
+		if _, ok := r.reposted[repostedID]; ok {
+			continue
+		}
+
+		original, err := api.Feed.GetFeedEventById(ctx, repostedID)
+		if err != nil {
+			if _, ok := err.(persist.ErrFeedEventNotFoundByID); ok {
+				continue
+			}
+			return err
+		}
+		r.reposted[repostedID] = *original
+		all = append(all, *original)
+	}
+
+	var userIDs, collectionIDs, tokenIDs []persist.DBID
+	for _, event := range all {
+		data := event.Data
+		userIDs = append(userIDs, event.OwnerID)
+		userIDs = append(userIDs, data.UserFollowedIDs...)
+		collectionIDs = append(collectionIDs, data.CollectionID, data.TokenCollectionID)
+		collectionIDs = append(collectionIDs, data.GalleryNewCollections...)
+		tokenIDs = append(tokenIDs, data.TokenID)
+		tokenIDs = append(tokenIDs, data.CollectionTokenIDs...)
+		tokenIDs = append(tokenIDs, data.AcquiredTokenIDs...)
+		tokenIDs = append(tokenIDs, data.MintedTokenIDs...)
+		for collectionID, ids := range data.GalleryNewCollectionTokenIDs {
+			collectionIDs = append(collectionIDs, collectionID)
+			tokenIDs = append(tokenIDs, ids...)
+		}
+		for collectionID := range data.GalleryNewCollectionCollectorsNotes {
+			collectionIDs = append(collectionIDs, collectionID)
+		}
+		for collectionID, notes := range data.GalleryNewCollectionTokenCollectorsNotes {
+			collectionIDs = append(collectionIDs, collectionID)
+			for tokenID := range notes {
+				tokenIDs = append(tokenIDs, tokenID)
+			}
+		}
+	}
+
+	for _, userID := range distinct(userIDs) {
+		user, err := api.User.GetUserById(ctx, userID)
+		if err != nil {
+			if _, ok := err.(persist.ErrUserNotFound); ok {
+				continue
+			}
+			return err
+		}
+		r.users[userID] = *user
+	}
+
+	collectionIDs = distinct(collectionIDs)
+	collections, errs := api.Collection.GetCollectionsByIds(ctx, collectionIDs)
+	for i, err := range errs {
+		if err != nil {
+			if _, ok := err.(persist.ErrCollectionNotFoundByID); ok {
+				continue
+			}
+			return err
+		}
+		r.collections[collectionIDs[i]] = *collections[i]
+	}
+
+	tokens, err := api.Token.GetTokensByIDs(ctx, distinct(tokenIDs))
+	if err != nil {
+		return err
+	}
+	for _, token := range tokens {
+		r.tokens[token.ID] = token
+	}
+
+	return nil
+}
+
+func distinct(ids []persist.DBID) []persist.DBID {
+	result := make([]persist.DBID, 0, len(ids))
+	seen := make(map[persist.DBID]bool)
+	for _, id := range ids {
+		if id != "" && !seen[id] {
+			result = append(result, id)
+			seen[id] = true
+		}
+	}
+	return result
+}
@@ -0,0 +1,411 @@
+package syndication
+
+import (
+	"fmt"
+	"html"
+	"sort"
+	"strings"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/mediamapper"
+	"github.com/mikeydub/go-gallery/service/moderation"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// maxThumbnails is the most token thumbnails that are shown in a single item
+const maxThumbnails = 4
+
+// renderer turns feed events into feed items. Everything that the events refer to is loaded before rendering,
+// so an event that refers to something that no longer exists is rendered without it.
+type renderer struct {
+	galleryHost string
+	// mm may be nil when image urls can't be signed, in which case thumbnails link to the media itself
+	mm          *mediamapper.MediaMapper
+	users       map[persist.DBID]db.User
+	collections map[persist.DBID]db.Collection
+	tokens      map[persist.DBID]db.Token
+	reposted    map[persist.DBID]db.FeedEvent
+	// collectionID limits a gallery update to the parts about a single collection when rendering a collection's feed
+	collectionID persist.DBID
+}
+
+// itemBuilder writes the content of an item one paragraph at a time, and keeps track of the tokens it mentions.
+type itemBuilder struct {
+	title      string
+	link       string
+	paragraphs []string
+	tokens     []tokenRef
+}
+
+// tokenRef is a token that an item shows, along with the collection it was shown in so that it can be linked to.
+type tokenRef struct {
+	tokenID      persist.DBID
+	collectionID persist.DBID
+}
+
+func (b *itemBuilder) add(format string, args ...interface{}) {
+	b.paragraphs = append(b.paragraphs, "<p>"+fmt.Sprintf(format, args...)+"</p>")
+}
+
+func (b *itemBuilder) quote(text string) {
+	if text = strings.TrimSpace(text); text != "" {
+		b.paragraphs = append(b.paragraphs, "<blockquote>"+strings.ReplaceAll(html.EscapeString(text), "\n", "<br>")+"</blockquote>")
+	}
+}
+
+func (b *itemBuilder) addTokens(collectionID persist.DBID, tokenIDs ...persist.DBID) {
+	for _, id := range tokenIDs {
+		b.tokens = append(b.tokens, tokenRef{tokenID: id, collectionID: collectionID})
+	}
+}
+
+// items renders the events that can be shown, in the order they were given.
+func (r *renderer) items(events []db.FeedEvent) []Item {
+	items := make([]Item, 0, len(events))
+	for _, event := range events {
+		if item, ok := r.item(event); ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func (r *renderer) item(event db.FeedEvent) (Item, bool) {
+	owner, ok := r.username(event.OwnerID)
+	if !ok {
+		return Item{}, false
+	}
+
+	var b itemBuilder
+	b.quote(event.Caption.String)
+
+	if event.Action == persist.ActionRepostedFeedEvent {
+		original, ok := r.reposted[event.Data.RepostedFeedEventID]
+		if !ok {
+			return Item{}, false
+		}
+		originalOwner, ok := r.username(original.OwnerID)
+		if !ok {
+			return Item{}, false
+		}
+
+		var inner itemBuilder
+		inner.quote(original.Caption.String)
+		if !r.write(&inner, originalOwner, original) {
+			return Item{}, false
+		}
+
+		b.title = fmt.Sprintf("%s reposted a post by %s", owner, originalOwner)
+		b.link = inner.link
+		b.add(`%s reposted <a href="%s">%s</a>`, r.userLink(owner), html.EscapeString(inner.link), html.EscapeString(inner.title))
+		b.paragraphs = append(b.paragraphs, "<blockquote>"+strings.Join(inner.paragraphs, "")+"</blockquote>")
+		b.tokens = inner.tokens
+	} else if !r.write(&b, owner, event) {
+		return Item{}, false
+	}
+
+	item := Item{
+		ID:        event.ID,
+		Title:     b.title,
+		Link:      b.link,
+		Author:    owner,
+		Summary:   strings.TrimSpace(event.Caption.String),
+		Published: event.EventTime,
+		Updated:   event.LastUpdated,
+	}
+
+	// Captions can be changed after an event is posted, so an event is as new as its latest change
+	if item.Updated.Before(item.Published) {
+		item.Updated = item.Published
+	}
+
+	thumbnails := r.thumbnails(owner, b.tokens)
+	if len(thumbnails) > 0 {
+		item.Image = thumbnails[0].url
+		b.paragraphs = append(b.paragraphs, thumbnailParagraph(thumbnails))
+	}
+	item.Content = strings.Join(b.paragraphs, "")
+
+	return item, true
+}
+
+// write adds the title, link and content of an event to the builder, and reports whether the event could be rendered.
+func (r *renderer) write(b *itemBuilder, owner string, event db.FeedEvent) bool {
+	data := event.Data
+	b.link = r.profileURL(owner)
+
+	switch event.Action {
+	case persist.ActionUserCreated:
+		b.title = fmt.Sprintf("%s joined Gallery", owner)
+		b.add("%s joined Gallery", r.userLink(owner))
+		b.quote(data.UserBio)
+
+	case persist.ActionUserFollowedUsers:
+		followed := make([]string, 0, len(data.UserFollowedIDs))
+		links := make([]string, 0, len(data.UserFollowedIDs))
+		for _, id := range data.UserFollowedIDs {
+			if username, ok := r.username(id); ok {
+				followed = append(followed, username)
+				links = append(links, r.userLink(username))
+			}
+		}
+		if len(followed) == 0 {
+			return false
+		}
+		if len(followed) == 1 {
+			b.title = fmt.Sprintf("%s followed %s", owner, followed[0])
+			b.link = r.profileURL(followed[0])
+		} else {
+			b.title = fmt.Sprintf("%s followed %d collectors", owner, len(followed))
+		}
+		b.add("%s followed %s", r.userLink(owner), strings.Join(links, ", "))
+
+	case persist.ActionCollectorsNoteAddedToToken:
+		name := "a piece"
+		if token, ok := r.tokens[data.TokenID]; ok && token.Name.String != "" {
+			name = token.Name.String
+		}
+		b.title = fmt.Sprintf("%s added a collector's note to %s", owner, name)
+		if data.TokenCollectionID != "" {
+			b.link = r.tokenURL(owner, data.TokenCollectionID, data.TokenID)
+		}
+		b.add("%s added a collector's note to %s", r.userLink(owner), html.EscapeString(name))
+		b.quote(data.TokenNewCollectorsNote)
+		b.addTokens(data.TokenCollectionID, data.TokenID)
+
+	case persist.ActionCollectionCreated:
+		name := r.collectionName(data.CollectionID)
+		b.title = fmt.Sprintf("%s created a collection, %s", owner, name)
+		b.link = r.collectionURL(owner, data.CollectionID)
+		b.add("%s created a collection, %s", r.userLink(owner), r.collectionLink(owner, data.CollectionID))
+		b.quote(data.CollectionNewCollectorsNote)
+		b.addTokens(data.CollectionID, data.CollectionTokenIDs...)
+
+	case persist.ActionCollectorsNoteAddedToCollection:
+		name := r.collectionName(data.CollectionID)
+		b.title = fmt.Sprintf("%s added a collector's note to %s", owner, name)
+		b.link = r.collectionURL(owner, data.CollectionID)
+		b.add("%s added a collector's note to %s", r.userLink(owner), r.collectionLink(owner, data.CollectionID))
+		b.quote(data.CollectionNewCollectorsNote)
+
+	case persist.ActionTokensAddedToCollection:
+		name := r.collectionName(data.CollectionID)
+		b.title = fmt.Sprintf("%s added %s to %s", owner, pieces(len(data.CollectionTokenIDs)), name)
+		b.link = r.collectionURL(owner, data.CollectionID)
+		b.add("%s added %s to %s", r.userLink(owner), pieces(len(data.CollectionTokenIDs)), r.collectionLink(owner, data.CollectionID))
+		b.addTokens(data.CollectionID, data.CollectionTokenIDs...)
+
+	case persist.ActionGalleryUpdated:
+		return r.writeGalleryUpdate(b, owner, data)
+
+	case persist.ActionTokensAcquired:
+		var did []string
+		if len(data.AcquiredTokenIDs) > 0 {
+			did = append(did, "collected "+pieces(len(data.AcquiredTokenIDs)))
+		}
+		if len(data.MintedTokenIDs) > 0 {
+			did = append(did, "minted "+pieces(len(data.MintedTokenIDs)))
+		}
+		if len(did) == 0 {
+			return false
+		}
+		b.title = fmt.Sprintf("%s %s", owner, strings.Join(did, " and "))
+		b.add("%s %s", r.userLink(owner), strings.Join(did, " and "))
+		b.addTokens("", data.AcquiredTokenIDs...)
+		b.addTokens("", data.MintedTokenIDs...)
+
+	default:
+		return false
+	}
+
+	return true
+}
+
+func (r *renderer) writeGalleryUpdate(b *itemBuilder, owner string, data persist.FeedEventData) bool {
+	b.title = fmt.Sprintf("%s updated their gallery", owner)
+	if data.GalleryName != "" {
+		b.title = fmt.Sprintf("%s updated their gallery, %s", owner, data.GalleryName)
+	}
+	b.add("%s", html.EscapeString(b.title))
+
+	// New collections are written first in the order they were created, followed by any other updated collections
+	collectionIDs := make([]persist.DBID, 0, len(data.GalleryNewCollections))
+	isNew := make(map[persist.DBID]bool)
+	for _, id := range data.GalleryNewCollections {
+		collectionIDs = append(collectionIDs, id)
+		isNew[id] = true
+	}
+
+	var updated []persist.DBID
+	seen := make(map[persist.DBID]bool)
+	addUpdated := func(id persist.DBID) {
+		if !isNew[id] && !seen[id] {
+			updated = append(updated, id)
+			seen[id] = true
+		}
+	}
+	for id := range data.GalleryNewCollectionTokenIDs {
+		addUpdated(id)
+	}
+	for id := range data.GalleryNewCollectionCollectorsNotes {
+		addUpdated(id)
+	}
+	for id := range data.GalleryNewCollectionTokenCollectorsNotes {
+		addUpdated(id)
+	}
+	sort.Slice(updated, func(i, j int) bool { return updated[i] < updated[j] })
+	collectionIDs = append(collectionIDs, updated...)
+
+	for _, collectionID := range collectionIDs {
+		if r.collectionID != "" && r.collectionID != collectionID {
+			continue
+		}
+
+		coll := r.collectionLink(owner, collectionID)
+		tokenIDs := data.GalleryNewCollectionTokenIDs[collectionID]
+		if isNew[collectionID] {
+			b.add("Created a collection, %s", coll)
+		} else if len(tokenIDs) > 0 {
+			b.add("Added %s to %s", pieces(len(tokenIDs)), coll)
+		}
+		b.addTokens(collectionID, tokenIDs...)
+
+		if note, ok := data.GalleryNewCollectionCollectorsNotes[collectionID]; ok {
+			if !isNew[collectionID] {
+				b.add("Added a collector's note to %s", coll)
+			}
+			b.quote(note)
+		}
+
+		tokenNotes := data.GalleryNewCollectionTokenCollectorsNotes[collectionID]
+		tokenNoteIDs := make([]persist.DBID, 0, len(tokenNotes))
+		for tokenID := range tokenNotes {
+			tokenNoteIDs = append(tokenNoteIDs, tokenID)
+		}
+		sort.Slice(tokenNoteIDs, func(i, j int) bool { return tokenNoteIDs[i] < tokenNoteIDs[j] })
+
+		for _, tokenID := range tokenNoteIDs {
+			b.add("Added a collector's note to a piece in %s", coll)
+			b.quote(tokenNotes[tokenID])
+			b.addTokens(collectionID, tokenID)
+		}
+
+		if r.collectionID != "" {
+			b.link = r.collectionURL(owner, collectionID)
+		}
+	}
+
+	return true
+}
+
+type thumbnail struct {
+	url  string
+	name string
+	link string
+}
+
+// thumbnails returns the first few tokens that have something to show.
+func (r *renderer) thumbnails(owner string, refs []tokenRef) []thumbnail {
+	thumbnails := make([]thumbnail, 0, maxThumbnails)
+	seen := make(map[persist.DBID]bool)
+
+	for _, ref := range refs {
+		if len(thumbnails) == maxThumbnails {
+			break
+		}
+		if seen[ref.tokenID] {
+			continue
+		}
+		seen[ref.tokenID] = true
+
+		token, ok := r.tokens[ref.tokenID]
+		if !ok {
+			continue
+		}
+
+		url := r.thumbnailURL(token.Media)
+		if url == "" {
+			continue
+		}
+
+		t := thumbnail{url: url, name: token.Name.String}
+		if ref.collectionID != "" {
+			t.link = r.tokenURL(owner, ref.collectionID, ref.tokenID)
+		}
+		thumbnails = append(thumbnails, t)
+	}
+
+	return thumbnails
+}
+
+// thumbnailURL picks the image of a token the same way the app does for its previews.
+func (r *renderer) thumbnailURL(media persist.Media) string {
+	// SVGs that weren't cached couldn't be sanitized
+	if media.MediaType == persist.MediaTypeSVG && !moderation.CanServeSVG(media.MediaURL.String()) {
+		return ""
+	}
+	url := media.ThumbnailURL.String()
+	if (media.MediaType == persist.MediaTypeImage || media.MediaType == persist.MediaTypeSVG || media.MediaType == persist.MediaTypeGIF) && url == "" {
+		url = media.MediaURL.String()
+	}
+	if url == "" || r.mm == nil {
+		return url
+	}
+	return r.mm.GetThumbnailImageUrl(url)
+}
+
+func thumbnailParagraph(thumbnails []thumbnail) string {
+	var s strings.Builder
+	s.WriteString("<p>")
+	for _, t := range thumbnails {
+		img := fmt.Sprintf(`<img src="%s" alt="%s">`, html.EscapeString(t.url), html.EscapeString(t.name))
+		if t.link != "" {
+			img = fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(t.link), img)
+		}
+		s.WriteString(img)
+	}
+	s.WriteString("</p>")
+	return s.String()
+}
+
+func (r *renderer) username(userID persist.DBID) (string, bool) {
+	user, ok := r.users[userID]
+	if !ok || user.Username.String == "" {
+		return "", false
+	}
+	return user.Username.String, true
+}
+
+func (r *renderer) collectionName(collectionID persist.DBID) string {
+	if collection, ok := r.collections[collectionID]; ok && collection.Name.String != "" {
+		return collection.Name.String
+	}
+	return "a collection"
+}
+
+func (r *renderer) userLink(username string) string {
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(r.profileURL(username)), html.EscapeString(username))
+}
+
+func (r *renderer) collectionLink(username string, collectionID persist.DBID) string {
+	return fmt.Sprintf(`<a href="%s">%s</a>`, html.EscapeString(r.collectionURL(username, collectionID)), html.EscapeString(r.collectionName(collectionID)))
+}
+
+func (r *renderer) profileURL(username string) string {
+	return fmt.Sprintf("%s/%s", r.galleryHost, username)
+}
+
+func (r *renderer) collectionURL(username string, collectionID persist.DBID) string {
+	return fmt.Sprintf("%s/%s", r.profileURL(username), collectionID)
+}
+
+func (r *renderer) tokenURL(username string, collectionID, tokenID persist.DBID) string {
+	return fmt.Sprintf("%s/%s", r.collectionURL(username, collectionID), tokenID)
+}
+
+func pieces(count int) string {
+	if count == 1 {
+		return "a piece"
+	}
+	return fmt.Sprintf("%d pieces", count)
+}
@@ -0,0 +1,82 @@
+package syndication
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// feedSize is the most items that are included in a feed
+const feedSize = 50
+
+// Format is a syndication format that a feed can be served in.
+type Format string
+
+const (
+	FormatRSS  Format = "rss"
+	FormatAtom Format = "atom"
+	FormatJSON Format = "json"
+)
+
+func (f Format) IsValid() bool {
+	switch f {
+	case FormatRSS, FormatAtom, FormatJSON:
+		return true
+	}
+	return false
+}
+
+func (f Format) ContentType() string {
+	return f.mediaType() + "; charset=utf-8"
+}
+
+func (f Format) mediaType() string {
+	switch f {
+	case FormatRSS:
+		return "application/rss+xml"
+	case FormatAtom:
+		return "application/atom+xml"
+	case FormatJSON:
+		return "application/feed+json"
+	}
+	return "application/octet-stream"
+}
+
+type ErrUnknownFormat struct {
+	Format string
+}
+
+func (e ErrUnknownFormat) Error() string {
+	return fmt.Sprintf("unknown feed format: %s; expected one of rss, atom or json", e.Format)
+}
+
+// Feed is a format-independent feed that is encoded into one of the syndication formats.
+type Feed struct {
+	Title       string
+	Description string
+	// Link is the page on Gallery that the feed follows
+	Link string
+	// URL is the address that the feed itself is served from
+	URL     string
+	Updated time.Time
+	Items   []Item
+}
+
+// Item is a single feed event.
+type Item struct {
+	ID        persist.DBID
+	Title     string
+	Link      string
+	Author    string
+	Content   string
+	Summary   string
+	Image     string
+	Published time.Time
+	Updated   time.Time
+}
+
+// guid is the identifier of an item, which stays the same even if the page it links to changes.
+func (i Item) guid() string {
+	return fmt.Sprintf("urn:gallery:feed-event:%s", i.ID)
+}
@@ -0,0 +1,235 @@
+package syndication
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"encoding/xml"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var eventTime = time.Date(2023, 3, 1, 12, 0, 0, 0, time.UTC)
+
+func testRenderer() *renderer {
+	return &renderer{
+		galleryHost: "https://gallery.so",
+		users: map[persist.DBID]db.User{
+			"alice": {ID: "alice", Username: sql.NullString{String: "alice", Valid: true}},
+			"bob":   {ID: "bob", Username: sql.NullString{String: "bob", Valid: true}},
+		},
+		collections: map[persist.DBID]db.Collection{
+			"coll":  {ID: "coll", Name: sql.NullString{String: "Punks & Friends", Valid: true}},
+			"other": {ID: "other", Name: sql.NullString{String: "Other", Valid: true}},
+		},
+		tokens: map[persist.DBID]db.Token{
+			"a": {ID: "a", Name: sql.NullString{String: "Token A", Valid: true}, Media: persist.Media{MediaType: persist.MediaTypeImage, MediaURL: "https://example.com/a.png"}},
+			"b": {ID: "b", Media: persist.Media{MediaType: persist.MediaTypeHTML, MediaURL: "https://example.com/b.html"}},
+			"c": {ID: "c", Media: persist.Media{MediaType: persist.MediaTypeVideo, MediaURL: "https://example.com/c.mp4", ThumbnailURL: "https://example.com/c.jpg"}},
+		},
+		reposted: map[persist.DBID]db.FeedEvent{},
+	}
+}
+
+func TestRender_Success(t *testing.T) {
+	t.Run("renders tokens added to a collection with thumbnails", func(t *testing.T) {
+		r := testRenderer()
+
+		item, ok := r.item(db.FeedEvent{
+			ID:        "event",
+			OwnerID:   "alice",
+			Action:    persist.ActionTokensAddedToCollection,
+			EventTime: eventTime,
+			Caption:   sql.NullString{String: "new <stuff>", Valid: true},
+			Data:      persist.FeedEventData{CollectionID: "coll", CollectionTokenIDs: persist.DBIDList{"a", "b", "c"}},
+		})
+
+		require.True(t, ok)
+		assert.Equal(t, "alice added 3 pieces to Punks & Friends", item.Title)
+		assert.Equal(t, "https://gallery.so/alice/coll", item.Link)
+		assert.Equal(t, "https://example.com/a.png", item.Image)
+		assert.Equal(t, eventTime, item.Updated)
+		assert.Contains(t, item.Content, "<blockquote>new &lt;stuff&gt;</blockquote>")
+		assert.Contains(t, item.Content, `<a href="https://gallery.so/alice/coll/a"><img src="https://example.com/a.png" alt="Token A"></a>`)
+		assert.Contains(t, item.Content, `<img src="https://example.com/c.jpg" alt="">`)
+		assert.NotContains(t, item.Content, "b.html")
+	})
+
+	t.Run("renders only the updated collection in a collection feed", func(t *testing.T) {
+		r := testRenderer()
+		r.collectionID = "coll"
+
+		item, ok := r.item(db.FeedEvent{
+			ID:        "event",
+			OwnerID:   "alice",
+			Action:    persist.ActionGalleryUpdated,
+			EventTime: eventTime,
+			Data: persist.FeedEventData{
+				GalleryNewCollections: persist.DBIDList{"other"},
+				GalleryNewCollectionTokenIDs: map[persist.DBID]persist.DBIDList{
+					"coll":  {"a"},
+					"other": {"c"},
+				},
+			},
+		})
+
+		require.True(t, ok)
+		assert.Equal(t, "https://gallery.so/alice/coll", item.Link)
+		assert.Contains(t, item.Content, "Added a piece to")
+		assert.NotContains(t, item.Content, "Other")
+		assert.NotContains(t, item.Content, "c.jpg")
+	})
+
+	t.Run("renders a repost with the original post", func(t *testing.T) {
+		r := testRenderer()
+		r.reposted["original"] = db.FeedEvent{
+			ID:      "original",
+			OwnerID: "bob",
+			Action:  persist.ActionCollectorsNoteAddedToToken,
+			Data:    persist.FeedEventData{TokenID: "a", TokenCollectionID: "coll", TokenNewCollectorsNote: "love this one"},
+		}
+
+		item, ok := r.item(db.FeedEvent{
+			ID:        "repost",
+			OwnerID:   "alice",
+			Action:    persist.ActionRepostedFeedEvent,
+			EventTime: eventTime,
+			Data:      persist.FeedEventData{RepostedFeedEventID: "original"},
+		})
+
+		require.True(t, ok)
+		assert.Equal(t, "alice reposted a post by bob", item.Title)
+		assert.Equal(t, "https://gallery.so/bob/coll/a", item.Link)
+		assert.Contains(t, item.Content, "love this one")
+		assert.Equal(t, "https://example.com/a.png", item.Image)
+	})
+
+	t.Run("skips events of deleted users", func(t *testing.T) {
+		r := testRenderer()
+
+		items := r.items([]db.FeedEvent{
+			{ID: "gone", OwnerID: "carol", Action: persist.ActionUserCreated},
+			{ID: "here", OwnerID: "bob", Action: persist.ActionUserCreated},
+		})
+
+		require.Len(t, items, 1)
+		assert.Equal(t, persist.DBID("here"), items[0].ID)
+	})
+}
+
+func TestEncode_Success(t *testing.T) {
+	feed := Feed{
+		Title:   "alice on Gallery",
+		Link:    "https://gallery.so/alice",
+		URL:     "https://api.gallery.so/feeds/users/alice/rss",
+		Updated: eventTime,
+		Items: []Item{{
+			ID:        "event",
+			Title:     "alice joined Gallery",
+			Link:      "https://gallery.so/alice",
+			Author:    "alice",
+			Content:   "<p>hi</p>",
+			Image:     "https://example.com/a.png",
+			Published: eventTime,
+			Updated:   eventTime,
+		}},
+	}
+
+	t.Run("encodes rss", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, FormatRSS, feed))
+
+		var doc struct {
+			Items []struct {
+				Title   string `xml:"title"`
+				GUID    string `xml:"guid"`
+				PubDate string `xml:"pubDate"`
+				Content string `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+			} `xml:"channel>item"`
+		}
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+		require.Len(t, doc.Items, 1)
+		assert.Equal(t, "alice joined Gallery", doc.Items[0].Title)
+		assert.Equal(t, "urn:gallery:feed-event:event", doc.Items[0].GUID)
+		assert.Equal(t, "Wed, 01 Mar 2023 12:00:00 +0000", doc.Items[0].PubDate)
+		assert.Equal(t, "<p>hi</p>", doc.Items[0].Content)
+	})
+
+	t.Run("encodes atom", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, FormatAtom, feed))
+
+		var doc struct {
+			Updated string `xml:"updated"`
+			Entries []struct {
+				ID      string `xml:"id"`
+				Content string `xml:"content"`
+			} `xml:"entry"`
+		}
+		require.NoError(t, xml.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, "2023-03-01T12:00:00Z", doc.Updated)
+		require.Len(t, doc.Entries, 1)
+		assert.Equal(t, "urn:gallery:feed-event:event", doc.Entries[0].ID)
+		assert.Equal(t, "<p>hi</p>", doc.Entries[0].Content)
+	})
+
+	t.Run("encodes json feed", func(t *testing.T) {
+		var buf bytes.Buffer
+		require.NoError(t, Encode(&buf, FormatJSON, feed))
+
+		var doc jsonFeed
+		require.NoError(t, json.Unmarshal(buf.Bytes(), &doc))
+		assert.Equal(t, "https://jsonfeed.org/version/1.1", doc.Version)
+		require.Len(t, doc.Items, 1)
+		assert.Equal(t, "<p>hi</p>", doc.Items[0].ContentHTML)
+		assert.Equal(t, "https://example.com/a.png", doc.Items[0].Image)
+	})
+}
+
+func TestConditionalGet_Success(t *testing.T) {
+	events := []db.FeedEvent{
+		{ID: "new", EventTime: eventTime, LastUpdated: eventTime.Add(time.Minute)},
+		{ID: "old", EventTime: eventTime.Add(-time.Hour), LastUpdated: eventTime.Add(-time.Hour)},
+	}
+	etag, lastModified := validators(FormatRSS, events)
+
+	request := func(header, value string) *http.Request {
+		req := httptest.NewRequest(http.MethodGet, "/feeds/global/rss", nil)
+		req.Header.Set(header, value)
+		return req
+	}
+
+	t.Run("uses the latest change as the last modified time", func(t *testing.T) {
+		assert.Equal(t, eventTime.Add(time.Minute), lastModified)
+	})
+
+	t.Run("matches the same etag", func(t *testing.T) {
+		assert.True(t, notModified(request("If-None-Match", `"abc", `+etag), etag, lastModified))
+	})
+
+	t.Run("changes the etag when an event is edited or removed", func(t *testing.T) {
+		edited := []db.FeedEvent{events[0], events[1]}
+		edited[1].LastUpdated = eventTime
+
+		editedEtag, _ := validators(FormatRSS, edited)
+		removedEtag, _ := validators(FormatRSS, events[:1])
+		otherFormatEtag, _ := validators(FormatAtom, events)
+
+		assert.NotEqual(t, etag, editedEtag)
+		assert.NotEqual(t, etag, removedEtag)
+		assert.NotEqual(t, etag, otherFormatEtag)
+		assert.False(t, notModified(request("If-None-Match", etag), editedEtag, lastModified))
+	})
+
+	t.Run("compares the last modified time when there isn't an etag", func(t *testing.T) {
+		assert.True(t, notModified(request("If-Modified-Since", lastModified.Format(http.TimeFormat)), etag, lastModified))
+		assert.False(t, notModified(request("If-Modified-Since", lastModified.Add(-time.Second).Format(http.TimeFormat)), etag, lastModified))
+	})
+}
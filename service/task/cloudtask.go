@@ -23,6 +23,13 @@ import (
 // FeedMessage is the input message to the feed service
 type FeedMessage struct {
 	ID persist.DBID `json:"id" binding:"required"`
+	// EditID is set when publishing a scheduled gallery edit, in which case ID is the gallery that was edited
+	EditID string `json:"edit_id,omitempty"`
+}
+
+// FeedFanOutMessage is the input message to the feed service for adding a published feed event to home feeds
+type FeedFanOutMessage struct {
+	FeedEventID persist.DBID `json:"id" binding:"required"`
 }
 
 // FeedbotMessage is the input message to the feedbot service
@@ -31,6 +38,11 @@ type FeedbotMessage struct {
 	Action      persist.Action `json:"action" binding:"required"`
 }
 
+// ActivityPubMessage is the input message to the activitypub service
+type ActivityPubMessage struct {
+	FeedEventID persist.DBID `json:"id" binding:"required"`
+}
+
 type TokenProcessingUserMessage struct {
 	UserID   persist.DBID   `json:"user_id" binding:"required"`
 	TokenIDs []persist.DBID `json:"token_ids" binding:"required"`
@@ -90,6 +102,41 @@ func CreateTaskForFeed(ctx context.Context, scheduleOn time.Time, message FeedMe
 	return submitHttpTask(ctx, client, queue, task, body)
 }
 
+// CreateTaskForFeedFanOut sends a published feed event to the feed service to be added to the home feeds of its
+// owner's followers. The task is named after the feed event so that it's only fanned out once.
+func CreateTaskForFeedFanOut(ctx context.Context, scheduleOn time.Time, message FeedFanOutMessage, client *gcptasks.Client) error {
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForFeedFanOut")
+	defer tracing.FinishSpan(span)
+
+	tracing.AddEventDataToSpan(span, map[string]interface{}{
+		"Event ID": message.FeedEventID,
+	})
+
+	queue := env.GetString("GCLOUD_FEED_QUEUE")
+	task := &taskspb.Task{
+		Name:         fmt.Sprintf("%s/tasks/fan-out-%s", queue, message.FeedEventID.String()),
+		ScheduleTime: timestamppb.New(scheduleOn),
+		MessageType: &taskspb.Task_HttpRequest{
+			HttpRequest: &taskspb.HttpRequest{
+				HttpMethod: taskspb.HttpMethod_POST,
+				Url:        fmt.Sprintf("%s/tasks/fan-out", env.GetString("FEED_URL")),
+				Headers: map[string]string{
+					"Content-type":  "application/json",
+					"Authorization": "Basic " + env.GetString("FEED_SECRET"),
+					"sentry-trace":  span.TraceID.String(),
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return submitHttpTask(ctx, client, queue, task, body)
+}
+
 func CreateTaskForFeedbot(ctx context.Context, scheduleOn time.Time, message FeedbotMessage, client *gcptasks.Client) error {
 	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForFeedbot")
 	defer tracing.FinishSpan(span)
@@ -123,6 +170,45 @@ func CreateTaskForFeedbot(ctx context.Context, scheduleOn time.Time, message Fee
 	return submitHttpTask(ctx, client, queue, task, body)
 }
 
+// CreateTaskForActivityPub sends a published feed event to the activitypub service so that it can be delivered to
+// the owner's remote followers. The activitypub service is optional, so no task is created if it isn't configured.
+func CreateTaskForActivityPub(ctx context.Context, scheduleOn time.Time, message ActivityPubMessage, client *gcptasks.Client) error {
+	if env.GetString("ACTIVITYPUB_URL") == "" {
+		return nil
+	}
+
+	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForActivityPub")
+	defer tracing.FinishSpan(span)
+
+	tracing.AddEventDataToSpan(span, map[string]interface{}{
+		"Event ID": message.FeedEventID,
+	})
+
+	queue := env.GetString("GCLOUD_ACTIVITYPUB_TASK_QUEUE")
+	task := &taskspb.Task{
+		Name:         fmt.Sprintf("%s/tasks/%s", queue, message.FeedEventID.String()),
+		ScheduleTime: timestamppb.New(scheduleOn),
+		MessageType: &taskspb.Task_HttpRequest{
+			HttpRequest: &taskspb.HttpRequest{
+				HttpMethod: taskspb.HttpMethod_POST,
+				Url:        fmt.Sprintf("%s/tasks/feed-event", env.GetString("ACTIVITYPUB_URL")),
+				Headers: map[string]string{
+					"Content-type":  "application/json",
+					"Authorization": "Basic " + env.GetString("ACTIVITYPUB_SECRET"),
+					"sentry-trace":  span.TraceID.String(),
+				},
+			},
+		},
+	}
+
+	body, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+
+	return submitHttpTask(ctx, client, queue, task, body)
+}
+
 func CreateTaskForTokenProcessing(ctx context.Context, client *gcptasks.Client, message TokenProcessingUserMessage) error {
 	span, ctx := tracing.StartSpan(ctx, "cloudtask.create", "createTaskForTokenProcessing")
 	defer tracing.FinishSpan(span)
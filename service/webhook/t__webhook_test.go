@@ -0,0 +1,117 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDeliverer_Success(t *testing.T) {
+	deliverer := &Deliverer{client: &http.Client{Timeout: 5 * time.Second}}
+	hook := db.Webhook{ID: "hook", Secret: "whsec_test"}
+	payload, err := json.Marshal(NewPayload(db.Event{ID: "event", Action: persist.ActionUserFollowedUsers, SubjectID: "bob"}))
+	require.NoError(t, err)
+	delivery := db.WebhookDelivery{ID: "delivery", WebhookID: hook.ID, Action: persist.ActionUserFollowedUsers, Payload: payload}
+
+	t.Run("sends a signed payload", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			timestamp, err := strconv.ParseInt(r.Header.Get(TimestampHeader), 10, 64)
+			require.NoError(t, err)
+
+			assert.True(t, Verify(hook.Secret, timestamp, body, r.Header.Get(SignatureHeader)))
+			assert.Equal(t, "delivery", r.Header.Get(DeliveryHeader))
+			assert.Equal(t, string(persist.ActionUserFollowedUsers), r.Header.Get(ActionHeader))
+			assert.JSONEq(t, string(payload), string(body))
+			w.WriteHeader(http.StatusNoContent)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+
+		status, err := deliverer.post(context.Background(), hook, delivery)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, status)
+	})
+
+	t.Run("fails on an error response", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+
+		status, err := deliverer.post(context.Background(), hook, delivery)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusInternalServerError, status)
+	})
+}
+
+func TestDeliverer_Failure(t *testing.T) {
+	hook := db.Webhook{ID: "hook", Secret: "whsec_test"}
+	delivery := db.WebhookDelivery{ID: "delivery", WebhookID: hook.ID, Action: persist.ActionUserFollowedUsers, Payload: []byte("{}")}
+
+	t.Run("refuses to send to addresses that aren't public", func(t *testing.T) {
+		sent := false
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			sent = true
+		}))
+		defer server.Close()
+		hook.Url = server.URL
+		deliverer := &Deliverer{client: newClient(5 * time.Second)}
+
+		_, err := deliverer.post(context.Background(), hook, delivery)
+
+		assert.ErrorIs(t, err, util.ErrNonPublicAddress)
+		assert.False(t, sent)
+	})
+
+	t.Run("doesn't follow redirects", func(t *testing.T) {
+		redirected := false
+		target := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			redirected = true
+		}))
+		defer target.Close()
+		server := httptest.NewServer(http.RedirectHandler(target.URL, http.StatusTemporaryRedirect))
+		defer server.Close()
+		hook.Url = server.URL
+		// The test servers are on loopback, so only the redirect policy is kept
+		client := newClient(5 * time.Second)
+		client.Transport = http.DefaultTransport
+		deliverer := &Deliverer{client: client}
+
+		status, err := deliverer.post(context.Background(), hook, delivery)
+
+		assert.Error(t, err)
+		assert.Equal(t, http.StatusTemporaryRedirect, status)
+		assert.False(t, redirected)
+	})
+}
+
+func TestSign_Success(t *testing.T) {
+	body := []byte(`{"id":"event"}`)
+	signature := Sign("secret", 1700000000, body)
+
+	assert.True(t, Verify("secret", 1700000000, body, signature))
+	assert.False(t, Verify("other", 1700000000, body, signature))
+	assert.False(t, Verify("secret", 1700000001, body, signature))
+	assert.False(t, Verify("secret", 1700000000, []byte(`{"id":"other"}`), signature))
+}
+
+func TestRetryBackoff_Success(t *testing.T) {
+	assert.Equal(t, 30*time.Second, retryBackoff(1, 30*time.Second, time.Hour))
+	assert.Equal(t, 2*time.Minute, retryBackoff(3, 30*time.Second, time.Hour))
+	assert.Equal(t, time.Hour, retryBackoff(20, 30*time.Second, time.Hour))
+}
@@ -0,0 +1,325 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	db "github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/util"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// SignatureHeader is the header that carries a request's signature, formatted as "sha256=<hex digest>"
+	SignatureHeader = "X-Gallery-Signature"
+	// TimestampHeader is the header that carries the unix time a request was signed at
+	TimestampHeader = "X-Gallery-Timestamp"
+	// DeliveryHeader is the header that carries the ID of the delivery a request was sent for
+	DeliveryHeader = "X-Gallery-Delivery"
+	// ActionHeader is the header that carries the action of the event a request was sent for
+	ActionHeader = "X-Gallery-Action"
+)
+
+// Actions are the actions that webhooks can subscribe to
+var Actions = []persist.Action{
+	persist.ActionUserCreated,
+	persist.ActionUserFollowedUsers,
+	persist.ActionCollectorsNoteAddedToToken,
+	persist.ActionCollectionCreated,
+	persist.ActionCollectorsNoteAddedToCollection,
+	persist.ActionTokensAddedToCollection,
+	persist.ActionAdmiredFeedEvent,
+	persist.ActionCommentedOnFeedEvent,
+	persist.ActionViewedGallery,
+	persist.ActionGalleryUpdated,
+	persist.ActionGalleryInfoUpdated,
+	persist.ActionRepostedFeedEvent,
+	persist.ActionMentionedUser,
+	persist.ActionCommunityMemberJoined,
+}
+
+var errWebhookDeleted = errors.New("webhook was deleted")
+
+// Payload is the body of a webhook request. It describes the event that triggered the delivery.
+type Payload struct {
+	ID           persist.DBID         `json:"id"`
+	Action       persist.Action       `json:"action"`
+	CreatedAt    time.Time            `json:"created_at"`
+	ActorID      persist.DBID         `json:"actor_id,omitempty"`
+	ResourceType persist.ResourceType `json:"resource_type"`
+	SubjectID    persist.DBID         `json:"subject_id"`
+	UserID       persist.DBID         `json:"user_id,omitempty"`
+	TokenID      persist.DBID         `json:"token_id,omitempty"`
+	CollectionID persist.DBID         `json:"collection_id,omitempty"`
+	GalleryID    persist.DBID         `json:"gallery_id,omitempty"`
+	FeedEventID  persist.DBID         `json:"feed_event_id,omitempty"`
+	CommentID    persist.DBID         `json:"comment_id,omitempty"`
+	Caption      string               `json:"caption,omitempty"`
+	Data         persist.EventData    `json:"data"`
+}
+
+// NewPayload returns the payload that is sent to webhooks for an event
+func NewPayload(event db.Event) Payload {
+	return Payload{
+		ID:           event.ID,
+		Action:       event.Action,
+		CreatedAt:    event.CreatedAt,
+		ActorID:      persist.DBID(persist.NullStrToStr(event.ActorID)),
+		ResourceType: event.ResourceTypeID,
+		SubjectID:    event.SubjectID,
+		UserID:       event.UserID,
+		TokenID:      event.TokenID,
+		CollectionID: event.CollectionID,
+		GalleryID:    event.GalleryID,
+		FeedEventID:  event.FeedEventID,
+		CommentID:    event.CommentID,
+		Caption:      event.Caption.String,
+		Data:         event.Data,
+	}
+}
+
+// NewSecret returns a new secret for signing a webhook's payloads
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Sign returns the HMAC-SHA256 of "<timestamp>.<body>" keyed by secret. Receivers should compute the same digest
+// from the TimestampHeader and the raw request body, compare it to the SignatureHeader, and reject requests with
+// old timestamps to prevent replay attacks.
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify returns whether signature is a valid signature of body at timestamp
+func Verify(secret string, timestamp int64, body []byte, signature string) bool {
+	return hmac.Equal([]byte(Sign(secret, timestamp, body)), []byte(signature))
+}
+
+// NewDelivery adds a delivery of event to hook to the delivery log. It is sent by a Deliverer.
+func NewDelivery(ctx context.Context, queries *db.Queries, hook db.Webhook, event db.Event) (db.WebhookDelivery, error) {
+	payload, err := json.Marshal(NewPayload(event))
+	if err != nil {
+		return db.WebhookDelivery{}, err
+	}
+
+	return queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:        persist.GenerateID(),
+		WebhookID: hook.ID,
+		EventID:   event.ID,
+		Action:    event.Action,
+		Payload:   payload,
+	})
+}
+
+// Replay sends the payload of a delivery again. The original delivery is left as is, and the new delivery refers
+// back to it.
+func Replay(ctx context.Context, queries *db.Queries, delivery db.WebhookDelivery) (db.WebhookDelivery, error) {
+	return queries.CreateWebhookDelivery(ctx, db.CreateWebhookDeliveryParams{
+		ID:         persist.GenerateID(),
+		WebhookID:  delivery.WebhookID,
+		EventID:    delivery.EventID,
+		ReplayOfID: delivery.ID.String(),
+		Action:     delivery.Action,
+		Payload:    delivery.Payload,
+	})
+}
+
+// Deliverer sends pending webhook deliveries. Deliveries that fail are retried with exponential backoff until they
+// run out of attempts.
+type Deliverer struct {
+	queries      *db.Queries
+	client       *http.Client
+	pollInterval time.Duration
+	maxAttempts  int32
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	staleAfter   time.Duration
+}
+
+func NewDeliverer(queries *db.Queries) *Deliverer {
+	return &Deliverer{
+		queries:      queries,
+		client:       newClient(env.GetDuration("WEBHOOK_TIMEOUT")),
+		pollInterval: env.GetDuration("WEBHOOK_POLL_INTERVAL"),
+		maxAttempts:  int32(env.GetInt("WEBHOOK_MAX_ATTEMPTS")),
+		baseBackoff:  env.GetDuration("WEBHOOK_RETRY_BACKOFF"),
+		maxBackoff:   env.GetDuration("WEBHOOK_MAX_RETRY_BACKOFF"),
+		staleAfter:   env.GetDuration("WEBHOOK_STALE_AFTER"),
+	}
+}
+
+// newClient returns the client that deliveries are sent with. Webhook URLs are user supplied, so it won't connect to
+// addresses that aren't public, and redirects aren't followed so that they can't lead it somewhere else.
+func newClient(timeout time.Duration) *http.Client {
+	client := util.NewPublicHTTPClient(timeout)
+	client.CheckRedirect = func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}
+	return client
+}
+
+// Start sends deliveries until the context is cancelled
+func (d *Deliverer) Start(ctx context.Context) {
+	go d.work(ctx)
+	go d.requeueStale(ctx)
+}
+
+func (d *Deliverer) work(ctx context.Context) {
+	for {
+		delivery, err := d.queries.ClaimNextWebhookDelivery(ctx)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				logger.For(ctx).Errorf("failed to claim webhook delivery: %s", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(d.pollInterval):
+				continue
+			}
+		}
+		d.execute(ctx, delivery)
+	}
+}
+
+func (d *Deliverer) execute(ctx context.Context, delivery db.WebhookDelivery) {
+	ctx = logger.NewContextWithFields(ctx, logrus.Fields{
+		"deliveryID": delivery.ID,
+		"webhookID":  delivery.WebhookID,
+		"attempt":    delivery.Attempts,
+	})
+
+	status, err := d.send(ctx, delivery)
+	responseStatus := sql.NullInt32{Int32: int32(status), Valid: status != 0}
+
+	if err == nil {
+		if err := d.queries.CompleteWebhookDelivery(ctx, db.CompleteWebhookDeliveryParams{ID: delivery.ID, ResponseStatus: responseStatus}); err != nil {
+			logger.For(ctx).Errorf("failed to complete webhook delivery: %s", err)
+		}
+		return
+	}
+
+	lastError := sql.NullString{String: err.Error(), Valid: true}
+
+	if delivery.Attempts >= d.maxAttempts || errors.Is(err, errWebhookDeleted) {
+		logger.For(ctx).Warnf("webhook delivery failed after %d attempts: %s", delivery.Attempts, err)
+		if err := d.queries.FailWebhookDelivery(ctx, db.FailWebhookDeliveryParams{ID: delivery.ID, ResponseStatus: responseStatus, LastError: lastError}); err != nil {
+			logger.For(ctx).Errorf("failed to fail webhook delivery: %s", err)
+		}
+		return
+	}
+
+	backoff := retryBackoff(delivery.Attempts, d.baseBackoff, d.maxBackoff)
+	logger.For(ctx).Infof("webhook delivery failed, retrying in %s: %s", backoff, err)
+	if err := d.queries.RetryWebhookDelivery(ctx, db.RetryWebhookDeliveryParams{
+		ID:             delivery.ID,
+		ResponseStatus: responseStatus,
+		LastError:      lastError,
+		NextAttemptAt:  time.Now().Add(backoff),
+	}); err != nil {
+		logger.For(ctx).Errorf("failed to retry webhook delivery: %s", err)
+	}
+}
+
+// send posts a delivery's payload to its webhook, and returns the status code of the response if there was one
+func (d *Deliverer) send(ctx context.Context, delivery db.WebhookDelivery) (int, error) {
+	hook, err := d.queries.GetWebhookByID(ctx, delivery.WebhookID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, errWebhookDeleted
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	return d.post(ctx, hook, delivery)
+}
+
+// post sends a delivery's payload to a webhook, signed with the webhook's secret
+func (d *Deliverer) post(ctx context.Context, hook db.Webhook, delivery db.WebhookDelivery) (int, error) {
+	timestamp := time.Now().Unix()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, hook.Url, strings.NewReader(string(delivery.Payload)))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "Gallery-Webhooks/1.0")
+	req.Header.Set(DeliveryHeader, delivery.ID.String())
+	req.Header.Set(ActionHeader, string(delivery.Action))
+	req.Header.Set(TimestampHeader, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(SignatureHeader, Sign(hook.Secret, timestamp, delivery.Payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return resp.StatusCode, fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+
+	return resp.StatusCode, nil
+}
+
+// requeueStale puts deliveries back on the queue that have been sending for too long, e.g. because the instance
+// sending them was shut down
+func (d *Deliverer) requeueStale(ctx context.Context) {
+	ticker := time.NewTicker(d.staleAfter)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			requeued, err := d.queries.RequeueStaleWebhookDeliveries(ctx, sql.NullTime{Time: time.Now().Add(-d.staleAfter), Valid: true})
+			if err != nil {
+				logger.For(ctx).Errorf("failed to requeue stale webhook deliveries: %s", err)
+				continue
+			}
+			if requeued > 0 {
+				logger.For(ctx).Infof("requeued %d stale webhook deliveries", requeued)
+			}
+		}
+	}
+}
+
+// retryBackoff returns how long to wait before retrying a delivery that has been attempted the given number of times
+func retryBackoff(attempts int32, base, max time.Duration) time.Duration {
+	backoff := base
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
@@ -0,0 +1,57 @@
+package tokenprocessing
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+)
+
+// tokenSnapshot is the part of a token that is kept in its metadata history
+type tokenSnapshot struct {
+	Metadata persist.TokenMetadata `json:"metadata"`
+	Media    persist.Media         `json:"media"`
+}
+
+// hash returns a digest of the snapshot that is the same for equal snapshots. Maps are marshalled
+// with sorted keys, so the order that metadata was decoded in doesn't matter.
+func (s tokenSnapshot) hash() (string, error) {
+	b, err := json.Marshal(s)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// recordTokenHistory appends a snapshot of a token's metadata and media to its history, unless nothing
+// has changed since the last snapshot
+func recordTokenHistory(ctx context.Context, queries *coredb.Queries, ti persist.TokenIdentifiers, snapshot tokenSnapshot) error {
+	hash, err := snapshot.hash()
+	if err != nil {
+		return err
+	}
+
+	recorded, err := queries.InsertTokenMetadataHistory(ctx, coredb.InsertTokenMetadataHistoryParams{
+		ID:              persist.GenerateID(),
+		Chain:           ti.Chain,
+		ContractAddress: ti.ContractAddress,
+		TokenID:         ti.TokenID,
+		TokenMetadata:   snapshot.Metadata,
+		Media:           snapshot.Media,
+		Hash:            hash,
+	})
+	if err != nil {
+		return err
+	}
+
+	if recorded > 0 {
+		logger.For(ctx).Infof("recorded new metadata snapshot for %s", ti)
+	}
+
+	return nil
+}
@@ -0,0 +1,196 @@
+package tokenprocessing
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v4"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// priorityBulk is the priority of jobs that are created when a user's tokens are synced
+	priorityBulk int32 = 0
+	// priorityInteractive is the priority of jobs that are created when a user refreshes a token
+	priorityInteractive int32 = 10
+)
+
+// jobRunner processes a single job
+type jobRunner func(ctx context.Context, job coredb.TokenProcessingJob) error
+
+// jobFinisher is called once a job is finished, i.e. it succeeded or ran out of attempts. err is the error of the
+// job's last attempt, or nil if it succeeded.
+type jobFinisher func(ctx context.Context, job coredb.TokenProcessingJob, err error)
+
+// jobQueue processes persisted token processing jobs in order of priority.
+// Jobs that fail are retried with exponential backoff until they run out of attempts.
+type jobQueue struct {
+	queries      *coredb.Queries
+	run          jobRunner
+	finish       jobFinisher
+	workers      int
+	pollInterval time.Duration
+	maxAttempts  int32
+	baseBackoff  time.Duration
+	maxBackoff   time.Duration
+	staleAfter   time.Duration
+}
+
+func newJobQueue(queries *coredb.Queries, run jobRunner, finish jobFinisher) *jobQueue {
+	return &jobQueue{
+		queries:      queries,
+		run:          run,
+		finish:       finish,
+		workers:      env.GetInt("TOKEN_PROCESSING_WORKERS"),
+		pollInterval: env.GetDuration("TOKEN_PROCESSING_POLL_INTERVAL"),
+		maxAttempts:  int32(env.GetInt("TOKEN_PROCESSING_MAX_ATTEMPTS")),
+		baseBackoff:  env.GetDuration("TOKEN_PROCESSING_RETRY_BACKOFF"),
+		maxBackoff:   env.GetDuration("TOKEN_PROCESSING_MAX_RETRY_BACKOFF"),
+		staleAfter:   env.GetDuration("TOKEN_PROCESSING_STALE_AFTER"),
+	}
+}
+
+// enqueue adds a job for the token to the queue. If the token already has a job that isn't running, the job is reset.
+func (q *jobQueue) enqueue(ctx context.Context, ti persist.TokenIdentifiers, ownerAddress persist.Address, imageKeywords, animationKeywords []string, priority int32) (coredb.TokenProcessingJob, error) {
+	if imageKeywords == nil {
+		imageKeywords = []string{}
+	}
+	if animationKeywords == nil {
+		animationKeywords = []string{}
+	}
+	return q.queries.EnqueueTokenProcessingJob(ctx, coredb.EnqueueTokenProcessingJobParams{
+		ID:                persist.GenerateID(),
+		Chain:             ti.Chain,
+		ContractAddress:   ti.ContractAddress,
+		TokenID:           ti.TokenID,
+		OwnerAddress:      ownerAddress,
+		ImageKeywords:     imageKeywords,
+		AnimationKeywords: animationKeywords,
+		Priority:          priority,
+	})
+}
+
+// start runs the queue's workers until the context is cancelled
+func (q *jobQueue) start(ctx context.Context) {
+	for i := 0; i < q.workers; i++ {
+		go q.work(ctx)
+	}
+	go q.requeueStale(ctx)
+}
+
+func (q *jobQueue) work(ctx context.Context) {
+	for {
+		job, err := q.queries.ClaimNextTokenProcessingJob(ctx)
+		if err != nil {
+			if !errors.Is(err, pgx.ErrNoRows) {
+				logger.For(ctx).Errorf("failed to claim token processing job: %s", err)
+			}
+			select {
+			case <-ctx.Done():
+				return
+			case <-time.After(q.pollInterval):
+				continue
+			}
+		}
+		q.execute(ctx, job)
+	}
+}
+
+// runNow runs a queued job immediately instead of waiting for a worker to pick it up.
+// If a worker already claimed the job, runNow returns without doing anything.
+func (q *jobQueue) runNow(ctx context.Context, job coredb.TokenProcessingJob) error {
+	claimed, err := q.queries.ClaimTokenProcessingJobByID(ctx, job.ID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		logger.For(ctx).Infof("token processing job %s is already running", job.ID)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	return q.execute(ctx, claimed)
+}
+
+func (q *jobQueue) execute(ctx context.Context, job coredb.TokenProcessingJob) error {
+	ctx = logger.NewContextWithFields(ctx, logrus.Fields{
+		"jobID":    job.ID,
+		"attempt":  job.Attempts,
+		"priority": job.Priority,
+	})
+
+	err := q.run(ctx, job)
+	if err == nil {
+		if err := q.queries.CompleteTokenProcessingJob(ctx, job.ID); err != nil {
+			logger.For(ctx).Errorf("failed to complete token processing job: %s", err)
+		}
+		q.finish(ctx, job, nil)
+		return nil
+	}
+
+	lastError := sql.NullString{String: err.Error(), Valid: true}
+
+	if job.Attempts >= q.maxAttempts {
+		logger.For(ctx).Errorf("token processing job failed after %d attempts: %s", job.Attempts, err)
+		sentryutil.ReportError(ctx, err)
+		if err := q.queries.FailTokenProcessingJob(ctx, coredb.FailTokenProcessingJobParams{ID: job.ID, LastError: lastError}); err != nil {
+			logger.For(ctx).Errorf("failed to fail token processing job: %s", err)
+		}
+		q.finish(ctx, job, err)
+		return err
+	}
+
+	backoff := retryBackoff(job.Attempts, q.baseBackoff, q.maxBackoff)
+	logger.For(ctx).Warnf("token processing job failed, retrying in %s: %s", backoff, err)
+	if err := q.queries.RetryTokenProcessingJob(ctx, coredb.RetryTokenProcessingJobParams{
+		ID:            job.ID,
+		LastError:     lastError,
+		NextAttemptAt: time.Now().Add(backoff),
+	}); err != nil {
+		logger.For(ctx).Errorf("failed to retry token processing job: %s", err)
+	}
+
+	return err
+}
+
+// requeueStale puts jobs back on the queue that have been running for too long, e.g. because
+// the instance processing them was shut down
+func (q *jobQueue) requeueStale(ctx context.Context) {
+	ticker := time.NewTicker(q.staleAfter)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			requeued, err := q.queries.RequeueStaleTokenProcessingJobs(ctx, sql.NullTime{Time: time.Now().Add(-q.staleAfter), Valid: true})
+			if err != nil {
+				logger.For(ctx).Errorf("failed to requeue stale token processing jobs: %s", err)
+				continue
+			}
+			if requeued > 0 {
+				logger.For(ctx).Infof("requeued %d stale token processing jobs", requeued)
+			}
+		}
+	}
+}
+
+// retryBackoff returns how long to wait before retrying a job that has been attempted the given number of times
+func retryBackoff(attempts int32, base, max time.Duration) time.Duration {
+	backoff := base
+	for i := int32(1); i < attempts; i++ {
+		backoff *= 2
+		if backoff >= max {
+			return max
+		}
+	}
+	if backoff > max {
+		return max
+	}
+	return backoff
+}
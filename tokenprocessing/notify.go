@@ -0,0 +1,46 @@
+package tokenprocessing
+
+import (
+	"context"
+
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/notifications"
+	"github.com/mikeydub/go-gallery/service/persist"
+	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
+)
+
+// notifyMediaProcessed returns a jobFinisher that tells the owner of a refreshed token whether its media was
+// processed. Jobs that are created when tokens are synced aren't notified about, since a sync can create a lot of them.
+func notifyMediaProcessed(queries *coredb.Queries, notifs *notifications.NotificationHandlers) jobFinisher {
+	return func(ctx context.Context, job coredb.TokenProcessingJob, jobErr error) {
+		if job.Priority < priorityInteractive {
+			return
+		}
+
+		token, err := queries.GetTokenByTokenIdentifiersAndOwnerAddress(ctx, coredb.GetTokenByTokenIdentifiersAndOwnerAddressParams{
+			TokenHex:        job.TokenID,
+			Chain:           job.Chain,
+			ContractAddress: job.ContractAddress,
+			OwnerAddress:    job.OwnerAddress,
+		})
+		if err != nil {
+			logger.For(ctx).Warnf("failed to get token of %s to notify its owner: %s", job.OwnerAddress, err)
+			return
+		}
+
+		err = notifs.Notifications.Dispatch(ctx, coredb.Notification{
+			OwnerID: token.OwnerUserID,
+			Action:  persist.ActionTokenMediaProcessed,
+			Data: persist.NotificationData{
+				TokenIDs:    []persist.DBID{token.ID},
+				MediaFailed: persist.NullBool(jobErr != nil),
+			},
+			EventIds: persist.DBIDList{},
+		})
+		if err != nil {
+			logger.For(ctx).Errorf("failed to notify %s that the media of token %s was processed: %s", token.OwnerUserID, token.ID, err)
+			sentryutil.ReportError(ctx, err)
+		}
+	}
+}
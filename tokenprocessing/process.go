@@ -7,15 +7,17 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/mikeydub/go-gallery/db/gen/coredb"
+	"github.com/mikeydub/go-gallery/env"
 	"github.com/mikeydub/go-gallery/service/persist/postgres"
 
 	"cloud.google.com/go/storage"
 	"github.com/everFinance/goar"
-	"github.com/gammazero/workerpool"
 	"github.com/gin-gonic/gin"
 	shell "github.com/ipfs/go-ipfs-api"
 	"github.com/mikeydub/go-gallery/service/logger"
 	"github.com/mikeydub/go-gallery/service/media"
+	"github.com/mikeydub/go-gallery/service/moderation"
 	"github.com/mikeydub/go-gallery/service/multichain"
 	"github.com/mikeydub/go-gallery/service/persist"
 	"github.com/mikeydub/go-gallery/service/task"
@@ -33,7 +35,7 @@ type ProcessMediaForTokenInput struct {
 	AnimationKeywords []string        `json:"animation_keywords" binding:"required"`
 }
 
-func processMediaForUsersTokensOfChain(mc *multichain.Provider, tokenRepo *postgres.TokenGalleryRepository, contractRepo *postgres.ContractGalleryRepository, walletRepo persist.WalletRepository, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string, throttler *throttle.Locker) gin.HandlerFunc {
+func processMediaForUsersTokensOfChain(jobs *jobQueue, tokenRepo *postgres.TokenGalleryRepository, contractRepo *postgres.ContractGalleryRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input task.TokenProcessingUserMessage
 		if err := c.ShouldBindJSON(&input); err != nil {
@@ -43,14 +45,6 @@ func processMediaForUsersTokensOfChain(mc *multichain.Provider, tokenRepo *postg
 
 		ctx := logger.NewContextWithFields(c, logrus.Fields{"userID": input.UserID})
 
-		if err := throttler.Lock(ctx, input.UserID.String()); err != nil {
-			// Reply with a non-200 status so that the message is tried again later on
-			util.ErrResponse(c, http.StatusTooManyRequests, err)
-			return
-		}
-		defer throttler.Unlock(ctx, input.UserID.String())
-
-		wp := workerpool.New(100)
 		for _, tokenID := range input.TokenIDs {
 			t, err := tokenRepo.GetByID(ctx, tokenID)
 			if err != nil {
@@ -61,38 +55,31 @@ func processMediaForUsersTokensOfChain(mc *multichain.Provider, tokenRepo *postg
 			contract, err := contractRepo.GetByID(ctx, t.Contract)
 			if err != nil {
 				logger.For(ctx).Errorf("Error getting contract: %s", err)
+				continue
 			}
 
-			wp.Submit(func() {
-				key := fmt.Sprintf("%s-%s-%d", t.TokenID, contract.Address, t.Chain)
-				imageKeywords, animationKeywords := t.Chain.BaseKeywords()
-				err := processToken(ctx, key, t, contract.Address, "", mc, ethClient, ipfsClient, arweaveClient, stg, tokenBucket, tokenRepo, imageKeywords, animationKeywords)
-				if err != nil {
-					logger.For(c).Errorf("Error processing token: %s", err)
-				}
-			})
+			imageKeywords, animationKeywords := t.Chain.BaseKeywords()
+			_, err = jobs.enqueue(ctx, persist.NewTokenIdentifiers(contract.Address, t.TokenID, t.Chain), "", imageKeywords, animationKeywords, priorityBulk)
+			if err != nil {
+				// Reply with a non-200 status so that the message is tried again later on
+				util.ErrResponse(c, http.StatusInternalServerError, err)
+				return
+			}
 		}
 
-		wp.StopWait()
-		logger.For(ctx).Infof("Processing Media: %s - Finished", input.UserID)
+		logger.For(ctx).Infof("Processing Media: %s - Queued %d tokens", input.UserID, len(input.TokenIDs))
 
 		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
 
-func processMediaForToken(mc *multichain.Provider, tokenRepo *postgres.TokenGalleryRepository, userRepo *postgres.UserRepository, walletRepo *postgres.WalletRepository, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string, throttler *throttle.Locker) gin.HandlerFunc {
+func processMediaForToken(jobs *jobQueue, tokenRepo *postgres.TokenGalleryRepository, userRepo *postgres.UserRepository, walletRepo *postgres.WalletRepository) gin.HandlerFunc {
 	return func(c *gin.Context) {
 		var input ProcessMediaForTokenInput
 		if err := c.ShouldBindJSON(&input); err != nil {
 			util.ErrResponse(c, http.StatusBadRequest, err)
 			return
 		}
-		key := fmt.Sprintf("%s-%s-%d", input.TokenID, input.ContractAddress, input.Chain)
-		if err := throttler.Lock(c, key); err != nil {
-			util.ErrResponse(c, http.StatusTooManyRequests, err)
-			return
-		}
-		defer throttler.Unlock(c, key)
 
 		wallet, err := walletRepo.GetByChainAddress(c, persist.NewChainAddress(input.OwnerAddress, input.Chain))
 		if err != nil {
@@ -108,23 +95,41 @@ func processMediaForToken(mc *multichain.Provider, tokenRepo *postgres.TokenGall
 
 		ctx := logger.NewContextWithFields(c, logrus.Fields{"userID": user.ID})
 
-		t, err := tokenRepo.GetByFullIdentifiers(ctx, input.TokenID, input.ContractAddress, input.Chain, user.ID)
-		if err != nil {
+		if _, err := tokenRepo.GetByFullIdentifiers(ctx, input.TokenID, input.ContractAddress, input.Chain, user.ID); err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
 		}
 
-		err = processToken(ctx, key, t, input.ContractAddress, input.OwnerAddress, mc, ethClient, ipfsClient, arweaveClient, stg, tokenBucket, tokenRepo, input.ImageKeywords, input.AnimationKeywords)
+		job, err := jobs.enqueue(ctx, persist.NewTokenIdentifiers(input.ContractAddress, input.TokenID, input.Chain), input.OwnerAddress, input.ImageKeywords, input.AnimationKeywords, priorityInteractive)
 		if err != nil {
 			util.ErrResponse(c, http.StatusInternalServerError, err)
 			return
 		}
 
+		// The user is waiting on the refresh, so process the token now instead of waiting behind bulk jobs.
+		// If it fails, the job is retried in the background.
+		if err := jobs.runNow(ctx, job); err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
 		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
 	}
 }
 
-func processToken(c context.Context, key string, t persist.TokenGallery, contractAddress, ownerAddress persist.Address, mc *multichain.Provider, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string, tokenRepo *postgres.TokenGalleryRepository, imageKeywords, animationKeywords []string) error {
+// processJob returns a jobRunner that processes the media of a job's token
+func processJob(mc *multichain.Provider, tokenRepo *postgres.TokenGalleryRepository, queries *coredb.Queries, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string, moderator *moderation.Moderator) jobRunner {
+	return func(ctx context.Context, job coredb.TokenProcessingJob) error {
+		tokens, err := tokenRepo.GetByTokenIdentifiers(ctx, job.TokenID, job.ContractAddress, job.Chain, 1, 0)
+		if err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%s-%s-%d", job.TokenID, job.ContractAddress, job.Chain)
+		return processToken(ctx, key, tokens[0], job.ContractAddress, job.OwnerAddress, mc, ethClient, ipfsClient, arweaveClient, stg, tokenBucket, tokenRepo, queries, moderator, job.ImageKeywords, job.AnimationKeywords)
+	}
+}
+
+func processToken(c context.Context, key string, t persist.TokenGallery, contractAddress, ownerAddress persist.Address, mc *multichain.Provider, ethClient *ethclient.Client, ipfsClient *shell.Shell, arweaveClient *goar.Client, stg *storage.Client, tokenBucket string, tokenRepo *postgres.TokenGalleryRepository, queries *coredb.Queries, moderator *moderation.Moderator, imageKeywords, animationKeywords []string) error {
 	ctx := logger.NewContextWithFields(c, logrus.Fields{
 		"tokenDBID":       t.ID,
 		"tokenID":         t.TokenID,
@@ -158,9 +163,10 @@ func processToken(c context.Context, key string, t persist.TokenGallery, contrac
 	}
 
 	totalTimeOfMedia := time.Now()
-	newMedia, err := media.MakePreviewsForMetadata(ctx, newMetadata, contractAddress, persist.TokenID(t.TokenID.String()), t.TokenURI, t.Chain, ipfsClient, arweaveClient, stg, tokenBucket, image, animation)
-	if err != nil {
-		logger.For(ctx).Errorf("error processing media for %s: %s", key, err)
+	newMedia, mediaErr := media.MakePreviewsForMetadata(ctx, newMetadata, contractAddress, persist.TokenID(t.TokenID.String()), t.TokenURI, t.Chain, ipfsClient, arweaveClient, stg, tokenBucket, image, animation, queries)
+	if mediaErr != nil {
+		logger.For(ctx).Errorf("error processing media for %s: %s", key, mediaErr)
+		mediaErr = fmt.Errorf("error processing media for %s: %w", key, mediaErr)
 		newMedia = persist.Media{
 			MediaType: persist.MediaTypeUnknown,
 		}
@@ -170,13 +176,22 @@ func processToken(c context.Context, key string, t persist.TokenGallery, contrac
 	// Don't replace existing usable media if tokenprocessing failed to get new media
 	if t.Media.IsServable() && !newMedia.IsServable() {
 		logger.For(ctx).Debugf("not replacing existing media for %s: cur %v new %v", key, t.Media.IsServable(), newMedia.IsServable())
-		return nil
+		return mediaErr
 	}
 
 	if newMedia.MediaType.IsAnimationLike() && !persist.TokenURI(newMedia.ThumbnailURL).IsRenderable() && persist.TokenURI(t.Media.ThumbnailURL).IsRenderable() {
 		newMedia.ThumbnailURL = t.Media.ThumbnailURL
 	}
 
+	ti := persist.NewTokenIdentifiers(contractAddress, persist.TokenID(t.TokenID.String()), t.Chain)
+
+	if mediaErr == nil && moderator != nil {
+		_, err := moderator.ModerateMedia(ctx, ti, moderation.Subject{Media: newMedia, Metadata: newMetadata, Name: name, Description: description})
+		if err != nil {
+			logger.For(ctx).Errorf("error moderating media for %s: %s", key, err)
+		}
+	}
+
 	up := persist.TokenUpdateAllURIDerivedFieldsInput{
 		Media:       newMedia,
 		Metadata:    newMetadata,
@@ -189,8 +204,27 @@ func processToken(c context.Context, key string, t persist.TokenGallery, contrac
 		return err
 	}
 
+	if err := recordTokenHistory(ctx, queries, ti, tokenSnapshot{Metadata: newMetadata, Media: newMedia}); err != nil {
+		logger.For(ctx).Errorf("error recording metadata history for %s: %s", key, err)
+	}
+
 	logger.For(ctx).Infof("total processing took %s", time.Since(totalTime))
-	return nil
+
+	// Return the media error so that the job is retried
+	return mediaErr
+}
+
+func collectUnreferencedBlobs(repos *postgres.Repositories, queries *coredb.Queries, stg *storage.Client) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		collected, err := media.CollectUnreferencedBlobs(c, repos, queries, stg, env.GetDuration("MEDIA_BLOB_GC_GRACE_PERIOD"), env.GetInt("MEDIA_BLOB_GC_BATCH_SIZE"))
+		if err != nil {
+			util.ErrResponse(c, http.StatusInternalServerError, err)
+			return
+		}
+
+		logger.For(c).Infof("collected %d unreferenced media blobs", collected)
+		c.JSON(http.StatusOK, util.SuccessResponse{Success: true})
+	}
 }
 
 func processOwnersForContractTokens(mc *multichain.Provider, contractRepo *postgres.ContractGalleryRepository, throttler *throttle.Locker) gin.HandlerFunc {
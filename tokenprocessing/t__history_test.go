@@ -0,0 +1,41 @@
+package tokenprocessing
+
+import (
+	"testing"
+
+	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenSnapshotHash(t *testing.T) {
+	snapshot := func(metadata persist.TokenMetadata, mediaURL string) tokenSnapshot {
+		return tokenSnapshot{
+			Metadata: metadata,
+			Media:    persist.Media{MediaURL: persist.NullString(mediaURL), MediaType: persist.MediaTypeImage},
+		}
+	}
+
+	hash := func(s tokenSnapshot) string {
+		h, err := s.hash()
+		require.NoError(t, err)
+		return h
+	}
+
+	original := hash(snapshot(persist.TokenMetadata{"name": "Egg", "attributes": []any{"unrevealed"}}, "https://example.com/egg.png"))
+
+	t.Run("equal snapshots have the same hash", func(t *testing.T) {
+		same := hash(snapshot(persist.TokenMetadata{"attributes": []any{"unrevealed"}, "name": "Egg"}, "https://example.com/egg.png"))
+		assert.Equal(t, original, same)
+	})
+
+	t.Run("revealed metadata changes the hash", func(t *testing.T) {
+		revealed := hash(snapshot(persist.TokenMetadata{"name": "Dragon", "attributes": []any{"fire"}}, "https://example.com/egg.png"))
+		assert.NotEqual(t, original, revealed)
+	})
+
+	t.Run("new media changes the hash", func(t *testing.T) {
+		newMedia := hash(snapshot(persist.TokenMetadata{"name": "Egg", "attributes": []any{"unrevealed"}}, "https://example.com/dragon.png"))
+		assert.NotEqual(t, original, newMedia)
+	})
+}
@@ -0,0 +1,27 @@
+package tokenprocessing
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryBackoff(t *testing.T) {
+	tests := []struct {
+		attempts int32
+		expected time.Duration
+	}{
+		{0, time.Minute},
+		{1, time.Minute},
+		{2, 2 * time.Minute},
+		{3, 4 * time.Minute},
+		{6, 32 * time.Minute},
+		{7, time.Hour},
+		{100, time.Hour},
+	}
+
+	for _, test := range tests {
+		assert.Equal(t, test.expected, retryBackoff(test.attempts, time.Minute, time.Hour), "attempts=%d", test.attempts)
+	}
+}
@@ -12,7 +12,12 @@ import (
 	"github.com/mikeydub/go-gallery/middleware"
 	"github.com/mikeydub/go-gallery/server"
 	"github.com/mikeydub/go-gallery/service/auth"
+	"github.com/mikeydub/go-gallery/service/dm"
 	"github.com/mikeydub/go-gallery/service/logger"
+	"github.com/mikeydub/go-gallery/service/moderation"
+	"github.com/mikeydub/go-gallery/service/notifications"
+	"github.com/mikeydub/go-gallery/service/pubsub"
+	"github.com/mikeydub/go-gallery/service/push"
 	"github.com/mikeydub/go-gallery/service/redis"
 	sentryutil "github.com/mikeydub/go-gallery/service/sentry"
 	"github.com/mikeydub/go-gallery/service/throttle"
@@ -51,12 +56,25 @@ func coreInitServer() *gin.Engine {
 	c := server.ClientInit(context.Background())
 	mc := server.NewMultichainProvider(c)
 
-	return handlersInitServer(router, mc, c.Repos, c.EthClient, c.IPFSClient, c.ArweaveClient, c.StorageClient, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), t)
+	moderator := moderation.NewModerator(c.Queries, c.StorageClient, moderation.NewLocalClassifier())
+
+	pusher := push.NewPusher(c.Queries, push.NewSendersFromEnv(context.Background()))
+	messenger := dm.NewMessenger(c.Queries, dm.NewSendersFromEnv())
+	notifs := notifications.NewSender(c.Queries, c.PubSub, redis.NewLockClient(redis.NotificationLockDB), pusher, messenger)
+
+	jobs := newJobQueue(c.Queries, processJob(mc, c.Repos.TokenRepository, c.Queries, c.EthClient, c.IPFSClient, c.ArweaveClient, c.StorageClient, env.GetString("GCLOUD_TOKEN_CONTENT_BUCKET"), moderator), notifyMediaProcessed(c.Queries, notifs))
+	jobs.start(context.Background())
+
+	return handlersInitServer(router, jobs, mc, c.Repos, c.Queries, c.StorageClient, t)
 }
 
 func setDefaults() {
 	viper.SetDefault("IPFS_URL", "https://gallery.infura-ipfs.io")
 	viper.SetDefault("IPFS_API_URL", "https://ipfs.infura.io:5001")
+	viper.SetDefault("IPFS_GATEWAYS", "")
+	viper.SetDefault("IPFS_LOCAL_API_URL", "")
+	viper.SetDefault("IPFS_HEDGE_DELAY", "2s")
+	viper.SetDefault("MODERATION_BLOCKED_KEYWORDS", "")
 	viper.SetDefault("IPFS_PROJECT_ID", "")
 	viper.SetDefault("IPFS_PROJECT_SECRET", "")
 	viper.SetDefault("CHAIN", 0)
@@ -72,7 +90,40 @@ func setDefaults() {
 	viper.SetDefault("REDIS_URL", "localhost:6379")
 	viper.SetDefault("SENTRY_DSN", "")
 	viper.SetDefault("IMGIX_API_KEY", "")
+	viper.SetDefault("MEDIA_BLOB_GC_GRACE_PERIOD", "24h")
+	viper.SetDefault("MEDIA_BLOB_GC_BATCH_SIZE", 1000)
+	viper.SetDefault("TOKEN_PROCESSING_WORKERS", 25)
+	viper.SetDefault("TOKEN_PROCESSING_POLL_INTERVAL", "5s")
+	viper.SetDefault("TOKEN_PROCESSING_MAX_ATTEMPTS", 5)
+	viper.SetDefault("TOKEN_PROCESSING_RETRY_BACKOFF", "1m")
+	viper.SetDefault("TOKEN_PROCESSING_MAX_RETRY_BACKOFF", "6h")
+	viper.SetDefault("TOKEN_PROCESSING_STALE_AFTER", "90m")
 	viper.SetDefault("VERSION", "")
+	viper.SetDefault("FEED_URL", "")
+	viper.SetDefault("FEED_SECRET", "feed-secret")
+	viper.SetDefault("GCLOUD_FEED_QUEUE", "projects/gallery-local/locations/here/queues/feed-event")
+	viper.SetDefault("GCLOUD_FEED_BUFFER_SECS", 20)
+	viper.SetDefault("FEED_MAX_ACQUIRED_TOKENS", 25)
+	viper.SetDefault("GOOGLE_CLOUD_PROJECT", "gallery-dev-322005")
+	viper.SetDefault("PUBSUB_BACKEND", pubsub.BackendGCP)
+	viper.SetDefault("PUBSUB_EMULATOR_HOST", "")
+	viper.SetDefault("PUBSUB_TOPIC_NEW_NOTIFICATIONS", "dev-new-notifications")
+	viper.SetDefault("PUBSUB_TOPIC_UPDATED_NOTIFICATIONS", "dev-updated-notifications")
+	viper.SetDefault("GALLERY_HOST", "http://localhost:3000")
+	viper.SetDefault("VAPID_PUBLIC_KEY", "")
+	viper.SetDefault("VAPID_PRIVATE_KEY", "")
+	viper.SetDefault("VAPID_SUBJECT", "mailto:support@gallery.so")
+	viper.SetDefault("APNS_HOST", "https://api.sandbox.push.apple.com")
+	viper.SetDefault("APNS_KEY", "")
+	viper.SetDefault("APNS_KEY_ID", "")
+	viper.SetDefault("APNS_TEAM_ID", "")
+	viper.SetDefault("APNS_TOPIC", "")
+	viper.SetDefault("FCM_HOST", "https://fcm.googleapis.com")
+	viper.SetDefault("FCM_PROJECT_ID", "")
+	viper.SetDefault("TELEGRAM_API_HOST", "https://api.telegram.org")
+	viper.SetDefault("TELEGRAM_BOT_TOKEN", "")
+	viper.SetDefault("DISCORD_API_HOST", "https://discord.com/api/v10")
+	viper.SetDefault("DISCORD_BOT_TOKEN", "")
 
 	viper.AutomaticEnv()
 
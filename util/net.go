@@ -0,0 +1,81 @@
+package util
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// ErrNonPublicAddress is returned when a request would reach an address that isn't on the public internet
+var ErrNonPublicAddress = errors.New("address isn't public")
+
+// carrierGradeNAT is the shared address space of RFC 6598, which isn't covered by net.IP.IsPrivate
+var carrierGradeNAT = &net.IPNet{IP: net.IPv4(100, 64, 0, 0), Mask: net.CIDRMask(10, 32)}
+
+// IsPublicIP returns whether ip is reachable on the public internet, i.e. it isn't loopback, private, link-local,
+// multicast or unspecified
+func IsPublicIP(ip net.IP) bool {
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsMulticast() || ip.IsUnspecified() || carrierGradeNAT.Contains(ip))
+}
+
+// ValidatePublicURL checks that every address that rawURL's host resolves to is public. It's meant to reject user
+// supplied URLs early, but DNS can change afterwards, so requests to them should still be sent with a client from
+// NewPublicHTTPClient.
+func ValidatePublicURL(ctx context.Context, rawURL string) error {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return err
+	}
+
+	host := u.Hostname()
+	if ip := net.ParseIP(host); ip != nil {
+		if !IsPublicIP(ip) {
+			return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+		}
+		return nil
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return err
+	}
+
+	for _, addr := range addrs {
+		if !IsPublicIP(addr.IP) {
+			return fmt.Errorf("%s resolves to %s: %w", host, addr.IP, ErrNonPublicAddress)
+		}
+	}
+
+	return nil
+}
+
+// NewPublicHTTPClient returns a client that refuses to connect to addresses that aren't public. The address is
+// checked when it's dialed, after the host is resolved, so a host can't pass validation and then be pointed
+// somewhere internal.
+func NewPublicHTTPClient(timeout time.Duration) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 30 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !IsPublicIP(ip) {
+				return fmt.Errorf("%s: %w", host, ErrNonPublicAddress)
+			}
+			return nil
+		},
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+
+	return &http.Client{Timeout: timeout, Transport: transport}
+}
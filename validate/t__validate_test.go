@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/go-playground/validator/v10"
@@ -47,6 +48,32 @@ func TestValidate_usernameValidator(pTest *testing.T) {
 	testValidatorWithTestValues(pTest, UsernameValidator, testUsernames)
 }
 
+func TestValidate_hashtagValidator(pTest *testing.T) {
+	var testHashtags = []testValue{
+		{"#punks", "Valid hashtag", true},
+		{"punks", "Valid hashtag without #", true},
+		{"#Généralité_2023", "Valid hashtag with unicode letters", true},
+		{"#2023", "Hashtag must have a letter", false},
+		{"#punks #apes", "Must be a single hashtag", false},
+		{"#punk-rock", "Hashtag must only have letters, numbers and underscores", false},
+		{"#thisHashtagIsTooLongBecauseItHasMoreThanFiftyCharacters", "Hashtag must not be more than 50 characters", false},
+	}
+	testValidatorWithTestValues(pTest, HashtagValidator, testHashtags)
+}
+
+func TestValidate_entitiesAlias(pTest *testing.T) {
+	validate := WithCustomValidators()
+
+	many := ""
+	for i := 0; i < 11; i++ {
+		many += fmt.Sprintf("#tag%c ", 'a'+i)
+	}
+
+	assert.Nil(pTest, validate.Var("gm @alice and @bob #punks #Punks", "caption"), "Repeated hashtags are only counted once")
+	assert.Error(pTest, validate.Var(many, "caption"), "Too many hashtags")
+	assert.Error(pTest, validate.Var(many, "comment"), "Too many hashtags")
+}
+
 func testValidatorWithTestValues(pTest *testing.T, validatorFunc validator.Func, testValues []testValue) {
 	validate := validator.New()
 	validate.RegisterValidation("validatorName", validatorFunc)
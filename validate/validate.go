@@ -5,11 +5,14 @@ import (
 	"net/url"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/mikeydub/go-gallery/db/gen/coredb"
 	"github.com/mikeydub/go-gallery/graphql/model"
+	"github.com/mikeydub/go-gallery/service/entity"
 	"github.com/mikeydub/go-gallery/service/persist"
+	"github.com/mikeydub/go-gallery/service/webhook"
 	"golang.org/x/exp/slices"
 
 	"github.com/go-playground/validator/v10"
@@ -136,11 +139,17 @@ func RegisterCustomValidators(v *validator.Validate) {
 	v.RegisterValidation("role", IsValidRole)
 	v.RegisterValidation("created_collections", CreatedCollectionsValidator)
 	v.RegisterValidation("http", HTTPValidator)
+	v.RegisterValidation("max_mentions", MaxMentionsValidator)
+	v.RegisterValidation("max_hashtags", MaxHashtagsValidator)
+	v.RegisterValidation("hashtag", HashtagValidator)
+	v.RegisterValidation("webhook_action", WebhookActionValidator)
+	v.RegisterAlias("entities", "max_mentions=10,max_hashtags=10")
 	v.RegisterAlias("collection_name", "max=200")
-	v.RegisterAlias("collection_note", "max=600")
-	v.RegisterAlias("token_note", "max=1200")
+	v.RegisterAlias("collection_note", "max=600,entities")
+	v.RegisterAlias("token_note", "max=1200,entities")
 	v.RegisterAlias("bio", "max=600")
-	v.RegisterAlias("caption", "max=600")
+	v.RegisterAlias("caption", "max=600,entities")
+	v.RegisterAlias("comment", "entities")
 
 	v.RegisterStructValidation(ChainAddressValidator, persist.ChainAddress{})
 	v.RegisterStructValidation(ConnectionPaginationParamsValidator, ConnectionPaginationParams{})
@@ -153,6 +162,11 @@ var IsValidRole validator.Func = func(fl validator.FieldLevel) bool {
 	return role == persist.RoleAdmin || role == persist.RoleBetaTester
 }
 
+// WebhookActionValidator validates that an action is one that webhooks can subscribe to
+var WebhookActionValidator validator.Func = func(fl validator.FieldLevel) bool {
+	return slices.Contains(webhook.Actions, persist.Action(fl.Field().String()))
+}
+
 func ChainAddressValidator(sl validator.StructLevel) {
 	chainAddress := sl.Current().Interface().(persist.ChainAddress)
 
@@ -305,12 +319,39 @@ var CreatedCollectionsValidator validator.Func = func(fl validator.FieldLevel) b
 	return true
 }
 
+// MaxMentionsValidator ensures that text doesn't mention more distinct users than the tag's parameter, e.g. max_mentions=10
+var MaxMentionsValidator validator.Func = func(fl validator.FieldLevel) bool {
+	return len(entity.Mentions(fl.Field().String())) <= asInt(fl.Param())
+}
+
+// MaxHashtagsValidator ensures that text doesn't have more distinct hashtags than the tag's parameter, e.g. max_hashtags=10
+var MaxHashtagsValidator validator.Func = func(fl validator.FieldLevel) bool {
+	return len(entity.Hashtags(fl.Field().String())) <= asInt(fl.Param())
+}
+
+// HashtagValidator ensures that a string is a single hashtag, with or without its leading #
+var HashtagValidator validator.Func = func(fl validator.FieldLevel) bool {
+	s := fl.Field().String()
+	if s == "" {
+		return true
+	}
+	return entity.IsHashtag(s)
+}
+
 // ChainValidator ensures the specified Chain is one we support
 var ChainValidator validator.Func = func(fl validator.FieldLevel) bool {
 	chain := fl.Field().Int()
 	return chain >= 0 && chain <= int64(persist.MaxChainValue)
 }
 
+func asInt(param string) int {
+	i, err := strconv.Atoi(param)
+	if err != nil {
+		panic(fmt.Sprintf("bad validation parameter %q: %s", param, err))
+	}
+	return i
+}
+
 func consecutivePeriodsOrUnderscores(s string) bool {
 	for i, r := range s {
 		if r == '.' || r == '_' {